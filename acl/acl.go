@@ -0,0 +1,360 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package acl implements access control lists for varlink servers.
+//
+// An access control list is a list of rules, each allowing or denying the
+// calls to a set of methods to a set of peers. Policies are typically loaded
+// from a JSON configuration file:
+//
+//	{
+//		"default": "deny",
+//		"rules": [
+//			{"methods": ["org.varlink.service.*"], "action": "allow"},
+//			{"methods": ["org.example.admin.*"], "users": ["root"], "action": "allow"},
+//			{"methods": ["org.example.admin.*"], "action": "deny"},
+//			{"methods": ["org.example.*"], "groups": ["example"], "action": "allow"}
+//		]
+//	}
+//
+// and enforced by installing Policy.Intercept as an interceptor of the
+// server:
+//
+//	policy, err := acl.LoadFile("/etc/example/acl.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	go policy.WatchFile(ctx, "/etc/example/acl.json", 10*time.Second)
+//
+//	server := varlink.Server{
+//		Handler:      &mux,
+//		Interceptors: []varlink.Interceptor{policy.Intercept},
+//	}
+package acl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/user"
+	"path"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+// Action is the action taken by a rule on the calls it matches.
+type Action string
+
+const (
+	Allow Action = "allow"
+	Deny  Action = "deny"
+)
+
+// Config is the configuration of an access control list.
+type Config struct {
+	// Default is the action taken on calls that no rule matches. The
+	// default is Deny.
+	Default Action `json:"default,omitempty"`
+
+	// Rules are evaluated in order, and the first rule matching a call
+	// decides whether the call is allowed.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is a rule of an access control list.
+//
+// A rule matches a call if the method matches one of its patterns, and the
+// peer is one of its users or a member of one of its groups. A rule without
+// users or groups matches any peer, including peers whose credentials are
+// not known, like peers connected over tcp.
+type Rule struct {
+	// Methods are patterns matched against fully-qualified method names,
+	// with the syntax of path.Match.
+	Methods []string `json:"methods"`
+
+	// Users are user names or numeric user IDs.
+	Users []string `json:"users,omitempty"`
+
+	// Groups are group names or numeric group IDs. Both the primary and
+	// supplementary groups of the peer are considered.
+	Groups []string `json:"groups,omitempty"`
+
+	// Action is the action taken on the calls matched by the rule.
+	Action Action `json:"action"`
+}
+
+// compiledRule is a rule whose users and groups have been resolved to IDs.
+type compiledRule struct {
+	methods []string
+	uids    []int
+	gids    []int
+	allow   bool
+}
+
+type compiledConfig struct {
+	rules []compiledRule
+	allow bool
+
+	// groups caches the groups of the users making calls.
+	groups sync.Map // map[int][]int
+}
+
+// Policy enforces an access control list. Its configuration can be replaced
+// at any time, which lets it be reloaded while the server is running.
+type Policy struct {
+	// Logger, if set, receives an audit record for each denied call, and
+	// errors that occur when reloading the configuration from WatchFile.
+	Logger *slog.Logger
+
+	config atomic.Pointer[compiledConfig]
+}
+
+// NewPolicy returns a policy enforcing the specified configuration.
+func NewPolicy(config *Config) (*Policy, error) {
+	var p Policy
+	if err := p.Update(config); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// LoadFile returns a policy enforcing the configuration in the specified
+// file.
+func LoadFile(name string) (*Policy, error) {
+	var p Policy
+	if err := p.ReloadFile(name); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Update replaces the configuration of the policy. If the configuration is
+// invalid, the previous configuration stays in effect.
+func (p *Policy) Update(config *Config) error {
+	compiled, err := compile(config)
+	if err != nil {
+		return err
+	}
+	p.config.Store(compiled)
+	return nil
+}
+
+// ReloadFile replaces the configuration of the policy with the configuration
+// in the specified file. If the configuration is invalid, the previous
+// configuration stays in effect.
+func (p *Policy) ReloadFile(name string) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if err := p.Update(&config); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+func compile(config *Config) (*compiledConfig, error) {
+	var compiled compiledConfig
+
+	switch config.Default {
+	case Allow:
+		compiled.allow = true
+	case Deny, "":
+	default:
+		return nil, fmt.Errorf("invalid default action %q", config.Default)
+	}
+
+	for i, rule := range config.Rules {
+		var cr compiledRule
+
+		switch rule.Action {
+		case Allow:
+			cr.allow = true
+		case Deny:
+		default:
+			return nil, fmt.Errorf("rule %d: invalid action %q", i, rule.Action)
+		}
+
+		if len(rule.Methods) == 0 {
+			return nil, fmt.Errorf("rule %d: no methods", i)
+		}
+		for _, pattern := range rule.Methods {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("rule %d: method pattern %q: %w", i, pattern, err)
+			}
+		}
+		cr.methods = rule.Methods
+
+		for _, name := range rule.Users {
+			uid, err := lookupID(name, func(name string) (string, error) {
+				u, err := user.Lookup(name)
+				if err != nil {
+					return "", err
+				}
+				return u.Uid, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+			cr.uids = append(cr.uids, uid)
+		}
+
+		for _, name := range rule.Groups {
+			gid, err := lookupID(name, func(name string) (string, error) {
+				g, err := user.LookupGroup(name)
+				if err != nil {
+					return "", err
+				}
+				return g.Gid, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+			cr.gids = append(cr.gids, gid)
+		}
+
+		compiled.rules = append(compiled.rules, cr)
+	}
+	return &compiled, nil
+}
+
+func lookupID(name string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(name); err == nil {
+		return id, nil
+	}
+	id, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(id)
+}
+
+// Check returns whether the peer with the specified credentials is allowed
+// to call the method, and the index of the rule that decided it, or -1 if
+// the default action applies. The credentials are nil if they are not known.
+func (p *Policy) Check(method string, creds *varlink.PeerCredentials) (allowed bool, rule int) {
+	config := p.config.Load()
+	if config == nil {
+		return false, -1
+	}
+
+	for i := range config.rules {
+		if config.matches(&config.rules[i], method, creds) {
+			return config.rules[i].allow, i
+		}
+	}
+	return config.allow, -1
+}
+
+func (config *compiledConfig) matches(rule *compiledRule, method string, creds *varlink.PeerCredentials) bool {
+	matched := slices.ContainsFunc(rule.methods, func(pattern string) bool {
+		ok, _ := path.Match(pattern, method)
+		return ok
+	})
+	if !matched {
+		return false
+	}
+
+	if len(rule.uids) == 0 && len(rule.gids) == 0 {
+		return true
+	}
+	if creds == nil {
+		return false
+	}
+	if slices.Contains(rule.uids, creds.UID) {
+		return true
+	}
+	if len(rule.gids) == 0 {
+		return false
+	}
+	if slices.Contains(rule.gids, creds.GID) {
+		return true
+	}
+	for _, gid := range config.groupsOf(creds.UID) {
+		if slices.Contains(rule.gids, gid) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupsOf returns the supplementary groups of the specified user.
+func (config *compiledConfig) groupsOf(uid int) []int {
+	if groups, ok := config.groups.Load(uid); ok {
+		return groups.([]int)
+	}
+
+	var gids []int
+	if u, err := user.LookupId(strconv.Itoa(uid)); err == nil {
+		ids, _ := u.GroupIds()
+		for _, id := range ids {
+			if gid, err := strconv.Atoi(id); err == nil {
+				gids = append(gids, gid)
+			}
+		}
+	}
+	config.groups.Store(uid, gids)
+	return gids
+}
+
+// Intercept is a varlink.Interceptor that enforces the policy. Denied calls
+// fail with org.varlink.service.PermissionDenied.
+func (p *Policy) Intercept(next varlink.MethodHandler) varlink.MethodHandler {
+	return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		ctx := w.Context()
+
+		var creds *varlink.PeerCredentials
+		session := varlink.SessionFromContext(ctx)
+		if session != nil {
+			creds, _ = session.PeerCredentials()
+		}
+
+		allowed, rule := p.Check(call.Method, creds)
+		if !allowed {
+			p.audit(ctx, call, session, creds, rule)
+			w.WriteError(service.PermissionDenied())
+			return
+		}
+		next.ServeMethod(w, call)
+	})
+}
+
+func (p *Policy) audit(ctx context.Context, call *varlink.Call, session *varlink.Session, creds *varlink.PeerCredentials, rule int) {
+	if p.Logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", call.Method),
+		slog.Int("rule", rule),
+	}
+	if session != nil {
+		if addr := session.RemoteAddr(); addr != nil {
+			attrs = append(attrs, slog.String("addr", addr.String()))
+		}
+	}
+	if creds != nil {
+		attrs = append(attrs,
+			slog.Int("pid", creds.PID),
+			slog.Int("uid", creds.UID),
+			slog.Int("gid", creds.GID))
+	}
+	if md := varlink.MetadataFromContext(ctx); md != nil {
+		attrs = append(attrs, slog.Any("metadata", md))
+	}
+	p.Logger.LogAttrs(ctx, slog.LevelWarn, "varlink call denied", attrs...)
+}
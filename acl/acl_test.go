@@ -0,0 +1,117 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package acl_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/acl"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+func TestCheck(t *testing.T) {
+	policy, err := acl.NewPolicy(&acl.Config{
+		Rules: []acl.Rule{
+			{Methods: []string{"org.example.admin.*"}, Users: []string{"0"}, Action: acl.Allow},
+			{Methods: []string{"org.example.admin.*"}, Action: acl.Deny},
+			{Methods: []string{"org.example.*"}, Groups: []string{"100"}, Action: acl.Allow},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := &varlink.PeerCredentials{UID: 0, GID: 0}
+	user := &varlink.PeerCredentials{UID: 1000, GID: 100}
+
+	tcases := []struct {
+		method  string
+		creds   *varlink.PeerCredentials
+		allowed bool
+		rule    int
+	}{
+		{"org.example.admin.Reboot", root, true, 0},
+		{"org.example.admin.Reboot", user, false, 1},
+		{"org.example.admin.Reboot", nil, false, 1},
+		{"org.example.Ping", user, true, 2},
+		{"org.example.Ping", nil, false, -1},
+		{"org.other.Ping", root, false, -1},
+	}
+
+	for _, tcase := range tcases {
+		allowed, rule := policy.Check(tcase.method, tcase.creds)
+		if allowed != tcase.allowed || rule != tcase.rule {
+			t.Errorf("Check(%q, %+v) = %v, %d; expected %v, %d",
+				tcase.method, tcase.creds, allowed, rule, tcase.allowed, tcase.rule)
+		}
+	}
+}
+
+func TestIntercept(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "acl.json")
+
+	err := os.WriteFile(config, []byte(`{
+		"rules": [
+			{"methods": ["org.example.Allowed"], "users": ["`+strconv.Itoa(os.Getuid())+`"], "action": "allow"}
+		]
+	}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := acl.LoadFile(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.*", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+
+	l, err := net.Listen("unix", filepath.Join(dir, "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	server := varlink.Server{
+		Handler:      &mux,
+		Interceptors: []varlink.Interceptor{policy.Intercept},
+	}
+	go server.Serve(l)
+
+	uri := "unix:" + l.Addr().String()
+	for method, expected := range map[string]string{
+		"org.example.Allowed": "",
+		"org.example.Denied":  service.PermissionDenied().ErrorCode(),
+	} {
+		rs, err := varlink.DoCallContext(context.Background(), method, nil, varlink.CallURI(uri))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs.Next()
+
+		var code string
+		var verr varlink.Error
+		if errors.As(rs.Error(), &verr) {
+			code = verr.ErrorCode()
+		} else if err := rs.Error(); err != nil {
+			t.Fatal(err)
+		}
+		if code != expected {
+			t.Errorf("%s: got error %q, expected %q", method, code, expected)
+		}
+	}
+}
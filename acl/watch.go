@@ -0,0 +1,66 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package acl
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// WatchFile reloads the configuration of the policy from the specified file
+// whenever it changes, until ctx becomes done. The file is polled at the
+// specified interval.
+//
+// If the new configuration cannot be loaded, the previous configuration
+// stays in effect, and the error is logged to the Logger of the policy.
+//
+// WatchFile always returns the context error.
+func (p *Policy) WatchFile(ctx context.Context, name string, interval time.Duration) error {
+	var last os.FileInfo
+	if fi, err := os.Stat(name); err == nil {
+		last = fi
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		fi, err := os.Stat(name)
+		if err != nil {
+			p.logReload(ctx, name, err)
+			continue
+		}
+		if last != nil && fi.ModTime().Equal(last.ModTime()) && fi.Size() == last.Size() {
+			continue
+		}
+		last = fi
+
+		if err := p.ReloadFile(name); err != nil {
+			p.logReload(ctx, name, err)
+			continue
+		}
+		if p.Logger != nil {
+			p.Logger.LogAttrs(ctx, slog.LevelInfo, "access control list reloaded", slog.String("file", name))
+		}
+	}
+}
+
+func (p *Policy) logReload(ctx context.Context, name string, err error) {
+	if p.Logger == nil {
+		return
+	}
+	p.Logger.LogAttrs(ctx, slog.LevelError, "failed to reload access control list",
+		slog.String("file", name),
+		slog.Any("error", err))
+}
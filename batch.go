@@ -0,0 +1,91 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import "time"
+
+// DefaultBatchInterval is the longest time a one-way call is kept in a
+// batch when OneWayBatching.Interval is zero.
+const DefaultBatchInterval = 10 * time.Millisecond
+
+// OneWayBatching controls the batching of the one-way calls written to a
+// session. Instead of being flushed to the connection one by one, batched
+// calls are buffered and flushed together once the batch is full or old
+// enough, which saves system calls and packets when emitting many events
+// or telemetry calls in bursts.
+//
+// Any other message written to the session, like a call expecting replies,
+// flushes the batch first, so messages are still sent in order. Calls
+// passing file descriptors are never batched.
+type OneWayBatching struct {
+	// MaxCalls is the number of calls in a batch that triggers a flush. If
+	// zero, the number of calls is not limited.
+	MaxCalls int
+
+	// MaxBytes is the size of the calls in a batch that triggers a flush.
+	// If zero, the size is not limited, beyond the size of the write
+	// buffer of the session.
+	MaxBytes int
+
+	// Interval is the longest time a call is kept in a batch. If zero,
+	// DefaultBatchInterval is used.
+	Interval time.Duration
+}
+
+// BatchOneWay enables the batching of the one-way calls written to the
+// session, or disables it if batching is nil. See OneWayBatching.
+//
+// BatchOneWay must be called before the session is used.
+func (session *Session) BatchOneWay(batching *OneWayBatching) {
+	session.batching = batching
+}
+
+// Flush writes the calls of the current batch to the connection. It is
+// only useful to sessions batching one-way calls, for instance before
+// waiting for a while; see BatchOneWay.
+func (session *Session) Flush() error {
+	session.wmu.Lock()
+	defer session.wmu.Unlock()
+
+	if session.conn == nil {
+		return nil
+	}
+	return session.flushUnlocked()
+}
+
+// flushUnlocked flushes the buffered messages, and resets the current
+// batch. It must be called with wmu held.
+func (session *Session) flushUnlocked() error {
+	session.batchCalls, session.batchBytes = 0, 0
+	if session.batchTimer != nil {
+		session.batchTimer.Stop()
+		session.batchTimer = nil
+	}
+	return session.rw.Flush()
+}
+
+// batchUnlocked adds a one-way call of the specified size, just written to
+// the write buffer, to the current batch, and flushes the batch if it is
+// full. It must be called with wmu held.
+func (session *Session) batchUnlocked(size int) error {
+	b := session.batching
+	session.batchCalls++
+	session.batchBytes += size
+
+	if (b.MaxCalls > 0 && session.batchCalls >= b.MaxCalls) || (b.MaxBytes > 0 && session.batchBytes >= b.MaxBytes) {
+		return session.flushUnlocked()
+	}
+	if session.batchTimer == nil {
+		interval := b.Interval
+		if interval <= 0 {
+			interval = DefaultBatchInterval
+		}
+		session.batchTimer = session.clock.AfterFunc(interval, func() {
+			session.Flush()
+		})
+	}
+	return nil
+}
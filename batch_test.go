@@ -0,0 +1,85 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// writeCountingConn counts the writes made to a connection.
+type writeCountingConn struct {
+	net.Conn
+	writes atomic.Int32
+}
+
+func (c *writeCountingConn) Write(p []byte) (int, error) {
+	c.writes.Add(1)
+	return c.Conn.Write(p)
+}
+
+func TestBatchOneWay(t *testing.T) {
+	events := make(chan string, 100)
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Event", func(w varlink.ReplyWriter, call *varlink.Call) {
+		events <- string(call.Parameters)
+	})
+	mux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+	server := varlink.Server{Handler: &mux}
+
+	c1, c2 := net.Pipe()
+	go server.ServeConn(context.Background(), c2)
+
+	conn := &writeCountingConn{Conn: c1}
+	session := varlink.NewSession(conn)
+	defer session.Close()
+	session.BatchOneWay(&varlink.OneWayBatching{MaxCalls: 5, Interval: time.Hour})
+
+	ctx := context.Background()
+	event := func(i int) {
+		t.Helper()
+		call, _ := varlink.MakeCall("org.example.Event", map[string]int{"n": i}, varlink.OneWay())
+		if err := session.WriteCall(ctx, &call); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range 10 {
+		event(i)
+	}
+	if n := conn.writes.Load(); n != 2 {
+		t.Fatalf("10 one-way calls took %d writes, expected 2 batches of 5", n)
+	}
+
+	// A call expecting a reply flushes the pending one-way calls first.
+	event(10)
+	call, _ := varlink.MakeCall("org.example.Ping", nil)
+	if err := session.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	rs := varlink.NewReplyStream(ctx, &call, session)
+	for rs.Next() {
+	}
+	if err := rs.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 11 {
+		select {
+		case <-events:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d one-way calls, expected 11", i)
+		}
+	}
+}
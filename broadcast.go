@@ -0,0 +1,246 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// DefaultBroadcastBuffer is the number of events buffered per subscriber
+// when Broadcaster.Buffer is zero.
+const DefaultBroadcastBuffer = 64
+
+// ErrorSlowConsumer is the error code ending the subscriptions of clients
+// that do not keep up with the events of a Broadcaster using the
+// DisconnectSlow policy.
+const ErrorSlowConsumer = `snai.pe.varlink.SlowConsumer`
+
+// ErrBroadcasterClosed is returned by Broadcaster.Publish and
+// Broadcaster.Subscribe once the broadcaster has been closed.
+var ErrBroadcasterClosed = errors.New("broadcaster is closed")
+
+// SlowConsumerPolicy is what a Broadcaster does when the buffer of a
+// subscriber is full.
+type SlowConsumerPolicy int
+
+const (
+	// DropEvents drops the events that do not fit in the buffer of the
+	// subscriber. See Broadcaster.Dropped.
+	DropEvents SlowConsumerPolicy = iota
+
+	// DisconnectSlow ends the subscription with an ErrorSlowConsumer error.
+	DisconnectSlow
+
+	// BlockPublisher makes Publish wait until the subscriber has room for
+	// the event, which slows all subscribers down to the slowest one.
+	BlockPublisher
+)
+
+// Broadcaster fans events of type T out to the clients subscribed to them
+// with calls made with the `more` flag. Services emitting notifications
+// typically serve the method with Subscribe, and call Publish whenever
+// something happens:
+//
+//	var events varlink.Broadcaster[Event]
+//
+//	mux.HandleFunc("org.example.Monitor", func(w varlink.ReplyWriter, call *varlink.Call) {
+//		if err := events.Subscribe(w, call); errors.Is(err, varlink.ErrBroadcasterClosed) {
+//			w.WriteFinal(nil)
+//		}
+//	})
+//
+//	events.Publish(ctx, Event{...})
+//
+// The zero value is a broadcaster ready to use. A Broadcaster must not be
+// copied after first use.
+type Broadcaster[T any] struct {
+	// Buffer is the number of events buffered per subscriber. If zero,
+	// DefaultBroadcastBuffer is used.
+	Buffer int
+
+	// Policy is what to do when the buffer of a subscriber is full.
+	Policy SlowConsumerPolicy
+
+	pmu     sync.Mutex // serializes Publish
+	mu      sync.Mutex
+	subs    map[*subscriber[T]]struct{}
+	closing chan struct{}
+	closed  bool
+	dropped atomic.Int64
+}
+
+type subscriber[T any] struct {
+	events chan T
+	slow   chan struct{} // closed when disconnected for being too slow
+	done   chan struct{} // closed once the subscription ended
+}
+
+// init must be called with b.mu held.
+func (b *Broadcaster[T]) init() {
+	if b.subs == nil {
+		b.subs = make(map[*subscriber[T]]struct{})
+		b.closing = make(chan struct{})
+	}
+}
+
+// Subscribe subscribes the client making the call to the events of the
+// broadcaster, and writes them as replies until the client goes away, or
+// the broadcaster is closed.
+//
+// The call must have the `more` flag, otherwise Subscribe replies with
+// org.varlink.service.ExpectedMore. Subscribe returns nil once it has
+// written a final reply, like for slow clients disconnected by the
+// DisconnectSlow policy. Otherwise, it returns why the subscription ended
+// without writing a final reply, which the handler is responsible for if
+// the client is still there: ErrBroadcasterClosed once the events buffered
+// for the subscriber have been written after Close, or the error of the
+// context of the call, or of a failed write.
+func (b *Broadcaster[T]) Subscribe(w ReplyWriter, call *Call) error {
+	if !call.More {
+		return w.WriteError(service.ExpectedMore())
+	}
+
+	size := b.Buffer
+	if size <= 0 {
+		size = DefaultBroadcastBuffer
+	}
+	s := &subscriber[T]{
+		events: make(chan T, size),
+		slow:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrBroadcasterClosed
+	}
+	b.init()
+	b.subs[s] = struct{}{}
+	closing := b.closing
+	b.mu.Unlock()
+
+	defer func() {
+		close(s.done)
+		b.remove(s)
+	}()
+
+	ctx := w.Context()
+	for {
+		select {
+		case ev := <-s.events:
+			if err := w.WriteMore(ev); err != nil {
+				return err
+			}
+		case <-s.slow:
+			return w.WriteError(NewError(ErrorSlowConsumer))
+		case <-closing:
+			for {
+				select {
+				case ev := <-s.events:
+					if err := w.WriteMore(ev); err != nil {
+						return err
+					}
+				default:
+					return ErrBroadcasterClosed
+				}
+			}
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+	}
+}
+
+// remove unsubscribes s, and returns whether it was subscribed.
+func (b *Broadcaster[T]) remove(s *subscriber[T]) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.subs[s]
+	delete(b.subs, s)
+	return ok
+}
+
+// Publish sends an event to all current subscribers, applying the slow
+// consumer policy to those whose buffer is full. Only the BlockPublisher
+// policy makes Publish wait, in which case it returns the error of ctx if
+// it becomes done first.
+//
+// Events are delivered to each subscriber in the order in which they were
+// published; concurrent calls to Publish are serialized.
+func (b *Broadcaster[T]) Publish(ctx context.Context, event T) error {
+	b.pmu.Lock()
+	defer b.pmu.Unlock()
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return ErrBroadcasterClosed
+	}
+	subs := slices.Collect(maps.Keys(b.subs))
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		select {
+		case s.events <- event:
+			continue
+		default:
+		}
+
+		switch b.Policy {
+		case DropEvents:
+			b.dropped.Add(1)
+		case DisconnectSlow:
+			if b.remove(s) {
+				close(s.slow)
+			}
+		case BlockPublisher:
+			select {
+			case s.events <- event:
+			case <-s.done:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// Subscribers returns the number of current subscribers.
+func (b *Broadcaster[T]) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Dropped returns the number of events dropped by the DropEvents policy,
+// counting once per subscriber that missed them.
+func (b *Broadcaster[T]) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// Close closes the broadcaster. Subscriptions end once the events buffered
+// for them have been written, and further calls to Publish and Subscribe
+// return ErrBroadcasterClosed.
+func (b *Broadcaster[T]) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.init()
+	b.closed = true
+	close(b.closing)
+	return nil
+}
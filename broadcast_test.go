@@ -0,0 +1,139 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// gatedWriter is a ReplyWriter whose writes wait for the gate to open.
+type gatedWriter struct {
+	varlink.ReplyWriter
+	gate chan struct{}
+
+	mu      sync.Mutex
+	replies []any
+	err     varlink.Error
+}
+
+func newGatedWriter() *gatedWriter {
+	return &gatedWriter{gate: make(chan struct{})}
+}
+
+func (w *gatedWriter) Context() context.Context { return context.Background() }
+
+func (w *gatedWriter) WriteMore(params any, opts ...varlink.ReplyOption) error {
+	<-w.gate
+	w.mu.Lock()
+	w.replies = append(w.replies, params)
+	w.mu.Unlock()
+	return nil
+}
+
+func (w *gatedWriter) WriteError(err varlink.Error) error {
+	w.mu.Lock()
+	w.err = err
+	w.mu.Unlock()
+	return nil
+}
+
+func waitSubscribers(t *testing.T, b *varlink.Broadcaster[int], n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for b.Subscribers() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d subscribers, expected %d", b.Subscribers(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBroadcasterPolicies(t *testing.T) {
+	ctx := context.Background()
+	call := &varlink.Call{Method: "org.example.Monitor", More: true}
+
+	t.Run("drop", func(t *testing.T) {
+		b := &varlink.Broadcaster[int]{Buffer: 1, Policy: varlink.DropEvents}
+		w := newGatedWriter()
+		done := make(chan error)
+		go func() { done <- b.Subscribe(w, call) }()
+		waitSubscribers(t, b, 1)
+
+		// At most one event is being written and one is buffered, so at
+		// least one of three is dropped.
+		for i := range 3 {
+			b.Publish(ctx, i)
+		}
+		b.Close()
+		close(w.gate)
+
+		if err := <-done; !errors.Is(err, varlink.ErrBroadcasterClosed) {
+			t.Fatalf("subscription ended with %v, expected ErrBroadcasterClosed", err)
+		}
+		if b.Dropped() == 0 || int(b.Dropped())+len(w.replies) != 3 {
+			t.Fatalf("got events %v with %d dropped, expected 3 events in total", w.replies, b.Dropped())
+		}
+		if w.replies[0] != any(0) {
+			t.Fatalf("got events %v, expected the first event to be delivered", w.replies)
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		b := &varlink.Broadcaster[int]{Buffer: 1, Policy: varlink.DisconnectSlow}
+		w := newGatedWriter()
+		done := make(chan error)
+		go func() { done <- b.Subscribe(w, call) }()
+		waitSubscribers(t, b, 1)
+
+		for i := range 3 {
+			b.Publish(ctx, i)
+		}
+		close(w.gate)
+
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+		if w.err == nil || w.err.ErrorCode() != varlink.ErrorSlowConsumer {
+			t.Fatalf("subscription ended with %v, expected %s", w.err, varlink.ErrorSlowConsumer)
+		}
+		if n := b.Subscribers(); n != 0 {
+			t.Fatalf("got %d subscribers after disconnection, expected 0", n)
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		b := &varlink.Broadcaster[int]{Buffer: 1, Policy: varlink.BlockPublisher}
+		w := newGatedWriter()
+		done := make(chan error)
+		go func() { done <- b.Subscribe(w, call) }()
+		waitSubscribers(t, b, 1)
+
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		var err error
+		for i := 0; err == nil; i++ {
+			err = b.Publish(timeout, i)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("publishing to a blocked subscriber failed with %v, expected a timeout", err)
+		}
+
+		close(w.gate)
+		b.Close()
+		<-done
+		for i, ev := range w.replies {
+			if ev != any(i) {
+				t.Fatalf("got events %v, expected all events in order", w.replies)
+			}
+		}
+	})
+}
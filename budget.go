@@ -0,0 +1,85 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// ErrProtocol is wrapped by the errors returned when reading messages that
+// fail to decode, for instance because they are not valid JSON.
+var ErrProtocol = errors.New("protocol error")
+
+// ErrorProtocol is the error code of the reply that a Server writes before
+// closing a session that exceeded its DecodeErrorBudget.
+const ErrorProtocol = `snai.pe.varlink.ProtocolError`
+
+// errSkipMessage is returned when reading a message that failed to decode,
+// but is tolerated by the decode error budget of the session.
+var errSkipMessage = errors.New("skipped message")
+
+// DecodeErrorBudget is the number of messages failing to decode that a
+// session tolerates from its peer within a time window. Messages within the
+// budget are skipped, as if they were not received; the next one fails the
+// read with an error wrapping ErrProtocol, after which the session must be
+// closed.
+//
+// Decode errors are counted in SessionStats.DecodeErrors, whether they are
+// tolerated or not. The zero value tolerates no errors.
+type DecodeErrorBudget struct {
+	// Max is the number of decode errors tolerated within Window.
+	Max int
+
+	// Window is the time window of the budget. If zero, it is one minute.
+	Window time.Duration
+}
+
+// SetDecodeErrorBudget sets the number of messages failing to decode that
+// the session tolerates. It must be called before the session is used.
+func (session *Session) SetDecodeErrorBudget(budget DecodeErrorBudget) {
+	session.decodeBudget = budget
+}
+
+// decodeError accounts for a message that failed to decode, and returns
+// errSkipMessage if the budget of the session tolerates it. The file
+// descriptors received with the message are closed, since nothing can
+// collect them.
+func (session *Session) decodeError(err error, fds []uintptr) error {
+	for _, fd := range fds {
+		os.NewFile(fd, "").Close()
+	}
+	if session.stats != nil {
+		session.stats.DecodeErrors.Add(1)
+	}
+	err = fmt.Errorf("%w: decoding message: %w", ErrProtocol, err)
+
+	budget := session.decodeBudget
+	if budget.Max <= 0 {
+		return err
+	}
+	window := budget.Window
+	if window <= 0 {
+		window = time.Minute
+	}
+
+	// Only the times of the errors within the window are kept.
+	now := session.clock.Now()
+	recent := session.decodeErrors[:0]
+	for _, t := range session.decodeErrors {
+		if now.Sub(t) < window {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= budget.Max {
+		session.decodeErrors = recent
+		return fmt.Errorf("%w (more than %d errors in %v)", err, budget.Max, window)
+	}
+	session.decodeErrors = append(recent, now)
+	return errSkipMessage
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrMoreReplies is returned by calls expecting exactly one reply when the
+// service replies more than once.
+var ErrMoreReplies = errors.New("more than one reply on single-reply call")
+
+// CallError is the error reported by a CallGroup for a failed call.
+type CallError struct {
+	// Method is the method that was called.
+	Method string
+
+	// Err is the error returned by the call.
+	Err error
+}
+
+func (err *CallError) Error() string {
+	return fmt.Sprintf("%s: %v", err.Method, err.Err)
+}
+
+func (err *CallError) Unwrap() error {
+	return err.Err
+}
+
+// CallGroup runs a group of method calls concurrently, under a shared
+// context, and collects their errors.
+//
+// A typical use is to fan out to several services and wait for all of
+// them to reply:
+//
+//	g := varlink.NewCallGroup(ctx, nil)
+//	a := varlink.GroupCall[AOutput](g, "org.example.a.Get", nil)
+//	b := varlink.GroupCall[BOutput](g, "org.example.b.Get", nil)
+//	if err := g.Wait(); err != nil {
+//		return err
+//	}
+//	// use a.Value and b.Value
+//
+// To enforce a timeout on all calls of the group, derive the context
+// passed to NewCallGroup with context.WithTimeout.
+type CallGroup struct {
+	// FailFast, if true, cancels the context of the group as soon as one
+	// call fails. The calls failing as a consequence of that cancellation
+	// are not reported by Wait.
+	FailFast bool
+
+	client *Client
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// NewCallGroup creates a new call group whose calls are made with the
+// specified client, and under a context derived from ctx.
+//
+// If client is nil, DefaultClient is used.
+func NewCallGroup(ctx context.Context, client *Client) *CallGroup {
+	if client == nil {
+		client = DefaultClient
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &CallGroup{client: client, ctx: ctx, cancel: cancel}
+}
+
+// Context returns the context shared by all calls of the group. It is
+// canceled when Wait returns, or when a call fails if FailFast is set.
+func (g *CallGroup) Context() context.Context {
+	return g.ctx
+}
+
+// Client returns the client used by the calls of the group.
+func (g *CallGroup) Client() *Client {
+	return g.client
+}
+
+// Go runs fn in a new goroutine with the context of the group. If fn
+// returns a non-nil error, it is reported by Wait as a *CallError for the
+// specified method.
+//
+// Go is typically used to call methods of generated clients:
+//
+//	g.Go("org.example.Ping", func(ctx context.Context) (err error) {
+//		pong, err = client.Ping(ctx, "hello")
+//		return err
+//	})
+func (g *CallGroup) Go(method string, fn func(ctx context.Context) error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		err := fn(g.ctx)
+		if err == nil {
+			return
+		}
+		g.fail(&CallError{Method: method, Err: err})
+	}()
+}
+
+func (g *CallGroup) fail(err *CallError) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.FailFast && len(g.errs) > 0 && errors.Is(err, context.Canceled) {
+		if _, ok := context.Cause(g.ctx).(*CallError); ok {
+			return
+		}
+	}
+	g.errs = append(g.errs, err)
+	if g.FailFast {
+		g.cancel(err)
+	}
+}
+
+// Wait waits for all calls of the group to complete, then cancels the
+// context of the group.
+//
+// Wait returns nil if all calls succeeded. Otherwise, it returns the
+// errors of the failed calls joined with errors.Join, each being a
+// *CallError.
+func (g *CallGroup) Wait() error {
+	g.wg.Wait()
+	g.cancel(context.Canceled)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return errors.Join(g.errs...)
+}
+
+// CallResult holds the result of a call made with GroupCall.
+//
+// Its fields must not be accessed before the Wait method of the group
+// returns.
+type CallResult[T any] struct {
+	// Value is the output of the call.
+	Value T
+
+	// Err is the error returned by the call, if any.
+	Err error
+}
+
+// GroupCall calls the specified method with the specified parameters as
+// part of the group, and unmarshals its reply into the Value field of the
+// returned result.
+//
+// The method is expected to reply exactly once.
+func GroupCall[T any](g *CallGroup, method string, params any, opts ...CallOption) *CallResult[T] {
+	var res CallResult[T]
+	g.Go(method, func(ctx context.Context) error {
+		res.Err = callOnce(ctx, g.client, method, params, &res.Value, opts...)
+		return res.Err
+	})
+	return &res
+}
+
+func callOnce(ctx context.Context, client *Client, method string, params, out any, opts ...CallOption) error {
+	rs, err := client.Call(ctx, method, params, opts...)
+	if err != nil {
+		return err
+	}
+	if !rs.Next() {
+		return rs.Error()
+	}
+	if err := rs.Error(); err != nil {
+		return err
+	}
+	if rs.Reply().Continues {
+		rs.discard()
+		return ErrMoreReplies
+	}
+	if err := rs.Unmarshal(out); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+func TestCallGroup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sock")
+	l, err := varlink.Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Echo", func(w varlink.ReplyWriter, call *varlink.Call) {
+		var in struct{ Value int }
+		if err := call.Unmarshal(&in); err != nil {
+			w.WriteError(err)
+			return
+		}
+		w.WriteReply(in)
+	})
+	mux.HandleFunc("org.example.Fail", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteError(varlink.NewError("org.example.Failed"))
+	})
+
+	srv := varlink.Server{Handler: &mux}
+	go srv.Serve(l)
+
+	uri, err := varlink.ParseURI("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &varlink.Client{Transport: &varlink.Transport{}, URI: uri}
+
+	type echo struct{ Value int }
+
+	g := varlink.NewCallGroup(context.Background(), client)
+	results := make([]*varlink.CallResult[echo], 4)
+	for i := range results {
+		results[i] = varlink.GroupCall[echo](g, "org.example.Echo", echo{Value: i})
+	}
+	failed := varlink.GroupCall[echo](g, "org.example.Fail", nil)
+
+	err = g.Wait()
+
+	var cerr *varlink.CallError
+	if !errors.As(err, &cerr) {
+		t.Fatalf("expected a CallError, got %v", err)
+	}
+	if cerr.Method != "org.example.Fail" {
+		t.Fatalf("error reported for %q, expected org.example.Fail", cerr.Method)
+	}
+	if failed.Err == nil {
+		t.Fatal("expected org.example.Fail to return an error")
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			t.Fatalf("call %d: %v", i, res.Err)
+		}
+		if res.Value.Value != i {
+			t.Fatalf("call %d: got value %d", i, res.Value.Value)
+		}
+	}
+}
+
+func TestCallGroupMoreReplies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sock")
+	l, err := varlink.Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// Serve with a bare session, since servers refuse to reply more than
+	// once to calls without the more flag.
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		session := varlink.NewSession(conn)
+		defer session.Close()
+
+		ctx := context.Background()
+		for {
+			var call varlink.Call
+			if err := session.ReadCall(ctx, &call); err != nil {
+				return
+			}
+			replies := 1
+			if call.Method == "org.example.Twice" {
+				replies = 3
+			}
+			for i := range replies {
+				reply := varlink.Reply{
+					Parameters: json.RawMessage(`{"Value":1}`),
+					Continues:  i < replies-1,
+				}
+				if err := session.WriteReply(ctx, &reply); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	uri, err := varlink.ParseURI("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &varlink.Client{Transport: &varlink.Transport{}, URI: uri}
+
+	type echo struct{ Value int }
+
+	g := varlink.NewCallGroup(context.Background(), client)
+	res := varlink.GroupCall[echo](g, "org.example.Twice", nil)
+	if err := g.Wait(); !errors.Is(err, varlink.ErrMoreReplies) {
+		t.Fatalf("expected ErrMoreReplies, got %v", err)
+	}
+	if !errors.Is(res.Err, varlink.ErrMoreReplies) {
+		t.Fatalf("expected ErrMoreReplies, got %v", res.Err)
+	}
+
+	// The session is reused for the next call, which must get its own
+	// reply rather than the remaining ones of the previous call.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	g = varlink.NewCallGroup(ctx, client)
+	res = varlink.GroupCall[echo](g, "org.example.Once", nil)
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if res.Value.Value != 1 {
+		t.Fatalf("got value %d, expected 1", res.Value.Value)
+	}
+}
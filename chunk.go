@@ -0,0 +1,145 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// DefaultChunkSize is the default size of the chunks written by WriteChunks.
+const DefaultChunkSize = 64 << 10
+
+// Chunk is a piece of file contents transferred in a reply. See
+// [WriteChunks].
+type Chunk struct {
+	// Offset is the offset of Data in the file.
+	Offset int64 `json:"offset"`
+
+	// Data is the contents of the chunk, encoded in base64.
+	Data []byte `json:"data"`
+
+	// EOF is set on the last chunk of the file.
+	EOF bool `json:"eof,omitempty"`
+}
+
+// ErrChunkOffset is returned by ChunkReader when a chunk does not start
+// where the previous one ended.
+var ErrChunkOffset = errors.New("chunk offset does not follow the previous chunk")
+
+// WriteChunks streams the contents of r as a sequence of replies with the
+// parameters of a Chunk, the last of which has EOF set. It is meant for
+// services passing files to their clients over sessions that cannot pass
+// file descriptors, such as tcp or tls sessions; clients read the file back
+// with ChunkReader.
+//
+// The call must have the `more` flag, otherwise WriteChunks replies with
+// org.varlink.service.ExpectedMore. A size of 0 or less means that
+// DefaultChunkSize is used.
+//
+// If reading r fails, WriteChunks returns the error without writing a final
+// reply, which the handler is responsible for.
+func WriteChunks(w ReplyWriter, call *Call, r io.Reader, size int) error {
+	if !call.More {
+		return w.WriteError(service.ExpectedMore())
+	}
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+
+	buf := make([]byte, size)
+	var offset int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			return err
+		}
+
+		chunk := Chunk{Offset: offset, Data: buf[:n], EOF: eof}
+		if eof {
+			return w.WriteFinal(&chunk)
+		}
+		if err := w.WriteMore(&chunk); err != nil {
+			return err
+		}
+		offset += int64(n)
+	}
+}
+
+// ChunkReader is an io.Reader reading the contents of a file streamed with
+// WriteChunks.
+type ChunkReader struct {
+	stream *ReplyStream
+	buf    []byte
+	offset int64
+	err    error
+}
+
+// NewChunkReader returns a reader over the chunks received on the stream of
+// replies of a call made with the `more` flag:
+//
+//	rs, err := client.Call(ctx, "org.example.files.Download", &in, varlink.More())
+//	if err != nil {
+//		return err
+//	}
+//	_, err = io.Copy(f, varlink.NewChunkReader(rs))
+//
+// Error replies are returned as errors by Read.
+func NewChunkReader(stream *ReplyStream) *ChunkReader {
+	return &ChunkReader{stream: stream}
+}
+
+// Read implements io.Reader.
+func (r *ChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.next()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next reads the next chunk of the stream.
+func (r *ChunkReader) next() {
+	if !r.stream.Next() {
+		r.err = r.stream.Error()
+		if r.err == nil {
+			r.err = io.ErrUnexpectedEOF
+		}
+		return
+	}
+	if err := r.stream.Error(); err != nil {
+		r.err = err
+		return
+	}
+
+	var chunk Chunk
+	if err := r.stream.Unmarshal(&chunk); err != nil {
+		r.err = err
+		return
+	}
+	if chunk.Offset != r.offset {
+		r.err = fmt.Errorf("%w: got offset %d, expected %d", ErrChunkOffset, chunk.Offset, r.offset)
+		return
+	}
+	r.offset += int64(len(chunk.Data))
+	r.buf = chunk.Data
+
+	switch {
+	case chunk.EOF:
+		r.err = io.EOF
+	case !r.stream.Reply().Continues:
+		// The stream ended without a chunk marking the end of the file.
+		r.err = io.ErrUnexpectedEOF
+	}
+}
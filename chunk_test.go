@@ -0,0 +1,115 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestChunks(t *testing.T) {
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	content := make([]byte, 150000)
+	for i := range content {
+		content[i] = byte(rand.Int())
+	}
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Download", func(w varlink.ReplyWriter, call *varlink.Call) {
+		if err := varlink.WriteChunks(w, call, bytes.NewReader(content), 0); err != nil {
+			t.Error(err)
+		}
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+	client := varlink.Client{Transport: transport}
+	uri := varlink.CallURI("unix:" + l.Addr().String())
+
+	rs, err := client.Call(context.Background(), "org.example.Download", nil, uri, varlink.More())
+	if err != nil {
+		t.Fatal(err)
+	}
+	received, err := io.ReadAll(varlink.NewChunkReader(rs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(received, content) {
+		t.Fatalf("received %d bytes that differ from the %d bytes sent", len(received), len(content))
+	}
+
+	rs, err = client.Call(context.Background(), "org.example.Download", nil, uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = io.ReadAll(varlink.NewChunkReader(rs))
+	var verr varlink.Error
+	if !errors.As(err, &verr) || verr.ErrorCode() != "org.varlink.service.ExpectedMore" {
+		t.Fatalf("call without more failed with %v, expected ExpectedMore", err)
+	}
+}
+
+func TestDescriptionChunks(t *testing.T) {
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	desc := "# Exemple d'interface, à découper\ninterface org.example.large\n"
+	for i := range 100 {
+		desc += fmt.Sprintf("\n# Méthode numéro %d\nmethod M%d(entree: string) -> (sortie: string)\n", i, i)
+	}
+
+	mux := varlink.ServeMux{DescriptionChunkSize: 100}
+	mux.SetDescription("org.example.large", desc)
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+	client := varlink.Client{Transport: transport}
+	uri := varlink.CallURI("unix:" + l.Addr().String())
+
+	received, err := client.Description(context.Background(), "org.example.large", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received != desc {
+		t.Fatalf("received a description of %d bytes that differs from the %d bytes sent", len(received), len(desc))
+	}
+
+	in := map[string]string{"interface": "org.example.large"}
+	rs, err := client.Call(context.Background(), "org.varlink.service.GetInterfaceDescription", in, uri, varlink.More())
+	if err != nil {
+		t.Fatal(err)
+	}
+	replies := 0
+	for rs.Next() {
+		replies++
+	}
+	if err := rs.Error(); err != nil || replies < len(desc)/100 {
+		t.Fatalf("got %d replies and error %v, expected at least %d replies", replies, err, len(desc)/100)
+	}
+
+	if _, err := client.Description(context.Background(), "org.example.missing", uri); err == nil {
+		t.Fatal("expected an error for a missing interface")
+	}
+}
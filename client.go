@@ -7,29 +7,109 @@ package varlink
 
 import (
 	"context"
+	"strings"
+	"time"
 )
 
-var DefaultClient = &Client{}
+// DefaultClient is the client used by DoCall and DoCallContext. Its
+// Timeout is set by the VARLINK_TIMEOUT environment variable, for instance
+// VARLINK_TIMEOUT=30s. If the variable is invalid, calls made with the
+// client fail with the error.
+var DefaultClient = newDefaultClient()
 
 type Client struct {
 	// The RoundTripper to make calls with. If nil, DefaultTransport is used.
 	Transport RoundTripper
+
+	// URI is the default URI for calls made without the CallURI option. If
+	// empty, the Transport decides where to send the call.
+	URI URI
+
+	// DecodeOptions, if set, control how the parameters of the replies
+	// received by the client are decoded.
+	DecodeOptions *DecodeOptions
+
+	// Interface, if set, is the interface of the methods called by their
+	// relative name, i.e. without the interface prefix. Fully qualified
+	// method names are called as-is.
+	Interface string
+
+	// Timeout, if non-zero, limits the time each call may take, from the
+	// time it is made to the time its last reply is read.
+	Timeout time.Duration
+
+	// PropagateMetadata, if set, attaches the metadata carried by the
+	// context of each call to the call, in addition to the metadata
+	// attached with call options. This includes the metadata of the call
+	// being served, when calls are made with the context of a ReplyWriter,
+	// so only set it on clients of trusted services that accept the
+	// MetadataExtension.
+	PropagateMetadata bool
+
+	// err, if set, is returned by every call, for clients whose
+	// configuration is invalid.
+	err error
+}
+
+// WithInterface returns a copy of the client bound to the specified
+// interface, whose Call accepts relative method names:
+//
+//	fib := client.WithInterface("org.example.fib")
+//	rs, err := fib.Call(ctx, "Fibonacci", &in)
+//
+// The returned client shares the Transport and URI of the original one.
+func (client *Client) WithInterface(name string) *Client {
+	bound := *client
+	bound.Interface = name
+	return &bound
+}
+
+// qualifyMethod returns the fully qualified name of the method.
+func (client *Client) qualifyMethod(method string) string {
+	if client.Interface == "" || strings.Contains(method, ".") {
+		return method
+	}
+	return client.Interface + "." + method
 }
 
 // Call performs a method call with the specified parameters and options using
-// the underlying Transport.
+// the underlying Transport. If the client is bound to an interface, method
+// may be relative to it.
 func (client *Client) Call(ctx context.Context, method string, params any, opts ...CallOption) (*ReplyStream, error) {
-	call, err := MakeCall(method, params, opts...)
+	if client.err != nil {
+		return nil, client.err
+	}
+	call, err := MakeCall(client.qualifyMethod(method), params, opts...)
 	if err != nil {
 		return nil, err
 	}
+	if call.URI == (URI{}) {
+		call.URI = client.URI
+	}
+	call.decodeOpts = client.DecodeOptions
+	if client.PropagateMetadata {
+		if err := injectMetadata(ctx, &call); err != nil {
+			return nil, err
+		}
+	}
 
 	transport := client.Transport
 	if transport == nil {
 		transport = DefaultTransport
 	}
 
-	return transport.RoundTrip(ctx, nil, &call)
+	if client.Timeout <= 0 {
+		return transport.RoundTrip(ctx, nil, &call)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, client.Timeout)
+	rs, err := transport.RoundTrip(ctx, nil, &call)
+	if err != nil || call.OneWay {
+		cancel()
+		return rs, err
+	}
+	rs.cancel = cancel
+	return rs, nil
 }
 
 // DoCall performs a method call with the default client and context.Background().
@@ -0,0 +1,133 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordingTransport struct {
+	calls []Call
+}
+
+func (ts *recordingTransport) RoundTrip(ctx context.Context, session *Session, call *Call) (*ReplyStream, error) {
+	ts.calls = append(ts.calls, *call)
+	return nil, errors.New("not sent")
+}
+
+func TestClientWithInterface(t *testing.T) {
+	var transport recordingTransport
+	uri, _ := ParseURI("unix:/run/org.example.fib")
+	client := Client{Transport: &transport, URI: uri}
+	fib := client.WithInterface("org.example.fib")
+
+	fib.Call(context.Background(), "Fibonacci", nil)
+	fib.Call(context.Background(), "org.varlink.service.GetInfo", nil)
+	client.Call(context.Background(), "Fibonacci", nil)
+
+	expected := []string{"org.example.fib.Fibonacci", "org.varlink.service.GetInfo", "Fibonacci"}
+	if len(transport.calls) != len(expected) {
+		t.Fatalf("made %d calls, expected %d", len(transport.calls), len(expected))
+	}
+	for i, call := range transport.calls {
+		if call.Method != expected[i] {
+			t.Errorf("call %d: method is %q, expected %q", i, call.Method, expected[i])
+		}
+		if call.URI != client.URI {
+			t.Errorf("call %d: URI is %v, expected %v", i, call.URI, client.URI)
+		}
+	}
+}
+
+type contextTransport struct {
+	ctx context.Context
+}
+
+func (ts *contextTransport) RoundTrip(ctx context.Context, session *Session, call *Call) (*ReplyStream, error) {
+	ts.ctx = ctx
+	return NewStaticReplyStream(ctx, []Reply{{Parameters: json.RawMessage(`{}`)}}, nil), nil
+}
+
+func TestClientTimeout(t *testing.T) {
+	var transport contextTransport
+	client := Client{Transport: &transport, Timeout: time.Minute}
+
+	rs, err := client.Call(context.Background(), "org.example.Timeout", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := transport.ctx.Deadline(); !ok {
+		t.Fatal("the call was made without a deadline")
+	}
+	for rs.Next() {
+	}
+	if err := rs.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if transport.ctx.Err() != context.Canceled {
+		t.Fatalf("the context of the call is %v after the stream ended, expected it to be canceled", transport.ctx.Err())
+	}
+}
+
+func TestAddressRoutes(t *testing.T) {
+	routes, err := parseRoutes("org.example=unix:/tmp/example.sock, org.example.fib=tcp:127.0.0.1:1234;mode=0600,unix:@fallback")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		intf, uri string
+	}{
+		{"org.example", "unix:/tmp/example.sock"},
+		{"org.example.foo", "unix:/tmp/example.sock"},
+		{"org.example.fib", "tcp:127.0.0.1:1234;mode=0600"},
+		{"org.examples", "unix:@fallback"},
+	}
+	for _, tt := range tests {
+		if uri := lookupRoute(routes, tt.intf); uri.String() != tt.uri {
+			t.Errorf("interface %s is routed to %v, expected %v", tt.intf, uri, tt.uri)
+		}
+	}
+	if uri := lookupRoute(routes[:2], "org.other"); uri != (URI{}) {
+		t.Errorf("interface org.other is routed to %v, expected no route", uri)
+	}
+
+	if _, err := parseRoutes("org.example=/tmp/example.sock"); err == nil {
+		t.Error("parsing a route without a URI scheme succeeded")
+	}
+}
+
+func TestTimeoutFromEnvironment(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+		valid    bool
+	}{
+		{"", 0, true},
+		{"30s", 30 * time.Second, true},
+		{"30", 0, false},
+		{"-1s", 0, false},
+	}
+	for _, tt := range tests {
+		t.Setenv(EnvTimeout, tt.value)
+
+		client := newDefaultClient().WithInterface("org.example")
+		if client.Timeout != tt.expected {
+			t.Errorf("%q: got timeout %v, expected %v", tt.value, client.Timeout, tt.expected)
+		}
+
+		// Invalid values fail calls rather than being ignored.
+		_, err := client.Call(context.Background(), "Ping", nil, CallURI("unix:@nonexistent"))
+		if failed := err != nil && strings.Contains(err.Error(), EnvTimeout); failed == tt.valid {
+			t.Errorf("%q: call failed with %v", tt.value, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import "time"
+
+// Clock is a source of time. Sessions, transports and servers read the time
+// from a Clock, which lets tests control the time they observe; see
+// varlinktest.FakeClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// AfterFunc calls f in its own goroutine once the duration has
+	// elapsed, like time.AfterFunc.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a timer created by Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the timer from firing. It returns false if the timer
+	// already fired or was stopped.
+	Stop() bool
+}
+
+// SystemClock is the clock reading the system time.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// SetClock sets the clock of the session, which is used for timestamps and
+// the statistics of reply streams. The default is SystemClock.
+//
+// SetClock must be called before the session is used.
+func (session *Session) SetClock(clock Clock) {
+	if clock == nil {
+		clock = SystemClock
+	}
+	session.clock = clock
+}
+
+// FaultInjector injects faults into the messages of a session. It is meant
+// for testing how clients and services behave when messages are lost,
+// delayed or corrupted; see varlinktest.Faults.
+type FaultInjector interface {
+	// InjectWrite is called with each encoded message before it is
+	// written, and returns the message to write instead, or an empty
+	// message to drop it silently.
+	InjectWrite(msg []byte) []byte
+
+	// InjectRead is called with each message once it is read, before it
+	// is decoded, and returns the message to decode instead. It may block
+	// to delay the message.
+	InjectRead(msg []byte) []byte
+}
+
+// InjectFaults makes the session pass the messages it reads and writes
+// through the specified fault injector.
+//
+// InjectFaults must be called before the session is used.
+func (session *Session) InjectFaults(faults FaultInjector) {
+	session.faults = faults
+}
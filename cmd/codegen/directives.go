@@ -0,0 +1,79 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// Directive is an instruction to the code generator, written as a comment
+// of the form `# @name` or `# @name: value` right before the definition it
+// applies to.
+type Directive struct {
+	Name  string
+	Value string
+}
+
+// ParseDirective parses a comment token as a directive. It returns false if
+// the comment is not a directive.
+func ParseDirective(comment syntax.Token) (Directive, bool) {
+	text, _ := comment.Value.(string)
+	text, ok := strings.CutPrefix(text, "@")
+	if !ok || text == "" {
+		return Directive{}, false
+	}
+	name, value, _ := strings.Cut(text, ":")
+	return Directive{
+		Name:  strings.TrimSpace(name),
+		Value: strings.TrimSpace(value),
+	}, true
+}
+
+// Directives returns the directives found in the specified comments.
+func Directives(comments []syntax.Token) []Directive {
+	var directives []Directive
+	for _, comment := range comments {
+		if d, ok := ParseDirective(comment); ok {
+			directives = append(directives, d)
+		}
+	}
+	return directives
+}
+
+// LookupDirective returns the value of the last directive with the
+// specified name in comments, and whether it was found.
+func LookupDirective(comments []syntax.Token, name string) (string, bool) {
+	var (
+		value string
+		found bool
+	)
+	for _, d := range Directives(comments) {
+		if d.Name == name {
+			value, found = d.Value, true
+		}
+	}
+	return value, found
+}
+
+// DocComments returns the comments that are not directives, which make up
+// the documentation of a definition.
+func DocComments(comments []syntax.Token) []syntax.Token {
+	var doc []syntax.Token
+	for _, comment := range comments {
+		if _, ok := ParseDirective(comment); !ok {
+			doc = append(doc, comment)
+		}
+	}
+	return doc
+}
+
+// Doc returns the documentation of a definition, leaving its directives
+// out.
+func Doc(comments []syntax.Token) syntax.Doc {
+	return syntax.ParseDoc(DocComments(comments))
+}
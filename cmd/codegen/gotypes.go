@@ -0,0 +1,155 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// GoType is a Go type that values of a builtin IDL type are generated as,
+// instead of the default mapping.
+type GoType struct {
+	// Import is the import path of the package of the type, or empty for
+	// predeclared types.
+	Import string
+
+	// Name is the type, qualified with the name of its package.
+	Name string
+}
+
+// ParseGoType parses a Go type, written with the import path of its
+// package if it is not predeclared, as in int64, time.Time or
+// encoding/json.Number. The name of the package is the last element of
+// its import path.
+func ParseGoType(s string) (GoType, error) {
+	slash := strings.LastIndexByte(s, '/')
+	dot := strings.LastIndexByte(s, '.')
+	if dot < slash {
+		return GoType{}, fmt.Errorf("invalid Go type %q: expected <import path>.<type>", s)
+	}
+	if dot == -1 {
+		if !token.IsIdentifier(s) {
+			return GoType{}, fmt.Errorf("invalid Go type %q", s)
+		}
+		return GoType{Name: s}, nil
+	}
+	path, name := s[:dot], s[dot+1:]
+	pkg := path[slash+1:]
+	if !token.IsIdentifier(pkg) || !token.IsIdentifier(name) {
+		return GoType{}, fmt.Errorf("invalid Go type %q", s)
+	}
+	return GoType{Import: path, Name: pkg + "." + name}, nil
+}
+
+// builtinNames maps the names of the builtin types in the interface
+// description language to their names in the syntax tree, which are the
+// Go types they are generated as by default.
+var builtinNames = map[string]string{
+	"bool":   "bool",
+	"int":    "int",
+	"float":  "float64",
+	"string": "string",
+	"object": "json.RawMessage",
+}
+
+// ParseTypeMap parses a comma-separated list of <builtin>=<Go type>
+// mappings, as in int=int64,float=float32.
+func ParseTypeMap(s string) (map[string]GoType, error) {
+	types := make(map[string]GoType)
+	for entry := range strings.SplitSeq(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		idl, gotype, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid type mapping %q: expected <builtin>=<Go type>", entry)
+		}
+		name, ok := builtinNames[strings.TrimSpace(idl)]
+		if !ok {
+			return nil, fmt.Errorf("invalid type mapping %q: %s is not a builtin type", entry, idl)
+		}
+		t, err := ParseGoType(strings.TrimSpace(gotype))
+		if err != nil {
+			return nil, err
+		}
+		types[name] = t
+	}
+	return types, nil
+}
+
+// BuiltinType returns the Go type that values of the named builtin type are
+// generated as, which is set by the -type-map flag.
+func (context *Context) BuiltinType(name string) string {
+	if t, ok := context.TypeMap[name]; ok {
+		return t.Name
+	}
+	return name
+}
+
+// GoType returns the Go type of a struct field set by a `@go-type: <type>`
+// directive on the field, or the empty string if there is none. Nullable
+// fields are pointers to that type. The directive applies to fields of
+// builtin and nullable builtin types.
+func (context *Context) GoType(field syntax.StructField) (string, error) {
+	v, ok := LookupDirective(field.Comments, "go-type")
+	if !ok {
+		return "", nil
+	}
+	typ := field.Type
+	if nullable, ok := typ.(syntax.NullableType); ok {
+		typ = nullable.Type
+	}
+	if _, ok := typ.(syntax.BuiltinType); !ok {
+		return "", fmt.Errorf("field %s: @go-type applies to fields of builtin types only", field.Name)
+	}
+	t, err := ParseGoType(v)
+	if err != nil {
+		return "", fmt.Errorf("field %s: %w", field.Name, err)
+	}
+	return t.Name, nil
+}
+
+// Imports returns the Go types of the -type-map flag and of the `@go-type`
+// directives of the interface that belong to packages other than the ones
+// generated code always imports, one per package, sorted by import path.
+func (context *Context) Imports() []GoType {
+	imports := make(map[string]GoType)
+	add := func(t GoType) {
+		switch t.Import {
+		case "", "context", "encoding/json", "fmt":
+			return
+		}
+		if _, ok := imports[t.Import]; !ok {
+			imports[t.Import] = t
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(context.TypeMap)) {
+		add(context.TypeMap[name])
+	}
+	syntax.Inspect(context.Interface, func(node any) bool {
+		if field, ok := node.(syntax.StructField); ok {
+			if v, ok := LookupDirective(field.Comments, "go-type"); ok {
+				if t, err := ParseGoType(v); err == nil {
+					add(t)
+				}
+			}
+		}
+		return true
+	})
+
+	var types []GoType
+	for _, path := range slices.Sorted(maps.Keys(imports)) {
+		types = append(types, imports[path])
+	}
+	return types
+}
@@ -7,14 +7,18 @@ package main
 
 import (
 	"bytes"
+	"cmp"
 	"embed"
+	"errors"
 	"flag"
 	"fmt"
 	"go/format"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 	"unicode"
@@ -25,6 +29,9 @@ import (
 //go:embed templates/*.tmpl
 var templates embed.FS
 
+// generatedHeader is the first line of generated files.
+const generatedHeader = "// This file was automatically generated by snai.pe/go-varlink/codegen\n"
+
 func fatalf(format string, args ...any) {
 	fmt.Fprintf(os.Stderr, "%s: %s\n", os.Args[0], fmt.Sprintf(format, args...))
 	os.Exit(1)
@@ -32,13 +39,206 @@ func fatalf(format string, args ...any) {
 
 type Context struct {
 	PkgName    string
+	ImportRoot string
 	GenErrors  bool
 	GenTypes   bool
 	GenClient  bool
 	GenService bool
 	GenMeta    bool
+	GenPartial bool
+	GenCLI     bool
+	JSONCase   string
+	Collisions string
 	Source     string
 	Interface  syntax.InterfaceDef
+
+	// TypeMap holds the Go types that builtin types are generated as, keyed
+	// by their names in the syntax tree, as set by the -type-map flag.
+	TypeMap map[string]GoType
+
+	// Nullable is how nullable types are generated, as set by the -nullable
+	// flag.
+	Nullable string
+
+	// Names holds the Go identifiers of the fields and enum values of the
+	// interface.
+	Names *Names
+
+	// Section is the section of the generated code written to the current
+	// file when the output is split, or empty for the main file.
+	Section string
+
+	// Chunk holds the definitions whose code is written to the current
+	// file. First is set for the first file of a section, which also holds
+	// the code that is not generated per definition.
+	Chunk syntax.InterfaceDef
+	First bool
+}
+
+// Output split strategies.
+const (
+	SplitNone    = "none"
+	SplitSection = "section"
+)
+
+// sections are the sections of the generated code, in the order they are
+// written to the output.
+var sections = []string{"types", "partial", "errors", "client", "service", "cli"}
+
+// enabled returns whether the specified section is generated.
+func (context *Context) enabled(section string) bool {
+	switch section {
+	case "types":
+		return context.GenTypes
+	case "partial":
+		return context.GenPartial
+	case "errors":
+		return context.GenErrors
+	case "client":
+		return context.GenClient
+	case "service":
+		return context.GenService
+	case "cli":
+		return context.GenCLI
+	default:
+		return false
+	}
+}
+
+// Nullable type mappings.
+const (
+	NullablePointer = "pointer"
+	NullableOption  = "option"
+)
+
+// Options returns whether nullable types are generated as varlink.Option
+// rather than pointers.
+func (context *Context) Options() bool {
+	return context.Nullable == NullableOption
+}
+
+// UsesOptions returns whether any type of the interface is generated as a
+// varlink.Option.
+func (context *Context) UsesOptions() bool {
+	if !context.Options() {
+		return false
+	}
+	var uses bool
+	syntax.Inspect(context.Interface, func(node any) bool {
+		if _, ok := node.(syntax.NullableType); ok {
+			uses = true
+		}
+		return !uses
+	})
+	return uses
+}
+
+// JSON field name casing policies.
+const (
+	CaseVerbatim = "verbatim"
+	CaseSnake    = "snake"
+	CaseCamel    = "camel"
+)
+
+// JSONName returns the JSON name of a struct field.
+//
+// The name is the IDL field name, unless a `@json-name: <name>` directive
+// is set on the field, or a casing policy applies. The casing policy is,
+// in order of precedence, set by a `@json-case: <policy>` directive on the
+// field, by the same directive on the interface, or by the -json-case flag.
+func (context *Context) JSONName(field syntax.StructField) (string, error) {
+	if name, ok := LookupDirective(field.Comments, "json-name"); ok {
+		return name, nil
+	}
+
+	policy := context.JSONCase
+	if v, ok := LookupDirective(context.Interface.Comments, "json-case"); ok {
+		policy = v
+	}
+	if v, ok := LookupDirective(field.Comments, "json-case"); ok {
+		policy = v
+	}
+
+	switch policy {
+	case "", CaseVerbatim:
+		return field.Name, nil
+	case CaseSnake:
+		return SnakeCase(field.Name), nil
+	case CaseCamel:
+		return CamelCase(field.Name), nil
+	default:
+		return "", fmt.Errorf("field %s: unknown JSON casing policy %q", field.Name, policy)
+	}
+}
+
+// Ordered returns whether a struct field is a dict generated as a
+// varlinkrt.OrderedMap, as set by an `@ordered` directive on the field. The
+// directive applies to fields of dict and nullable dict types.
+func (context *Context) Ordered(field syntax.StructField) (bool, error) {
+	v, ok := LookupDirective(field.Comments, "ordered")
+	if !ok {
+		return false, nil
+	}
+	if v != "" {
+		ordered, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("field %s: invalid @ordered value %q", field.Name, v)
+		}
+		if !ordered {
+			return false, nil
+		}
+	}
+
+	typ := field.Type
+	if nullable, ok := typ.(syntax.NullableType); ok {
+		typ = nullable.Type
+	}
+	if _, ok := typ.(syntax.DictType); !ok {
+		return false, fmt.Errorf("field %s: @ordered applies to dict fields only", field.Name)
+	}
+	return true, nil
+}
+
+// UsesOrderedMaps returns whether any field of the interface is generated
+// as a varlinkrt.OrderedMap.
+func (context *Context) UsesOrderedMaps() bool {
+	var uses bool
+	syntax.Inspect(context.Interface, func(node any) bool {
+		if field, ok := node.(syntax.StructField); ok {
+			if ordered, _ := context.Ordered(field); ordered {
+				uses = true
+			}
+		}
+		return !uses
+	})
+	return uses
+}
+
+// Idempotent returns whether the method is safe to call more than once, as
+// set by an `@idempotent` directive on the method. The generated client
+// makes its calls with the varlink.Idempotent option, which lets
+// transports retry them.
+func (context *Context) Idempotent(method syntax.MethodDef) (bool, error) {
+	v, ok := LookupDirective(method.Comments, "idempotent")
+	if !ok || v == "" {
+		return ok, nil
+	}
+	idempotent, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("method %s: invalid @idempotent value %q", method.Name, v)
+	}
+	return idempotent, nil
+}
+
+// LookupType returns the definition of the named type, or an error if the
+// interface does not define it.
+func (context *Context) LookupType(name string) (*syntax.TypeDef, error) {
+	for i := range context.Interface.Types {
+		if context.Interface.Types[i].Name == name {
+			return &context.Interface.Types[i], nil
+		}
+	}
+	return nil, fmt.Errorf("undefined type %s", name)
 }
 
 func PascalCase(s string) string {
@@ -63,6 +263,16 @@ func camelCase(s string, sep rune, capitalizeFirst bool) string {
 	})
 }
 
+func SnakeCase(s string) string {
+	return FormatCase(s, func(r rune, i int, boundary, upper, wupper bool) (rune, rune) {
+		var sc rune
+		if boundary && i != 0 {
+			sc = '_'
+		}
+		return unicode.ToLower(r), sc
+	})
+}
+
 func FormatCase(s string, runefunc func(r rune, i int, boundary, upper, wupper bool) (rune, rune)) string {
 
 	in := strings.NewReader(s)
@@ -157,9 +367,13 @@ var kwmap = map[string]bool{
 
 func main() {
 	var (
-		context Context
-		output  string
-		gen     string
+		context   Context
+		output    string
+		gen       string
+		verify    bool
+		split     string
+		splitSize int
+		typeMap   string
 	)
 
 	genmap := map[string]*bool{
@@ -168,11 +382,20 @@ func main() {
 		"client":  &context.GenClient,
 		"service": &context.GenService,
 		"meta":    &context.GenMeta,
+		"partial": &context.GenPartial,
+		"cli":     &context.GenCLI,
 	}
 
-	flag.StringVar(&context.PkgName, "pkgname", "", "override package name in generated code")
+	flag.StringVar(&context.PkgName, "pkgname", "", "override package name in generated code (derived from the output directory by default)")
 	flag.StringVar(&output, "output", "", "override output filename")
-	flag.StringVar(&gen, "gen", "errors,types,client,service,meta", "what to generate")
+	flag.StringVar(&gen, "gen", "errors,types,client,service,meta", "what to generate (errors, types, client, service, meta, partial, cli)")
+	flag.StringVar(&context.JSONCase, "json-case", CaseVerbatim, "casing policy of JSON field names (verbatim, snake, camel)")
+	flag.StringVar(&context.Collisions, "collisions", CollideSuffix, "how to rename IDL names that are Go keywords or collide once converted to Go identifiers (suffix, prefix, error)")
+	flag.StringVar(&typeMap, "type-map", "", "comma-separated Go types of builtin types, as in int=int64,float=float32,string=time.Time")
+	flag.StringVar(&context.Nullable, "nullable", NullablePointer, "how to generate nullable types (pointer, option)")
+	flag.BoolVar(&verify, "verify", false, "check that the output files are up to date instead of writing them")
+	flag.StringVar(&split, "split", SplitNone, "how to split the output into files (none, section)")
+	flag.IntVar(&splitSize, "split-size", 0, "with -split=section, maximum number of definitions of each kind per file (0 means no limit)")
 	flag.Parse()
 
 	if flag.NArg() != 1 {
@@ -187,16 +410,127 @@ func main() {
 		}
 		*b = true
 	}
+	if context.GenCLI && !(context.GenTypes && context.GenClient && context.GenMeta) {
+		fatalf("generating cli requires generating types, client and meta")
+	}
+
+	switch context.JSONCase {
+	case CaseVerbatim, CaseSnake, CaseCamel:
+	default:
+		fatalf("unknown JSON casing policy %q", context.JSONCase)
+	}
+
+	switch context.Collisions {
+	case CollideSuffix, CollidePrefix, CollideError:
+	default:
+		fatalf("unknown collision policy %q", context.Collisions)
+	}
+
+	switch context.Nullable {
+	case NullablePointer, NullableOption:
+	default:
+		fatalf("unknown nullable type mapping %q", context.Nullable)
+	}
+
+	var err error
+	context.TypeMap, err = ParseTypeMap(typeMap)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	switch split {
+	case SplitNone, SplitSection:
+	default:
+		fatalf("unknown split strategy %q", split)
+	}
 
 	if output == "" {
 		output = flag.Arg(0) + ".go"
 	}
 
-	out := generate(&context, flag.Arg(0))
+	if context.PkgName == "" {
+		context.PkgName, _ = PackageName(output)
+	}
+	context.ImportRoot = ImportRoot()
 
-	if err := writeResult(output, out); err != nil {
+	files := generate(&context, flag.Arg(0), output, split, splitSize)
+	stale, err := staleFiles(output, files)
+	if err != nil {
 		fatalf("%v", err)
 	}
+
+	if verify {
+		for _, f := range files {
+			current, err := os.ReadFile(f.name)
+			if err != nil {
+				fatalf("%v", err)
+			}
+			if !bytes.Equal(current, f.data) {
+				fatalf("%s is out of date with %s; regenerate it", f.name, flag.Arg(0))
+			}
+		}
+		for _, name := range stale {
+			fatalf("%s is no longer generated from %s; regenerate it", name, flag.Arg(0))
+		}
+		return
+	}
+
+	for _, f := range files {
+		if err := writeResult(f.name, f.data); err != nil {
+			fatalf("%v", err)
+		}
+	}
+	for _, name := range stale {
+		if err := os.Remove(name); err != nil {
+			fatalf("%v", err)
+		}
+	}
+}
+
+// staleFiles returns the section files left next to output by a previous
+// run that this one does not generate, like the files of chunks past the
+// current number of chunks, or of sections that are no longer generated.
+// Only files starting with the header of generated files are considered.
+func staleFiles(output string, files []file) ([]string, error) {
+	dir, name := filepath.Split(output)
+	base := strings.TrimSuffix(name, ".go")
+
+	entries, err := os.ReadDir(cmp.Or(dir, "."))
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []string
+	for _, entry := range entries {
+		rest, ok := strings.CutPrefix(entry.Name(), base+"_")
+		if !ok {
+			continue
+		}
+		rest, ok = strings.CutSuffix(rest, ".go")
+		if !ok {
+			continue
+		}
+		section, n, chunked := strings.Cut(rest, "_")
+		if !slices.Contains(sections, section) {
+			continue
+		}
+		if _, err := strconv.Atoi(n); chunked && err != nil {
+			continue
+		}
+
+		path := dir + entry.Name()
+		if slices.ContainsFunc(files, func(f file) bool { return f.name == path }) {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(data, []byte(generatedHeader)) {
+			stale = append(stale, path)
+		}
+	}
+	return stale, nil
 }
 
 func writeResult(filepath string, data []byte) error {
@@ -218,7 +552,43 @@ func writeResult(filepath string, data []byte) error {
 	return os.Rename(out.Name(), filepath)
 }
 
-func generate(context *Context, filename string) []byte {
+// file is a generated file.
+type file struct {
+	name string
+	data []byte
+}
+
+// chunks splits the definitions of the interface into chunks holding at
+// most size definitions of each kind. A size of 0 or less means that a
+// single chunk holds all the definitions.
+func chunks(intf syntax.InterfaceDef, size int) []syntax.InterfaceDef {
+	if size <= 0 {
+		return []syntax.InterfaceDef{intf}
+	}
+
+	n := 1
+	for _, l := range []int{len(intf.Types), len(intf.Methods), len(intf.Errors)} {
+		n = max(n, (l+size-1)/size)
+	}
+
+	part := func(i, l int) (int, int) {
+		return min(i*size, l), min((i+1)*size, l)
+	}
+
+	out := make([]syntax.InterfaceDef, n)
+	for i := range out {
+		out[i] = intf
+		lo, hi := part(i, len(intf.Types))
+		out[i].Types = intf.Types[lo:hi]
+		lo, hi = part(i, len(intf.Methods))
+		out[i].Methods = intf.Methods[lo:hi]
+		lo, hi = part(i, len(intf.Errors))
+		out[i].Errors = intf.Errors[lo:hi]
+	}
+	return out
+}
+
+func generate(context *Context, filename, output, split string, splitSize int) []file {
 	f, err := os.Open(filename)
 	if err != nil {
 		fatalf("%v", err)
@@ -233,20 +603,55 @@ func generate(context *Context, filename string) []byte {
 
 	context.Source = s.String()
 	context.Interface, err = p.Parse()
+	if err != nil {
+		var serr *syntax.Error
+		if errors.As(err, &serr) {
+			serr.Filename = filename
+		}
+		fatalf("%s", syntax.FormatError(err, context.Source))
+	}
+
+	context.Names, err = ResolveNames(context.Interface, filename, context.Collisions)
 	if err != nil {
 		fatalf("%v", err)
 	}
+	for _, rename := range context.Names.Renames {
+		fmt.Fprintf(os.Stderr, "%s: warning: %s\n", os.Args[0], rename)
+	}
 
 	tmpl := template.New("").Option("missingkey=error")
 
 	tmpl, err = tmpl.Funcs(template.FuncMap{
-		"pascalCase": PascalCase,
-		"camelCase":  CamelCase,
-		"split":      strings.Split,
-		"last":       func(s []string) string { return s[len(s)-1] },
-		"errorf":     func(msg string, args ...any) (struct{}, error) { return struct{}{}, fmt.Errorf(msg, args...) },
-		"list":       func(args ...any) []any { return args },
-		"concat":     func(s ...string) string { return strings.Join(s, "") },
+		"pascalCase":  PascalCase,
+		"fieldName":   context.Names.FieldName,
+		"argName":     context.Names.ArgName,
+		"valueName":   context.Names.ValueName,
+		"jsonName":    context.JSONName,
+		"doc":         DocComments,
+		"docOf":       Doc,
+		"lookupType":  context.LookupType,
+		"ordered":     context.Ordered,
+		"pagination":  context.Pagination,
+		"streaming":   context.Streaming,
+		"more":        context.More,
+		"idempotent":  context.Idempotent,
+		"goType":      context.GoType,
+		"builtinType": context.BuiltinType,
+		"options":     context.Options,
+		"without":     Without,
+		"orderedElem": func(t syntax.Type) syntax.Type {
+			if nullable, ok := t.(syntax.NullableType); ok {
+				t = nullable.Type
+			}
+			return t.(syntax.DictType).ElemType
+		},
+		"fingerprint": syntax.Fingerprint,
+		"camelCase":   CamelCase,
+		"split":       strings.Split,
+		"last":        func(s []string) string { return s[len(s)-1] },
+		"errorf":      func(msg string, args ...any) (struct{}, error) { return struct{}{}, fmt.Errorf(msg, args...) },
+		"list":        func(args ...any) []any { return args },
+		"concat":      func(s ...string) string { return strings.Join(s, "") },
 		"join": func(sep string, s ...string) string {
 			s = slices.DeleteFunc(s, func(s string) bool { return s == "" })
 			return strings.Join(s, sep)
@@ -277,29 +682,66 @@ func generate(context *Context, filename string) []byte {
 			}
 			return val
 		},
-		"escapekw": func(s string) string {
-			if kwmap[s] {
-				return s + "_"
-			}
-			return s
-		},
 	}).
 		ParseFS(templates, "templates/*.tmpl")
 	if err != nil {
 		fatalf("%v", err)
 	}
 
-	var buf bytes.Buffer
+	execute := func(names ...string) []byte {
+		var buf bytes.Buffer
+		for _, name := range names {
+			if err := tmpl.ExecuteTemplate(&buf, name, context); err != nil {
+				fatalf("%v", err)
+			}
+		}
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			os.Stdout.Write(buf.Bytes())
+			fatalf("%v", err)
+		}
+		return formatted
+	}
 
-	if err := tmpl.ExecuteTemplate(&buf, "package.tmpl", &context); err != nil {
-		fatalf("%v", err)
+	context.Chunk = context.Interface
+	context.First = true
+
+	if split == SplitNone {
+		return []file{{name: output, data: execute("package.tmpl")}}
 	}
 
-	formatted, err := format.Source(buf.Bytes())
-	if err != nil {
-		os.Stdout.Write(buf.Bytes())
-		fatalf("%v", err)
+	// The main file holds the declarations shared by the other files, and
+	// each section is written to its own files, named after the main file.
+	shared := []string{"header", "common"}
+	if context.GenMeta {
+		shared = append(shared, "meta")
 	}
+	files := []file{{name: output, data: execute(shared...)}}
+
+	base := strings.TrimSuffix(output, ".go")
+	for _, section := range sections {
+		if !context.enabled(section) {
+			continue
+		}
+		context.Section = section
+		for i, chunk := range chunks(context.Interface, splitSize) {
+			context.Chunk = chunk
+			context.First = i == 0
+
+			var body strings.Builder
+			if err := tmpl.ExecuteTemplate(&body, section, context); err != nil {
+				fatalf("%v", err)
+			}
+			if strings.TrimSpace(body.String()) == "" {
+				continue
+			}
 
-	return formatted
+			name := base + "_" + section + ".go"
+			if i > 0 {
+				name = fmt.Sprintf("%s_%s_%d.go", base, section, i+1)
+			}
+			files = append(files, file{name: name, data: execute("header", section)})
+		}
+	}
+	return files
 }
@@ -0,0 +1,155 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// Identifier collision policies, which resolve the IDL names that are Go
+// keywords, or that collide with another name of the same struct or enum
+// once converted to Go identifiers, like no_op and noOp.
+const (
+	// CollideSuffix appends underscores to the identifier.
+	CollideSuffix = "suffix"
+
+	// CollidePrefix prepends an underscore to unexported identifiers, and
+	// an X to exported ones, which must start with an uppercase letter.
+	CollidePrefix = "prefix"
+
+	// CollideError fails code generation.
+	CollideError = "error"
+)
+
+// Names holds the Go identifiers of the struct fields and enum values of an
+// interface, keyed by the position of their definition.
+type Names struct {
+	fields map[syntax.Cursor]string // exported field names
+	args   map[syntax.Cursor]string // argument and variable names
+	values map[syntax.Cursor]string // enum constant names, after the type name
+
+	// Renames describes the identifiers that were renamed because they
+	// collided with another, in order.
+	Renames []string
+}
+
+// ResolveNames computes the Go identifiers of the struct fields and enum
+// values of the interface parsed from filename, renaming the ones that
+// collide according to policy. With CollideError, the returned error lists
+// all the collisions.
+func ResolveNames(intf syntax.InterfaceDef, filename, policy string) (*Names, error) {
+	names := Names{
+		fields: make(map[syntax.Cursor]string),
+		args:   make(map[syntax.Cursor]string),
+		values: make(map[syntax.Cursor]string),
+	}
+	var errs []error
+
+	// resolve assigns names to the members of a struct or enum, in order.
+	resolve := func(out map[syntax.Cursor]string, kind string, members []syntax.Node, idl []string, convert func(string) string, keywords bool) {
+		taken := make(map[string]string) // identifier -> IDL name
+		for i, node := range members {
+			name := convert(idl[i])
+			other, dup := taken[name]
+			keyword := keywords && kwmap[name]
+			if dup || keyword {
+				reason := "is a Go keyword"
+				if dup {
+					reason = "collides with " + other
+				}
+				at := fmt.Sprintf("%s:%d:%d", filename, node.Position.Line, node.Position.Column)
+				if policy == CollideError {
+					errs = append(errs, fmt.Errorf("%s: %s %s %s as %s", at, kind, idl[i], reason, name))
+				} else {
+					renamed := rename(name, policy)
+					for kwmap[renamed] || taken[renamed] != "" {
+						renamed = rename(renamed, policy)
+					}
+					// Keywords are renamed by convention, but renaming
+					// duplicates changes the API in unexpected ways.
+					if dup {
+						names.Renames = append(names.Renames, fmt.Sprintf("%s: %s %s %s as %s, renamed to %s", at, kind, idl[i], reason, name, renamed))
+					}
+					name = renamed
+				}
+			}
+			if _, ok := taken[name]; !ok {
+				taken[name] = idl[i]
+			}
+			out[node.Position] = name
+		}
+	}
+
+	syntax.Inspect(intf, func(node any) bool {
+		switch n := node.(type) {
+		case syntax.StructType:
+			nodes := make([]syntax.Node, len(n.Fields))
+			idl := make([]string, len(n.Fields))
+			for i, f := range n.Fields {
+				nodes[i], idl[i] = f.Node, f.Name
+			}
+			resolve(names.fields, "field", nodes, idl, PascalCase, false)
+			resolve(names.args, "argument", nodes, idl, CamelCase, true)
+		case syntax.EnumType:
+			nodes := make([]syntax.Node, len(n.Values))
+			idl := make([]string, len(n.Values))
+			for i, v := range n.Values {
+				nodes[i], idl[i] = v.Node, v.Name
+			}
+			resolve(names.values, "enum value", nodes, idl, PascalCase, false)
+		}
+		return true
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return &names, nil
+}
+
+// rename renames a colliding identifier according to policy.
+func rename(name, policy string) string {
+	if policy == CollidePrefix {
+		if name != "" && unicode.IsUpper([]rune(name)[0]) {
+			return "X" + name
+		}
+		return "_" + name
+	}
+	return name + "_"
+}
+
+// FieldName returns the Go name of a struct field.
+func (names *Names) FieldName(field syntax.StructField) string {
+	if name, ok := names.fields[field.Position]; ok {
+		return name
+	}
+	return PascalCase(field.Name)
+}
+
+// ArgName returns the name of the argument or variable holding the value of
+// a struct field.
+func (names *Names) ArgName(field syntax.StructField) string {
+	if name, ok := names.args[field.Position]; ok {
+		return name
+	}
+	name := CamelCase(field.Name)
+	if kwmap[name] {
+		name += "_"
+	}
+	return name
+}
+
+// ValueName returns the Go name of an enum value, which follows the name of
+// its type in the name of its constant.
+func (names *Names) ValueName(value syntax.EnumValue) string {
+	if name, ok := names.values[value.Position]; ok {
+		return name
+	}
+	return PascalCase(value.Name)
+}
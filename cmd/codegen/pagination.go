@@ -0,0 +1,145 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// Pagination describes the fields of a paginated method, as set by an
+// `@paginated` directive on the method:
+//
+//	# @paginated: max=100
+//	method List(cursor: ?string, limit: ?int) -> (items: []Item, next: ?string)
+//
+// The client passes the cursor returned as next by the previous page to get
+// the following one, until next is null or empty, and limits the number of
+// items of each page with limit.
+//
+// The directive value is a comma-separated list of settings: cursor, limit,
+// next and items rename the corresponding fields, which default to the
+// names above, except for items which defaults to the only array field of
+// the output; max sets the maximum page size enforced by the server.
+type Pagination struct {
+	Cursor syntax.StructField
+	Limit  syntax.StructField
+	Next   syntax.StructField
+	Items  syntax.StructField
+	Max    int
+}
+
+// Pagination returns how the method is paginated, or nil if it is not.
+func (context *Context) Pagination(method syntax.MethodDef) (*Pagination, error) {
+	value, ok := LookupDirective(method.Comments, "paginated")
+	if !ok {
+		return nil, nil
+	}
+	fail := func(format string, args ...any) (*Pagination, error) {
+		return nil, fmt.Errorf("method %s: @paginated: %s", method.Name, fmt.Sprintf(format, args...))
+	}
+
+	names := map[string]string{
+		"cursor": "cursor",
+		"limit":  "limit",
+		"next":   "next",
+	}
+	var p Pagination
+	for setting := range strings.SplitSeq(value, ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+		key, val, _ := strings.Cut(setting, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		switch key {
+		case "cursor", "limit", "next", "items":
+			names[key] = val
+		case "max":
+			max, err := strconv.Atoi(val)
+			if err != nil || max <= 0 {
+				return fail("invalid max %q", val)
+			}
+			p.Max = max
+		default:
+			return fail("unknown setting %q", key)
+		}
+	}
+
+	if _, ok := names["items"]; !ok {
+		for _, field := range method.Output.Fields {
+			if _, ok := field.Type.(syntax.ArrayType); ok {
+				if _, dup := names["items"]; dup {
+					return fail("output has more than one array field; set items explicitly")
+				}
+				names["items"] = field.Name
+			}
+		}
+		if _, ok := names["items"]; !ok {
+			return fail("output has no array field")
+		}
+	}
+
+	lookup := func(params syntax.StructType, name, typ string) (syntax.StructField, bool) {
+		i := slices.IndexFunc(params.Fields, func(f syntax.StructField) bool { return f.Name == name })
+		if i == -1 {
+			return syntax.StructField{}, false
+		}
+		field := params.Fields[i]
+		switch t := field.Type.(type) {
+		case syntax.NullableType:
+			b, ok := t.Type.(syntax.BuiltinType)
+			return field, ok && b.Name == typ
+		case syntax.ArrayType:
+			return field, typ == "array"
+		}
+		return field, false
+	}
+
+	var found bool
+	if p.Cursor, found = lookup(method.Input, names["cursor"], "string"); !found {
+		return fail("input has no %q field of type ?string", names["cursor"])
+	}
+	if p.Limit, found = lookup(method.Input, names["limit"], "int"); !found {
+		return fail("input has no %q field of type ?int", names["limit"])
+	}
+	if p.Next, found = lookup(method.Output, names["next"], "string"); !found {
+		return fail("output has no %q field of type ?string", names["next"])
+	}
+	if p.Items, found = lookup(method.Output, names["items"], "array"); !found {
+		return fail("output has no %q array field", names["items"])
+	}
+
+	// The client iterates over pages with cursors of type *string.
+	for _, field := range []syntax.StructField{p.Cursor, p.Limit, p.Next} {
+		builtin := field.Type.(syntax.NullableType).Type.(syntax.BuiltinType).Name
+		if gotype, _ := context.GoType(field); gotype != "" || context.BuiltinType(builtin) != builtin {
+			return fail("field %q must be generated as *%s", field.Name, builtin)
+		}
+	}
+	return &p, nil
+}
+
+// UsesPagination returns whether any method of the interface is paginated.
+func (context *Context) UsesPagination() bool {
+	return slices.ContainsFunc(context.Interface.Methods, func(m syntax.MethodDef) bool {
+		p, _ := context.Pagination(m)
+		return p != nil
+	})
+}
+
+// Without returns a copy of the struct without the field with the specified
+// name.
+func Without(s syntax.StructType, name string) syntax.StructType {
+	s.Fields = slices.DeleteFunc(slices.Clone(s.Fields), func(f syntax.StructField) bool {
+		return f.Name == name
+	})
+	return s
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// DefaultImportRoot is the import path of the go-varlink module, used when
+// it cannot be determined from the build information of the generator.
+const DefaultImportRoot = "snai.pe/go-varlink"
+
+// ImportRoot returns the import path of the go-varlink module that the
+// generator was built from, which the generated code imports. This keeps
+// generated code importing the right packages when go-varlink is used
+// under another module path, like a fork.
+func ImportRoot() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return DefaultImportRoot
+	}
+	if root, ok := strings.CutSuffix(info.Path, "/cmd/codegen"); ok && root != "" {
+		return root
+	}
+	return DefaultImportRoot
+}
+
+// FindModule returns the module path and root directory of the module
+// containing dir, or false if dir is not within a module.
+func FindModule(dir string) (modpath, root string, ok bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", false
+	}
+	for {
+		f, err := os.Open(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			modpath := parseModulePath(f)
+			f.Close()
+			return modpath, dir, modpath != ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", false
+		}
+		dir = parent
+	}
+}
+
+// parseModulePath returns the path of the module directive of a go.mod
+// file.
+func parseModulePath(f *os.File) string {
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		rest, ok := strings.CutPrefix(line, "module")
+		if !ok || rest == "" || (rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+		rest, _, _ = strings.Cut(rest, "//")
+		rest = strings.TrimSpace(rest)
+		if unquoted, err := strconv.Unquote(rest); err == nil {
+			rest = unquoted
+		}
+		return rest
+	}
+	return ""
+}
+
+// ImportPath returns the import path of the package in dir, or false if
+// dir is not within a module.
+func ImportPath(dir string) (string, bool) {
+	modpath, root, ok := FindModule(dir)
+	if !ok {
+		return "", false
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	if rel == "." {
+		return modpath, true
+	}
+	return path.Join(modpath, filepath.ToSlash(rel)), true
+}
+
+var (
+	identRegexp   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	versionRegexp = regexp.MustCompile(`^v[0-9]+$`)
+)
+
+// PackageName returns the name of the package that the output file belongs
+// to. It is, in order of precedence:
+//
+//   - the package name of the other Go files in the directory of output,
+//     other than the files of a split output,
+//   - the last element of the import path of the directory, unless it is a
+//     major version suffix, if it is a valid identifier,
+//
+// or false if neither applies.
+func PackageName(output string) (string, bool) {
+	dir := filepath.Dir(output)
+	base := strings.TrimSuffix(filepath.Base(output), ".go")
+
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if name == filepath.Base(output) || strings.HasPrefix(name, base+"_") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, name), nil, parser.PackageClauseOnly)
+		if err == nil && f.Name.Name != "main" {
+			return f.Name.Name, true
+		}
+	}
+
+	importPath, ok := ImportPath(dir)
+	if !ok {
+		return "", false
+	}
+	elems := strings.Split(importPath, "/")
+	last := elems[len(elems)-1]
+	if versionRegexp.MatchString(last) && len(elems) > 1 {
+		last = elems[len(elems)-2]
+	}
+	last = strings.ReplaceAll(last, "-", "_")
+	if !identRegexp.MatchString(last) || kwmap[last] {
+		return "", false
+	}
+	return last, true
+}
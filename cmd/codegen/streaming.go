@@ -0,0 +1,111 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// Streaming describes the items of a method returning a list, as set by a
+// `@streamable` directive on the method:
+//
+//	# @streamable
+//	method List(filter: ?string) -> (items: []Item)
+//
+// Calls with the `more` flag get one reply per item, and other calls a
+// single reply with all the items, so that the same implementation serves
+// both kinds of clients.
+//
+// The directive value may be items=<name>, which names the field holding
+// the items, and defaults to the only array field of the output. The
+// output must not have other fields, since they could not be told apart
+// between the replies.
+type Streaming struct {
+	Items syntax.StructField
+}
+
+// Streaming returns how the method streams its items, or nil if it does
+// not.
+func (context *Context) Streaming(method syntax.MethodDef) (*Streaming, error) {
+	value, ok := LookupDirective(method.Comments, "streamable")
+	if !ok {
+		return nil, nil
+	}
+	fail := func(format string, args ...any) (*Streaming, error) {
+		return nil, fmt.Errorf("method %s: @streamable: %s", method.Name, fmt.Sprintf(format, args...))
+	}
+
+	var items string
+	for setting := range strings.SplitSeq(value, ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "" {
+			continue
+		}
+		key, val, _ := strings.Cut(setting, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		switch key {
+		case "items":
+			items = val
+		default:
+			return fail("unknown setting %q", key)
+		}
+	}
+
+	var s Streaming
+	for _, field := range method.Output.Fields {
+		_, isArray := field.Type.(syntax.ArrayType)
+		switch {
+		case items == "" && isArray && s.Items.Name != "":
+			return fail("output has more than one array field; set items explicitly")
+		case items == "" && isArray, field.Name == items && isArray:
+			s.Items = field
+		case field.Name == items:
+			return fail("output field %q is not an array", items)
+		default:
+			return fail("output has field %q besides the items", field.Name)
+		}
+	}
+	if s.Items.Name == "" {
+		if items != "" {
+			return fail("output has no %q array field", items)
+		}
+		return fail("output has no array field")
+	}
+	return &s, nil
+}
+
+// More returns whether the method replies more than once to calls with the
+// `more` flag, as set by a `@more` directive on the method, or implied by
+// `@streamable`. The methods of typed services replying more than once
+// send their replies with a send function.
+func (context *Context) More(method syntax.MethodDef) (bool, error) {
+	if _, ok := LookupDirective(method.Comments, "streamable"); ok {
+		return true, nil
+	}
+	v, ok := LookupDirective(method.Comments, "more")
+	if !ok || v == "" {
+		return ok, nil
+	}
+	more, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("method %s: invalid @more value %q", method.Name, v)
+	}
+	return more, nil
+}
+
+// UsesIterators returns whether the generated client returns iterators,
+// for paginated methods or methods replying more than once.
+func (context *Context) UsesIterators() bool {
+	return context.UsesPagination() || slices.ContainsFunc(context.Interface.Methods, func(m syntax.MethodDef) bool {
+		more, _ := context.More(m)
+		return more
+	})
+}
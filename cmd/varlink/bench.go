@@ -0,0 +1,200 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+var benchCommand = &command{
+	Name:  "bench",
+	Args:  "<address>/<method>",
+	Short: "benchmark a method",
+	Long: `Bench calls a method of the service at the specified address repeatedly,
+from concurrent workers, and reports the throughput, the latency
+percentiles and the errors of the calls.
+
+The parameters of the calls are read from the JSON file specified with
+-payload, or from the standard input if it is "-". If it is omitted, the
+method is called without parameters.
+
+Workers share a pool of sessions, whose size is set with -sessions. Calls
+are pipelined on the sessions of the pool when there are more workers than
+sessions.
+
+A first call is made before the benchmark starts, to check that the method
+can be called; if it fails, the command fails.`,
+	Flags: func(fs *flag.FlagSet) {
+		fs.Int("concurrency", 1, "make calls from `n` concurrent workers")
+		fs.Duration("duration", 10*time.Second, "make calls for `duration`")
+		fs.String("payload", "", "read the call parameters from `file`")
+		fs.Int("sessions", 1, "keep up to `n` sessions open to the service")
+	},
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return errUsage
+		}
+		uri, method, err := splitAddress(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		params := json.RawMessage("{}")
+		switch path := flagValue[string](fs, "payload"); path {
+		case "":
+		case "-":
+			params, err = io.ReadAll(os.Stdin)
+		default:
+			params, err = os.ReadFile(path)
+		}
+		if err != nil {
+			return err
+		}
+		if !json.Valid(params) {
+			return errors.New("payload is not valid JSON")
+		}
+
+		concurrency := flagValue[int](fs, "concurrency")
+		if concurrency < 1 {
+			return errors.New("concurrency must be at least 1")
+		}
+
+		transport := &varlink.Transport{MaxKeepAliveSessions: flagValue[int](fs, "sessions")}
+		defer transport.Close()
+		client := &varlink.Client{Transport: transport, URI: uri}
+
+		// Check that the method can be called before measuring anything.
+		if err := benchCall(ctx, client, method, params); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, flagValue[time.Duration](fs, "duration"))
+		defer cancel()
+
+		results := make([]benchResult, concurrency)
+		start := time.Now()
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Go(func() {
+				results[i] = benchWorker(ctx, client, method, params)
+			})
+		}
+		wg.Wait()
+		elapsed := time.Since(start)
+
+		var total benchResult
+		for _, r := range results {
+			total.merge(r)
+		}
+		total.report(os.Stdout, elapsed)
+		return nil
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		return completeMethod(ctx, cur)
+	},
+}
+
+// flagValue returns the value of the flag with the specified name.
+func flagValue[T any](fs *flag.FlagSet, name string) T {
+	return fs.Lookup(name).Value.(flag.Getter).Get().(T)
+}
+
+type benchResult struct {
+	latencies []time.Duration
+	errors    map[string]int // number of failed calls, by error
+}
+
+func (r *benchResult) merge(other benchResult) {
+	r.latencies = append(r.latencies, other.latencies...)
+	for err, n := range other.errors {
+		if r.errors == nil {
+			r.errors = make(map[string]int)
+		}
+		r.errors[err] += n
+	}
+}
+
+// benchWorker calls the method until ctx is done.
+func benchWorker(ctx context.Context, client *varlink.Client, method string, params json.RawMessage) (r benchResult) {
+	r.errors = make(map[string]int)
+	for {
+		start := time.Now()
+		err := benchCall(ctx, client, method, params)
+		latency := time.Since(start)
+		if ctx.Err() != nil {
+			// The call was interrupted at the end of the benchmark.
+			return r
+		}
+		if err != nil {
+			r.errors[err.Error()]++
+		}
+		r.latencies = append(r.latencies, latency)
+	}
+}
+
+// benchCall makes a call and reads all its replies.
+func benchCall(ctx context.Context, client *varlink.Client, method string, params json.RawMessage) error {
+	rs, err := client.Call(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	for rs.Next() {
+	}
+	return rs.Error()
+}
+
+func (r *benchResult) report(w io.Writer, elapsed time.Duration) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	defer tw.Flush()
+
+	calls := len(r.latencies)
+	fmt.Fprintf(tw, "calls:\t%d\t(%.1f/s)\n", calls, float64(calls)/elapsed.Seconds())
+
+	var failed int
+	for _, n := range r.errors {
+		failed += n
+	}
+	fmt.Fprintf(tw, "errors:\t%d\n", failed)
+	errs := make([]string, 0, len(r.errors))
+	for err := range r.errors {
+		errs = append(errs, err)
+	}
+	slices.Sort(errs)
+	for _, err := range errs {
+		fmt.Fprintf(tw, "  %s\t%d\n", err, r.errors[err])
+	}
+
+	if calls == 0 {
+		return
+	}
+	slices.Sort(r.latencies)
+	var sum time.Duration
+	for _, l := range r.latencies {
+		sum += l
+	}
+	fmt.Fprintf(tw, "latency:\n")
+	fmt.Fprintf(tw, "  min\t%v\n", r.latencies[0])
+	fmt.Fprintf(tw, "  mean\t%v\n", sum/time.Duration(calls))
+	for _, p := range []float64{50, 90, 99, 99.9} {
+		fmt.Fprintf(tw, "  p%g\t%v\n", p, r.latencies[int(float64(calls-1)*p/100)])
+	}
+	fmt.Fprintf(tw, "  max\t%v\n", r.latencies[calls-1])
+}
@@ -0,0 +1,377 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+var infoCommand = &command{
+	Name:  "info",
+	Args:  "<address>",
+	Short: "print information about a service",
+	Long: `Info prints the vendor, product, version and URL of the service at the
+specified address, and the list of interfaces it implements.`,
+	Flags: timeoutFlag,
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return errUsage
+		}
+		uri, err := varlink.ParseURI(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := withTimeout(ctx, fs)
+		defer cancel()
+
+		client := service.Client{Client: varlink.Client{URI: uri}}
+		vendor, product, version, url, interfaces, err := client.GetInfo(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Vendor: %s\nProduct: %s\nVersion: %s\nURL: %s\nInterfaces:\n", vendor, product, version, url)
+		for _, intf := range interfaces {
+			fmt.Printf("  %s\n", intf)
+		}
+		return nil
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		return completeAddress(cur, "")
+	},
+}
+
+var listCommand = &command{
+	Name:  "list",
+	Short: "list the services running on the local host",
+	Long: `List prints the address, product and interfaces of the varlink services
+running on the local host.
+
+The services are found by probing the varlink resolver, the unix sockets
+in $XDG_RUNTIME_DIR/varlink, and the listening unix sockets of the
+abstract namespace.`,
+	Flags: timeoutFlag,
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+
+		ctx, cancel := withTimeout(ctx, fs)
+		defer cancel()
+
+		services, err := varlink.DiscoverLocal(ctx)
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		for _, svc := range services {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", svc.URI, svc.Product, strings.Join(svc.Interfaces, ", "))
+		}
+		tw.Flush()
+		return err
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		return nil
+	},
+}
+
+var helpCommand = &command{
+	Name:  "help",
+	Args:  "[<command> | <address>/<interface>]",
+	Short: "print help about a command, or the description of an interface",
+	Long: `Help prints the usage of the specified command, or the list of commands if
+none is specified.
+
+If the argument is an interface of a service, help prints the description
+of the interface, as returned by the service.`,
+	Flags: timeoutFlag,
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		switch {
+		case fs.NArg() == 0:
+			usage(os.Stdout)
+			return nil
+		case fs.NArg() > 1:
+			return errUsage
+		}
+		if cmd := lookupCommand(fs.Arg(0)); cmd != nil {
+			cmd.usage(os.Stdout)
+			return nil
+		}
+
+		uri, intf, err := splitAddress(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := withTimeout(ctx, fs)
+		defer cancel()
+
+		client := service.Client{Client: varlink.Client{URI: uri}}
+		desc, err := client.Description(ctx, intf)
+		if err != nil {
+			return err
+		}
+		fmt.Print(strings.TrimRight(desc, "\n"), "\n")
+		return nil
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		var out []string
+		for _, cmd := range commands {
+			if !cmd.Hidden && strings.HasPrefix(cmd.Name, cur) {
+				out = append(out, cmd.Name)
+			}
+		}
+		if len(out) > 0 && cur == "" {
+			return out
+		}
+		return append(out, completeInterface(ctx, cur)...)
+	},
+}
+
+var callCommand = &command{
+	Name:  "call",
+	Args:  "<address>/<method> [<parameters>]",
+	Short: "call a method",
+	Long: `Call calls a method of the service at the specified address, and prints the
+parameters of its replies as JSON.
+
+The parameters of the call are a JSON object, read from the standard input
+if they are "-". If they are omitted, the method is called without
+parameters.
+
+The -output flag selects how the parameters are printed: as indented JSON
+(json), as rows of keys and values (table), where arrays of objects get one
+row per element, or as raw values (raw), where strings are printed without
+quotes. The -select flag prints only the value at a path into the
+parameters, made of .key and [index] elements as in .interfaces[0];
+keys that are not identifiers are quoted, as in .["org.example"].
+
+If the call fails with an error reply, the error and its parameters are
+printed on the standard error, and the command exits with status 1.`,
+	Flags: func(fs *flag.FlagSet) {
+		timeoutFlag(fs)
+		outputFlags(fs)
+		fs.Bool("more", false, "request multiple replies")
+		fs.Bool("oneway", false, "do not wait for a reply")
+	},
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() < 1 || fs.NArg() > 2 {
+			return errUsage
+		}
+		uri, method, err := splitAddress(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		params := json.RawMessage("{}")
+		switch arg := fs.Arg(1); arg {
+		case "":
+		case "-":
+			params, err = io.ReadAll(os.Stdin)
+			if err != nil {
+				return err
+			}
+		default:
+			params = json.RawMessage(arg)
+		}
+		if !json.Valid(params) {
+			return errors.New("parameters are not valid JSON")
+		}
+		printer, err := newPrinter(fs)
+		if err != nil {
+			return err
+		}
+
+		opts := []varlink.CallOption{varlink.CallURI(uri.String())}
+		if boolFlag(fs, "more") {
+			opts = append(opts, varlink.More())
+		}
+		if boolFlag(fs, "oneway") {
+			opts = append(opts, varlink.OneWay())
+		}
+
+		ctx, cancel := withTimeout(ctx, fs)
+		defer cancel()
+
+		rs, err := varlink.DoCallContext(ctx, method, params, opts...)
+		if err != nil {
+			return err
+		}
+		for rs.Next() {
+			reply := rs.Reply()
+			if reply.Error != "" {
+				fmt.Fprintf(os.Stderr, "Call failed with error: %s\n", reply.Error)
+				printJSON(os.Stderr, reply.Parameters)
+				os.Exit(1)
+			}
+			if err := printer.print(os.Stdout, reply.Parameters); err != nil {
+				return err
+			}
+		}
+		return rs.Error()
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		return completeMethod(ctx, cur)
+	},
+}
+
+func boolFlag(fs *flag.FlagSet, name string) bool {
+	return fs.Lookup(name).Value.(flag.Getter).Get().(bool)
+}
+
+func printJSON(w io.Writer, data json.RawMessage) {
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		buf.Reset()
+		buf.Write(data)
+	}
+	buf.WriteByte('\n')
+	w.Write(buf.Bytes())
+}
+
+// resolverURI is the address of the varlink resolver, which maps interface
+// names to the addresses of the services implementing them.
+const resolverURI = "unix:/run/org.varlink.resolver"
+
+var bridgeCommand = &command{
+	Name:  "bridge",
+	Short: "bridge the standard input and output to services",
+	Long: `Bridge serves varlink calls on its standard input and output, and forwards
+them to the service at the address set with -connect, or if it is not set,
+to the service implementing the interface of each call, as resolved by the
+varlink resolver at ` + resolverURI + `.
+
+Bridge lets peers that can only exchange data with the standard input and
+output of a process call the services of the host.
+
+File descriptors cannot be passed through a bridge.`,
+	Flags: func(fs *flag.FlagSet) {
+		fs.String("connect", "", "forward all calls to the service at `address`")
+	},
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		var b bridge
+		if addr := fs.Lookup("connect").Value.String(); addr != "" {
+			uri, err := varlink.ParseURI(addr)
+			if err != nil {
+				return err
+			}
+			b.uri = uri
+		}
+		return varlink.ServeStdio(ctx, &b)
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		return nil
+	},
+}
+
+// bridge is a method handler forwarding calls to other services.
+type bridge struct {
+	uri varlink.URI
+}
+
+func (b *bridge) resolve(ctx context.Context, method string) (varlink.URI, error) {
+	if b.uri != (varlink.URI{}) {
+		return b.uri, nil
+	}
+	i := strings.LastIndexByte(method, '.')
+	if i == -1 {
+		return varlink.URI{}, service.MethodNotFound(method)
+	}
+
+	var in struct {
+		Interface string `json:"interface"`
+	}
+	var out struct {
+		Address string `json:"address"`
+	}
+	in.Interface = method[:i]
+
+	rs, err := varlink.DoCallContext(ctx, "org.varlink.resolver.Resolve", &in, varlink.CallURI(resolverURI))
+	if err != nil {
+		return varlink.URI{}, err
+	}
+	for rs.Next() {
+		if reply := rs.Reply(); reply.Error != "" {
+			return varlink.URI{}, service.InterfaceNotFound(in.Interface)
+		}
+		if err := rs.Unmarshal(&out); err != nil {
+			return varlink.URI{}, err
+		}
+	}
+	if err := rs.Error(); err != nil {
+		return varlink.URI{}, err
+	}
+	return varlink.ParseURI(out.Address)
+}
+
+func (b *bridge) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	ctx := w.Context()
+
+	uri, err := b.resolve(ctx, call.Method)
+	if err != nil {
+		w.WriteError(varlink.ConvertError(ctx, err))
+		return
+	}
+
+	opts := []varlink.CallOption{varlink.CallURI(uri.String())}
+	if call.More {
+		opts = append(opts, varlink.More())
+	}
+	if call.OneWay {
+		opts = append(opts, varlink.OneWay())
+	}
+
+	rs, err := varlink.DoCallContext(ctx, call.Method, call.Parameters, opts...)
+	if err != nil {
+		w.WriteError(varlink.ConvertError(ctx, err))
+		return
+	}
+	for rs.Next() {
+		reply := rs.Reply()
+		switch {
+		case reply.Error != "":
+			verr, err := varlink.NewErrorParams(reply.Error, reply.Parameters)
+			if err != nil {
+				verr = varlink.NewError(reply.Error)
+			}
+			w.WriteError(verr)
+			return
+		case reply.Continues:
+			w.WriteMore(reply.Parameters)
+		default:
+			w.WriteFinal(reply.Parameters)
+		}
+	}
+	if err := rs.Error(); err != nil {
+		w.WriteError(varlink.ConvertError(ctx, err))
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+	"snai.pe/go-varlink/syntax"
+)
+
+var completionCommand = &command{
+	Name:  "completion",
+	Args:  "bash|zsh|fish",
+	Short: "print a shell completion script",
+	Long: `Completion prints the completion script of varlink for the specified shell.
+
+The scripts complete commands and flags, the addresses of the services
+listening on unix sockets in the well-known socket directories, and the
+interfaces and methods of services, which are obtained by introspecting
+them.
+
+To enable completion in the current shell, run:
+
+    bash: source <(varlink completion bash)
+    zsh:  source <(varlink completion zsh)
+    fish: varlink completion fish | source
+
+or install the script in the completion directory of the shell.`,
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return errUsage
+		}
+		script, ok := completionScripts[fs.Arg(0)]
+		if !ok {
+			return fmt.Errorf("unsupported shell %q", fs.Arg(0))
+		}
+		fmt.Print(script)
+		return nil
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		if len(args) > 0 {
+			return nil
+		}
+		return []string{"bash", "fish", "zsh"}
+	},
+}
+
+// The completion scripts call "varlink __complete -- <words>", where the
+// words are the arguments typed so far, the last one being the word under
+// the cursor, and offer the candidates it prints, one per line.
+//
+// Candidates ending with a slash are completed without adding a space
+// after them, so that an interface or method name can be typed right after
+// an address.
+var completionScripts = map[string]string{
+	"bash": `# bash completion for varlink
+
+_varlink() {
+	local line=${COMP_LINE:0:COMP_POINT}
+	local -a words
+	read -r -a words <<< "$line"
+	if [[ $line == *[[:space:]] ]]; then
+		words+=("")
+	fi
+
+	local cur=${words[-1]}
+	local IFS=$'\n'
+	COMPREPLY=($(varlink __complete -- "${words[@]:1}" 2>/dev/null))
+
+	if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == */ ]]; then
+		compopt -o nospace
+	fi
+
+	# Addresses contain colons, which bash treats as word separators.
+	if [[ $cur == *:* && $COMP_WORDBREAKS == *:* ]]; then
+		local prefix=${cur%"${cur##*:}"}
+		COMPREPLY=("${COMPREPLY[@]#"$prefix"}")
+	fi
+}
+
+complete -F _varlink varlink
+`,
+	"zsh": `#compdef varlink
+
+_varlink() {
+	local -a candidates dirs
+	candidates=("${(@f)$(varlink __complete -- "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+	dirs=(${(M)candidates:#*/})
+	candidates=(${candidates:#*/})
+	compadd -Q -S '' -a dirs
+	compadd -Q -a candidates
+}
+
+if [[ $zsh_eval_context[-1] == loadautofunc ]]; then
+	_varlink "$@"
+else
+	compdef _varlink varlink
+fi
+`,
+	"fish": `# fish completion for varlink
+
+function __varlink_complete
+	set -l words (commandline -opc)[2..-1] (commandline -ct)
+	varlink __complete -- $words 2>/dev/null
+end
+
+complete -c varlink -f -a '(__varlink_complete)'
+`,
+}
+
+var completeCommand = &command{
+	Name:   "__complete",
+	Args:   "-- <words>",
+	Short:  "print completion candidates",
+	Long:   `__complete prints the completion candidates of the last word.`,
+	Hidden: true,
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() == 0 {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+		defer cancel()
+
+		for _, candidate := range complete(ctx, fs.Args()) {
+			fmt.Println(candidate)
+		}
+		return nil
+	},
+}
+
+// completionTimeout bounds the time spent introspecting services to
+// complete a word, to keep the shell responsive.
+const completionTimeout = 2 * time.Second
+
+// complete returns the candidates for the last of the specified words.
+func complete(ctx context.Context, words []string) []string {
+	cur := words[len(words)-1]
+	words = words[:len(words)-1]
+
+	if len(words) == 0 {
+		var out []string
+		for _, cmd := range commands {
+			if !cmd.Hidden {
+				out = append(out, cmd.Name)
+			}
+		}
+		return filterPrefix(out, cur)
+	}
+
+	cmd := lookupCommand(words[0])
+	if cmd == nil || cmd.Complete == nil {
+		return nil
+	}
+	fs := cmd.flagSet()
+
+	if strings.HasPrefix(cur, "-") {
+		var out []string
+		fs.VisitAll(func(f *flag.Flag) {
+			out = append(out, "-"+f.Name)
+		})
+		return filterPrefix(out, cur)
+	}
+
+	// Don't complete the values of flags.
+	if last := words[len(words)-1]; strings.HasPrefix(last, "-") && !strings.Contains(last, "=") {
+		if f := fs.Lookup(strings.TrimLeft(last, "-")); f != nil {
+			if b, ok := f.Value.(interface{ IsBoolFlag() bool }); !ok || !b.IsBoolFlag() {
+				return nil
+			}
+		}
+	}
+
+	fs.Parse(words[1:])
+	return filterPrefix(cmd.Complete(ctx, fs.Args(), cur), cur)
+}
+
+func filterPrefix(candidates []string, prefix string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// socketDirs returns the directories in which services conventionally
+// create their unix sockets.
+func socketDirs() []string {
+	dirs := []string{
+		"/run",
+		"/run/varlink",
+		"/run/systemd",
+		"/run/systemd/userdb",
+	}
+	if rundir := os.Getenv("XDG_RUNTIME_DIR"); rundir != "" {
+		dirs = append(dirs, rundir, filepath.Join(rundir, "varlink"))
+	}
+	return dirs
+}
+
+// completeAddress completes the address of a service. Each completed
+// address is followed by suffix.
+//
+// Addresses of unix sockets typed as a path are completed from the entries
+// of the directory being typed; otherwise, the sockets in the well-known
+// socket directories are offered.
+func completeAddress(cur, suffix string) []string {
+	var out []string
+	if path, ok := strings.CutPrefix(cur, "unix:"); ok && strings.Contains(path, "/") {
+		dir := path[:strings.LastIndexByte(path, '/')+1]
+		entries, _ := os.ReadDir(dir)
+		for _, entry := range entries {
+			switch {
+			case entry.Type()&os.ModeSocket != 0:
+				out = append(out, "unix:"+dir+entry.Name()+suffix)
+			case entry.IsDir():
+				out = append(out, "unix:"+dir+entry.Name()+"/")
+			}
+		}
+		return out
+	}
+
+	for _, dir := range socketDirs() {
+		entries, _ := os.ReadDir(dir)
+		for _, entry := range entries {
+			if entry.Type()&os.ModeSocket != 0 {
+				out = append(out, "unix:"+filepath.Join(dir, entry.Name())+suffix)
+			}
+		}
+	}
+	return out
+}
+
+// completeService splits the word being completed into the address of a
+// service and the start of a name. If the word does not contain a name yet,
+// ok is false.
+func completeService(cur string) (uri varlink.URI, name string, ok bool) {
+	uri, name, err := splitAddress(cur)
+	if err != nil || uri.Scheme == "" {
+		return varlink.URI{}, "", false
+	}
+	if uri.Scheme == "unix" && !strings.HasPrefix(uri.Address, "@") {
+		// The slash may belong to the path of the socket.
+		if fi, err := os.Stat(uri.Address); err != nil || fi.Mode()&os.ModeSocket == 0 {
+			return varlink.URI{}, "", false
+		}
+	}
+	return uri, name, true
+}
+
+// completeInterface completes <address>/<interface> arguments.
+func completeInterface(ctx context.Context, cur string) []string {
+	uri, _, ok := completeService(cur)
+	if !ok {
+		return completeAddress(cur, "/")
+	}
+
+	client := service.Client{Client: varlink.Client{URI: uri}}
+	_, _, _, _, interfaces, err := client.GetInfo(ctx)
+	if err != nil {
+		return nil
+	}
+
+	out := make([]string, 0, len(interfaces))
+	for _, intf := range interfaces {
+		out = append(out, uri.String()+"/"+intf)
+	}
+	return out
+}
+
+// completeMethod completes <address>/<method> arguments.
+func completeMethod(ctx context.Context, cur string) []string {
+	uri, name, ok := completeService(cur)
+	if !ok {
+		return completeAddress(cur, "/")
+	}
+
+	client := service.Client{Client: varlink.Client{URI: uri}}
+	_, _, _, _, interfaces, err := client.GetInfo(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var out []string
+	for _, intf := range interfaces {
+		if !strings.HasPrefix(intf+".", name) && !strings.HasPrefix(name, intf+".") {
+			continue
+		}
+		desc, err := client.Description(ctx, intf)
+		if err != nil {
+			continue
+		}
+		parser := syntax.NewParser(strings.NewReader(desc))
+		parser.Limits = syntax.UntrustedLimits
+		def, err := parser.Parse()
+		if err != nil {
+			continue
+		}
+		for _, method := range def.Methods {
+			out = append(out, uri.String()+"/"+intf+"."+method.Name)
+		}
+	}
+	return out
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Command varlink calls and inspects varlink services from the command line.
+//
+// Usage:
+//
+//	varlink <command> [arguments]
+//
+// Services are designated by their address, which is a varlink URI like
+// unix:/run/org.example.ftl or tcp:127.0.0.1:12345. Interfaces and methods
+// are designated by appending their name to the address, separated with a
+// slash, as in unix:/run/org.example.ftl/org.example.ftl.Monitor.
+//
+// Run "varlink help" for the list of commands. Shell completion scripts
+// and manual pages are generated by the completion and man commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// command is a subcommand of the varlink command.
+type command struct {
+	// Name is the name of the command, as typed on the command line.
+	Name string
+
+	// Args is the synopsis of the positional arguments of the command.
+	Args string
+
+	// Short is a one-line description of the command.
+	Short string
+
+	// Long is the full description of the command, as paragraphs separated
+	// by blank lines.
+	Long string
+
+	// Hidden commands are not listed in the usage nor the manual.
+	Hidden bool
+
+	// Flags returns the flag set of the command, with its flags registered.
+	Flags func(fs *flag.FlagSet)
+
+	// Run runs the command with its flags parsed.
+	Run func(ctx context.Context, fs *flag.FlagSet) error
+
+	// Complete returns the completion candidates of the positional argument
+	// being typed, given the previous positional arguments.
+	Complete func(ctx context.Context, args []string, cur string) []string
+}
+
+// flagSet returns the flag set of the command.
+func (cmd *command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("varlink "+cmd.Name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if cmd.Flags != nil {
+		cmd.Flags(fs)
+	}
+	return fs
+}
+
+func (cmd *command) usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: varlink %s", cmd.Name)
+	if hasFlags(cmd.flagSet()) {
+		fmt.Fprint(w, " [flags]")
+	}
+	if cmd.Args != "" {
+		fmt.Fprintf(w, " %s", cmd.Args)
+	}
+	fmt.Fprintf(w, "\n\n%s\n", cmd.Long)
+
+	fs := cmd.flagSet()
+	if hasFlags(fs) {
+		fmt.Fprint(w, "\nflags:\n")
+		fs.SetOutput(w)
+		fs.PrintDefaults()
+	}
+}
+
+func hasFlags(fs *flag.FlagSet) bool {
+	has := false
+	fs.VisitAll(func(*flag.Flag) { has = true })
+	return has
+}
+
+// commands is the list of commands, in the order in which they are listed
+// in the usage. It is filled in by init to break the initialization cycle
+// between the commands that list commands and the list itself.
+var commands []*command
+
+func init() {
+	commands = []*command{
+		listCommand,
+		infoCommand,
+		helpCommand,
+		callCommand,
+		benchCommand,
+		bridgeCommand,
+		completionCommand,
+		manCommand,
+		completeCommand,
+	}
+}
+
+func lookupCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.Name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprint(w, "usage: varlink <command> [arguments]\n\ncommands:\n")
+	for _, cmd := range commands {
+		if !cmd.Hidden {
+			fmt.Fprintf(w, "  %-12s %s\n", cmd.Name, cmd.Short)
+		}
+	}
+	fmt.Fprint(w, "\nRun \"varlink help <command>\" for more information about a command.\n")
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "varlink: %s\n", fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+// errUsage is returned by commands invoked with invalid arguments.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	if name == "-h" || name == "-help" || name == "--help" {
+		usage(os.Stdout)
+		return
+	}
+
+	cmd := lookupCommand(name)
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "varlink: unknown command %q\n", name)
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	fs := cmd.flagSet()
+	if err := fs.Parse(os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			cmd.usage(os.Stdout)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "varlink %s: %v\n", cmd.Name, err)
+		cmd.usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	err := cmd.Run(context.Background(), fs)
+	switch {
+	case errors.Is(err, errUsage):
+		cmd.usage(os.Stderr)
+		os.Exit(2)
+	case err != nil:
+		fatalf("%s: %v", cmd.Name, err)
+	}
+}
+
+// timeoutFlag registers the -timeout flag common to the commands making
+// calls.
+func timeoutFlag(fs *flag.FlagSet) {
+	fs.Duration("timeout", 0, "abort the command if it has not completed after `duration`")
+}
+
+// withTimeout returns a context that becomes done after the duration of the
+// -timeout flag, if set.
+func withTimeout(ctx context.Context, fs *flag.FlagSet) (context.Context, context.CancelFunc) {
+	f := fs.Lookup("timeout")
+	if f == nil {
+		return ctx, func() {}
+	}
+	timeout := f.Value.(flag.Getter).Get().(time.Duration)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// splitAddress splits a string of the form <uri>/<name> into the URI of a
+// service and the name of an interface or method.
+func splitAddress(s string) (varlink.URI, string, error) {
+	i := strings.LastIndexByte(s, '/')
+	if i == -1 {
+		return varlink.URI{}, "", fmt.Errorf("%q: not in the form <address>/<name>", s)
+	}
+	uri, err := varlink.ParseURI(s[:i])
+	if err != nil {
+		return varlink.URI{}, "", err
+	}
+	return uri, s[i+1:], nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var manCommand = &command{
+	Name:  "man",
+	Short: "generate manual pages",
+	Long: `Man generates the manual pages of varlink, in the roff format of man(1).
+
+Without -dir, the varlink(1) page is printed on the standard output. With
+-dir, the varlink(1) page and a varlink-<command>(1) page for each command
+are written to the specified directory.`,
+	Flags: func(fs *flag.FlagSet) {
+		fs.String("dir", "", "write all manual pages to `directory`")
+	},
+	Run: func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 0 {
+			return errUsage
+		}
+		dir := fs.Lookup("dir").Value.String()
+		if dir == "" {
+			return writeMan(os.Stdout, nil)
+		}
+
+		if err := writeManFile(filepath.Join(dir, "varlink.1"), nil); err != nil {
+			return err
+		}
+		for _, cmd := range commands {
+			if cmd.Hidden {
+				continue
+			}
+			if err := writeManFile(filepath.Join(dir, "varlink-"+cmd.Name+".1"), cmd); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Complete: func(ctx context.Context, args []string, cur string) []string {
+		return nil
+	},
+}
+
+// overview is the description of the varlink(1) manual page.
+const overview = `Varlink calls and inspects varlink services from the command line.
+
+Services are designated by their address, which is a varlink URI like
+unix:/run/org.example.ftl or tcp:127.0.0.1:12345. Interfaces and methods
+are designated by appending their name to the address, separated with a
+slash, as in unix:/run/org.example.ftl/org.example.ftl.Monitor.`
+
+func writeManFile(name string, cmd *command) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := writeMan(f, cmd); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeMan writes the manual page of the specified command, or of varlink
+// itself if cmd is nil.
+func writeMan(w io.Writer, cmd *command) error {
+	out := bufio.NewWriter(w)
+
+	title, name, short, long := "VARLINK", "varlink", "call and inspect varlink services", overview
+	if cmd != nil {
+		title = "VARLINK-" + strings.ToUpper(cmd.Name)
+		name = "varlink-" + cmd.Name
+		short, long = cmd.Short, cmd.Long
+	}
+
+	fmt.Fprintf(out, ".TH %s 1 \"\" \"go-varlink\" \"User Commands\"\n", title)
+	fmt.Fprintf(out, ".SH NAME\n%s \\- %s\n", name, roffEscape(short))
+
+	out.WriteString(".SH SYNOPSIS\n")
+	if cmd == nil {
+		out.WriteString(".B varlink\n.I command\n[\\fIarguments\\fR]\n")
+	} else {
+		fmt.Fprintf(out, ".B varlink %s\n", cmd.Name)
+		if hasFlags(cmd.flagSet()) {
+			out.WriteString("[\\fIflags\\fR]\n")
+		}
+		if cmd.Args != "" {
+			fmt.Fprintf(out, "%s\n", roffEscape(cmd.Args))
+		}
+	}
+
+	out.WriteString(".SH DESCRIPTION\n")
+	writeRoffText(out, long)
+
+	if cmd == nil {
+		out.WriteString(".SH COMMANDS\n")
+		for _, cmd := range commands {
+			if cmd.Hidden {
+				continue
+			}
+			fmt.Fprintf(out, ".TP\n.B %s\n%s; see \\fBvarlink-%s\\fR(1).\n", cmd.Name, roffEscape(cmd.Short), cmd.Name)
+		}
+	} else if fs := cmd.flagSet(); hasFlags(fs) {
+		out.WriteString(".SH OPTIONS\n")
+		fs.VisitAll(func(f *flag.Flag) {
+			arg, usage := flag.UnquoteUsage(f)
+			fmt.Fprintf(out, ".TP\n\\fB\\-%s\\fR", roffEscape(f.Name))
+			if arg != "" {
+				fmt.Fprintf(out, " \\fI%s\\fR", roffEscape(arg))
+			}
+			fmt.Fprintf(out, "\n%s\n", roffEscape(usage))
+		})
+	}
+
+	out.WriteString(".SH SEE ALSO\n")
+	if cmd == nil {
+		var refs []string
+		for _, cmd := range commands {
+			if !cmd.Hidden {
+				refs = append(refs, fmt.Sprintf("\\fBvarlink-%s\\fR(1)", cmd.Name))
+			}
+		}
+		fmt.Fprintf(out, "%s\n", strings.Join(refs, ",\n"))
+	} else {
+		out.WriteString("\\fBvarlink\\fR(1)\n")
+	}
+
+	return out.Flush()
+}
+
+// writeRoffText writes text made of paragraphs separated by blank lines.
+// Indented lines are written verbatim, as examples.
+func writeRoffText(out *bufio.Writer, text string) {
+	for i, para := range strings.Split(text, "\n\n") {
+		if i > 0 {
+			out.WriteString(".PP\n")
+		}
+		verbatim := false
+		for _, line := range strings.Split(para, "\n") {
+			indented := strings.HasPrefix(line, "    ")
+			switch {
+			case indented && !verbatim:
+				out.WriteString(".RS\n.nf\n")
+			case !indented && verbatim:
+				out.WriteString(".fi\n.RE\n")
+			}
+			verbatim = indented
+			fmt.Fprintf(out, "%s\n", roffEscape(strings.TrimPrefix(line, "    ")))
+		}
+		if verbatim {
+			out.WriteString(".fi\n.RE\n")
+		}
+	}
+}
+
+// roffEscape escapes text so that it is rendered verbatim by roff.
+func roffEscape(s string) string {
+	s = strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(s)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+	return s
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// outputFlags registers the flags controlling how reply parameters are
+// printed.
+func outputFlags(fs *flag.FlagSet) {
+	fs.String("output", "json", "print replies as `json`, table or raw values")
+	fs.String("select", "", "print only the value at `path`, like .field[0].name")
+}
+
+// printer prints reply parameters as selected by the output flags.
+type printer struct {
+	format string
+	path   []pathElem
+}
+
+func newPrinter(fs *flag.FlagSet) (*printer, error) {
+	p := &printer{format: fs.Lookup("output").Value.String()}
+	switch p.format {
+	case "json", "table", "raw":
+	default:
+		return nil, fmt.Errorf("unknown output format %q", p.format)
+	}
+	path, err := parsePath(fs.Lookup("select").Value.String())
+	if err != nil {
+		return nil, err
+	}
+	p.path = path
+	return p, nil
+}
+
+func (p *printer) print(w io.Writer, data json.RawMessage) error {
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	data, err := selectPath(data, p.path)
+	if err != nil {
+		return err
+	}
+
+	switch p.format {
+	case "raw":
+		fmt.Fprintln(w, rawValue(data))
+	case "table":
+		printTable(w, data)
+	default:
+		printJSON(w, data)
+	}
+	return nil
+}
+
+// pathElem is an element of a path selecting a value in a JSON document,
+// either an object key or an array index.
+type pathElem struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath parses a path made of .key and [index] elements, as in
+// .interfaces[0]. Keys that are not identifiers are quoted, as in
+// .["org.example.key"]. The path "." or "" selects the whole document.
+func parsePath(s string) ([]pathElem, error) {
+	if s == "" || s == "." {
+		return nil, nil
+	}
+	orig := s
+	var path []pathElem
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, ".["), s[0] == '[':
+			s = strings.TrimPrefix(s, ".")
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", orig)
+			}
+			inner := s[1:end]
+			s = s[end+1:]
+			if strings.HasPrefix(inner, `"`) {
+				key, err := strconv.Unquote(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: bad key %s", orig, inner)
+				}
+				path = append(path, pathElem{key: key})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: bad index %s", orig, inner)
+			}
+			path = append(path, pathElem{index: index, isIndex: true})
+		case s[0] == '.':
+			s = s[1:]
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty key", orig)
+			}
+			path = append(path, pathElem{key: s[:end]})
+			s = s[end:]
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected . or [ before %q", orig, s)
+		}
+	}
+	return path, nil
+}
+
+// selectPath returns the value at the specified path in data. Like jq,
+// selecting a missing key yields null.
+func selectPath(data json.RawMessage, path []pathElem) (json.RawMessage, error) {
+	for _, elem := range path {
+		if bytes.Equal(data, []byte("null")) {
+			return data, nil
+		}
+		if !elem.isIndex {
+			var obj map[string]json.RawMessage
+			if err := json.Unmarshal(data, &obj); err != nil {
+				return nil, fmt.Errorf("cannot select key %q of %s", elem.key, kindOf(data))
+			}
+			var ok bool
+			if data, ok = obj[elem.key]; !ok {
+				data = json.RawMessage("null")
+			}
+			continue
+		}
+		var arr []json.RawMessage
+		if err := json.Unmarshal(data, &arr); err != nil {
+			return nil, fmt.Errorf("cannot index %s", kindOf(data))
+		}
+		index := elem.index
+		if index < 0 {
+			index += len(arr)
+		}
+		if index < 0 || index >= len(arr) {
+			data = json.RawMessage("null")
+			continue
+		}
+		data = arr[index]
+	}
+	return data, nil
+}
+
+func kindOf(data json.RawMessage) string {
+	switch data = bytes.TrimSpace(data); {
+	case len(data) == 0:
+		return "nothing"
+	case data[0] == '{':
+		return "an object"
+	case data[0] == '[':
+		return "an array"
+	case data[0] == '"':
+		return "a string"
+	case data[0] == 't', data[0] == 'f':
+		return "a boolean"
+	case data[0] == 'n':
+		return "null"
+	default:
+		return "a number"
+	}
+}
+
+// rawValue returns strings without quotes, and other values as compact
+// JSON.
+func rawValue(data json.RawMessage) string {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
+
+// printTable prints objects as rows of keys and values, and arrays of
+// objects with one row per element and one column per key. Other values
+// are printed raw.
+func printTable(w io.Writer, data json.RawMessage) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	defer tw.Flush()
+
+	if keys, values, err := objectFields(data); err == nil {
+		for i, key := range keys {
+			fmt.Fprintf(tw, "%s\t%s\n", key, cell(values[i]))
+		}
+		return
+	}
+
+	var arr []json.RawMessage
+	if json.Unmarshal(data, &arr) != nil {
+		fmt.Fprintln(tw, rawValue(data))
+		return
+	}
+
+	var (
+		columns []string
+		rows    []map[string]json.RawMessage
+	)
+	for _, elem := range arr {
+		keys, values, err := objectFields(elem)
+		if err != nil {
+			fmt.Fprintln(tw, rawValue(elem))
+			continue
+		}
+		row := make(map[string]json.RawMessage, len(keys))
+		for i, key := range keys {
+			if _, ok := row[key]; !ok && !slices.Contains(columns, key) {
+				columns = append(columns, key)
+			}
+			row[key] = values[i]
+		}
+		rows = append(rows, row)
+	}
+	if len(columns) == 0 {
+		return
+	}
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			if v, ok := row[col]; ok {
+				cells[i] = cell(v)
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+}
+
+// cellEscaper escapes the characters that would break the layout of
+// tables.
+var cellEscaper = strings.NewReplacer("\n", `\n`, "\t", `\t`)
+
+func cell(data json.RawMessage) string {
+	return cellEscaper.Replace(rawValue(data))
+}
+
+// objectFields returns the keys and values of a JSON object, in order.
+func objectFields(data json.RawMessage) (keys []string, values []json.RawMessage, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, nil, errors.New("not an object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, tok.(string))
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
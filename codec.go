@@ -0,0 +1,72 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Codec encodes and decodes the messages of a session. Since varlink
+// messages are JSON documents, codecs are meant to plug alternative JSON
+// implementations in, and must honor the json.Marshaler and
+// json.Unmarshaler implementations of the values they are passed, like
+// json.RawMessage.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is the default Codec of sessions, which uses the encoding/json
+// package.
+var JSONCodec Codec = jsonCodec{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// CanonicalJSON enables or disables the canonicalization of the messages
+// written to the session. Canonicalization is disabled by default.
+//
+// Canonical messages have the keys of their JSON objects sorted, at any
+// depth, and no insignificant whitespace. Characters are not escaped
+// beyond what JSON requires, and numbers are written as they were encoded.
+// This makes the encoding of calls, replies and errors deterministic, which
+// deployments that hash or sign payloads rely on.
+func (session *Session) CanonicalJSON(enabled bool) {
+	session.canonical.Store(enabled)
+}
+
+// encodeMessage encodes a call or a reply to be written to the session.
+func (session *Session) encodeMessage(msg any) ([]byte, error) {
+	payload, err := session.codec.Marshal(msg)
+	if err != nil || !session.canonical.Load() {
+		return payload, err
+	}
+	return Canonicalize(payload)
+}
+
+// Canonicalize returns the canonical form of a JSON document, as written by
+// sessions with canonical JSON enabled. See [Session.CanonicalJSON].
+func Canonicalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	// Maps are encoded with sorted keys.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
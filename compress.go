@@ -0,0 +1,179 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Supported compression algorithms.
+//
+// Only gzip is supported, since go-varlink has no dependencies outside of
+// the standard library. The negotiation lets peers announce several
+// algorithms, so that others can be added without breaking compatibility.
+const (
+	CompressionGzip = "gzip"
+)
+
+// DefaultCompressionThreshold is the default size, in bytes, under which
+// messages are sent uncompressed.
+const DefaultCompressionThreshold = 1024
+
+// frameCompressed is set in the length header of length-prefixed frames
+// whose payload is compressed. maxFrameLength guarantees that it is never
+// set for uncompressed frames.
+const frameCompressed = 1 << 31
+
+// CompressionOptions configures the compression of the messages written on
+// a session.
+//
+// Compression is only used on top of length-prefixed framing, which is
+// negotiated at the same time. It is transparent for the rest of the
+// session: each side decides on its own which messages are worth
+// compressing, and flags them on the wire.
+type CompressionOptions struct {
+	// Algorithm is the compression algorithm. The only supported algorithm
+	// is CompressionGzip.
+	Algorithm string `json:"algorithm"`
+
+	// Level is the compression level, as defined by compress/gzip. The
+	// default is gzip.DefaultCompression.
+	Level int `json:"level,omitempty"`
+
+	// Threshold is the size, in bytes, under which messages are sent
+	// uncompressed, since compressing small messages usually costs more
+	// than it saves. The default is DefaultCompressionThreshold.
+	Threshold int `json:"threshold,omitempty"`
+}
+
+func (opts *CompressionOptions) validate() error {
+	if opts.Algorithm != CompressionGzip {
+		return fmt.Errorf("unsupported compression algorithm %q", opts.Algorithm)
+	}
+	if opts.Level != 0 {
+		if _, err := gzip.NewWriterLevel(io.Discard, opts.Level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (opts *CompressionOptions) threshold() int {
+	if opts.Threshold <= 0 {
+		return DefaultCompressionThreshold
+	}
+	return opts.Threshold
+}
+
+// NegotiateCompression attempts to switch the session to length-prefixed
+// framing, like NegotiateFraming, and to compress the messages written on
+// the session with the specified options.
+//
+// If the peer does not support framing negotiation, the session keeps using
+// the standard framing, and NegotiateCompression returns false with a nil
+// error. If it only supports length-prefixed framing, or does not accept
+// the compression algorithm, the session switches framing, but messages are
+// sent uncompressed and NegotiateCompression returns false.
+//
+// The same restrictions as NegotiateFraming apply.
+func (session *Session) NegotiateCompression(ctx context.Context, opts CompressionOptions) (bool, error) {
+	if err := opts.validate(); err != nil {
+		return false, err
+	}
+
+	params := framingParams{
+		Framing:     framingNameLengthPrefixed,
+		Compression: []string{opts.Algorithm},
+	}
+	reply, err := session.negotiateFraming(ctx, params, &opts)
+	if err != nil || reply.Error != "" {
+		return false, err
+	}
+
+	var out framingReply
+	if err := json.Unmarshal(reply.Parameters, &out); err != nil {
+		return false, nil
+	}
+	return out.Compression == opts.Algorithm, nil
+}
+
+// acceptCompression returns the compression options to use for the write
+// side of the session, given the algorithms that the peer supports, or nil
+// if none of them can be used.
+func acceptCompression(opts *CompressionOptions, algorithms []string) *CompressionOptions {
+	if opts == nil || opts.validate() != nil || !slices.Contains(algorithms, opts.Algorithm) {
+		return nil
+	}
+	return opts
+}
+
+// compressUnlocked compresses msg if the session is configured to, and if
+// it is worth it. It returns the message to write, and whether it was
+// compressed. It must be called with wmu held.
+func (session *Session) compressUnlocked(msg []byte) ([]byte, bool, error) {
+	opts := session.wcompress
+	if opts == nil || len(msg) < opts.threshold() {
+		return msg, false, nil
+	}
+
+	session.wzbuf.Reset()
+	if session.zw == nil {
+		level := opts.Level
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		zw, err := gzip.NewWriterLevel(&session.wzbuf, level)
+		if err != nil {
+			return nil, false, err
+		}
+		session.zw = zw
+	} else {
+		session.zw.Reset(&session.wzbuf)
+	}
+
+	if _, err := session.zw.Write(msg); err != nil {
+		return nil, false, err
+	}
+	if err := session.zw.Close(); err != nil {
+		return nil, false, err
+	}
+
+	if session.wzbuf.Len() >= len(msg) {
+		return msg, false, nil
+	}
+	return session.wzbuf.Bytes(), true, nil
+}
+
+// decompressFrame decompresses the payload of a compressed frame. The
+// returned slice is only valid until the next read.
+func (session *Session) decompressFrame(frame []byte) ([]byte, error) {
+	if session.zr == nil {
+		zr, err := gzip.NewReader(bytes.NewReader(frame))
+		if err != nil {
+			return nil, err
+		}
+		session.zr = zr
+	} else if err := session.zr.Reset(bytes.NewReader(frame)); err != nil {
+		return nil, err
+	}
+
+	session.rzbuf.Reset()
+	max := int64(session.maxFrameLength())
+	n, err := session.rzbuf.ReadFrom(io.LimitReader(session.zr, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > max {
+		return nil, fmt.Errorf("%w: decompressed frame exceeds maximum of %d bytes", ErrMessageTooLarge, max)
+	}
+	return session.rzbuf.Bytes(), nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// serializedHandler runs calls to its handler one at a time.
+type serializedHandler struct {
+	handler MethodHandler
+	mu      sync.Mutex
+}
+
+func (h *serializedHandler) ServeMethod(w ReplyWriter, call *Call) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handler.ServeMethod(w, call)
+}
+
+// coalescedHandler runs calls to its handler one at a time, and only runs
+// the latest call waiting for its turn.
+type coalescedHandler struct {
+	handler MethodHandler
+	mu      sync.Mutex // held while a call runs
+
+	cmu     sync.Mutex // protects pending and coalescedCall.supersededBy
+	pending *coalescedCall
+}
+
+type coalescedCall struct {
+	w            ReplyWriter
+	call         *Call
+	followers    []*coalescedCall
+	supersededBy *coalescedCall
+	done         chan struct{}
+}
+
+func (h *coalescedHandler) ServeMethod(w ReplyWriter, call *Call) {
+	self := &coalescedCall{w: w, call: call, done: make(chan struct{})}
+
+	h.cmu.Lock()
+	if prev := h.pending; prev != nil {
+		prev.supersededBy = self
+		self.followers = append(prev.followers, prev)
+		prev.followers = nil
+	}
+	h.pending = self
+	h.cmu.Unlock()
+
+	h.mu.Lock()
+
+	h.cmu.Lock()
+	next := self.supersededBy
+	if next == nil {
+		h.pending = nil
+	}
+	h.cmu.Unlock()
+
+	if next != nil {
+		// A more recent call took our place; it will reply on our behalf,
+		// and we only need to wait until it does.
+		h.mu.Unlock()
+		<-next.done
+		close(self.done)
+		return
+	}
+
+	defer close(self.done)
+	defer h.mu.Unlock()
+
+	h.handler.ServeMethod(&fanoutReplyWriter{c: self}, call)
+}
+
+// fanoutReplyWriter writes replies to a call and all the calls it superseded.
+//
+// Only the errors writing to the call that runs are returned to the
+// handler. Followers failing to get a reply are reported to the ErrorHook
+// of the server, and get no further replies.
+type fanoutReplyWriter struct {
+	c  *coalescedCall
+	mu sync.Mutex // protects c.followers
+}
+
+func (w *fanoutReplyWriter) Context() context.Context {
+	return w.c.w.Context()
+}
+
+func (w *fanoutReplyWriter) WriteError(err Error) error {
+	w.fanout(func(f *coalescedCall) error {
+		return f.w.WriteError(err)
+	})
+	return w.c.w.WriteError(err)
+}
+
+func (w *fanoutReplyWriter) WriteReply(parameters any, opts ...ReplyOption) error {
+	var reply Reply
+	for _, opt := range opts {
+		opt.SetReplyOption(&reply)
+	}
+	w.fanout(func(f *coalescedCall) error {
+		// Don't send intermediate replies to followers that didn't ask
+		// for them.
+		if reply.Continues && !f.call.More {
+			return nil
+		}
+		return f.w.WriteReply(parameters, opts...)
+	})
+	return w.c.w.WriteReply(parameters, opts...)
+}
+
+func (w *fanoutReplyWriter) WriteMore(parameters any, opts ...ReplyOption) error {
+	w.fanout(func(f *coalescedCall) error {
+		switch {
+		case !w.c.call.More:
+			// The reply is final for the call that runs, so it must be
+			// for its followers too.
+			return f.w.WriteFinal(parameters, opts...)
+		case f.call.More:
+			return f.w.WriteMore(parameters, opts...)
+		}
+		return nil
+	})
+	return w.c.w.WriteMore(parameters, opts...)
+}
+
+func (w *fanoutReplyWriter) WriteFinal(parameters any, opts ...ReplyOption) error {
+	w.fanout(func(f *coalescedCall) error {
+		return f.w.WriteFinal(parameters, opts...)
+	})
+	return w.c.w.WriteFinal(parameters, opts...)
+}
+
+// fanout writes a reply to the followers with write, and drops those that
+// fail, after reporting the error.
+func (w *fanoutReplyWriter) fanout(write func(f *coalescedCall) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.c.followers = slices.DeleteFunc(w.c.followers, func(f *coalescedCall) bool {
+		err := write(f)
+		if err != nil && !errors.Is(err, ErrReplied) {
+			reportError(f.w.Context(), fmt.Errorf("writing coalesced reply: %w", err))
+		}
+		return err != nil
+	})
+}
+
+func (w *fanoutReplyWriter) Call(method string, params any, opts ...CallOption) (*ReplyStream, error) {
+	return w.c.w.Call(method, params, opts...)
+}
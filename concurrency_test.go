@@ -0,0 +1,225 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordWriter is a ReplyWriter recording the replies written to it.
+type recordWriter struct {
+	ReplyWriter
+
+	ctx  context.Context
+	more bool
+	err  error
+
+	mu      sync.Mutex
+	replies []string
+	done    bool
+}
+
+func (w *recordWriter) Context() context.Context {
+	if w.ctx != nil {
+		return w.ctx
+	}
+	return context.Background()
+}
+
+func (w *recordWriter) WriteMore(parameters any, opts ...ReplyOption) error {
+	return w.write(parameters, w.more)
+}
+
+func (w *recordWriter) WriteFinal(parameters any, opts ...ReplyOption) error {
+	return w.write(parameters, false)
+}
+
+func (w *recordWriter) write(parameters any, continues bool) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.done {
+		return ErrReplied
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.done = !continues
+	reply := fmt.Sprint(parameters)
+	if continues {
+		reply += "+"
+	}
+	w.replies = append(w.replies, reply)
+	return nil
+}
+
+func (w *recordWriter) Replies() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.replies
+}
+
+func TestSerialized(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		events  []string
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	h := &serializedHandler{handler: HandlerFunc(func(w ReplyWriter, call *Call) {
+		mu.Lock()
+		events = append(events, "start "+call.Method)
+		mu.Unlock()
+
+		if call.Method == "org.example.First" {
+			close(started)
+			<-release
+		}
+
+		mu.Lock()
+		events = append(events, "end "+call.Method)
+		mu.Unlock()
+		w.WriteFinal(nil)
+	})}
+
+	var wg sync.WaitGroup
+	serve := func(method string) {
+		wg.Go(func() {
+			h.ServeMethod(&recordWriter{}, &Call{Method: method})
+		})
+	}
+	serve("org.example.First")
+	<-started
+	serve("org.example.Second")
+	serve("org.example.Third")
+	close(release)
+	wg.Wait()
+
+	if len(events) != 6 || events[0] != "start org.example.First" {
+		t.Fatalf("unexpected events %q", events)
+	}
+	for i := 0; i < len(events); i += 2 {
+		method, _ := strings.CutPrefix(events[i], "start ")
+		if events[i+1] != "end "+method {
+			t.Fatalf("calls overlapped: %q", events)
+		}
+	}
+}
+
+func TestCoalesced(t *testing.T) {
+	errBroken := errors.New("broken")
+
+	for _, latestMore := range []bool{true, false} {
+		t.Run(fmt.Sprintf("more=%v", latestMore), func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				ran      []int
+				errs     []error
+				reported []error
+				started  = make(chan struct{})
+				release  = make(chan struct{})
+			)
+			hooked := &replyWriter{errorHook: func(session *Session, call *Call, err error) {
+				mu.Lock()
+				reported = append(reported, err)
+				mu.Unlock()
+			}}
+			ctx := context.WithValue(context.Background(), errorHookKey{}, hooked)
+			h := &coalescedHandler{}
+			h.handler = HandlerFunc(func(w ReplyWriter, call *Call) {
+				n, _ := strconv.Atoi(string(call.Parameters))
+				mu.Lock()
+				ran = append(ran, n)
+				mu.Unlock()
+
+				if n == 1 {
+					close(started)
+					<-release
+				}
+				err1 := w.WriteMore(n)
+				err2 := w.WriteFinal(n)
+
+				mu.Lock()
+				errs = append(errs, err1, err2)
+				mu.Unlock()
+			})
+
+			writers := []*recordWriter{
+				{more: true},
+				{more: true},
+				{more: false},
+				{more: true, err: errBroken, ctx: ctx},
+				{more: latestMore},
+			}
+			var wg sync.WaitGroup
+			serve := func(n int) {
+				call := &Call{Method: "org.example.Refresh", Parameters: json.RawMessage(strconv.Itoa(n)), More: writers[n-1].more}
+				wg.Go(func() {
+					h.ServeMethod(writers[n-1], call)
+				})
+				// Wait for the call to be queued, so that the next one
+				// supersedes it.
+				for {
+					h.cmu.Lock()
+					queued := h.pending != nil && h.pending.call == call
+					h.cmu.Unlock()
+					if queued || n == 1 {
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+			serve(1)
+			<-started
+			for n := 2; n <= len(writers); n++ {
+				serve(n)
+			}
+			close(release)
+			wg.Wait()
+
+			if !slices.Equal(ran, []int{1, 5}) {
+				t.Fatalf("calls %v ran, expected only the first and the latest to run", ran)
+			}
+			for _, err := range errs {
+				if errors.Is(err, errBroken) {
+					t.Fatalf("the error writing to a superseded call was returned: %v", err)
+				}
+			}
+			// The failing call is reported once, and dropped.
+			if len(reported) != 1 || !errors.Is(reported[0], errBroken) {
+				t.Fatalf("got reported errors %v, expected one %v", reported, errBroken)
+			}
+
+			expected := [][]string{
+				{"1+", "1"},
+				{"5+", "5"}, // superseded, with the more flag
+				{"5"},       // superseded, without the more flag
+				nil,         // superseded, failing
+				{"5+", "5"},
+			}
+			if !latestMore {
+				// The first reply of the latest call is final, and so it
+				// is for the calls it superseded.
+				expected[1] = []string{"5"}
+				expected[4] = []string{"5"}
+			}
+			for i, w := range writers {
+				if got := w.Replies(); !slices.Equal(got, expected[i]) {
+					t.Errorf("call %d got replies %q, expected %q", i+1, got, expected[i])
+				}
+			}
+		})
+	}
+}
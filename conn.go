@@ -0,0 +1,118 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"time"
+)
+
+// connAddr is the address of connections that are not sockets.
+type connAddr struct {
+	network string
+	address string
+}
+
+func (addr connAddr) Network() string { return addr.network }
+func (addr connAddr) String() string  { return addr.address }
+
+// fileConn is a connection over a file that can be both read and written,
+// like a character device.
+type fileConn struct {
+	*os.File
+	addr connAddr
+}
+
+func (c *fileConn) LocalAddr() net.Addr  { return c.addr }
+func (c *fileConn) RemoteAddr() net.Addr { return c.addr }
+
+// pipeConn is a connection over a pair of files, one for reading and one
+// for writing, like pipes.
+type pipeConn struct {
+	r    *os.File
+	w    *os.File
+	addr connAddr
+}
+
+func (c *pipeConn) Read(p []byte) (int, error)  { return c.r.Read(p) }
+func (c *pipeConn) Write(p []byte) (int, error) { return c.w.Write(p) }
+
+func (c *pipeConn) Close() error {
+	return errors.Join(c.w.Close(), c.r.Close())
+}
+
+func (c *pipeConn) LocalAddr() net.Addr  { return c.addr }
+func (c *pipeConn) RemoteAddr() net.Addr { return c.addr }
+
+func (c *pipeConn) SetDeadline(t time.Time) error {
+	if err := c.r.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.w.SetWriteDeadline(t)
+}
+
+func (c *pipeConn) SetReadDeadline(t time.Time) error  { return c.r.SetReadDeadline(t) }
+func (c *pipeConn) SetWriteDeadline(t time.Time) error { return c.w.SetWriteDeadline(t) }
+
+// streamConn is a connection over an arbitrary stream. Deadlines are set on
+// the stream if it supports them.
+type streamConn struct {
+	io.ReadWriteCloser
+	addr connAddr
+}
+
+func (c *streamConn) LocalAddr() net.Addr  { return c.addr }
+func (c *streamConn) RemoteAddr() net.Addr { return c.addr }
+
+func (c *streamConn) SetDeadline(t time.Time) error {
+	if d, ok := c.ReadWriteCloser.(interface{ SetDeadline(time.Time) error }); ok {
+		return d.SetDeadline(t)
+	}
+	return os.ErrNoDeadline
+}
+
+func (c *streamConn) SetReadDeadline(t time.Time) error {
+	if d, ok := c.ReadWriteCloser.(interface{ SetReadDeadline(time.Time) error }); ok {
+		return d.SetReadDeadline(t)
+	}
+	return os.ErrNoDeadline
+}
+
+func (c *streamConn) SetWriteDeadline(t time.Time) error {
+	if d, ok := c.ReadWriteCloser.(interface{ SetWriteDeadline(time.Time) error }); ok {
+		return d.SetWriteDeadline(t)
+	}
+	return os.ErrNoDeadline
+}
+
+// inheritedFile returns a file for an inherited file descriptor. The
+// descriptor is switched to non-blocking mode, so that the runtime poller
+// is used, which makes deadlines work and lets Close interrupt reads.
+func inheritedFile(fd int, name string) *os.File {
+	syscall.SetNonblock(fd, true)
+	return os.NewFile(uintptr(fd), name)
+}
+
+// fileToConn returns a connection over the specified file, which is closed.
+// Sockets are turned into their net.Conn counterpart, and other files are
+// read and written directly.
+func fileToConn(file *os.File, network string) (net.Conn, error) {
+	conn, err := net.FileConn(file)
+	switch {
+	case err == nil:
+		file.Close()
+		return conn, nil
+	case errors.Is(err, syscall.ENOTSOCK):
+		return &fileConn{File: file, addr: connAddr{network: network, address: file.Name()}}, nil
+	default:
+		file.Close()
+		return nil, err
+	}
+}
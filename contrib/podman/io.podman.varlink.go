@@ -0,0 +1,9513 @@
+// This file was automatically generated by snai.pe/go-varlink/codegen
+// DO NOT EDIT
+
+// Podman Service Interface and API description.  The master version of this document can be found
+// in the [API.md](https://github.com/containers/libpod/blob/master/API.md) file in the upstream libpod repository.
+//
+// This is a subset of the io.podman interface published by libpod v1, kept
+// here to exercise the code generator against a large real-world IDL.
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinkrt"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+var _ = fmt.Errorf
+var _ = json.RawMessage(nil)
+var _ = context.Background
+var _ = varlinkrt.Validate
+
+type Error = varlink.Error
+
+// InterfaceName is the fully-qualified name of this varlink interface.
+const InterfaceName = `io.podman`
+
+type Volume struct {
+	Name       string            `json:"name"`
+	Labels     map[string]string `json:"labels"`
+	MountPoint string            `json:"mountPoint"`
+	Driver     string            `json:"driver"`
+	Options    map[string]string `json:"options"`
+}
+
+type NotImplemented struct {
+	Comment string `json:"comment"`
+}
+
+type StringResponse struct {
+	Message string `json:"message"`
+}
+
+type RemoveImageResponse struct {
+	Untagged []string `json:"untagged"`
+	Deleted  string   `json:"deleted"`
+}
+
+type LogLine struct {
+	Device       string `json:"device"`
+	ParseLogType string `json:"parseLogType"`
+	Time         string `json:"time"`
+	Msg          string `json:"msg"`
+	Cid          string `json:"cid"`
+}
+
+// ContainerChanges describes the return struct for ListContainerChanges
+type ContainerChanges struct {
+	Changed []string `json:"changed"`
+	Added   []string `json:"added"`
+	Deleted []string `json:"deleted"`
+}
+
+type ImageSaveOptions struct {
+	Name       string   `json:"name"`
+	Format     string   `json:"format"`
+	Output     string   `json:"output"`
+	OutputType string   `json:"outputType"`
+	MoreTags   []string `json:"moreTags"`
+	Quiet      bool     `json:"quiet"`
+	Compress   bool     `json:"compress"`
+}
+
+type VolumeCreateOpts struct {
+	VolumeName string            `json:"volumeName"`
+	Driver     string            `json:"driver"`
+	Labels     map[string]string `json:"labels"`
+	Options    map[string]string `json:"options"`
+}
+
+type VolumeRemoveOpts struct {
+	Volumes []string `json:"volumes"`
+	All     bool     `json:"all"`
+	Force   bool     `json:"force"`
+}
+
+type Image struct {
+	Id          string            `json:"id"`
+	Digest      string            `json:"digest"`
+	Digests     []string          `json:"digests"`
+	ParentId    string            `json:"parentId"`
+	RepoTags    []string          `json:"repoTags"`
+	RepoDigests []string          `json:"repoDigests"`
+	Created     string            `json:"created"`
+	Size        int               `json:"size"`
+	VirtualSize int               `json:"virtualSize"`
+	Containers  int               `json:"containers"`
+	Labels      map[string]string `json:"labels"`
+	IsParent    bool              `json:"isParent"`
+	TopLayer    string            `json:"topLayer"`
+	ReadOnly    bool              `json:"readOnly"`
+}
+
+// ImageHistory describes the returned structure from ImageHistory.
+type ImageHistory struct {
+	Id        string   `json:"id"`
+	Created   string   `json:"created"`
+	CreatedBy string   `json:"createdBy"`
+	Tags      []string `json:"tags"`
+	Size      int      `json:"size"`
+	Comment   string   `json:"comment"`
+}
+
+// Represents a single search result from SearchImages
+type ImageSearchResult struct {
+	Description string `json:"description"`
+	IsOfficial  bool   `json:"is_official"`
+	IsAutomated bool   `json:"is_automated"`
+	Registry    string `json:"registry"`
+	Name        string `json:"name"`
+	StarCount   int    `json:"star_count"`
+}
+
+type ImageSearchFilter struct {
+	IsOfficial  *bool `json:"is_official,omitempty"`
+	IsAutomated *bool `json:"is_automated,omitempty"`
+	StarCount   int   `json:"star_count"`
+}
+
+type KubePodService struct {
+	Pod     string `json:"pod"`
+	Service string `json:"service"`
+}
+
+type Container struct {
+	Id               string                  `json:"id"`
+	Image            string                  `json:"image"`
+	Imageid          string                  `json:"imageid"`
+	Command          []string                `json:"command"`
+	Createdat        string                  `json:"createdat"`
+	Runningfor       string                  `json:"runningfor"`
+	Status           string                  `json:"status"`
+	Ports            []ContainerPortMappings `json:"ports"`
+	Rootfssize       int                     `json:"rootfssize"`
+	Rwsize           int                     `json:"rwsize"`
+	Names            string                  `json:"names"`
+	Labels           map[string]string       `json:"labels"`
+	Mounts           []ContainerMount        `json:"mounts"`
+	Containerrunning bool                    `json:"containerrunning"`
+	Namespaces       ContainerNameSpace      `json:"namespaces"`
+}
+
+// Validate checks the values of the fields of Container, as a
+// parameter of the specified name.
+func (t Container) Validate(param string) Error {
+	for _, e := range t.Ports {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "ports[*]")); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, e := range t.Mounts {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "mounts[*]")); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := any(t.Namespaces).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "namespaces")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ContainerStats is the return struct for the stats of a container
+type ContainerStats struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Cpu         float64 `json:"cpu"`
+	CpuNano     int     `json:"cpu_nano"`
+	SystemNano  int     `json:"system_nano"`
+	MemUsage    int     `json:"mem_usage"`
+	MemLimit    int     `json:"mem_limit"`
+	MemPerc     float64 `json:"mem_perc"`
+	NetInput    int     `json:"net_input"`
+	NetOutput   int     `json:"net_output"`
+	BlockOutput int     `json:"block_output"`
+	BlockInput  int     `json:"block_input"`
+	Pids        int     `json:"pids"`
+}
+
+type PsOpts struct {
+	All     bool      `json:"all"`
+	Filters *[]string `json:"filters,omitempty"`
+	Last    *int      `json:"last,omitempty"`
+	Latest  *bool     `json:"latest,omitempty"`
+	NoTrunc *bool     `json:"noTrunc,omitempty"`
+	Pod     *bool     `json:"pod,omitempty"`
+	Quiet   *bool     `json:"quiet,omitempty"`
+	Size    *bool     `json:"size,omitempty"`
+	Sort    *string   `json:"sort,omitempty"`
+	Sync    *bool     `json:"sync,omitempty"`
+}
+
+type PsContainer struct {
+	Id         string            `json:"id"`
+	Image      string            `json:"image"`
+	Command    string            `json:"command"`
+	Created    string            `json:"created"`
+	Ports      string            `json:"ports"`
+	Names      string            `json:"names"`
+	IsInfra    bool              `json:"isInfra"`
+	Status     string            `json:"status"`
+	State      string            `json:"state"`
+	PidNum     int               `json:"pidNum"`
+	RootFsSize int               `json:"rootFsSize"`
+	RwSize     int               `json:"rwSize"`
+	Pod        string            `json:"pod"`
+	CreatedAt  string            `json:"createdAt"`
+	ExitedAt   string            `json:"exitedAt"`
+	StartedAt  string            `json:"startedAt"`
+	Labels     map[string]string `json:"labels"`
+	NsPid      string            `json:"nsPid"`
+	Cgroup     string            `json:"cgroup"`
+	Ipc        string            `json:"ipc"`
+	Mnt        string            `json:"mnt"`
+	Net        string            `json:"net"`
+	PidNs      string            `json:"pidNs"`
+	User       string            `json:"user"`
+	Uts        string            `json:"uts"`
+	Mounts     string            `json:"mounts"`
+}
+
+// ContainerMount describes the struct for mounts in a container
+type ContainerMount struct {
+	Destination string   `json:"destination"`
+	Type        string   `json:"type"`
+	Source      string   `json:"source"`
+	Options     []string `json:"options"`
+}
+
+// ContainerPortMappings describes the struct for portmappings in an existing container
+type ContainerPortMappings struct {
+	HostPort      string `json:"host_port"`
+	HostIp        string `json:"host_ip"`
+	Protocol      string `json:"protocol"`
+	ContainerPort string `json:"container_port"`
+}
+
+// ContainerNamespace describes the namespace structure for an existing container
+type ContainerNameSpace struct {
+	User   string `json:"user"`
+	Uts    string `json:"uts"`
+	Pidns  string `json:"pidns"`
+	Pid    string `json:"pid"`
+	Cgroup string `json:"cgroup"`
+	Net    string `json:"net"`
+	Mnt    string `json:"mnt"`
+	Ipc    string `json:"ipc"`
+}
+
+// InfoDistribution describes the host's distribution
+type InfoDistribution struct {
+	Distribution string `json:"distribution"`
+	Version      string `json:"version"`
+}
+
+// InfoHost describes the host stats portion of PodmanInfo
+type InfoHost struct {
+	BuildahVersion string           `json:"buildah_version"`
+	Distribution   InfoDistribution `json:"distribution"`
+	MemFree        int              `json:"mem_free"`
+	MemTotal       int              `json:"mem_total"`
+	SwapFree       int              `json:"swap_free"`
+	SwapTotal      int              `json:"swap_total"`
+	Arch           string           `json:"arch"`
+	Cpus           int              `json:"cpus"`
+	Hostname       string           `json:"hostname"`
+	Kernel         string           `json:"kernel"`
+	Os             string           `json:"os"`
+	Uptime         string           `json:"uptime"`
+	Eventlogger    string           `json:"eventlogger"`
+}
+
+// Validate checks the values of the fields of InfoHost, as a
+// parameter of the specified name.
+func (t InfoHost) Validate(param string) Error {
+	if v, ok := any(t.Distribution).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "distribution")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InfoGraphStatus describes the detailed status of the storage driver
+type InfoGraphStatus struct {
+	BackingFilesystem string `json:"backing_filesystem"`
+	NativeOverlayDiff string `json:"native_overlay_diff"`
+	SupportsDType     string `json:"supports_d_type"`
+}
+
+// InfoStore describes the host's storage informatoin
+type InfoStore struct {
+	Containers         int             `json:"containers"`
+	Images             int             `json:"images"`
+	GraphDriverName    string          `json:"graph_driver_name"`
+	GraphDriverOptions string          `json:"graph_driver_options"`
+	GraphRoot          string          `json:"graph_root"`
+	GraphStatus        InfoGraphStatus `json:"graph_status"`
+	RunRoot            string          `json:"run_root"`
+}
+
+// Validate checks the values of the fields of InfoStore, as a
+// parameter of the specified name.
+func (t InfoStore) Validate(param string) Error {
+	if v, ok := any(t.GraphStatus).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "graph_status")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InfoPodman provides details on the podman binary
+type InfoPodmanBinary struct {
+	Compiler      string `json:"compiler"`
+	GoVersion     string `json:"go_version"`
+	PodmanVersion string `json:"podman_version"`
+	GitCommit     string `json:"git_commit"`
+}
+
+// PodmanInfo describes the Podman host and build
+type PodmanInfo struct {
+	Host               InfoHost         `json:"host"`
+	Registries         []string         `json:"registries"`
+	InsecureRegistries []string         `json:"insecure_registries"`
+	Store              InfoStore        `json:"store"`
+	Podman             InfoPodmanBinary `json:"podman"`
+}
+
+// Validate checks the values of the fields of PodmanInfo, as a
+// parameter of the specified name.
+func (t PodmanInfo) Validate(param string) Error {
+	if v, ok := any(t.Host).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "host")); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := any(t.Store).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "store")); err != nil {
+			return err
+		}
+	}
+	if v, ok := any(t.Podman).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "podman")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sockets describes sockets location for a container
+type Sockets struct {
+	ContainerId   string `json:"container_id"`
+	IoSocket      string `json:"io_socket"`
+	ControlSocket string `json:"control_socket"`
+}
+
+// ListPodContainerInfo is a returned struct for describing containers
+// in a pod.
+type ListPodContainerInfo struct {
+	Name   string `json:"name"`
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// ListPodData is the returned struct for an individual pod
+type ListPodData struct {
+	Id                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	Createdat          string                 `json:"createdat"`
+	Cgroup             string                 `json:"cgroup"`
+	Status             string                 `json:"status"`
+	Labels             map[string]string      `json:"labels"`
+	Numberofcontainers string                 `json:"numberofcontainers"`
+	Containersinfo     []ListPodContainerInfo `json:"containersinfo"`
+}
+
+// Validate checks the values of the fields of ListPodData, as a
+// parameter of the specified name.
+func (t ListPodData) Validate(param string) Error {
+	for _, e := range t.Containersinfo {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "containersinfo[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type PodContainerErrorData struct {
+	Containerid string `json:"containerid"`
+	Reason      string `json:"reason"`
+}
+
+type PodmanVersion struct {
+	Version          string `json:"version"`
+	GoVersion        string `json:"go_version"`
+	GitCommit        string `json:"git_commit"`
+	Built            string `json:"built"`
+	OsArch           string `json:"os_arch"`
+	RemoteApiVersion int    `json:"remote_api_version"`
+}
+
+// Event describes a libpod struct
+type Event struct {
+	// TODO: make status and type a enum at some point?
+	// id is the container, volume, pod, image ID
+	Id string `json:"id"`
+
+	// image is the image name where applicable
+	Image string `json:"image"`
+
+	// name is the name of the pod, container, image
+	Name string `json:"name"`
+
+	// status describes the event that happened (i.e. create, remove, ...)
+	Status string `json:"status"`
+
+	// time the event happened
+	Time string `json:"time"`
+
+	// type describes object the event happened with (image, container...)
+	Type string `json:"type"`
+}
+
+type DiffInfo struct {
+	// path that is different
+	Path string `json:"path"`
+
+	// Add, Delete, Modify
+	ChangeType string `json:"changeType"`
+}
+
+type ExecOpts struct {
+	// container name or id
+	Name string `json:"name"`
+
+	// Create pseudo tty
+	Tty bool `json:"tty"`
+
+	// privileged access in container
+	Privileged bool `json:"privileged"`
+
+	// command to execute in container
+	Cmd []string `json:"cmd"`
+
+	// user to use in container
+	User *string `json:"user,omitempty"`
+
+	// workdir to run command in container
+	Workdir *string `json:"workdir,omitempty"`
+
+	// slice of keyword=value environment variables
+	Env *[]string `json:"env,omitempty"`
+
+	// string of detach keys
+	DetachKeys *string `json:"detachKeys,omitempty"`
+}
+
+// Input parameters for GetVersion method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetVersionInput struct{}
+
+// Output parameters for GetVersion method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetVersionOutput struct {
+	Version          string `json:"version"`
+	GoVersion        string `json:"go_version"`
+	GitCommit        string `json:"git_commit"`
+	Built            string `json:"built"`
+	OsArch           string `json:"os_arch"`
+	RemoteApiVersion int    `json:"remote_api_version"`
+}
+
+// Pack fills in the fields of GetVersionOutput from a
+// parameter list.
+func (output_ *GetVersionOutput) Pack(version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int) {
+	output_.Version = version
+	output_.GoVersion = goVersion
+	output_.GitCommit = gitCommit
+	output_.Built = built
+	output_.OsArch = osArch
+	output_.RemoteApiVersion = remoteApiVersion
+}
+
+// Unpack unpacks the fields of GetVersionInput to a
+// parameter list.
+func (output_ *GetVersionOutput) Unpack() (version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int) {
+	version = output_.Version
+	goVersion = output_.GoVersion
+	gitCommit = output_.GitCommit
+	built = output_.Built
+	osArch = output_.OsArch
+	remoteApiVersion = output_.RemoteApiVersion
+	return
+}
+
+// Input parameters for GetInfo method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetInfoInput struct{}
+
+// Output parameters for GetInfo method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetInfoOutput struct {
+	Info PodmanInfo `json:"info"`
+}
+
+func (output *GetInfoOutput) Validate(param string) Error {
+	if v, ok := any(output.Info).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "info")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetInfoOutput from a
+// parameter list.
+func (output_ *GetInfoOutput) Pack(info PodmanInfo) {
+	output_.Info = info
+}
+
+// Unpack unpacks the fields of GetInfoInput to a
+// parameter list.
+func (output_ *GetInfoOutput) Unpack() (info PodmanInfo) {
+	info = output_.Info
+	return
+}
+
+// Input parameters for ListContainers method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainersInput struct{}
+
+// Output parameters for ListContainers method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainersOutput struct {
+	Containers []Container `json:"containers"`
+}
+
+func (output *ListContainersOutput) Validate(param string) Error {
+	for _, e := range output.Containers {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "containers[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ListContainersOutput from a
+// parameter list.
+func (output_ *ListContainersOutput) Pack(containers []Container) {
+	output_.Containers = containers
+}
+
+// Unpack unpacks the fields of ListContainersInput to a
+// parameter list.
+func (output_ *ListContainersOutput) Unpack() (containers []Container) {
+	containers = output_.Containers
+	return
+}
+
+// Input parameters for Ps method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type PsInput struct {
+	Opts PsOpts `json:"opts"`
+}
+
+func (input *PsInput) Validate(param string) Error {
+	if v, ok := any(input.Opts).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "opts")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of PsInput from a
+// parameter list.
+func (input_ *PsInput) Pack(opts PsOpts) {
+	input_.Opts = opts
+}
+
+// Unpack unpacks the fields of PsInput to a
+// parameter list.
+func (input_ *PsInput) Unpack() (opts PsOpts) {
+	opts = input_.Opts
+	return
+}
+
+// Output parameters for Ps method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type PsOutput struct {
+	Containers []PsContainer `json:"containers"`
+}
+
+func (output *PsOutput) Validate(param string) Error {
+	for _, e := range output.Containers {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "containers[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of PsOutput from a
+// parameter list.
+func (output_ *PsOutput) Pack(containers []PsContainer) {
+	output_.Containers = containers
+}
+
+// Unpack unpacks the fields of PsInput to a
+// parameter list.
+func (output_ *PsOutput) Unpack() (containers []PsContainer) {
+	containers = output_.Containers
+	return
+}
+
+// Input parameters for GetContainersByStatus method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersByStatusInput struct {
+	Status []string `json:"status"`
+}
+
+// Pack fills in the fields of GetContainersByStatusInput from a
+// parameter list.
+func (input_ *GetContainersByStatusInput) Pack(status []string) {
+	input_.Status = status
+}
+
+// Unpack unpacks the fields of GetContainersByStatusInput to a
+// parameter list.
+func (input_ *GetContainersByStatusInput) Unpack() (status []string) {
+	status = input_.Status
+	return
+}
+
+// Output parameters for GetContainersByStatus method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersByStatusOutput struct {
+	ContainerS []Container `json:"containerS"`
+}
+
+func (output *GetContainersByStatusOutput) Validate(param string) Error {
+	for _, e := range output.ContainerS {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "containerS[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainersByStatusOutput from a
+// parameter list.
+func (output_ *GetContainersByStatusOutput) Pack(containerS []Container) {
+	output_.ContainerS = containerS
+}
+
+// Unpack unpacks the fields of GetContainersByStatusInput to a
+// parameter list.
+func (output_ *GetContainersByStatusOutput) Unpack() (containerS []Container) {
+	containerS = output_.ContainerS
+	return
+}
+
+// Input parameters for Top method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type TopInput struct {
+	NameOrID    string   `json:"nameOrID"`
+	Descriptors []string `json:"descriptors"`
+}
+
+// Pack fills in the fields of TopInput from a
+// parameter list.
+func (input_ *TopInput) Pack(nameOrID string, descriptors []string) {
+	input_.NameOrID = nameOrID
+	input_.Descriptors = descriptors
+}
+
+// Unpack unpacks the fields of TopInput to a
+// parameter list.
+func (input_ *TopInput) Unpack() (nameOrID string, descriptors []string) {
+	nameOrID = input_.NameOrID
+	descriptors = input_.Descriptors
+	return
+}
+
+// Output parameters for Top method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type TopOutput struct {
+	Top []string `json:"top"`
+}
+
+// Pack fills in the fields of TopOutput from a
+// parameter list.
+func (output_ *TopOutput) Pack(top []string) {
+	output_.Top = top
+}
+
+// Unpack unpacks the fields of TopInput to a
+// parameter list.
+func (output_ *TopOutput) Unpack() (top []string) {
+	top = output_.Top
+	return
+}
+
+// Input parameters for HealthCheckRun method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type HealthCheckRunInput struct {
+	NameOrID string `json:"nameOrID"`
+}
+
+// Pack fills in the fields of HealthCheckRunInput from a
+// parameter list.
+func (input_ *HealthCheckRunInput) Pack(nameOrID string) {
+	input_.NameOrID = nameOrID
+}
+
+// Unpack unpacks the fields of HealthCheckRunInput to a
+// parameter list.
+func (input_ *HealthCheckRunInput) Unpack() (nameOrID string) {
+	nameOrID = input_.NameOrID
+	return
+}
+
+// Output parameters for HealthCheckRun method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type HealthCheckRunOutput struct {
+	HealthCheckStatus string `json:"healthCheckStatus"`
+}
+
+// Pack fills in the fields of HealthCheckRunOutput from a
+// parameter list.
+func (output_ *HealthCheckRunOutput) Pack(healthCheckStatus string) {
+	output_.HealthCheckStatus = healthCheckStatus
+}
+
+// Unpack unpacks the fields of HealthCheckRunInput to a
+// parameter list.
+func (output_ *HealthCheckRunOutput) Unpack() (healthCheckStatus string) {
+	healthCheckStatus = output_.HealthCheckStatus
+	return
+}
+
+// Input parameters for GetContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerInput struct {
+	Id string `json:"id"`
+}
+
+// Pack fills in the fields of GetContainerInput from a
+// parameter list.
+func (input_ *GetContainerInput) Pack(id string) {
+	input_.Id = id
+}
+
+// Unpack unpacks the fields of GetContainerInput to a
+// parameter list.
+func (input_ *GetContainerInput) Unpack() (id string) {
+	id = input_.Id
+	return
+}
+
+// Output parameters for GetContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerOutput struct {
+	Container Container `json:"container"`
+}
+
+func (output *GetContainerOutput) Validate(param string) Error {
+	if v, ok := any(output.Container).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "container")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainerOutput from a
+// parameter list.
+func (output_ *GetContainerOutput) Pack(container Container) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of GetContainerInput to a
+// parameter list.
+func (output_ *GetContainerOutput) Unpack() (container Container) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for GetContainersByContext method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersByContextInput struct {
+	All    bool     `json:"all"`
+	Latest bool     `json:"latest"`
+	Args   []string `json:"args"`
+}
+
+// Pack fills in the fields of GetContainersByContextInput from a
+// parameter list.
+func (input_ *GetContainersByContextInput) Pack(all bool, latest bool, args []string) {
+	input_.All = all
+	input_.Latest = latest
+	input_.Args = args
+}
+
+// Unpack unpacks the fields of GetContainersByContextInput to a
+// parameter list.
+func (input_ *GetContainersByContextInput) Unpack() (all bool, latest bool, args []string) {
+	all = input_.All
+	latest = input_.Latest
+	args = input_.Args
+	return
+}
+
+// Output parameters for GetContainersByContext method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersByContextOutput struct {
+	Containers []string `json:"containers"`
+}
+
+// Pack fills in the fields of GetContainersByContextOutput from a
+// parameter list.
+func (output_ *GetContainersByContextOutput) Pack(containers []string) {
+	output_.Containers = containers
+}
+
+// Unpack unpacks the fields of GetContainersByContextInput to a
+// parameter list.
+func (output_ *GetContainersByContextOutput) Unpack() (containers []string) {
+	containers = output_.Containers
+	return
+}
+
+// Input parameters for InspectContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type InspectContainerInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of InspectContainerInput from a
+// parameter list.
+func (input_ *InspectContainerInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of InspectContainerInput to a
+// parameter list.
+func (input_ *InspectContainerInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for InspectContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type InspectContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of InspectContainerOutput from a
+// parameter list.
+func (output_ *InspectContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of InspectContainerInput to a
+// parameter list.
+func (output_ *InspectContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for ListContainerProcesses method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerProcessesInput struct {
+	Name string   `json:"name"`
+	Opts []string `json:"opts"`
+}
+
+// Pack fills in the fields of ListContainerProcessesInput from a
+// parameter list.
+func (input_ *ListContainerProcessesInput) Pack(name string, opts []string) {
+	input_.Name = name
+	input_.Opts = opts
+}
+
+// Unpack unpacks the fields of ListContainerProcessesInput to a
+// parameter list.
+func (input_ *ListContainerProcessesInput) Unpack() (name string, opts []string) {
+	name = input_.Name
+	opts = input_.Opts
+	return
+}
+
+// Output parameters for ListContainerProcesses method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerProcessesOutput struct {
+	Container []string `json:"container"`
+}
+
+// Pack fills in the fields of ListContainerProcessesOutput from a
+// parameter list.
+func (output_ *ListContainerProcessesOutput) Pack(container []string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of ListContainerProcessesInput to a
+// parameter list.
+func (output_ *ListContainerProcessesOutput) Unpack() (container []string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for GetContainerLogs method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerLogsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of GetContainerLogsInput from a
+// parameter list.
+func (input_ *GetContainerLogsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of GetContainerLogsInput to a
+// parameter list.
+func (input_ *GetContainerLogsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for GetContainerLogs method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerLogsOutput struct {
+	Container []string `json:"container"`
+}
+
+// Pack fills in the fields of GetContainerLogsOutput from a
+// parameter list.
+func (output_ *GetContainerLogsOutput) Pack(container []string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of GetContainerLogsInput to a
+// parameter list.
+func (output_ *GetContainerLogsOutput) Unpack() (container []string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for GetContainersLogs method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersLogsInput struct {
+	Names      []string `json:"names"`
+	Follow     bool     `json:"follow"`
+	Latest     bool     `json:"latest"`
+	Since      string   `json:"since"`
+	Tail       int      `json:"tail"`
+	Timestamps bool     `json:"timestamps"`
+}
+
+// Pack fills in the fields of GetContainersLogsInput from a
+// parameter list.
+func (input_ *GetContainersLogsInput) Pack(names []string, follow bool, latest bool, since string, tail int, timestamps bool) {
+	input_.Names = names
+	input_.Follow = follow
+	input_.Latest = latest
+	input_.Since = since
+	input_.Tail = tail
+	input_.Timestamps = timestamps
+}
+
+// Unpack unpacks the fields of GetContainersLogsInput to a
+// parameter list.
+func (input_ *GetContainersLogsInput) Unpack() (names []string, follow bool, latest bool, since string, tail int, timestamps bool) {
+	names = input_.Names
+	follow = input_.Follow
+	latest = input_.Latest
+	since = input_.Since
+	tail = input_.Tail
+	timestamps = input_.Timestamps
+	return
+}
+
+// Output parameters for GetContainersLogs method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersLogsOutput struct {
+	Log LogLine `json:"log"`
+}
+
+func (output *GetContainersLogsOutput) Validate(param string) Error {
+	if v, ok := any(output.Log).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "log")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainersLogsOutput from a
+// parameter list.
+func (output_ *GetContainersLogsOutput) Pack(log LogLine) {
+	output_.Log = log
+}
+
+// Unpack unpacks the fields of GetContainersLogsInput to a
+// parameter list.
+func (output_ *GetContainersLogsOutput) Unpack() (log LogLine) {
+	log = output_.Log
+	return
+}
+
+// Input parameters for ListContainerChanges method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerChangesInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of ListContainerChangesInput from a
+// parameter list.
+func (input_ *ListContainerChangesInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of ListContainerChangesInput to a
+// parameter list.
+func (input_ *ListContainerChangesInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for ListContainerChanges method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerChangesOutput struct {
+	Container ContainerChanges `json:"container"`
+}
+
+func (output *ListContainerChangesOutput) Validate(param string) Error {
+	if v, ok := any(output.Container).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "container")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ListContainerChangesOutput from a
+// parameter list.
+func (output_ *ListContainerChangesOutput) Pack(container ContainerChanges) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of ListContainerChangesInput to a
+// parameter list.
+func (output_ *ListContainerChangesOutput) Unpack() (container ContainerChanges) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for ExportContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ExportContainerInput struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Pack fills in the fields of ExportContainerInput from a
+// parameter list.
+func (input_ *ExportContainerInput) Pack(name string, path string) {
+	input_.Name = name
+	input_.Path = path
+}
+
+// Unpack unpacks the fields of ExportContainerInput to a
+// parameter list.
+func (input_ *ExportContainerInput) Unpack() (name string, path string) {
+	name = input_.Name
+	path = input_.Path
+	return
+}
+
+// Output parameters for ExportContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ExportContainerOutput struct {
+	Tarfile string `json:"tarfile"`
+}
+
+// Pack fills in the fields of ExportContainerOutput from a
+// parameter list.
+func (output_ *ExportContainerOutput) Pack(tarfile string) {
+	output_.Tarfile = tarfile
+}
+
+// Unpack unpacks the fields of ExportContainerInput to a
+// parameter list.
+func (output_ *ExportContainerOutput) Unpack() (tarfile string) {
+	tarfile = output_.Tarfile
+	return
+}
+
+// Input parameters for GetContainerStats method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerStatsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of GetContainerStatsInput from a
+// parameter list.
+func (input_ *GetContainerStatsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of GetContainerStatsInput to a
+// parameter list.
+func (input_ *GetContainerStatsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for GetContainerStats method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerStatsOutput struct {
+	Container ContainerStats `json:"container"`
+}
+
+func (output *GetContainerStatsOutput) Validate(param string) Error {
+	if v, ok := any(output.Container).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "container")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainerStatsOutput from a
+// parameter list.
+func (output_ *GetContainerStatsOutput) Pack(container ContainerStats) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of GetContainerStatsInput to a
+// parameter list.
+func (output_ *GetContainerStatsOutput) Unpack() (container ContainerStats) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for GetContainerStatsWithHistory method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerStatsWithHistoryInput struct {
+	PreviousStats ContainerStats `json:"previousStats"`
+}
+
+func (input *GetContainerStatsWithHistoryInput) Validate(param string) Error {
+	if v, ok := any(input.PreviousStats).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "previousStats")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainerStatsWithHistoryInput from a
+// parameter list.
+func (input_ *GetContainerStatsWithHistoryInput) Pack(previousStats ContainerStats) {
+	input_.PreviousStats = previousStats
+}
+
+// Unpack unpacks the fields of GetContainerStatsWithHistoryInput to a
+// parameter list.
+func (input_ *GetContainerStatsWithHistoryInput) Unpack() (previousStats ContainerStats) {
+	previousStats = input_.PreviousStats
+	return
+}
+
+// Output parameters for GetContainerStatsWithHistory method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainerStatsWithHistoryOutput struct {
+	Container ContainerStats `json:"container"`
+}
+
+func (output *GetContainerStatsWithHistoryOutput) Validate(param string) Error {
+	if v, ok := any(output.Container).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "container")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainerStatsWithHistoryOutput from a
+// parameter list.
+func (output_ *GetContainerStatsWithHistoryOutput) Pack(container ContainerStats) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of GetContainerStatsWithHistoryInput to a
+// parameter list.
+func (output_ *GetContainerStatsWithHistoryOutput) Unpack() (container ContainerStats) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for StartContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StartContainerInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of StartContainerInput from a
+// parameter list.
+func (input_ *StartContainerInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of StartContainerInput to a
+// parameter list.
+func (input_ *StartContainerInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for StartContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StartContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of StartContainerOutput from a
+// parameter list.
+func (output_ *StartContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of StartContainerInput to a
+// parameter list.
+func (output_ *StartContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for StopContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StopContainerInput struct {
+	Name    string `json:"name"`
+	Timeout int    `json:"timeout"`
+}
+
+// Pack fills in the fields of StopContainerInput from a
+// parameter list.
+func (input_ *StopContainerInput) Pack(name string, timeout int) {
+	input_.Name = name
+	input_.Timeout = timeout
+}
+
+// Unpack unpacks the fields of StopContainerInput to a
+// parameter list.
+func (input_ *StopContainerInput) Unpack() (name string, timeout int) {
+	name = input_.Name
+	timeout = input_.Timeout
+	return
+}
+
+// Output parameters for StopContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StopContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of StopContainerOutput from a
+// parameter list.
+func (output_ *StopContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of StopContainerInput to a
+// parameter list.
+func (output_ *StopContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for RestartContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RestartContainerInput struct {
+	Name    string `json:"name"`
+	Timeout int    `json:"timeout"`
+}
+
+// Pack fills in the fields of RestartContainerInput from a
+// parameter list.
+func (input_ *RestartContainerInput) Pack(name string, timeout int) {
+	input_.Name = name
+	input_.Timeout = timeout
+}
+
+// Unpack unpacks the fields of RestartContainerInput to a
+// parameter list.
+func (input_ *RestartContainerInput) Unpack() (name string, timeout int) {
+	name = input_.Name
+	timeout = input_.Timeout
+	return
+}
+
+// Output parameters for RestartContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RestartContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of RestartContainerOutput from a
+// parameter list.
+func (output_ *RestartContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of RestartContainerInput to a
+// parameter list.
+func (output_ *RestartContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for KillContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type KillContainerInput struct {
+	Name   string `json:"name"`
+	Signal int    `json:"signal"`
+}
+
+// Pack fills in the fields of KillContainerInput from a
+// parameter list.
+func (input_ *KillContainerInput) Pack(name string, signal int) {
+	input_.Name = name
+	input_.Signal = signal
+}
+
+// Unpack unpacks the fields of KillContainerInput to a
+// parameter list.
+func (input_ *KillContainerInput) Unpack() (name string, signal int) {
+	name = input_.Name
+	signal = input_.Signal
+	return
+}
+
+// Output parameters for KillContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type KillContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of KillContainerOutput from a
+// parameter list.
+func (output_ *KillContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of KillContainerInput to a
+// parameter list.
+func (output_ *KillContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for PauseContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type PauseContainerInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of PauseContainerInput from a
+// parameter list.
+func (input_ *PauseContainerInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of PauseContainerInput to a
+// parameter list.
+func (input_ *PauseContainerInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for PauseContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type PauseContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of PauseContainerOutput from a
+// parameter list.
+func (output_ *PauseContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of PauseContainerInput to a
+// parameter list.
+func (output_ *PauseContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for UnpauseContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type UnpauseContainerInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of UnpauseContainerInput from a
+// parameter list.
+func (input_ *UnpauseContainerInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of UnpauseContainerInput to a
+// parameter list.
+func (input_ *UnpauseContainerInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for UnpauseContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type UnpauseContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of UnpauseContainerOutput from a
+// parameter list.
+func (output_ *UnpauseContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of UnpauseContainerInput to a
+// parameter list.
+func (output_ *UnpauseContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for WaitContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type WaitContainerInput struct {
+	Name     string `json:"name"`
+	Interval int    `json:"interval"`
+}
+
+// Pack fills in the fields of WaitContainerInput from a
+// parameter list.
+func (input_ *WaitContainerInput) Pack(name string, interval int) {
+	input_.Name = name
+	input_.Interval = interval
+}
+
+// Unpack unpacks the fields of WaitContainerInput to a
+// parameter list.
+func (input_ *WaitContainerInput) Unpack() (name string, interval int) {
+	name = input_.Name
+	interval = input_.Interval
+	return
+}
+
+// Output parameters for WaitContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type WaitContainerOutput struct {
+	Exitcode int `json:"exitcode"`
+}
+
+// Pack fills in the fields of WaitContainerOutput from a
+// parameter list.
+func (output_ *WaitContainerOutput) Pack(exitcode int) {
+	output_.Exitcode = exitcode
+}
+
+// Unpack unpacks the fields of WaitContainerInput to a
+// parameter list.
+func (output_ *WaitContainerOutput) Unpack() (exitcode int) {
+	exitcode = output_.Exitcode
+	return
+}
+
+// Input parameters for RemoveContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemoveContainerInput struct {
+	Name          string `json:"name"`
+	Force         bool   `json:"force"`
+	RemoveVolumes bool   `json:"removeVolumes"`
+}
+
+// Pack fills in the fields of RemoveContainerInput from a
+// parameter list.
+func (input_ *RemoveContainerInput) Pack(name string, force bool, removeVolumes bool) {
+	input_.Name = name
+	input_.Force = force
+	input_.RemoveVolumes = removeVolumes
+}
+
+// Unpack unpacks the fields of RemoveContainerInput to a
+// parameter list.
+func (input_ *RemoveContainerInput) Unpack() (name string, force bool, removeVolumes bool) {
+	name = input_.Name
+	force = input_.Force
+	removeVolumes = input_.RemoveVolumes
+	return
+}
+
+// Output parameters for RemoveContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemoveContainerOutput struct {
+	Container string `json:"container"`
+}
+
+// Pack fills in the fields of RemoveContainerOutput from a
+// parameter list.
+func (output_ *RemoveContainerOutput) Pack(container string) {
+	output_.Container = container
+}
+
+// Unpack unpacks the fields of RemoveContainerInput to a
+// parameter list.
+func (output_ *RemoveContainerOutput) Unpack() (container string) {
+	container = output_.Container
+	return
+}
+
+// Input parameters for DeleteStoppedContainers method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DeleteStoppedContainersInput struct{}
+
+// Output parameters for DeleteStoppedContainers method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DeleteStoppedContainersOutput struct {
+	Containers []string `json:"containers"`
+}
+
+// Pack fills in the fields of DeleteStoppedContainersOutput from a
+// parameter list.
+func (output_ *DeleteStoppedContainersOutput) Pack(containers []string) {
+	output_.Containers = containers
+}
+
+// Unpack unpacks the fields of DeleteStoppedContainersInput to a
+// parameter list.
+func (output_ *DeleteStoppedContainersOutput) Unpack() (containers []string) {
+	containers = output_.Containers
+	return
+}
+
+// Input parameters for ListImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListImagesInput struct{}
+
+// Output parameters for ListImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListImagesOutput struct {
+	Images []Image `json:"images"`
+}
+
+func (output *ListImagesOutput) Validate(param string) Error {
+	for _, e := range output.Images {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "images[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ListImagesOutput from a
+// parameter list.
+func (output_ *ListImagesOutput) Pack(images []Image) {
+	output_.Images = images
+}
+
+// Unpack unpacks the fields of ListImagesInput to a
+// parameter list.
+func (output_ *ListImagesOutput) Unpack() (images []Image) {
+	images = output_.Images
+	return
+}
+
+// Input parameters for GetImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetImageInput struct {
+	Id string `json:"id"`
+}
+
+// Pack fills in the fields of GetImageInput from a
+// parameter list.
+func (input_ *GetImageInput) Pack(id string) {
+	input_.Id = id
+}
+
+// Unpack unpacks the fields of GetImageInput to a
+// parameter list.
+func (input_ *GetImageInput) Unpack() (id string) {
+	id = input_.Id
+	return
+}
+
+// Output parameters for GetImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetImageOutput struct {
+	Image Image `json:"image"`
+}
+
+func (output *GetImageOutput) Validate(param string) Error {
+	if v, ok := any(output.Image).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "image")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetImageOutput from a
+// parameter list.
+func (output_ *GetImageOutput) Pack(image Image) {
+	output_.Image = image
+}
+
+// Unpack unpacks the fields of GetImageInput to a
+// parameter list.
+func (output_ *GetImageOutput) Unpack() (image Image) {
+	image = output_.Image
+	return
+}
+
+// Input parameters for InspectImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type InspectImageInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of InspectImageInput from a
+// parameter list.
+func (input_ *InspectImageInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of InspectImageInput to a
+// parameter list.
+func (input_ *InspectImageInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for InspectImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type InspectImageOutput struct {
+	Image string `json:"image"`
+}
+
+// Pack fills in the fields of InspectImageOutput from a
+// parameter list.
+func (output_ *InspectImageOutput) Pack(image string) {
+	output_.Image = image
+}
+
+// Unpack unpacks the fields of InspectImageInput to a
+// parameter list.
+func (output_ *InspectImageOutput) Unpack() (image string) {
+	image = output_.Image
+	return
+}
+
+// Input parameters for HistoryImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type HistoryImageInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of HistoryImageInput from a
+// parameter list.
+func (input_ *HistoryImageInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of HistoryImageInput to a
+// parameter list.
+func (input_ *HistoryImageInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for HistoryImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type HistoryImageOutput struct {
+	History []ImageHistory `json:"history"`
+}
+
+func (output *HistoryImageOutput) Validate(param string) Error {
+	for _, e := range output.History {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "history[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of HistoryImageOutput from a
+// parameter list.
+func (output_ *HistoryImageOutput) Pack(history []ImageHistory) {
+	output_.History = history
+}
+
+// Unpack unpacks the fields of HistoryImageInput to a
+// parameter list.
+func (output_ *HistoryImageOutput) Unpack() (history []ImageHistory) {
+	history = output_.History
+	return
+}
+
+// Input parameters for TagImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type TagImageInput struct {
+	Name   string `json:"name"`
+	Tagged string `json:"tagged"`
+}
+
+// Pack fills in the fields of TagImageInput from a
+// parameter list.
+func (input_ *TagImageInput) Pack(name string, tagged string) {
+	input_.Name = name
+	input_.Tagged = tagged
+}
+
+// Unpack unpacks the fields of TagImageInput to a
+// parameter list.
+func (input_ *TagImageInput) Unpack() (name string, tagged string) {
+	name = input_.Name
+	tagged = input_.Tagged
+	return
+}
+
+// Output parameters for TagImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type TagImageOutput struct {
+	Image string `json:"image"`
+}
+
+// Pack fills in the fields of TagImageOutput from a
+// parameter list.
+func (output_ *TagImageOutput) Pack(image string) {
+	output_.Image = image
+}
+
+// Unpack unpacks the fields of TagImageInput to a
+// parameter list.
+func (output_ *TagImageOutput) Unpack() (image string) {
+	image = output_.Image
+	return
+}
+
+// Input parameters for RemoveImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemoveImageInput struct {
+	Name  string `json:"name"`
+	Force bool   `json:"force"`
+}
+
+// Pack fills in the fields of RemoveImageInput from a
+// parameter list.
+func (input_ *RemoveImageInput) Pack(name string, force bool) {
+	input_.Name = name
+	input_.Force = force
+}
+
+// Unpack unpacks the fields of RemoveImageInput to a
+// parameter list.
+func (input_ *RemoveImageInput) Unpack() (name string, force bool) {
+	name = input_.Name
+	force = input_.Force
+	return
+}
+
+// Output parameters for RemoveImage method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemoveImageOutput struct {
+	Image string `json:"image"`
+}
+
+// Pack fills in the fields of RemoveImageOutput from a
+// parameter list.
+func (output_ *RemoveImageOutput) Pack(image string) {
+	output_.Image = image
+}
+
+// Unpack unpacks the fields of RemoveImageInput to a
+// parameter list.
+func (output_ *RemoveImageOutput) Unpack() (image string) {
+	image = output_.Image
+	return
+}
+
+// Input parameters for SearchImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type SearchImagesInput struct {
+	Query  string            `json:"query"`
+	Limit  *int              `json:"limit,omitempty"`
+	Filter ImageSearchFilter `json:"filter"`
+}
+
+func (input *SearchImagesInput) Validate(param string) Error {
+	if v, ok := any(input.Filter).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "filter")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of SearchImagesInput from a
+// parameter list.
+func (input_ *SearchImagesInput) Pack(query string, limit *int, filter ImageSearchFilter) {
+	input_.Query = query
+	input_.Limit = limit
+	input_.Filter = filter
+}
+
+// Unpack unpacks the fields of SearchImagesInput to a
+// parameter list.
+func (input_ *SearchImagesInput) Unpack() (query string, limit *int, filter ImageSearchFilter) {
+	query = input_.Query
+	limit = input_.Limit
+	filter = input_.Filter
+	return
+}
+
+// Output parameters for SearchImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type SearchImagesOutput struct {
+	Results []ImageSearchResult `json:"results"`
+}
+
+func (output *SearchImagesOutput) Validate(param string) Error {
+	for _, e := range output.Results {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "results[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of SearchImagesOutput from a
+// parameter list.
+func (output_ *SearchImagesOutput) Pack(results []ImageSearchResult) {
+	output_.Results = results
+}
+
+// Unpack unpacks the fields of SearchImagesInput to a
+// parameter list.
+func (output_ *SearchImagesOutput) Unpack() (results []ImageSearchResult) {
+	results = output_.Results
+	return
+}
+
+// Input parameters for DeleteUnusedImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DeleteUnusedImagesInput struct{}
+
+// Output parameters for DeleteUnusedImages method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DeleteUnusedImagesOutput struct {
+	Images []string `json:"images"`
+}
+
+// Pack fills in the fields of DeleteUnusedImagesOutput from a
+// parameter list.
+func (output_ *DeleteUnusedImagesOutput) Pack(images []string) {
+	output_.Images = images
+}
+
+// Unpack unpacks the fields of DeleteUnusedImagesInput to a
+// parameter list.
+func (output_ *DeleteUnusedImagesOutput) Unpack() (images []string) {
+	images = output_.Images
+	return
+}
+
+// Input parameters for ImageExists method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ImageExistsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of ImageExistsInput from a
+// parameter list.
+func (input_ *ImageExistsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of ImageExistsInput to a
+// parameter list.
+func (input_ *ImageExistsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for ImageExists method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ImageExistsOutput struct {
+	Exists int `json:"exists"`
+}
+
+// Pack fills in the fields of ImageExistsOutput from a
+// parameter list.
+func (output_ *ImageExistsOutput) Pack(exists int) {
+	output_.Exists = exists
+}
+
+// Unpack unpacks the fields of ImageExistsInput to a
+// parameter list.
+func (output_ *ImageExistsOutput) Unpack() (exists int) {
+	exists = output_.Exists
+	return
+}
+
+// Input parameters for ContainerExists method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ContainerExistsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of ContainerExistsInput from a
+// parameter list.
+func (input_ *ContainerExistsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of ContainerExistsInput to a
+// parameter list.
+func (input_ *ContainerExistsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for ContainerExists method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ContainerExistsOutput struct {
+	Exists int `json:"exists"`
+}
+
+// Pack fills in the fields of ContainerExistsOutput from a
+// parameter list.
+func (output_ *ContainerExistsOutput) Pack(exists int) {
+	output_.Exists = exists
+}
+
+// Unpack unpacks the fields of ContainerExistsInput to a
+// parameter list.
+func (output_ *ContainerExistsOutput) Unpack() (exists int) {
+	exists = output_.Exists
+	return
+}
+
+// Input parameters for ListPods method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListPodsInput struct{}
+
+// Output parameters for ListPods method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListPodsOutput struct {
+	Pods []ListPodData `json:"pods"`
+}
+
+func (output *ListPodsOutput) Validate(param string) Error {
+	for _, e := range output.Pods {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "pods[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ListPodsOutput from a
+// parameter list.
+func (output_ *ListPodsOutput) Pack(pods []ListPodData) {
+	output_.Pods = pods
+}
+
+// Unpack unpacks the fields of ListPodsInput to a
+// parameter list.
+func (output_ *ListPodsOutput) Unpack() (pods []ListPodData) {
+	pods = output_.Pods
+	return
+}
+
+// Input parameters for GetPod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetPodInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of GetPodInput from a
+// parameter list.
+func (input_ *GetPodInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of GetPodInput to a
+// parameter list.
+func (input_ *GetPodInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for GetPod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetPodOutput struct {
+	Pod ListPodData `json:"pod"`
+}
+
+func (output *GetPodOutput) Validate(param string) Error {
+	if v, ok := any(output.Pod).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "pod")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetPodOutput from a
+// parameter list.
+func (output_ *GetPodOutput) Pack(pod ListPodData) {
+	output_.Pod = pod
+}
+
+// Unpack unpacks the fields of GetPodInput to a
+// parameter list.
+func (output_ *GetPodOutput) Unpack() (pod ListPodData) {
+	pod = output_.Pod
+	return
+}
+
+// Input parameters for StartPod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StartPodInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of StartPodInput from a
+// parameter list.
+func (input_ *StartPodInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of StartPodInput to a
+// parameter list.
+func (input_ *StartPodInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for StartPod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type StartPodOutput struct {
+	Pod string `json:"pod"`
+}
+
+// Pack fills in the fields of StartPodOutput from a
+// parameter list.
+func (output_ *StartPodOutput) Pack(pod string) {
+	output_.Pod = pod
+}
+
+// Unpack unpacks the fields of StartPodInput to a
+// parameter list.
+func (output_ *StartPodOutput) Unpack() (pod string) {
+	pod = output_.Pod
+	return
+}
+
+// Input parameters for RemovePod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemovePodInput struct {
+	Name  string `json:"name"`
+	Force bool   `json:"force"`
+}
+
+// Pack fills in the fields of RemovePodInput from a
+// parameter list.
+func (input_ *RemovePodInput) Pack(name string, force bool) {
+	input_.Name = name
+	input_.Force = force
+}
+
+// Unpack unpacks the fields of RemovePodInput to a
+// parameter list.
+func (input_ *RemovePodInput) Unpack() (name string, force bool) {
+	name = input_.Name
+	force = input_.Force
+	return
+}
+
+// Output parameters for RemovePod method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type RemovePodOutput struct {
+	Pod string `json:"pod"`
+}
+
+// Pack fills in the fields of RemovePodOutput from a
+// parameter list.
+func (output_ *RemovePodOutput) Pack(pod string) {
+	output_.Pod = pod
+}
+
+// Unpack unpacks the fields of RemovePodInput to a
+// parameter list.
+func (output_ *RemovePodOutput) Unpack() (pod string) {
+	pod = output_.Pod
+	return
+}
+
+// Input parameters for GetEvents method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetEventsInput struct {
+	Filter []string `json:"filter"`
+	Since  string   `json:"since"`
+	Until  string   `json:"until"`
+}
+
+// Pack fills in the fields of GetEventsInput from a
+// parameter list.
+func (input_ *GetEventsInput) Pack(filter []string, since string, until string) {
+	input_.Filter = filter
+	input_.Since = since
+	input_.Until = until
+}
+
+// Unpack unpacks the fields of GetEventsInput to a
+// parameter list.
+func (input_ *GetEventsInput) Unpack() (filter []string, since string, until string) {
+	filter = input_.Filter
+	since = input_.Since
+	until = input_.Until
+	return
+}
+
+// Output parameters for GetEvents method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetEventsOutput struct {
+	Events Event `json:"events"`
+}
+
+func (output *GetEventsOutput) Validate(param string) Error {
+	if v, ok := any(output.Events).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "events")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetEventsOutput from a
+// parameter list.
+func (output_ *GetEventsOutput) Pack(events Event) {
+	output_.Events = events
+}
+
+// Unpack unpacks the fields of GetEventsInput to a
+// parameter list.
+func (output_ *GetEventsOutput) Unpack() (events Event) {
+	events = output_.Events
+	return
+}
+
+// Input parameters for Diff method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DiffInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of DiffInput from a
+// parameter list.
+func (input_ *DiffInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of DiffInput to a
+// parameter list.
+func (input_ *DiffInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for Diff method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type DiffOutput struct {
+	Diffs []DiffInfo `json:"diffs"`
+}
+
+func (output *DiffOutput) Validate(param string) Error {
+	for _, e := range output.Diffs {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "diffs[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of DiffOutput from a
+// parameter list.
+func (output_ *DiffOutput) Pack(diffs []DiffInfo) {
+	output_.Diffs = diffs
+}
+
+// Unpack unpacks the fields of DiffInput to a
+// parameter list.
+func (output_ *DiffOutput) Unpack() (diffs []DiffInfo) {
+	diffs = output_.Diffs
+	return
+}
+
+// Input parameters for GetLayersMapWithImageInfo method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetLayersMapWithImageInfoInput struct{}
+
+// Output parameters for GetLayersMapWithImageInfo method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetLayersMapWithImageInfoOutput struct {
+	LayerMap string `json:"layerMap"`
+}
+
+// Pack fills in the fields of GetLayersMapWithImageInfoOutput from a
+// parameter list.
+func (output_ *GetLayersMapWithImageInfoOutput) Pack(layerMap string) {
+	output_.LayerMap = layerMap
+}
+
+// Unpack unpacks the fields of GetLayersMapWithImageInfoInput to a
+// parameter list.
+func (output_ *GetLayersMapWithImageInfoOutput) Unpack() (layerMap string) {
+	layerMap = output_.LayerMap
+	return
+}
+
+// Input parameters for VolumeCreate method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type VolumeCreateInput struct {
+	Options VolumeCreateOpts `json:"options"`
+}
+
+func (input *VolumeCreateInput) Validate(param string) Error {
+	if v, ok := any(input.Options).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "options")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of VolumeCreateInput from a
+// parameter list.
+func (input_ *VolumeCreateInput) Pack(options VolumeCreateOpts) {
+	input_.Options = options
+}
+
+// Unpack unpacks the fields of VolumeCreateInput to a
+// parameter list.
+func (input_ *VolumeCreateInput) Unpack() (options VolumeCreateOpts) {
+	options = input_.Options
+	return
+}
+
+// Output parameters for VolumeCreate method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type VolumeCreateOutput struct {
+	VolumeName string `json:"volumeName"`
+}
+
+// Pack fills in the fields of VolumeCreateOutput from a
+// parameter list.
+func (output_ *VolumeCreateOutput) Pack(volumeName string) {
+	output_.VolumeName = volumeName
+}
+
+// Unpack unpacks the fields of VolumeCreateInput to a
+// parameter list.
+func (output_ *VolumeCreateOutput) Unpack() (volumeName string) {
+	volumeName = output_.VolumeName
+	return
+}
+
+// Input parameters for VolumeRemove method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type VolumeRemoveInput struct {
+	Options VolumeRemoveOpts `json:"options"`
+}
+
+func (input *VolumeRemoveInput) Validate(param string) Error {
+	if v, ok := any(input.Options).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "options")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of VolumeRemoveInput from a
+// parameter list.
+func (input_ *VolumeRemoveInput) Pack(options VolumeRemoveOpts) {
+	input_.Options = options
+}
+
+// Unpack unpacks the fields of VolumeRemoveInput to a
+// parameter list.
+func (input_ *VolumeRemoveInput) Unpack() (options VolumeRemoveOpts) {
+	options = input_.Options
+	return
+}
+
+// Output parameters for VolumeRemove method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type VolumeRemoveOutput struct {
+	Successes []string          `json:"successes"`
+	Failures  map[string]string `json:"failures"`
+}
+
+// Pack fills in the fields of VolumeRemoveOutput from a
+// parameter list.
+func (output_ *VolumeRemoveOutput) Pack(successes []string, failures map[string]string) {
+	output_.Successes = successes
+	output_.Failures = failures
+}
+
+// Unpack unpacks the fields of VolumeRemoveInput to a
+// parameter list.
+func (output_ *VolumeRemoveOutput) Unpack() (successes []string, failures map[string]string) {
+	successes = output_.Successes
+	failures = output_.Failures
+	return
+}
+
+// Input parameters for GetVolumes method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetVolumesInput struct {
+	Args []string `json:"args"`
+	All  bool     `json:"all"`
+}
+
+// Pack fills in the fields of GetVolumesInput from a
+// parameter list.
+func (input_ *GetVolumesInput) Pack(args []string, all bool) {
+	input_.Args = args
+	input_.All = all
+}
+
+// Unpack unpacks the fields of GetVolumesInput to a
+// parameter list.
+func (input_ *GetVolumesInput) Unpack() (args []string, all bool) {
+	args = input_.Args
+	all = input_.All
+	return
+}
+
+// Output parameters for GetVolumes method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetVolumesOutput struct {
+	Volumes []Volume `json:"volumes"`
+}
+
+func (output *GetVolumesOutput) Validate(param string) Error {
+	for _, e := range output.Volumes {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "volumes[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetVolumesOutput from a
+// parameter list.
+func (output_ *GetVolumesOutput) Pack(volumes []Volume) {
+	output_.Volumes = volumes
+}
+
+// Unpack unpacks the fields of GetVolumesInput to a
+// parameter list.
+func (output_ *GetVolumesOutput) Unpack() (volumes []Volume) {
+	volumes = output_.Volumes
+	return
+}
+
+// Input parameters for GetContainersSockets method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersSocketsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of GetContainersSocketsInput from a
+// parameter list.
+func (input_ *GetContainersSocketsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of GetContainersSocketsInput to a
+// parameter list.
+func (input_ *GetContainersSocketsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for GetContainersSockets method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type GetContainersSocketsOutput struct {
+	Sockets Sockets `json:"sockets"`
+}
+
+func (output *GetContainersSocketsOutput) Validate(param string) Error {
+	if v, ok := any(output.Sockets).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "sockets")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of GetContainersSocketsOutput from a
+// parameter list.
+func (output_ *GetContainersSocketsOutput) Pack(sockets Sockets) {
+	output_.Sockets = sockets
+}
+
+// Unpack unpacks the fields of GetContainersSocketsInput to a
+// parameter list.
+func (output_ *GetContainersSocketsOutput) Unpack() (sockets Sockets) {
+	sockets = output_.Sockets
+	return
+}
+
+// Input parameters for ExecContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ExecContainerInput struct {
+	Opts ExecOpts `json:"opts"`
+}
+
+func (input *ExecContainerInput) Validate(param string) Error {
+	if v, ok := any(input.Opts).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "opts")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ExecContainerInput from a
+// parameter list.
+func (input_ *ExecContainerInput) Pack(opts ExecOpts) {
+	input_.Opts = opts
+}
+
+// Unpack unpacks the fields of ExecContainerInput to a
+// parameter list.
+func (input_ *ExecContainerInput) Unpack() (opts ExecOpts) {
+	opts = input_.Opts
+	return
+}
+
+// Output parameters for ExecContainer method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ExecContainerOutput struct{}
+
+// Input parameters for ListContainerPorts method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerPortsInput struct {
+	Name string `json:"name"`
+}
+
+// Pack fills in the fields of ListContainerPortsInput from a
+// parameter list.
+func (input_ *ListContainerPortsInput) Pack(name string) {
+	input_.Name = name
+}
+
+// Unpack unpacks the fields of ListContainerPortsInput to a
+// parameter list.
+func (input_ *ListContainerPortsInput) Unpack() (name string) {
+	name = input_.Name
+	return
+}
+
+// Output parameters for ListContainerPorts method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ListContainerPortsOutput struct {
+	Notimplemented NotImplemented `json:"notimplemented"`
+}
+
+func (output *ListContainerPortsOutput) Validate(param string) Error {
+	if v, ok := any(output.Notimplemented).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "notimplemented")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ListContainerPortsOutput from a
+// parameter list.
+func (output_ *ListContainerPortsOutput) Pack(notimplemented NotImplemented) {
+	output_.Notimplemented = notimplemented
+}
+
+// Unpack unpacks the fields of ListContainerPortsInput to a
+// parameter list.
+func (output_ *ListContainerPortsOutput) Unpack() (notimplemented NotImplemented) {
+	notimplemented = output_.Notimplemented
+	return
+}
+
+// ImageNotFound means the image could not be found by the provided name or ID in local storage.
+type ImageNotFoundError struct {
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func (ImageNotFoundError) ErrorCode() string {
+	return `io.podman.ImageNotFound`
+}
+
+func (ImageNotFoundError) Error() string {
+	return `ImageNotFound means the image could not be found by the provided name or ID in local storage.`
+}
+
+func ImageNotFound(id string, reason string) ImageNotFoundError {
+	var err_ ImageNotFoundError
+	err_.Id = id
+	err_.Reason = reason
+	return err_
+}
+
+// ContainerNotFound means the container could not be found by the provided name or ID in local storage.
+type ContainerNotFoundError struct {
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func (ContainerNotFoundError) ErrorCode() string {
+	return `io.podman.ContainerNotFound`
+}
+
+func (ContainerNotFoundError) Error() string {
+	return `ContainerNotFound means the container could not be found by the provided name or ID in local storage.`
+}
+
+func ContainerNotFound(id string, reason string) ContainerNotFoundError {
+	var err_ ContainerNotFoundError
+	err_.Id = id
+	err_.Reason = reason
+	return err_
+}
+
+// NoContainerRunning means none of the containers requested are running in a command that requires a running container.
+type NoContainerRunningError struct{}
+
+func (NoContainerRunningError) ErrorCode() string {
+	return `io.podman.NoContainerRunning`
+}
+
+func (NoContainerRunningError) Error() string {
+	return `NoContainerRunning means none of the containers requested are running in a command that requires a running container.`
+}
+
+func NoContainerRunning() NoContainerRunningError {
+	var err_ NoContainerRunningError
+	return err_
+}
+
+// PodNotFound means the pod could not be found by the provided name or ID in local storage.
+type PodNotFoundError struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+func (PodNotFoundError) ErrorCode() string {
+	return `io.podman.PodNotFound`
+}
+
+func (PodNotFoundError) Error() string {
+	return `PodNotFound means the pod could not be found by the provided name or ID in local storage.`
+}
+
+func PodNotFound(name string, reason string) PodNotFoundError {
+	var err_ PodNotFoundError
+	err_.Name = name
+	err_.Reason = reason
+	return err_
+}
+
+// VolumeNotFound means the volume could not be found by the name or ID in local storage.
+type VolumeNotFoundError struct {
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func (VolumeNotFoundError) ErrorCode() string {
+	return `io.podman.VolumeNotFound`
+}
+
+func (VolumeNotFoundError) Error() string {
+	return `VolumeNotFound means the volume could not be found by the name or ID in local storage.`
+}
+
+func VolumeNotFound(id string, reason string) VolumeNotFoundError {
+	var err_ VolumeNotFoundError
+	err_.Id = id
+	err_.Reason = reason
+	return err_
+}
+
+// PodContainerError means a container associated with a pod failed to perform an operation. It contains
+// a container ID of the container that failed.
+type PodContainerErrorError struct {
+	Podname string                  `json:"podname"`
+	Errors  []PodContainerErrorData `json:"errors"`
+}
+
+func (PodContainerErrorError) ErrorCode() string {
+	return `io.podman.PodContainerError`
+}
+
+func (PodContainerErrorError) Error() string {
+	return `PodContainerError means a container associated with a pod failed to perform an operation. It contains a container ID of the container that failed.`
+}
+
+func PodContainerError(podname string, errors []PodContainerErrorData) PodContainerErrorError {
+	var err_ PodContainerErrorError
+	err_.Podname = podname
+	err_.Errors = errors
+	return err_
+}
+
+// NoContainersInPod means a pod has no containers on which to perform the operation. It contains
+// the pod ID.
+type NoContainersInPodError struct {
+	Name string `json:"name"`
+}
+
+func (NoContainersInPodError) ErrorCode() string {
+	return `io.podman.NoContainersInPod`
+}
+
+func (NoContainersInPodError) Error() string {
+	return `NoContainersInPod means a pod has no containers on which to perform the operation. It contains the pod ID.`
+}
+
+func NoContainersInPod(name string) NoContainersInPodError {
+	var err_ NoContainersInPodError
+	err_.Name = name
+	return err_
+}
+
+// InvalidState indicates that a container or pod was in an improper state for the requested operation
+type InvalidStateError struct {
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func (InvalidStateError) ErrorCode() string {
+	return `io.podman.InvalidState`
+}
+
+func (InvalidStateError) Error() string {
+	return `InvalidState indicates that a container or pod was in an improper state for the requested operation`
+}
+
+func InvalidState(id string, reason string) InvalidStateError {
+	var err_ InvalidStateError
+	err_.Id = id
+	err_.Reason = reason
+	return err_
+}
+
+// ErrorOccurred is a generic error for an error that occurs during the execution.  The actual error message
+// is includes as part of the error's text.
+type ErrorOccurredError struct {
+	Reason string `json:"reason"`
+}
+
+func (ErrorOccurredError) ErrorCode() string {
+	return `io.podman.ErrorOccurred`
+}
+
+func (ErrorOccurredError) Error() string {
+	return `ErrorOccurred is a generic error for an error that occurs during the execution.  The actual error message is includes as part of the error's text.`
+}
+
+func ErrorOccurred(reason string) ErrorOccurredError {
+	var err_ ErrorOccurredError
+	err_.Reason = reason
+	return err_
+}
+
+// RuntimeErrors generally means a runtime could not be found or gotten.
+type RuntimeErrorError struct {
+	Reason string `json:"reason"`
+}
+
+func (RuntimeErrorError) ErrorCode() string {
+	return `io.podman.RuntimeError`
+}
+
+func (RuntimeErrorError) Error() string {
+	return `RuntimeErrors generally means a runtime could not be found or gotten.`
+}
+
+func RuntimeError(reason string) RuntimeErrorError {
+	var err_ RuntimeErrorError
+	err_.Reason = reason
+	return err_
+}
+
+// The Podman endpoint requires that you use a streaming connection.
+type WantsMoreRequiredError struct {
+	Reason string `json:"reason"`
+}
+
+func (WantsMoreRequiredError) ErrorCode() string {
+	return `io.podman.WantsMoreRequired`
+}
+
+func (WantsMoreRequiredError) Error() string {
+	return `The Podman endpoint requires that you use a streaming connection.`
+}
+
+func WantsMoreRequired(reason string) WantsMoreRequiredError {
+	var err_ WantsMoreRequiredError
+	err_.Reason = reason
+	return err_
+}
+
+// Container is already stopped
+type ErrCtrStoppedError struct {
+	Id string `json:"id"`
+}
+
+func (ErrCtrStoppedError) ErrorCode() string {
+	return `io.podman.ErrCtrStopped`
+}
+
+func (ErrCtrStoppedError) Error() string {
+	return `Container is already stopped`
+}
+
+func ErrCtrStopped(id string) ErrCtrStoppedError {
+	var err_ ErrCtrStoppedError
+	err_.Id = id
+	return err_
+}
+
+// This function requires CGroupsV2 to run in rootless mode.
+type ErrRequiresCgroupsV2ForRootlessError struct {
+	Reason string `json:"reason"`
+}
+
+func (ErrRequiresCgroupsV2ForRootlessError) ErrorCode() string {
+	return `io.podman.ErrRequiresCgroupsV2ForRootless`
+}
+
+func (ErrRequiresCgroupsV2ForRootlessError) Error() string {
+	return `This function requires CGroupsV2 to run in rootless mode.`
+}
+
+func ErrRequiresCgroupsV2ForRootless(reason string) ErrRequiresCgroupsV2ForRootlessError {
+	var err_ ErrRequiresCgroupsV2ForRootlessError
+	err_.Reason = reason
+	return err_
+}
+
+// Client represents a varlink client that implements the io.podman
+// interface.
+type Client struct {
+	varlink.Client
+}
+
+// ErrorFromCode returns a new varlink error constructed from the specified
+// code and parameters.
+func ErrorFromCode(code string, params json.RawMessage) Error {
+	switch code {
+	case `io.podman.ImageNotFound`:
+		return varlinkrt.UnmarshalError[ImageNotFoundError](code, params)
+	case `io.podman.ContainerNotFound`:
+		return varlinkrt.UnmarshalError[ContainerNotFoundError](code, params)
+	case `io.podman.NoContainerRunning`:
+		return varlinkrt.UnmarshalError[NoContainerRunningError](code, params)
+	case `io.podman.PodNotFound`:
+		return varlinkrt.UnmarshalError[PodNotFoundError](code, params)
+	case `io.podman.VolumeNotFound`:
+		return varlinkrt.UnmarshalError[VolumeNotFoundError](code, params)
+	case `io.podman.PodContainerError`:
+		return varlinkrt.UnmarshalError[PodContainerErrorError](code, params)
+	case `io.podman.NoContainersInPod`:
+		return varlinkrt.UnmarshalError[NoContainersInPodError](code, params)
+	case `io.podman.InvalidState`:
+		return varlinkrt.UnmarshalError[InvalidStateError](code, params)
+	case `io.podman.ErrorOccurred`:
+		return varlinkrt.UnmarshalError[ErrorOccurredError](code, params)
+	case `io.podman.RuntimeError`:
+		return varlinkrt.UnmarshalError[RuntimeErrorError](code, params)
+	case `io.podman.WantsMoreRequired`:
+		return varlinkrt.UnmarshalError[WantsMoreRequiredError](code, params)
+	case `io.podman.ErrCtrStopped`:
+		return varlinkrt.UnmarshalError[ErrCtrStoppedError](code, params)
+	case `io.podman.ErrRequiresCgroupsV2ForRootless`:
+		return varlinkrt.UnmarshalError[ErrRequiresCgroupsV2ForRootlessError](code, params)
+	default:
+		return varlinkrt.GenericError(code, params)
+	}
+}
+
+// GetVersion returns version and build information of the podman service
+func (client_ *Client) GetVersion(ctx context.Context) (version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int, err_ error) {
+	var (
+		input_  GetVersionInput
+		output_ GetVersionOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetVersion`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	version, goVersion, gitCommit, built, osArch, remoteApiVersion = output_.Unpack()
+	return
+}
+
+// GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,
+// build information of Podman, and system-wide registries.
+func (client_ *Client) GetInfo(ctx context.Context) (info PodmanInfo, err_ error) {
+	var (
+		input_  GetInfoInput
+		output_ GetInfoOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetInfo`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	info = output_.Unpack()
+	return
+}
+
+// ListContainers returns information about all containers.
+// See also [GetContainer](#GetContainer).
+func (client_ *Client) ListContainers(ctx context.Context) (containers []Container, err_ error) {
+	var (
+		input_  ListContainersInput
+		output_ ListContainersOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListContainers`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	containers = output_.Unpack()
+	return
+}
+
+func (client_ *Client) Ps(ctx context.Context, opts PsOpts) (containers []PsContainer, err_ error) {
+	var (
+		input_  PsInput
+		output_ PsOutput
+	)
+
+	input_.Pack(opts)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.Ps`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	containers = output_.Unpack()
+	return
+}
+
+func (client_ *Client) GetContainersByStatus(ctx context.Context, status []string) (containerS []Container, err_ error) {
+	var (
+		input_  GetContainersByStatusInput
+		output_ GetContainersByStatusOutput
+	)
+
+	input_.Pack(status)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainersByStatus`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	containerS = output_.Unpack()
+	return
+}
+
+func (client_ *Client) Top(ctx context.Context, nameOrID string, descriptors []string) (top []string, err_ error) {
+	var (
+		input_  TopInput
+		output_ TopOutput
+	)
+
+	input_.Pack(nameOrID, descriptors)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.Top`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	top = output_.Unpack()
+	return
+}
+
+// HealthCheckRun executes defined container's healthcheck command
+// and returns the container's health status.
+func (client_ *Client) HealthCheckRun(ctx context.Context, nameOrID string) (healthCheckStatus string, err_ error) {
+	var (
+		input_  HealthCheckRunInput
+		output_ HealthCheckRunOutput
+	)
+
+	input_.Pack(nameOrID)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.HealthCheckRun`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	healthCheckStatus = output_.Unpack()
+	return
+}
+
+// GetContainer returns information about a single container.  If a container
+// with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)
+// error will be returned.  See also [ListContainers](ListContainers) and
+// [InspectContainer](#InspectContainer).
+func (client_ *Client) GetContainer(ctx context.Context, id string) (container Container, err_ error) {
+	var (
+		input_  GetContainerInput
+		output_ GetContainerOutput
+	)
+
+	input_.Pack(id)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of
+// container names.  The definition of latest container means the latest by creation date.  In a multi-
+// user environment, results might differ from what you expect.
+func (client_ *Client) GetContainersByContext(ctx context.Context, all bool, latest bool, args []string) (containers []string, err_ error) {
+	var (
+		input_  GetContainersByContextInput
+		output_ GetContainersByContextOutput
+	)
+
+	input_.Pack(all, latest, args)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainersByContext`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	containers = output_.Unpack()
+	return
+}
+
+// InspectContainer data takes a name or ID of a container returns the inspection
+// data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)
+// error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).
+func (client_ *Client) InspectContainer(ctx context.Context, name string) (container string, err_ error) {
+	var (
+		input_  InspectContainerInput
+		output_ InspectContainerOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.InspectContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// ListContainerProcesses takes a name or ID of a container and returns the processes
+// running inside the container as array of strings.  It will accept an array of string
+// arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+// error will be returned.
+func (client_ *Client) ListContainerProcesses(ctx context.Context, name string, opts []string) (container []string, err_ error) {
+	var (
+		input_  ListContainerProcessesInput
+		output_ ListContainerProcessesOutput
+	)
+
+	input_.Pack(name, opts)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListContainerProcesses`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// GetContainerLogs takes a name or ID of a container and returns the logs of that container.
+// If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+// The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming
+// capability of varlink if the client invokes it.
+func (client_ *Client) GetContainerLogs(ctx context.Context, name string) (container []string, err_ error) {
+	var (
+		input_  GetContainerLogsInput
+		output_ GetContainerLogsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainerLogs`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+func (client_ *Client) GetContainersLogs(ctx context.Context, names []string, follow bool, latest bool, since string, tail int, timestamps bool) (log LogLine, err_ error) {
+	var (
+		input_  GetContainersLogsInput
+		output_ GetContainersLogsOutput
+	)
+
+	input_.Pack(names, follow, latest, since, tail, timestamps)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainersLogs`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	log = output_.Unpack()
+	return
+}
+
+// ListContainerChanges takes a name or ID of a container and returns changes between the container and
+// its base image. It returns a struct of changed, deleted, and added path names.
+func (client_ *Client) ListContainerChanges(ctx context.Context, name string) (container ContainerChanges, err_ error) {
+	var (
+		input_  ListContainerChangesInput
+		output_ ListContainerChangesOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListContainerChanges`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// ExportContainer creates an image from a container.  It takes the name or ID of a container and a
+// path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary
+// location and the path to that file will be returned.
+func (client_ *Client) ExportContainer(ctx context.Context, name string, path string) (tarfile string, err_ error) {
+	var (
+		input_  ExportContainerInput
+		output_ ExportContainerOutput
+	)
+
+	input_.Pack(name, path)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ExportContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	tarfile = output_.Unpack()
+	return
+}
+
+// GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which
+// contains attributes like memory and cpu usage.  If the container cannot be found, a
+// [ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)
+// error will be returned
+func (client_ *Client) GetContainerStats(ctx context.Context, name string) (container ContainerStats, err_ error) {
+	var (
+		input_  GetContainerStatsInput
+		output_ GetContainerStatsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainerStats`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions
+// to calculate the containers statistics based on current and previous measurements.
+func (client_ *Client) GetContainerStatsWithHistory(ctx context.Context, previousStats ContainerStats) (container ContainerStats, err_ error) {
+	var (
+		input_  GetContainerStatsWithHistoryInput
+		output_ GetContainerStatsWithHistoryOutput
+	)
+
+	input_.Pack(previousStats)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainerStatsWithHistory`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns
+// the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+// error will be returned.  See also [CreateContainer](#CreateContainer).
+func (client_ *Client) StartContainer(ctx context.Context, name string) (container string, err_ error) {
+	var (
+		input_  StartContainerInput
+		output_ StartContainerOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.StartContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a
+// timeout value.  The timeout value the time before a forcible stop to the container is applied.  It
+// returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+// error will be returned instead. See also [KillContainer](KillContainer).
+func (client_ *Client) StopContainer(ctx context.Context, name string, timeout int) (container string, err_ error) {
+	var (
+		input_  StopContainerInput
+		output_ StopContainerOutput
+	)
+
+	input_.Pack(name, timeout)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.StopContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// RestartContainer will restart a running container given a container name or ID and timeout value. The timeout
+// value is the time before a forcible stop is used to stop the container.  If the container cannot be found by
+// name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the
+// container will be returned.
+func (client_ *Client) RestartContainer(ctx context.Context, name string, timeout int) (container string, err_ error) {
+	var (
+		input_  RestartContainerInput
+		output_ RestartContainerOutput
+	)
+
+	input_.Pack(name, timeout)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.RestartContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the
+// container has been killed, the container's ID is returned.  If the container cannot be found, a
+// [ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).
+func (client_ *Client) KillContainer(ctx context.Context, name string, signal int) (container string, err_ error) {
+	var (
+		input_  KillContainerInput
+		output_ KillContainerOutput
+	)
+
+	input_.Pack(name, signal)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.KillContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,
+// a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+// See also [UnpauseContainer](#UnpauseContainer).
+func (client_ *Client) PauseContainer(ctx context.Context, name string) (container string, err_ error) {
+	var (
+		input_  PauseContainerInput
+		output_ PauseContainerOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.PauseContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be
+// found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+// See also [PauseContainer](#PauseContainer).
+func (client_ *Client) UnpauseContainer(ctx context.Context, name string) (container string, err_ error) {
+	var (
+		input_  UnpauseContainerInput
+		output_ UnpauseContainerOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.UnpauseContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container
+// stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID
+// or name, a [ContainerNotFound](#ContainerNotFound) error is returned.
+func (client_ *Client) WaitContainer(ctx context.Context, name string, interval int) (exitcode int, err_ error) {
+	var (
+		input_  WaitContainerInput
+		output_ WaitContainerOutput
+	)
+
+	input_.Pack(name, interval)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.WaitContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	exitcode = output_.Unpack()
+	return
+}
+
+// RemoveContainer requires the name or ID of a container as well as a boolean that
+// indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean
+// indicating whether to remove builtin volumes. Upon successful removal of the
+// container, its ID is returned.  If the
+// container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+// See also [EvictContainer](EvictContainer).
+func (client_ *Client) RemoveContainer(ctx context.Context, name string, force bool, removeVolumes bool) (container string, err_ error) {
+	var (
+		input_  RemoveContainerInput
+		output_ RemoveContainerOutput
+	)
+
+	input_.Pack(name, force, removeVolumes)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.RemoveContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	container = output_.Unpack()
+	return
+}
+
+// DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted
+// container IDs.  See also [RemoveContainer](RemoveContainer).
+func (client_ *Client) DeleteStoppedContainers(ctx context.Context) (containers []string, err_ error) {
+	var (
+		input_  DeleteStoppedContainersInput
+		output_ DeleteStoppedContainersOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.DeleteStoppedContainers`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	containers = output_.Unpack()
+	return
+}
+
+// ListImages returns information about the images that are currently in storage.
+// See also [InspectImage](#InspectImage).
+func (client_ *Client) ListImages(ctx context.Context) (images []Image, err_ error) {
+	var (
+		input_  ListImagesInput
+		output_ ListImagesOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListImages`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	images = output_.Unpack()
+	return
+}
+
+// GetImage returns information about a single image in storage.
+// If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.
+func (client_ *Client) GetImage(ctx context.Context, id string) (image Image, err_ error) {
+	var (
+		input_  GetImageInput
+		output_ GetImageOutput
+	)
+
+	input_.Pack(id)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetImage`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	image = output_.Unpack()
+	return
+}
+
+// InspectImage takes the name or ID of an image and returns a string representation of data associated with the
+// image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will
+// be returned if the image cannot be found.
+func (client_ *Client) InspectImage(ctx context.Context, name string) (image string, err_ error) {
+	var (
+		input_  InspectImageInput
+		output_ InspectImageOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.InspectImage`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	image = output_.Unpack()
+	return
+}
+
+// HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned
+// history is in the form of an array of ImageHistory structures.  If the image cannot be found, an
+// [ImageNotFound](#ImageNotFound) error is returned.
+func (client_ *Client) HistoryImage(ctx context.Context, name string) (history []ImageHistory, err_ error) {
+	var (
+		input_  HistoryImageInput
+		output_ HistoryImageOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.HistoryImage`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	history = output_.Unpack()
+	return
+}
+
+// TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot
+// be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.
+func (client_ *Client) TagImage(ctx context.Context, name string, tagged string) (image string, err_ error) {
+	var (
+		input_  TagImageInput
+		output_ TagImageOutput
+	)
+
+	input_.Pack(name, tagged)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.TagImage`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	image = output_.Unpack()
+	return
+}
+
+// RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image
+// should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The
+// ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).
+func (client_ *Client) RemoveImage(ctx context.Context, name string, force bool) (image string, err_ error) {
+	var (
+		input_  RemoveImageInput
+		output_ RemoveImageOutput
+	)
+
+	input_.Pack(name, force)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.RemoveImage`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	image = output_.Unpack()
+	return
+}
+
+// SearchImages searches available registries for images that contain the
+// contents of "query" in their name. If "limit" is given, limits the amount of
+// search results per registry.
+func (client_ *Client) SearchImages(ctx context.Context, query string, limit *int, filter ImageSearchFilter) (results []ImageSearchResult, err_ error) {
+	var (
+		input_  SearchImagesInput
+		output_ SearchImagesOutput
+	)
+
+	input_.Pack(query, limit, filter)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.SearchImages`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	results = output_.Unpack()
+	return
+}
+
+// DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned
+// in a string array.
+func (client_ *Client) DeleteUnusedImages(ctx context.Context) (images []string, err_ error) {
+	var (
+		input_  DeleteUnusedImagesInput
+		output_ DeleteUnusedImagesOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.DeleteUnusedImages`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	images = output_.Unpack()
+	return
+}
+
+// ImageExists talks a full or partial image ID or name and returns an int as to whether
+// the image exists in local storage. An int result of 0 means the image does exist in
+// local storage; whereas 1 indicates the image does not exists in local storage.
+func (client_ *Client) ImageExists(ctx context.Context, name string) (exists int, err_ error) {
+	var (
+		input_  ImageExistsInput
+		output_ ImageExistsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ImageExists`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	exists = output_.Unpack()
+	return
+}
+
+// ContainerExists takes a full or partial container ID or name and returns an int as to
+// whether the container exists in local storage.  A result of 0 means the container does
+// exists; whereas a result of 1 means it could not be found.
+func (client_ *Client) ContainerExists(ctx context.Context, name string) (exists int, err_ error) {
+	var (
+		input_  ContainerExistsInput
+		output_ ContainerExistsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ContainerExists`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	exists = output_.Unpack()
+	return
+}
+
+// ListPods returns a list of pods in no particular order.  They are
+// returned as an array of ListPodData structs.  See also [GetPod](#GetPod).
+func (client_ *Client) ListPods(ctx context.Context) (pods []ListPodData, err_ error) {
+	var (
+		input_  ListPodsInput
+		output_ ListPodsOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListPods`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	pods = output_.Unpack()
+	return
+}
+
+// GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)
+// structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.
+// See also [ListPods](ListPods).
+func (client_ *Client) GetPod(ctx context.Context, name string) (pod ListPodData, err_ error) {
+	var (
+		input_  GetPodInput
+		output_ GetPodOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetPod`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	pod = output_.Unpack()
+	return
+}
+
+// StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)
+// error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers
+// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+// If the pod was started with no errors, the pod ID is returned.
+// See also [CreatePod](#CreatePod).
+func (client_ *Client) StartPod(ctx context.Context, name string) (pod string, err_ error) {
+	var (
+		input_  StartPodInput
+		output_ StartPodOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.StartPod`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	pod = output_.Unpack()
+	return
+}
+
+// RemovePod takes the name or ID of a pod as well a boolean representing whether a running
+// container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,
+// an error will occur.
+// If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.
+// Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers
+// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+// If the pod was removed with no errors, the pod ID is returned.
+func (client_ *Client) RemovePod(ctx context.Context, name string, force bool) (pod string, err_ error) {
+	var (
+		input_  RemovePodInput
+		output_ RemovePodOutput
+	)
+
+	input_.Pack(name, force)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.RemovePod`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	pod = output_.Unpack()
+	return
+}
+
+// GetEvents returns known libpod events filtered by the options provided.
+func (client_ *Client) GetEvents(ctx context.Context, filter []string, since string, until string) (events Event, err_ error) {
+	var (
+		input_  GetEventsInput
+		output_ GetEventsOutput
+	)
+
+	input_.Pack(filter, since, until)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetEvents`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	events = output_.Unpack()
+	return
+}
+
+// Diff returns a diff between libpod objects
+func (client_ *Client) Diff(ctx context.Context, name string) (diffs []DiffInfo, err_ error) {
+	var (
+		input_  DiffInput
+		output_ DiffOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.Diff`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	diffs = output_.Unpack()
+	return
+}
+
+// GetLayersMapWithImageInfo is for the development of Podman and should not be used.
+func (client_ *Client) GetLayersMapWithImageInfo(ctx context.Context) (layerMap string, err_ error) {
+	var (
+		input_  GetLayersMapWithImageInfoInput
+		output_ GetLayersMapWithImageInfoOutput
+	)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetLayersMapWithImageInfo`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	layerMap = output_.Unpack()
+	return
+}
+
+// VolumeCreate creates a volume on a remote host
+func (client_ *Client) VolumeCreate(ctx context.Context, options VolumeCreateOpts) (volumeName string, err_ error) {
+	var (
+		input_  VolumeCreateInput
+		output_ VolumeCreateOutput
+	)
+
+	input_.Pack(options)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.VolumeCreate`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	volumeName = output_.Unpack()
+	return
+}
+
+// VolumeRemove removes a volume on a remote host
+func (client_ *Client) VolumeRemove(ctx context.Context, options VolumeRemoveOpts) (successes []string, failures map[string]string, err_ error) {
+	var (
+		input_  VolumeRemoveInput
+		output_ VolumeRemoveOutput
+	)
+
+	input_.Pack(options)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.VolumeRemove`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	successes, failures = output_.Unpack()
+	return
+}
+
+// GetVolumes gets slice of the volumes on a remote host
+func (client_ *Client) GetVolumes(ctx context.Context, args []string, all bool) (volumes []Volume, err_ error) {
+	var (
+		input_  GetVolumesInput
+		output_ GetVolumesOutput
+	)
+
+	input_.Pack(args, all)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetVolumes`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	volumes = output_.Unpack()
+	return
+}
+
+// GetContainerSockets takes a name or ID of a container and returns the sockets
+// that are used to communicate with the container.
+func (client_ *Client) GetContainersSockets(ctx context.Context, name string) (sockets Sockets, err_ error) {
+	var (
+		input_  GetContainersSocketsInput
+		output_ GetContainersSocketsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.GetContainersSockets`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	sockets = output_.Unpack()
+	return
+}
+
+// ExecContainer executes a command in the given container.
+func (client_ *Client) ExecContainer(ctx context.Context, opts ExecOpts) (err_ error) {
+	var (
+		input_  ExecContainerInput
+		output_ ExecContainerOutput
+	)
+
+	input_.Pack(opts)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ExecContainer`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	return
+}
+
+// This function is not implemented yet.
+func (client_ *Client) ListContainerPorts(ctx context.Context, name string) (notimplemented NotImplemented, err_ error) {
+	var (
+		input_  ListContainerPortsInput
+		output_ ListContainerPortsOutput
+	)
+
+	input_.Pack(name)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `io.podman.ListContainerPorts`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	notimplemented = output_.Unpack()
+	return
+}
+
+// Service is the interface that servers that implement the io.podman
+// varlink interface must adhere to.
+type Service interface {
+
+	// GetVersion returns version and build information of the podman service
+	GetVersion(ctx context.Context) (version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int, err_ Error)
+
+	// GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,
+	// build information of Podman, and system-wide registries.
+	GetInfo(ctx context.Context) (info PodmanInfo, err_ Error)
+
+	// ListContainers returns information about all containers.
+	// See also [GetContainer](#GetContainer).
+	ListContainers(ctx context.Context) (containers []Container, err_ Error)
+	Ps(ctx context.Context, opts PsOpts) (containers []PsContainer, err_ Error)
+	GetContainersByStatus(ctx context.Context, status []string) (containerS []Container, err_ Error)
+	Top(ctx context.Context, nameOrID string, descriptors []string) (top []string, err_ Error)
+
+	// HealthCheckRun executes defined container's healthcheck command
+	// and returns the container's health status.
+	HealthCheckRun(ctx context.Context, nameOrID string) (healthCheckStatus string, err_ Error)
+
+	// GetContainer returns information about a single container.  If a container
+	// with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.  See also [ListContainers](ListContainers) and
+	// [InspectContainer](#InspectContainer).
+	GetContainer(ctx context.Context, id string) (container Container, err_ Error)
+
+	// GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of
+	// container names.  The definition of latest container means the latest by creation date.  In a multi-
+	// user environment, results might differ from what you expect.
+	GetContainersByContext(ctx context.Context, all bool, latest bool, args []string) (containers []string, err_ Error)
+
+	// InspectContainer data takes a name or ID of a container returns the inspection
+	// data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)
+	// error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).
+	InspectContainer(ctx context.Context, name string) (container string, err_ Error)
+
+	// ListContainerProcesses takes a name or ID of a container and returns the processes
+	// running inside the container as array of strings.  It will accept an array of string
+	// arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.
+	ListContainerProcesses(ctx context.Context, name string, opts []string) (container []string, err_ Error)
+
+	// GetContainerLogs takes a name or ID of a container and returns the logs of that container.
+	// If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+	// The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming
+	// capability of varlink if the client invokes it.
+	GetContainerLogs(ctx context.Context, name string) (container []string, err_ Error)
+	GetContainersLogs(ctx context.Context, names []string, follow bool, latest bool, since string, tail int, timestamps bool) (log LogLine, err_ Error)
+
+	// ListContainerChanges takes a name or ID of a container and returns changes between the container and
+	// its base image. It returns a struct of changed, deleted, and added path names.
+	ListContainerChanges(ctx context.Context, name string) (container ContainerChanges, err_ Error)
+
+	// ExportContainer creates an image from a container.  It takes the name or ID of a container and a
+	// path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary
+	// location and the path to that file will be returned.
+	ExportContainer(ctx context.Context, name string, path string) (tarfile string, err_ Error)
+
+	// GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which
+	// contains attributes like memory and cpu usage.  If the container cannot be found, a
+	// [ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)
+	// error will be returned
+	GetContainerStats(ctx context.Context, name string) (container ContainerStats, err_ Error)
+
+	// GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions
+	// to calculate the containers statistics based on current and previous measurements.
+	GetContainerStatsWithHistory(ctx context.Context, previousStats ContainerStats) (container ContainerStats, err_ Error)
+
+	// StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns
+	// the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.  See also [CreateContainer](#CreateContainer).
+	StartContainer(ctx context.Context, name string) (container string, err_ Error)
+
+	// StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a
+	// timeout value.  The timeout value the time before a forcible stop to the container is applied.  It
+	// returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned instead. See also [KillContainer](KillContainer).
+	StopContainer(ctx context.Context, name string, timeout int) (container string, err_ Error)
+
+	// RestartContainer will restart a running container given a container name or ID and timeout value. The timeout
+	// value is the time before a forcible stop is used to stop the container.  If the container cannot be found by
+	// name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the
+	// container will be returned.
+	RestartContainer(ctx context.Context, name string, timeout int) (container string, err_ Error)
+
+	// KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the
+	// container has been killed, the container's ID is returned.  If the container cannot be found, a
+	// [ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).
+	KillContainer(ctx context.Context, name string, signal int) (container string, err_ Error)
+
+	// PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,
+	// a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+	// See also [UnpauseContainer](#UnpauseContainer).
+	PauseContainer(ctx context.Context, name string) (container string, err_ Error)
+
+	// UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be
+	// found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+	// See also [PauseContainer](#PauseContainer).
+	UnpauseContainer(ctx context.Context, name string) (container string, err_ Error)
+
+	// WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container
+	// stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID
+	// or name, a [ContainerNotFound](#ContainerNotFound) error is returned.
+	WaitContainer(ctx context.Context, name string, interval int) (exitcode int, err_ Error)
+
+	// RemoveContainer requires the name or ID of a container as well as a boolean that
+	// indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean
+	// indicating whether to remove builtin volumes. Upon successful removal of the
+	// container, its ID is returned.  If the
+	// container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+	// See also [EvictContainer](EvictContainer).
+	RemoveContainer(ctx context.Context, name string, force bool, removeVolumes bool) (container string, err_ Error)
+
+	// DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted
+	// container IDs.  See also [RemoveContainer](RemoveContainer).
+	DeleteStoppedContainers(ctx context.Context) (containers []string, err_ Error)
+
+	// ListImages returns information about the images that are currently in storage.
+	// See also [InspectImage](#InspectImage).
+	ListImages(ctx context.Context) (images []Image, err_ Error)
+
+	// GetImage returns information about a single image in storage.
+	// If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.
+	GetImage(ctx context.Context, id string) (image Image, err_ Error)
+
+	// InspectImage takes the name or ID of an image and returns a string representation of data associated with the
+	// image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will
+	// be returned if the image cannot be found.
+	InspectImage(ctx context.Context, name string) (image string, err_ Error)
+
+	// HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned
+	// history is in the form of an array of ImageHistory structures.  If the image cannot be found, an
+	// [ImageNotFound](#ImageNotFound) error is returned.
+	HistoryImage(ctx context.Context, name string) (history []ImageHistory, err_ Error)
+
+	// TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot
+	// be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.
+	TagImage(ctx context.Context, name string, tagged string) (image string, err_ Error)
+
+	// RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image
+	// should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The
+	// ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).
+	RemoveImage(ctx context.Context, name string, force bool) (image string, err_ Error)
+
+	// SearchImages searches available registries for images that contain the
+	// contents of "query" in their name. If "limit" is given, limits the amount of
+	// search results per registry.
+	SearchImages(ctx context.Context, query string, limit *int, filter ImageSearchFilter) (results []ImageSearchResult, err_ Error)
+
+	// DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned
+	// in a string array.
+	DeleteUnusedImages(ctx context.Context) (images []string, err_ Error)
+
+	// ImageExists talks a full or partial image ID or name and returns an int as to whether
+	// the image exists in local storage. An int result of 0 means the image does exist in
+	// local storage; whereas 1 indicates the image does not exists in local storage.
+	ImageExists(ctx context.Context, name string) (exists int, err_ Error)
+
+	// ContainerExists takes a full or partial container ID or name and returns an int as to
+	// whether the container exists in local storage.  A result of 0 means the container does
+	// exists; whereas a result of 1 means it could not be found.
+	ContainerExists(ctx context.Context, name string) (exists int, err_ Error)
+
+	// ListPods returns a list of pods in no particular order.  They are
+	// returned as an array of ListPodData structs.  See also [GetPod](#GetPod).
+	ListPods(ctx context.Context) (pods []ListPodData, err_ Error)
+
+	// GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)
+	// structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.
+	// See also [ListPods](ListPods).
+	GetPod(ctx context.Context, name string) (pod ListPodData, err_ Error)
+
+	// StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)
+	// error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers
+	// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+	// If the pod was started with no errors, the pod ID is returned.
+	// See also [CreatePod](#CreatePod).
+	StartPod(ctx context.Context, name string) (pod string, err_ Error)
+
+	// RemovePod takes the name or ID of a pod as well a boolean representing whether a running
+	// container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,
+	// an error will occur.
+	// If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.
+	// Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers
+	// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+	// If the pod was removed with no errors, the pod ID is returned.
+	RemovePod(ctx context.Context, name string, force bool) (pod string, err_ Error)
+
+	// GetEvents returns known libpod events filtered by the options provided.
+	GetEvents(ctx context.Context, filter []string, since string, until string) (events Event, err_ Error)
+
+	// Diff returns a diff between libpod objects
+	Diff(ctx context.Context, name string) (diffs []DiffInfo, err_ Error)
+
+	// GetLayersMapWithImageInfo is for the development of Podman and should not be used.
+	GetLayersMapWithImageInfo(ctx context.Context) (layerMap string, err_ Error)
+
+	// VolumeCreate creates a volume on a remote host
+	VolumeCreate(ctx context.Context, options VolumeCreateOpts) (volumeName string, err_ Error)
+
+	// VolumeRemove removes a volume on a remote host
+	VolumeRemove(ctx context.Context, options VolumeRemoveOpts) (successes []string, failures map[string]string, err_ Error)
+
+	// GetVolumes gets slice of the volumes on a remote host
+	GetVolumes(ctx context.Context, args []string, all bool) (volumes []Volume, err_ Error)
+
+	// GetContainerSockets takes a name or ID of a container and returns the sockets
+	// that are used to communicate with the container.
+	GetContainersSockets(ctx context.Context, name string) (sockets Sockets, err_ Error)
+
+	// ExecContainer executes a command in the given container.
+	ExecContainer(ctx context.Context, opts ExecOpts) (err_ Error)
+
+	// This function is not implemented yet.
+	ListContainerPorts(ctx context.Context, name string) (notimplemented NotImplemented, err_ Error)
+}
+
+// NewHandler creates a new method handler for the specified service implementation.
+func NewHandler(s Service) varlink.MethodHandler {
+	var mux varlink.ServeMux
+	RegisterHandlers(&mux, s)
+	return &mux
+}
+
+// RegisterHandlers registers all of the method handlers for the specified
+// service implementation into the passed ServeMux.
+func RegisterHandlers(mux *varlink.ServeMux, s Service) {
+	mux.HandleMethod(GetVersionHandler(s.GetVersion))
+	mux.HandleMethod(GetInfoHandler(s.GetInfo))
+	mux.HandleMethod(ListContainersHandler(s.ListContainers))
+	mux.HandleMethod(PsHandler(s.Ps))
+	mux.HandleMethod(GetContainersByStatusHandler(s.GetContainersByStatus))
+	mux.HandleMethod(TopHandler(s.Top))
+	mux.HandleMethod(HealthCheckRunHandler(s.HealthCheckRun))
+	mux.HandleMethod(GetContainerHandler(s.GetContainer))
+	mux.HandleMethod(GetContainersByContextHandler(s.GetContainersByContext))
+	mux.HandleMethod(InspectContainerHandler(s.InspectContainer))
+	mux.HandleMethod(ListContainerProcessesHandler(s.ListContainerProcesses))
+	mux.HandleMethod(GetContainerLogsHandler(s.GetContainerLogs))
+	mux.HandleMethod(GetContainersLogsHandler(s.GetContainersLogs))
+	mux.HandleMethod(ListContainerChangesHandler(s.ListContainerChanges))
+	mux.HandleMethod(ExportContainerHandler(s.ExportContainer))
+	mux.HandleMethod(GetContainerStatsHandler(s.GetContainerStats))
+	mux.HandleMethod(GetContainerStatsWithHistoryHandler(s.GetContainerStatsWithHistory))
+	mux.HandleMethod(StartContainerHandler(s.StartContainer))
+	mux.HandleMethod(StopContainerHandler(s.StopContainer))
+	mux.HandleMethod(RestartContainerHandler(s.RestartContainer))
+	mux.HandleMethod(KillContainerHandler(s.KillContainer))
+	mux.HandleMethod(PauseContainerHandler(s.PauseContainer))
+	mux.HandleMethod(UnpauseContainerHandler(s.UnpauseContainer))
+	mux.HandleMethod(WaitContainerHandler(s.WaitContainer))
+	mux.HandleMethod(RemoveContainerHandler(s.RemoveContainer))
+	mux.HandleMethod(DeleteStoppedContainersHandler(s.DeleteStoppedContainers))
+	mux.HandleMethod(ListImagesHandler(s.ListImages))
+	mux.HandleMethod(GetImageHandler(s.GetImage))
+	mux.HandleMethod(InspectImageHandler(s.InspectImage))
+	mux.HandleMethod(HistoryImageHandler(s.HistoryImage))
+	mux.HandleMethod(TagImageHandler(s.TagImage))
+	mux.HandleMethod(RemoveImageHandler(s.RemoveImage))
+	mux.HandleMethod(SearchImagesHandler(s.SearchImages))
+	mux.HandleMethod(DeleteUnusedImagesHandler(s.DeleteUnusedImages))
+	mux.HandleMethod(ImageExistsHandler(s.ImageExists))
+	mux.HandleMethod(ContainerExistsHandler(s.ContainerExists))
+	mux.HandleMethod(ListPodsHandler(s.ListPods))
+	mux.HandleMethod(GetPodHandler(s.GetPod))
+	mux.HandleMethod(StartPodHandler(s.StartPod))
+	mux.HandleMethod(RemovePodHandler(s.RemovePod))
+	mux.HandleMethod(GetEventsHandler(s.GetEvents))
+	mux.HandleMethod(DiffHandler(s.Diff))
+	mux.HandleMethod(GetLayersMapWithImageInfoHandler(s.GetLayersMapWithImageInfo))
+	mux.HandleMethod(VolumeCreateHandler(s.VolumeCreate))
+	mux.HandleMethod(VolumeRemoveHandler(s.VolumeRemove))
+	mux.HandleMethod(GetVolumesHandler(s.GetVolumes))
+	mux.HandleMethod(GetContainersSocketsHandler(s.GetContainersSockets))
+	mux.HandleMethod(ExecContainerHandler(s.ExecContainer))
+	mux.HandleMethod(ListContainerPortsHandler(s.ListContainerPorts))
+}
+
+// TypedService is implemented by implementations of the io.podman
+// varlink interface that take and return the input and output structs of
+// its methods. Methods replying more than once, which are marked with
+// @more or @streamable, send their replies with send.
+type TypedService interface {
+
+	// GetVersion returns version and build information of the podman service
+	GetVersion(ctx context.Context, input *GetVersionInput) (*GetVersionOutput, error)
+
+	// GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,
+	// build information of Podman, and system-wide registries.
+	GetInfo(ctx context.Context, input *GetInfoInput) (*GetInfoOutput, error)
+
+	// ListContainers returns information about all containers.
+	// See also [GetContainer](#GetContainer).
+	ListContainers(ctx context.Context, input *ListContainersInput) (*ListContainersOutput, error)
+	Ps(ctx context.Context, input *PsInput) (*PsOutput, error)
+	GetContainersByStatus(ctx context.Context, input *GetContainersByStatusInput) (*GetContainersByStatusOutput, error)
+	Top(ctx context.Context, input *TopInput) (*TopOutput, error)
+
+	// HealthCheckRun executes defined container's healthcheck command
+	// and returns the container's health status.
+	HealthCheckRun(ctx context.Context, input *HealthCheckRunInput) (*HealthCheckRunOutput, error)
+
+	// GetContainer returns information about a single container.  If a container
+	// with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.  See also [ListContainers](ListContainers) and
+	// [InspectContainer](#InspectContainer).
+	GetContainer(ctx context.Context, input *GetContainerInput) (*GetContainerOutput, error)
+
+	// GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of
+	// container names.  The definition of latest container means the latest by creation date.  In a multi-
+	// user environment, results might differ from what you expect.
+	GetContainersByContext(ctx context.Context, input *GetContainersByContextInput) (*GetContainersByContextOutput, error)
+
+	// InspectContainer data takes a name or ID of a container returns the inspection
+	// data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)
+	// error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).
+	InspectContainer(ctx context.Context, input *InspectContainerInput) (*InspectContainerOutput, error)
+
+	// ListContainerProcesses takes a name or ID of a container and returns the processes
+	// running inside the container as array of strings.  It will accept an array of string
+	// arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.
+	ListContainerProcesses(ctx context.Context, input *ListContainerProcessesInput) (*ListContainerProcessesOutput, error)
+
+	// GetContainerLogs takes a name or ID of a container and returns the logs of that container.
+	// If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+	// The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming
+	// capability of varlink if the client invokes it.
+	GetContainerLogs(ctx context.Context, input *GetContainerLogsInput) (*GetContainerLogsOutput, error)
+	GetContainersLogs(ctx context.Context, input *GetContainersLogsInput) (*GetContainersLogsOutput, error)
+
+	// ListContainerChanges takes a name or ID of a container and returns changes between the container and
+	// its base image. It returns a struct of changed, deleted, and added path names.
+	ListContainerChanges(ctx context.Context, input *ListContainerChangesInput) (*ListContainerChangesOutput, error)
+
+	// ExportContainer creates an image from a container.  It takes the name or ID of a container and a
+	// path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary
+	// location and the path to that file will be returned.
+	ExportContainer(ctx context.Context, input *ExportContainerInput) (*ExportContainerOutput, error)
+
+	// GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which
+	// contains attributes like memory and cpu usage.  If the container cannot be found, a
+	// [ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)
+	// error will be returned
+	GetContainerStats(ctx context.Context, input *GetContainerStatsInput) (*GetContainerStatsOutput, error)
+
+	// GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions
+	// to calculate the containers statistics based on current and previous measurements.
+	GetContainerStatsWithHistory(ctx context.Context, input *GetContainerStatsWithHistoryInput) (*GetContainerStatsWithHistoryOutput, error)
+
+	// StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns
+	// the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned.  See also [CreateContainer](#CreateContainer).
+	StartContainer(ctx context.Context, input *StartContainerInput) (*StartContainerOutput, error)
+
+	// StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a
+	// timeout value.  The timeout value the time before a forcible stop to the container is applied.  It
+	// returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+	// error will be returned instead. See also [KillContainer](KillContainer).
+	StopContainer(ctx context.Context, input *StopContainerInput) (*StopContainerOutput, error)
+
+	// RestartContainer will restart a running container given a container name or ID and timeout value. The timeout
+	// value is the time before a forcible stop is used to stop the container.  If the container cannot be found by
+	// name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the
+	// container will be returned.
+	RestartContainer(ctx context.Context, input *RestartContainerInput) (*RestartContainerOutput, error)
+
+	// KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the
+	// container has been killed, the container's ID is returned.  If the container cannot be found, a
+	// [ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).
+	KillContainer(ctx context.Context, input *KillContainerInput) (*KillContainerOutput, error)
+
+	// PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,
+	// a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+	// See also [UnpauseContainer](#UnpauseContainer).
+	PauseContainer(ctx context.Context, input *PauseContainerInput) (*PauseContainerOutput, error)
+
+	// UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be
+	// found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+	// See also [PauseContainer](#PauseContainer).
+	UnpauseContainer(ctx context.Context, input *UnpauseContainerInput) (*UnpauseContainerOutput, error)
+
+	// WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container
+	// stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID
+	// or name, a [ContainerNotFound](#ContainerNotFound) error is returned.
+	WaitContainer(ctx context.Context, input *WaitContainerInput) (*WaitContainerOutput, error)
+
+	// RemoveContainer requires the name or ID of a container as well as a boolean that
+	// indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean
+	// indicating whether to remove builtin volumes. Upon successful removal of the
+	// container, its ID is returned.  If the
+	// container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+	// See also [EvictContainer](EvictContainer).
+	RemoveContainer(ctx context.Context, input *RemoveContainerInput) (*RemoveContainerOutput, error)
+
+	// DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted
+	// container IDs.  See also [RemoveContainer](RemoveContainer).
+	DeleteStoppedContainers(ctx context.Context, input *DeleteStoppedContainersInput) (*DeleteStoppedContainersOutput, error)
+
+	// ListImages returns information about the images that are currently in storage.
+	// See also [InspectImage](#InspectImage).
+	ListImages(ctx context.Context, input *ListImagesInput) (*ListImagesOutput, error)
+
+	// GetImage returns information about a single image in storage.
+	// If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.
+	GetImage(ctx context.Context, input *GetImageInput) (*GetImageOutput, error)
+
+	// InspectImage takes the name or ID of an image and returns a string representation of data associated with the
+	// image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will
+	// be returned if the image cannot be found.
+	InspectImage(ctx context.Context, input *InspectImageInput) (*InspectImageOutput, error)
+
+	// HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned
+	// history is in the form of an array of ImageHistory structures.  If the image cannot be found, an
+	// [ImageNotFound](#ImageNotFound) error is returned.
+	HistoryImage(ctx context.Context, input *HistoryImageInput) (*HistoryImageOutput, error)
+
+	// TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot
+	// be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.
+	TagImage(ctx context.Context, input *TagImageInput) (*TagImageOutput, error)
+
+	// RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image
+	// should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The
+	// ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).
+	RemoveImage(ctx context.Context, input *RemoveImageInput) (*RemoveImageOutput, error)
+
+	// SearchImages searches available registries for images that contain the
+	// contents of "query" in their name. If "limit" is given, limits the amount of
+	// search results per registry.
+	SearchImages(ctx context.Context, input *SearchImagesInput) (*SearchImagesOutput, error)
+
+	// DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned
+	// in a string array.
+	DeleteUnusedImages(ctx context.Context, input *DeleteUnusedImagesInput) (*DeleteUnusedImagesOutput, error)
+
+	// ImageExists talks a full or partial image ID or name and returns an int as to whether
+	// the image exists in local storage. An int result of 0 means the image does exist in
+	// local storage; whereas 1 indicates the image does not exists in local storage.
+	ImageExists(ctx context.Context, input *ImageExistsInput) (*ImageExistsOutput, error)
+
+	// ContainerExists takes a full or partial container ID or name and returns an int as to
+	// whether the container exists in local storage.  A result of 0 means the container does
+	// exists; whereas a result of 1 means it could not be found.
+	ContainerExists(ctx context.Context, input *ContainerExistsInput) (*ContainerExistsOutput, error)
+
+	// ListPods returns a list of pods in no particular order.  They are
+	// returned as an array of ListPodData structs.  See also [GetPod](#GetPod).
+	ListPods(ctx context.Context, input *ListPodsInput) (*ListPodsOutput, error)
+
+	// GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)
+	// structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.
+	// See also [ListPods](ListPods).
+	GetPod(ctx context.Context, input *GetPodInput) (*GetPodOutput, error)
+
+	// StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)
+	// error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers
+	// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+	// If the pod was started with no errors, the pod ID is returned.
+	// See also [CreatePod](#CreatePod).
+	StartPod(ctx context.Context, input *StartPodInput) (*StartPodOutput, error)
+
+	// RemovePod takes the name or ID of a pod as well a boolean representing whether a running
+	// container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,
+	// an error will occur.
+	// If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.
+	// Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers
+	// will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+	// If the pod was removed with no errors, the pod ID is returned.
+	RemovePod(ctx context.Context, input *RemovePodInput) (*RemovePodOutput, error)
+
+	// GetEvents returns known libpod events filtered by the options provided.
+	GetEvents(ctx context.Context, input *GetEventsInput) (*GetEventsOutput, error)
+
+	// Diff returns a diff between libpod objects
+	Diff(ctx context.Context, input *DiffInput) (*DiffOutput, error)
+
+	// GetLayersMapWithImageInfo is for the development of Podman and should not be used.
+	GetLayersMapWithImageInfo(ctx context.Context, input *GetLayersMapWithImageInfoInput) (*GetLayersMapWithImageInfoOutput, error)
+
+	// VolumeCreate creates a volume on a remote host
+	VolumeCreate(ctx context.Context, input *VolumeCreateInput) (*VolumeCreateOutput, error)
+
+	// VolumeRemove removes a volume on a remote host
+	VolumeRemove(ctx context.Context, input *VolumeRemoveInput) (*VolumeRemoveOutput, error)
+
+	// GetVolumes gets slice of the volumes on a remote host
+	GetVolumes(ctx context.Context, input *GetVolumesInput) (*GetVolumesOutput, error)
+
+	// GetContainerSockets takes a name or ID of a container and returns the sockets
+	// that are used to communicate with the container.
+	GetContainersSockets(ctx context.Context, input *GetContainersSocketsInput) (*GetContainersSocketsOutput, error)
+
+	// ExecContainer executes a command in the given container.
+	ExecContainer(ctx context.Context, input *ExecContainerInput) (*ExecContainerOutput, error)
+
+	// This function is not implemented yet.
+	ListContainerPorts(ctx context.Context, input *ListContainerPortsInput) (*ListContainerPortsOutput, error)
+}
+
+// NewDispatcher returns a handler routing the calls to the methods of the
+// io.podman interface to impl, after decoding and validating
+// their input. Errors returned by impl are converted with
+// varlink.ConvertError, and calls to other methods are replied with
+// org.varlink.service.MethodNotFound. To also serve the introspection
+// methods, register the dispatcher with varlink.ServeMux.Handle, with the
+// pattern `io.podman.*`.
+func NewDispatcher(impl TypedService) varlink.MethodHandler {
+	return dispatcher{impl}
+}
+
+type dispatcher struct {
+	impl TypedService
+}
+
+func (d_ dispatcher) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	switch call.Method {
+	case `io.podman.GetVersion`:
+		var input_ GetVersionInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetVersion(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetVersionOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetInfo`:
+		var input_ GetInfoInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetInfo(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetInfoOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListContainers`:
+		var input_ ListContainersInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListContainers(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListContainersOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.Ps`:
+		var input_ PsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.Ps(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(PsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainersByStatus`:
+		var input_ GetContainersByStatusInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainersByStatus(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainersByStatusOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.Top`:
+		var input_ TopInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.Top(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(TopOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.HealthCheckRun`:
+		var input_ HealthCheckRunInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.HealthCheckRun(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(HealthCheckRunOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainer`:
+		var input_ GetContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainersByContext`:
+		var input_ GetContainersByContextInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainersByContext(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainersByContextOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.InspectContainer`:
+		var input_ InspectContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.InspectContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(InspectContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListContainerProcesses`:
+		var input_ ListContainerProcessesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListContainerProcesses(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListContainerProcessesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainerLogs`:
+		var input_ GetContainerLogsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainerLogs(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainerLogsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainersLogs`:
+		var input_ GetContainersLogsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainersLogs(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainersLogsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListContainerChanges`:
+		var input_ ListContainerChangesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListContainerChanges(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListContainerChangesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ExportContainer`:
+		var input_ ExportContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ExportContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ExportContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainerStats`:
+		var input_ GetContainerStatsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainerStats(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainerStatsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainerStatsWithHistory`:
+		var input_ GetContainerStatsWithHistoryInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainerStatsWithHistory(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainerStatsWithHistoryOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.StartContainer`:
+		var input_ StartContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.StartContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(StartContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.StopContainer`:
+		var input_ StopContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.StopContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(StopContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.RestartContainer`:
+		var input_ RestartContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.RestartContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(RestartContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.KillContainer`:
+		var input_ KillContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.KillContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(KillContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.PauseContainer`:
+		var input_ PauseContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.PauseContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(PauseContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.UnpauseContainer`:
+		var input_ UnpauseContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.UnpauseContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(UnpauseContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.WaitContainer`:
+		var input_ WaitContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.WaitContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(WaitContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.RemoveContainer`:
+		var input_ RemoveContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.RemoveContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(RemoveContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.DeleteStoppedContainers`:
+		var input_ DeleteStoppedContainersInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.DeleteStoppedContainers(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(DeleteStoppedContainersOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListImages`:
+		var input_ ListImagesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListImages(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListImagesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetImage`:
+		var input_ GetImageInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetImage(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetImageOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.InspectImage`:
+		var input_ InspectImageInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.InspectImage(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(InspectImageOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.HistoryImage`:
+		var input_ HistoryImageInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.HistoryImage(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(HistoryImageOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.TagImage`:
+		var input_ TagImageInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.TagImage(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(TagImageOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.RemoveImage`:
+		var input_ RemoveImageInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.RemoveImage(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(RemoveImageOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.SearchImages`:
+		var input_ SearchImagesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.SearchImages(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(SearchImagesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.DeleteUnusedImages`:
+		var input_ DeleteUnusedImagesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.DeleteUnusedImages(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(DeleteUnusedImagesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ImageExists`:
+		var input_ ImageExistsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ImageExists(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ImageExistsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ContainerExists`:
+		var input_ ContainerExistsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ContainerExists(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ContainerExistsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListPods`:
+		var input_ ListPodsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListPods(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListPodsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetPod`:
+		var input_ GetPodInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetPod(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetPodOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.StartPod`:
+		var input_ StartPodInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.StartPod(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(StartPodOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.RemovePod`:
+		var input_ RemovePodInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.RemovePod(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(RemovePodOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetEvents`:
+		var input_ GetEventsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetEvents(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetEventsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.Diff`:
+		var input_ DiffInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.Diff(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(DiffOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetLayersMapWithImageInfo`:
+		var input_ GetLayersMapWithImageInfoInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetLayersMapWithImageInfo(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetLayersMapWithImageInfoOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.VolumeCreate`:
+		var input_ VolumeCreateInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.VolumeCreate(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(VolumeCreateOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.VolumeRemove`:
+		var input_ VolumeRemoveInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.VolumeRemove(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(VolumeRemoveOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetVolumes`:
+		var input_ GetVolumesInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetVolumes(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetVolumesOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.GetContainersSockets`:
+		var input_ GetContainersSocketsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.GetContainersSockets(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetContainersSocketsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ExecContainer`:
+		var input_ ExecContainerInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ExecContainer(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ExecContainerOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `io.podman.ListContainerPorts`:
+		var input_ ListContainerPortsInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.ListContainerPorts(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ListContainerPortsOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	default:
+		w.WriteError(varlinkrt.MethodNotFound(call.Method))
+	}
+}
+
+// GetVersionHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetVersion method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetVersionHandler func(ctx context.Context) (version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetVersion method.
+func (GetVersionHandler) MethodName() string {
+	return `io.podman.GetVersion`
+}
+
+func (fn GetVersionHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetVersionInput
+		output GetVersionOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Version, output.GoVersion, output.GitCommit, output.Built, output.OsArch, output.RemoteApiVersion, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetVersionService is implemented by context-first implementations of
+// the GetVersion method, which send their replies with send.
+type GetVersionService interface {
+	GetVersion(ctx context.Context, send func(version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int) error) error
+}
+
+// NewGetVersionHandler returns a handler of the GetVersion method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetVersionHandler(impl GetVersionService) varlink.Method {
+	return getVersionAdapter{impl}
+}
+
+type getVersionAdapter struct {
+	impl GetVersionService
+}
+
+func (getVersionAdapter) MethodName() string {
+	return `io.podman.GetVersion`
+}
+
+func (a_ getVersionAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetVersionInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetVersionOutput) error) error {
+		return a_.impl.GetVersion(ctx_, func(version string, goVersion string, gitCommit string, built string, osArch string, remoteApiVersion int) error {
+			var output_ GetVersionOutput
+			output_.Pack(version, goVersion, gitCommit, built, osArch, remoteApiVersion)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetInfoHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetInfo method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetInfoHandler func(ctx context.Context) (info PodmanInfo, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetInfo method.
+func (GetInfoHandler) MethodName() string {
+	return `io.podman.GetInfo`
+}
+
+func (fn GetInfoHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetInfoInput
+		output GetInfoOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Info, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetInfoService is implemented by context-first implementations of
+// the GetInfo method, which send their replies with send.
+type GetInfoService interface {
+	GetInfo(ctx context.Context, send func(info PodmanInfo) error) error
+}
+
+// NewGetInfoHandler returns a handler of the GetInfo method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetInfoHandler(impl GetInfoService) varlink.Method {
+	return getInfoAdapter{impl}
+}
+
+type getInfoAdapter struct {
+	impl GetInfoService
+}
+
+func (getInfoAdapter) MethodName() string {
+	return `io.podman.GetInfo`
+}
+
+func (a_ getInfoAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetInfoInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetInfoOutput) error) error {
+		return a_.impl.GetInfo(ctx_, func(info PodmanInfo) error {
+			var output_ GetInfoOutput
+			output_.Pack(info)
+			return send_(&output_)
+		})
+	})
+}
+
+// ListContainersHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListContainers method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListContainersHandler func(ctx context.Context) (containers []Container, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListContainers method.
+func (ListContainersHandler) MethodName() string {
+	return `io.podman.ListContainers`
+}
+
+func (fn ListContainersHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListContainersInput
+		output ListContainersOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Containers, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListContainersService is implemented by context-first implementations of
+// the ListContainers method, which send their replies with send.
+type ListContainersService interface {
+	ListContainers(ctx context.Context, send func(containers []Container) error) error
+}
+
+// NewListContainersHandler returns a handler of the ListContainers method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListContainersHandler(impl ListContainersService) varlink.Method {
+	return listContainersAdapter{impl}
+}
+
+type listContainersAdapter struct {
+	impl ListContainersService
+}
+
+func (listContainersAdapter) MethodName() string {
+	return `io.podman.ListContainers`
+}
+
+func (a_ listContainersAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListContainersInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListContainersOutput) error) error {
+		return a_.impl.ListContainers(ctx_, func(containers []Container) error {
+			var output_ ListContainersOutput
+			output_.Pack(containers)
+			return send_(&output_)
+		})
+	})
+}
+
+// PsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the Ps method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type PsHandler func(ctx context.Context, opts PsOpts) (containers []PsContainer, err_ Error)
+
+// MethodName returns the fully-qualified name of the Ps method.
+func (PsHandler) MethodName() string {
+	return `io.podman.Ps`
+}
+
+func (fn PsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  PsInput
+		output PsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Containers, err = fn(w.Context(), input.Opts)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// PsService is implemented by context-first implementations of
+// the Ps method, which send their replies with send.
+type PsService interface {
+	Ps(ctx context.Context, opts PsOpts, send func(containers []PsContainer) error) error
+}
+
+// NewPsHandler returns a handler of the Ps method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewPsHandler(impl PsService) varlink.Method {
+	return psAdapter{impl}
+}
+
+type psAdapter struct {
+	impl PsService
+}
+
+func (psAdapter) MethodName() string {
+	return `io.podman.Ps`
+}
+
+func (a_ psAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ PsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*PsOutput) error) error {
+		return a_.impl.Ps(ctx_, input_.Opts, func(containers []PsContainer) error {
+			var output_ PsOutput
+			output_.Pack(containers)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainersByStatusHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainersByStatus method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainersByStatusHandler func(ctx context.Context, status []string) (containerS []Container, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainersByStatus method.
+func (GetContainersByStatusHandler) MethodName() string {
+	return `io.podman.GetContainersByStatus`
+}
+
+func (fn GetContainersByStatusHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainersByStatusInput
+		output GetContainersByStatusOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.ContainerS, err = fn(w.Context(), input.Status)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainersByStatusService is implemented by context-first implementations of
+// the GetContainersByStatus method, which send their replies with send.
+type GetContainersByStatusService interface {
+	GetContainersByStatus(ctx context.Context, status []string, send func(containerS []Container) error) error
+}
+
+// NewGetContainersByStatusHandler returns a handler of the GetContainersByStatus method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainersByStatusHandler(impl GetContainersByStatusService) varlink.Method {
+	return getContainersByStatusAdapter{impl}
+}
+
+type getContainersByStatusAdapter struct {
+	impl GetContainersByStatusService
+}
+
+func (getContainersByStatusAdapter) MethodName() string {
+	return `io.podman.GetContainersByStatus`
+}
+
+func (a_ getContainersByStatusAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainersByStatusInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainersByStatusOutput) error) error {
+		return a_.impl.GetContainersByStatus(ctx_, input_.Status, func(containerS []Container) error {
+			var output_ GetContainersByStatusOutput
+			output_.Pack(containerS)
+			return send_(&output_)
+		})
+	})
+}
+
+// TopHandler is an adapter to allow the use of ordinary
+// functions as handlers of the Top method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type TopHandler func(ctx context.Context, nameOrID string, descriptors []string) (top []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the Top method.
+func (TopHandler) MethodName() string {
+	return `io.podman.Top`
+}
+
+func (fn TopHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  TopInput
+		output TopOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Top, err = fn(w.Context(), input.NameOrID, input.Descriptors)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// TopService is implemented by context-first implementations of
+// the Top method, which send their replies with send.
+type TopService interface {
+	Top(ctx context.Context, nameOrID string, descriptors []string, send func(top []string) error) error
+}
+
+// NewTopHandler returns a handler of the Top method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewTopHandler(impl TopService) varlink.Method {
+	return topAdapter{impl}
+}
+
+type topAdapter struct {
+	impl TopService
+}
+
+func (topAdapter) MethodName() string {
+	return `io.podman.Top`
+}
+
+func (a_ topAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ TopInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*TopOutput) error) error {
+		return a_.impl.Top(ctx_, input_.NameOrID, input_.Descriptors, func(top []string) error {
+			var output_ TopOutput
+			output_.Pack(top)
+			return send_(&output_)
+		})
+	})
+}
+
+// HealthCheckRunHandler is an adapter to allow the use of ordinary
+// functions as handlers of the HealthCheckRun method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type HealthCheckRunHandler func(ctx context.Context, nameOrID string) (healthCheckStatus string, err_ Error)
+
+// MethodName returns the fully-qualified name of the HealthCheckRun method.
+func (HealthCheckRunHandler) MethodName() string {
+	return `io.podman.HealthCheckRun`
+}
+
+func (fn HealthCheckRunHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  HealthCheckRunInput
+		output HealthCheckRunOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.HealthCheckStatus, err = fn(w.Context(), input.NameOrID)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// HealthCheckRunService is implemented by context-first implementations of
+// the HealthCheckRun method, which send their replies with send.
+type HealthCheckRunService interface {
+	HealthCheckRun(ctx context.Context, nameOrID string, send func(healthCheckStatus string) error) error
+}
+
+// NewHealthCheckRunHandler returns a handler of the HealthCheckRun method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewHealthCheckRunHandler(impl HealthCheckRunService) varlink.Method {
+	return healthCheckRunAdapter{impl}
+}
+
+type healthCheckRunAdapter struct {
+	impl HealthCheckRunService
+}
+
+func (healthCheckRunAdapter) MethodName() string {
+	return `io.podman.HealthCheckRun`
+}
+
+func (a_ healthCheckRunAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ HealthCheckRunInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*HealthCheckRunOutput) error) error {
+		return a_.impl.HealthCheckRun(ctx_, input_.NameOrID, func(healthCheckStatus string) error {
+			var output_ HealthCheckRunOutput
+			output_.Pack(healthCheckStatus)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainerHandler func(ctx context.Context, id string) (container Container, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainer method.
+func (GetContainerHandler) MethodName() string {
+	return `io.podman.GetContainer`
+}
+
+func (fn GetContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainerInput
+		output GetContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Id)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainerService is implemented by context-first implementations of
+// the GetContainer method, which send their replies with send.
+type GetContainerService interface {
+	GetContainer(ctx context.Context, id string, send func(container Container) error) error
+}
+
+// NewGetContainerHandler returns a handler of the GetContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainerHandler(impl GetContainerService) varlink.Method {
+	return getContainerAdapter{impl}
+}
+
+type getContainerAdapter struct {
+	impl GetContainerService
+}
+
+func (getContainerAdapter) MethodName() string {
+	return `io.podman.GetContainer`
+}
+
+func (a_ getContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainerOutput) error) error {
+		return a_.impl.GetContainer(ctx_, input_.Id, func(container Container) error {
+			var output_ GetContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainersByContextHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainersByContext method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainersByContextHandler func(ctx context.Context, all bool, latest bool, args []string) (containers []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainersByContext method.
+func (GetContainersByContextHandler) MethodName() string {
+	return `io.podman.GetContainersByContext`
+}
+
+func (fn GetContainersByContextHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainersByContextInput
+		output GetContainersByContextOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Containers, err = fn(w.Context(), input.All, input.Latest, input.Args)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainersByContextService is implemented by context-first implementations of
+// the GetContainersByContext method, which send their replies with send.
+type GetContainersByContextService interface {
+	GetContainersByContext(ctx context.Context, all bool, latest bool, args []string, send func(containers []string) error) error
+}
+
+// NewGetContainersByContextHandler returns a handler of the GetContainersByContext method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainersByContextHandler(impl GetContainersByContextService) varlink.Method {
+	return getContainersByContextAdapter{impl}
+}
+
+type getContainersByContextAdapter struct {
+	impl GetContainersByContextService
+}
+
+func (getContainersByContextAdapter) MethodName() string {
+	return `io.podman.GetContainersByContext`
+}
+
+func (a_ getContainersByContextAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainersByContextInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainersByContextOutput) error) error {
+		return a_.impl.GetContainersByContext(ctx_, input_.All, input_.Latest, input_.Args, func(containers []string) error {
+			var output_ GetContainersByContextOutput
+			output_.Pack(containers)
+			return send_(&output_)
+		})
+	})
+}
+
+// InspectContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the InspectContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type InspectContainerHandler func(ctx context.Context, name string) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the InspectContainer method.
+func (InspectContainerHandler) MethodName() string {
+	return `io.podman.InspectContainer`
+}
+
+func (fn InspectContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  InspectContainerInput
+		output InspectContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// InspectContainerService is implemented by context-first implementations of
+// the InspectContainer method, which send their replies with send.
+type InspectContainerService interface {
+	InspectContainer(ctx context.Context, name string, send func(container string) error) error
+}
+
+// NewInspectContainerHandler returns a handler of the InspectContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewInspectContainerHandler(impl InspectContainerService) varlink.Method {
+	return inspectContainerAdapter{impl}
+}
+
+type inspectContainerAdapter struct {
+	impl InspectContainerService
+}
+
+func (inspectContainerAdapter) MethodName() string {
+	return `io.podman.InspectContainer`
+}
+
+func (a_ inspectContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ InspectContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*InspectContainerOutput) error) error {
+		return a_.impl.InspectContainer(ctx_, input_.Name, func(container string) error {
+			var output_ InspectContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// ListContainerProcessesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListContainerProcesses method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListContainerProcessesHandler func(ctx context.Context, name string, opts []string) (container []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListContainerProcesses method.
+func (ListContainerProcessesHandler) MethodName() string {
+	return `io.podman.ListContainerProcesses`
+}
+
+func (fn ListContainerProcessesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListContainerProcessesInput
+		output ListContainerProcessesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name, input.Opts)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListContainerProcessesService is implemented by context-first implementations of
+// the ListContainerProcesses method, which send their replies with send.
+type ListContainerProcessesService interface {
+	ListContainerProcesses(ctx context.Context, name string, opts []string, send func(container []string) error) error
+}
+
+// NewListContainerProcessesHandler returns a handler of the ListContainerProcesses method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListContainerProcessesHandler(impl ListContainerProcessesService) varlink.Method {
+	return listContainerProcessesAdapter{impl}
+}
+
+type listContainerProcessesAdapter struct {
+	impl ListContainerProcessesService
+}
+
+func (listContainerProcessesAdapter) MethodName() string {
+	return `io.podman.ListContainerProcesses`
+}
+
+func (a_ listContainerProcessesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListContainerProcessesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListContainerProcessesOutput) error) error {
+		return a_.impl.ListContainerProcesses(ctx_, input_.Name, input_.Opts, func(container []string) error {
+			var output_ ListContainerProcessesOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainerLogsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainerLogs method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainerLogsHandler func(ctx context.Context, name string) (container []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainerLogs method.
+func (GetContainerLogsHandler) MethodName() string {
+	return `io.podman.GetContainerLogs`
+}
+
+func (fn GetContainerLogsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainerLogsInput
+		output GetContainerLogsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainerLogsService is implemented by context-first implementations of
+// the GetContainerLogs method, which send their replies with send.
+type GetContainerLogsService interface {
+	GetContainerLogs(ctx context.Context, name string, send func(container []string) error) error
+}
+
+// NewGetContainerLogsHandler returns a handler of the GetContainerLogs method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainerLogsHandler(impl GetContainerLogsService) varlink.Method {
+	return getContainerLogsAdapter{impl}
+}
+
+type getContainerLogsAdapter struct {
+	impl GetContainerLogsService
+}
+
+func (getContainerLogsAdapter) MethodName() string {
+	return `io.podman.GetContainerLogs`
+}
+
+func (a_ getContainerLogsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainerLogsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainerLogsOutput) error) error {
+		return a_.impl.GetContainerLogs(ctx_, input_.Name, func(container []string) error {
+			var output_ GetContainerLogsOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainersLogsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainersLogs method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainersLogsHandler func(ctx context.Context, names []string, follow bool, latest bool, since string, tail int, timestamps bool) (log LogLine, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainersLogs method.
+func (GetContainersLogsHandler) MethodName() string {
+	return `io.podman.GetContainersLogs`
+}
+
+func (fn GetContainersLogsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainersLogsInput
+		output GetContainersLogsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Log, err = fn(w.Context(), input.Names, input.Follow, input.Latest, input.Since, input.Tail, input.Timestamps)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainersLogsService is implemented by context-first implementations of
+// the GetContainersLogs method, which send their replies with send.
+type GetContainersLogsService interface {
+	GetContainersLogs(ctx context.Context, names []string, follow bool, latest bool, since string, tail int, timestamps bool, send func(log LogLine) error) error
+}
+
+// NewGetContainersLogsHandler returns a handler of the GetContainersLogs method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainersLogsHandler(impl GetContainersLogsService) varlink.Method {
+	return getContainersLogsAdapter{impl}
+}
+
+type getContainersLogsAdapter struct {
+	impl GetContainersLogsService
+}
+
+func (getContainersLogsAdapter) MethodName() string {
+	return `io.podman.GetContainersLogs`
+}
+
+func (a_ getContainersLogsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainersLogsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainersLogsOutput) error) error {
+		return a_.impl.GetContainersLogs(ctx_, input_.Names, input_.Follow, input_.Latest, input_.Since, input_.Tail, input_.Timestamps, func(log LogLine) error {
+			var output_ GetContainersLogsOutput
+			output_.Pack(log)
+			return send_(&output_)
+		})
+	})
+}
+
+// ListContainerChangesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListContainerChanges method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListContainerChangesHandler func(ctx context.Context, name string) (container ContainerChanges, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListContainerChanges method.
+func (ListContainerChangesHandler) MethodName() string {
+	return `io.podman.ListContainerChanges`
+}
+
+func (fn ListContainerChangesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListContainerChangesInput
+		output ListContainerChangesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListContainerChangesService is implemented by context-first implementations of
+// the ListContainerChanges method, which send their replies with send.
+type ListContainerChangesService interface {
+	ListContainerChanges(ctx context.Context, name string, send func(container ContainerChanges) error) error
+}
+
+// NewListContainerChangesHandler returns a handler of the ListContainerChanges method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListContainerChangesHandler(impl ListContainerChangesService) varlink.Method {
+	return listContainerChangesAdapter{impl}
+}
+
+type listContainerChangesAdapter struct {
+	impl ListContainerChangesService
+}
+
+func (listContainerChangesAdapter) MethodName() string {
+	return `io.podman.ListContainerChanges`
+}
+
+func (a_ listContainerChangesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListContainerChangesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListContainerChangesOutput) error) error {
+		return a_.impl.ListContainerChanges(ctx_, input_.Name, func(container ContainerChanges) error {
+			var output_ ListContainerChangesOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// ExportContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ExportContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ExportContainerHandler func(ctx context.Context, name string, path string) (tarfile string, err_ Error)
+
+// MethodName returns the fully-qualified name of the ExportContainer method.
+func (ExportContainerHandler) MethodName() string {
+	return `io.podman.ExportContainer`
+}
+
+func (fn ExportContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ExportContainerInput
+		output ExportContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Tarfile, err = fn(w.Context(), input.Name, input.Path)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ExportContainerService is implemented by context-first implementations of
+// the ExportContainer method, which send their replies with send.
+type ExportContainerService interface {
+	ExportContainer(ctx context.Context, name string, path string, send func(tarfile string) error) error
+}
+
+// NewExportContainerHandler returns a handler of the ExportContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewExportContainerHandler(impl ExportContainerService) varlink.Method {
+	return exportContainerAdapter{impl}
+}
+
+type exportContainerAdapter struct {
+	impl ExportContainerService
+}
+
+func (exportContainerAdapter) MethodName() string {
+	return `io.podman.ExportContainer`
+}
+
+func (a_ exportContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ExportContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ExportContainerOutput) error) error {
+		return a_.impl.ExportContainer(ctx_, input_.Name, input_.Path, func(tarfile string) error {
+			var output_ ExportContainerOutput
+			output_.Pack(tarfile)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainerStatsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainerStats method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainerStatsHandler func(ctx context.Context, name string) (container ContainerStats, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainerStats method.
+func (GetContainerStatsHandler) MethodName() string {
+	return `io.podman.GetContainerStats`
+}
+
+func (fn GetContainerStatsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainerStatsInput
+		output GetContainerStatsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainerStatsService is implemented by context-first implementations of
+// the GetContainerStats method, which send their replies with send.
+type GetContainerStatsService interface {
+	GetContainerStats(ctx context.Context, name string, send func(container ContainerStats) error) error
+}
+
+// NewGetContainerStatsHandler returns a handler of the GetContainerStats method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainerStatsHandler(impl GetContainerStatsService) varlink.Method {
+	return getContainerStatsAdapter{impl}
+}
+
+type getContainerStatsAdapter struct {
+	impl GetContainerStatsService
+}
+
+func (getContainerStatsAdapter) MethodName() string {
+	return `io.podman.GetContainerStats`
+}
+
+func (a_ getContainerStatsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainerStatsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainerStatsOutput) error) error {
+		return a_.impl.GetContainerStats(ctx_, input_.Name, func(container ContainerStats) error {
+			var output_ GetContainerStatsOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainerStatsWithHistoryHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainerStatsWithHistory method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainerStatsWithHistoryHandler func(ctx context.Context, previousStats ContainerStats) (container ContainerStats, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainerStatsWithHistory method.
+func (GetContainerStatsWithHistoryHandler) MethodName() string {
+	return `io.podman.GetContainerStatsWithHistory`
+}
+
+func (fn GetContainerStatsWithHistoryHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainerStatsWithHistoryInput
+		output GetContainerStatsWithHistoryOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.PreviousStats)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainerStatsWithHistoryService is implemented by context-first implementations of
+// the GetContainerStatsWithHistory method, which send their replies with send.
+type GetContainerStatsWithHistoryService interface {
+	GetContainerStatsWithHistory(ctx context.Context, previousStats ContainerStats, send func(container ContainerStats) error) error
+}
+
+// NewGetContainerStatsWithHistoryHandler returns a handler of the GetContainerStatsWithHistory method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainerStatsWithHistoryHandler(impl GetContainerStatsWithHistoryService) varlink.Method {
+	return getContainerStatsWithHistoryAdapter{impl}
+}
+
+type getContainerStatsWithHistoryAdapter struct {
+	impl GetContainerStatsWithHistoryService
+}
+
+func (getContainerStatsWithHistoryAdapter) MethodName() string {
+	return `io.podman.GetContainerStatsWithHistory`
+}
+
+func (a_ getContainerStatsWithHistoryAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainerStatsWithHistoryInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainerStatsWithHistoryOutput) error) error {
+		return a_.impl.GetContainerStatsWithHistory(ctx_, input_.PreviousStats, func(container ContainerStats) error {
+			var output_ GetContainerStatsWithHistoryOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// StartContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the StartContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type StartContainerHandler func(ctx context.Context, name string) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the StartContainer method.
+func (StartContainerHandler) MethodName() string {
+	return `io.podman.StartContainer`
+}
+
+func (fn StartContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  StartContainerInput
+		output StartContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// StartContainerService is implemented by context-first implementations of
+// the StartContainer method, which send their replies with send.
+type StartContainerService interface {
+	StartContainer(ctx context.Context, name string, send func(container string) error) error
+}
+
+// NewStartContainerHandler returns a handler of the StartContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewStartContainerHandler(impl StartContainerService) varlink.Method {
+	return startContainerAdapter{impl}
+}
+
+type startContainerAdapter struct {
+	impl StartContainerService
+}
+
+func (startContainerAdapter) MethodName() string {
+	return `io.podman.StartContainer`
+}
+
+func (a_ startContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ StartContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*StartContainerOutput) error) error {
+		return a_.impl.StartContainer(ctx_, input_.Name, func(container string) error {
+			var output_ StartContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// StopContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the StopContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type StopContainerHandler func(ctx context.Context, name string, timeout int) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the StopContainer method.
+func (StopContainerHandler) MethodName() string {
+	return `io.podman.StopContainer`
+}
+
+func (fn StopContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  StopContainerInput
+		output StopContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name, input.Timeout)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// StopContainerService is implemented by context-first implementations of
+// the StopContainer method, which send their replies with send.
+type StopContainerService interface {
+	StopContainer(ctx context.Context, name string, timeout int, send func(container string) error) error
+}
+
+// NewStopContainerHandler returns a handler of the StopContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewStopContainerHandler(impl StopContainerService) varlink.Method {
+	return stopContainerAdapter{impl}
+}
+
+type stopContainerAdapter struct {
+	impl StopContainerService
+}
+
+func (stopContainerAdapter) MethodName() string {
+	return `io.podman.StopContainer`
+}
+
+func (a_ stopContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ StopContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*StopContainerOutput) error) error {
+		return a_.impl.StopContainer(ctx_, input_.Name, input_.Timeout, func(container string) error {
+			var output_ StopContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// RestartContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the RestartContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type RestartContainerHandler func(ctx context.Context, name string, timeout int) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the RestartContainer method.
+func (RestartContainerHandler) MethodName() string {
+	return `io.podman.RestartContainer`
+}
+
+func (fn RestartContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  RestartContainerInput
+		output RestartContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name, input.Timeout)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// RestartContainerService is implemented by context-first implementations of
+// the RestartContainer method, which send their replies with send.
+type RestartContainerService interface {
+	RestartContainer(ctx context.Context, name string, timeout int, send func(container string) error) error
+}
+
+// NewRestartContainerHandler returns a handler of the RestartContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewRestartContainerHandler(impl RestartContainerService) varlink.Method {
+	return restartContainerAdapter{impl}
+}
+
+type restartContainerAdapter struct {
+	impl RestartContainerService
+}
+
+func (restartContainerAdapter) MethodName() string {
+	return `io.podman.RestartContainer`
+}
+
+func (a_ restartContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ RestartContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*RestartContainerOutput) error) error {
+		return a_.impl.RestartContainer(ctx_, input_.Name, input_.Timeout, func(container string) error {
+			var output_ RestartContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// KillContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the KillContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type KillContainerHandler func(ctx context.Context, name string, signal int) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the KillContainer method.
+func (KillContainerHandler) MethodName() string {
+	return `io.podman.KillContainer`
+}
+
+func (fn KillContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  KillContainerInput
+		output KillContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name, input.Signal)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// KillContainerService is implemented by context-first implementations of
+// the KillContainer method, which send their replies with send.
+type KillContainerService interface {
+	KillContainer(ctx context.Context, name string, signal int, send func(container string) error) error
+}
+
+// NewKillContainerHandler returns a handler of the KillContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewKillContainerHandler(impl KillContainerService) varlink.Method {
+	return killContainerAdapter{impl}
+}
+
+type killContainerAdapter struct {
+	impl KillContainerService
+}
+
+func (killContainerAdapter) MethodName() string {
+	return `io.podman.KillContainer`
+}
+
+func (a_ killContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ KillContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*KillContainerOutput) error) error {
+		return a_.impl.KillContainer(ctx_, input_.Name, input_.Signal, func(container string) error {
+			var output_ KillContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// PauseContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the PauseContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type PauseContainerHandler func(ctx context.Context, name string) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the PauseContainer method.
+func (PauseContainerHandler) MethodName() string {
+	return `io.podman.PauseContainer`
+}
+
+func (fn PauseContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  PauseContainerInput
+		output PauseContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// PauseContainerService is implemented by context-first implementations of
+// the PauseContainer method, which send their replies with send.
+type PauseContainerService interface {
+	PauseContainer(ctx context.Context, name string, send func(container string) error) error
+}
+
+// NewPauseContainerHandler returns a handler of the PauseContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewPauseContainerHandler(impl PauseContainerService) varlink.Method {
+	return pauseContainerAdapter{impl}
+}
+
+type pauseContainerAdapter struct {
+	impl PauseContainerService
+}
+
+func (pauseContainerAdapter) MethodName() string {
+	return `io.podman.PauseContainer`
+}
+
+func (a_ pauseContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ PauseContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*PauseContainerOutput) error) error {
+		return a_.impl.PauseContainer(ctx_, input_.Name, func(container string) error {
+			var output_ PauseContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// UnpauseContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the UnpauseContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type UnpauseContainerHandler func(ctx context.Context, name string) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the UnpauseContainer method.
+func (UnpauseContainerHandler) MethodName() string {
+	return `io.podman.UnpauseContainer`
+}
+
+func (fn UnpauseContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  UnpauseContainerInput
+		output UnpauseContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// UnpauseContainerService is implemented by context-first implementations of
+// the UnpauseContainer method, which send their replies with send.
+type UnpauseContainerService interface {
+	UnpauseContainer(ctx context.Context, name string, send func(container string) error) error
+}
+
+// NewUnpauseContainerHandler returns a handler of the UnpauseContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewUnpauseContainerHandler(impl UnpauseContainerService) varlink.Method {
+	return unpauseContainerAdapter{impl}
+}
+
+type unpauseContainerAdapter struct {
+	impl UnpauseContainerService
+}
+
+func (unpauseContainerAdapter) MethodName() string {
+	return `io.podman.UnpauseContainer`
+}
+
+func (a_ unpauseContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ UnpauseContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*UnpauseContainerOutput) error) error {
+		return a_.impl.UnpauseContainer(ctx_, input_.Name, func(container string) error {
+			var output_ UnpauseContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// WaitContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the WaitContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type WaitContainerHandler func(ctx context.Context, name string, interval int) (exitcode int, err_ Error)
+
+// MethodName returns the fully-qualified name of the WaitContainer method.
+func (WaitContainerHandler) MethodName() string {
+	return `io.podman.WaitContainer`
+}
+
+func (fn WaitContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  WaitContainerInput
+		output WaitContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Exitcode, err = fn(w.Context(), input.Name, input.Interval)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// WaitContainerService is implemented by context-first implementations of
+// the WaitContainer method, which send their replies with send.
+type WaitContainerService interface {
+	WaitContainer(ctx context.Context, name string, interval int, send func(exitcode int) error) error
+}
+
+// NewWaitContainerHandler returns a handler of the WaitContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewWaitContainerHandler(impl WaitContainerService) varlink.Method {
+	return waitContainerAdapter{impl}
+}
+
+type waitContainerAdapter struct {
+	impl WaitContainerService
+}
+
+func (waitContainerAdapter) MethodName() string {
+	return `io.podman.WaitContainer`
+}
+
+func (a_ waitContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ WaitContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*WaitContainerOutput) error) error {
+		return a_.impl.WaitContainer(ctx_, input_.Name, input_.Interval, func(exitcode int) error {
+			var output_ WaitContainerOutput
+			output_.Pack(exitcode)
+			return send_(&output_)
+		})
+	})
+}
+
+// RemoveContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the RemoveContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type RemoveContainerHandler func(ctx context.Context, name string, force bool, removeVolumes bool) (container string, err_ Error)
+
+// MethodName returns the fully-qualified name of the RemoveContainer method.
+func (RemoveContainerHandler) MethodName() string {
+	return `io.podman.RemoveContainer`
+}
+
+func (fn RemoveContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  RemoveContainerInput
+		output RemoveContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Container, err = fn(w.Context(), input.Name, input.Force, input.RemoveVolumes)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// RemoveContainerService is implemented by context-first implementations of
+// the RemoveContainer method, which send their replies with send.
+type RemoveContainerService interface {
+	RemoveContainer(ctx context.Context, name string, force bool, removeVolumes bool, send func(container string) error) error
+}
+
+// NewRemoveContainerHandler returns a handler of the RemoveContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewRemoveContainerHandler(impl RemoveContainerService) varlink.Method {
+	return removeContainerAdapter{impl}
+}
+
+type removeContainerAdapter struct {
+	impl RemoveContainerService
+}
+
+func (removeContainerAdapter) MethodName() string {
+	return `io.podman.RemoveContainer`
+}
+
+func (a_ removeContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ RemoveContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*RemoveContainerOutput) error) error {
+		return a_.impl.RemoveContainer(ctx_, input_.Name, input_.Force, input_.RemoveVolumes, func(container string) error {
+			var output_ RemoveContainerOutput
+			output_.Pack(container)
+			return send_(&output_)
+		})
+	})
+}
+
+// DeleteStoppedContainersHandler is an adapter to allow the use of ordinary
+// functions as handlers of the DeleteStoppedContainers method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type DeleteStoppedContainersHandler func(ctx context.Context) (containers []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the DeleteStoppedContainers method.
+func (DeleteStoppedContainersHandler) MethodName() string {
+	return `io.podman.DeleteStoppedContainers`
+}
+
+func (fn DeleteStoppedContainersHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  DeleteStoppedContainersInput
+		output DeleteStoppedContainersOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Containers, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// DeleteStoppedContainersService is implemented by context-first implementations of
+// the DeleteStoppedContainers method, which send their replies with send.
+type DeleteStoppedContainersService interface {
+	DeleteStoppedContainers(ctx context.Context, send func(containers []string) error) error
+}
+
+// NewDeleteStoppedContainersHandler returns a handler of the DeleteStoppedContainers method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewDeleteStoppedContainersHandler(impl DeleteStoppedContainersService) varlink.Method {
+	return deleteStoppedContainersAdapter{impl}
+}
+
+type deleteStoppedContainersAdapter struct {
+	impl DeleteStoppedContainersService
+}
+
+func (deleteStoppedContainersAdapter) MethodName() string {
+	return `io.podman.DeleteStoppedContainers`
+}
+
+func (a_ deleteStoppedContainersAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ DeleteStoppedContainersInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*DeleteStoppedContainersOutput) error) error {
+		return a_.impl.DeleteStoppedContainers(ctx_, func(containers []string) error {
+			var output_ DeleteStoppedContainersOutput
+			output_.Pack(containers)
+			return send_(&output_)
+		})
+	})
+}
+
+// ListImagesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListImages method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListImagesHandler func(ctx context.Context) (images []Image, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListImages method.
+func (ListImagesHandler) MethodName() string {
+	return `io.podman.ListImages`
+}
+
+func (fn ListImagesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListImagesInput
+		output ListImagesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Images, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListImagesService is implemented by context-first implementations of
+// the ListImages method, which send their replies with send.
+type ListImagesService interface {
+	ListImages(ctx context.Context, send func(images []Image) error) error
+}
+
+// NewListImagesHandler returns a handler of the ListImages method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListImagesHandler(impl ListImagesService) varlink.Method {
+	return listImagesAdapter{impl}
+}
+
+type listImagesAdapter struct {
+	impl ListImagesService
+}
+
+func (listImagesAdapter) MethodName() string {
+	return `io.podman.ListImages`
+}
+
+func (a_ listImagesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListImagesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListImagesOutput) error) error {
+		return a_.impl.ListImages(ctx_, func(images []Image) error {
+			var output_ ListImagesOutput
+			output_.Pack(images)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetImageHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetImage method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetImageHandler func(ctx context.Context, id string) (image Image, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetImage method.
+func (GetImageHandler) MethodName() string {
+	return `io.podman.GetImage`
+}
+
+func (fn GetImageHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetImageInput
+		output GetImageOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Image, err = fn(w.Context(), input.Id)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetImageService is implemented by context-first implementations of
+// the GetImage method, which send their replies with send.
+type GetImageService interface {
+	GetImage(ctx context.Context, id string, send func(image Image) error) error
+}
+
+// NewGetImageHandler returns a handler of the GetImage method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetImageHandler(impl GetImageService) varlink.Method {
+	return getImageAdapter{impl}
+}
+
+type getImageAdapter struct {
+	impl GetImageService
+}
+
+func (getImageAdapter) MethodName() string {
+	return `io.podman.GetImage`
+}
+
+func (a_ getImageAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetImageInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetImageOutput) error) error {
+		return a_.impl.GetImage(ctx_, input_.Id, func(image Image) error {
+			var output_ GetImageOutput
+			output_.Pack(image)
+			return send_(&output_)
+		})
+	})
+}
+
+// InspectImageHandler is an adapter to allow the use of ordinary
+// functions as handlers of the InspectImage method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type InspectImageHandler func(ctx context.Context, name string) (image string, err_ Error)
+
+// MethodName returns the fully-qualified name of the InspectImage method.
+func (InspectImageHandler) MethodName() string {
+	return `io.podman.InspectImage`
+}
+
+func (fn InspectImageHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  InspectImageInput
+		output InspectImageOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Image, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// InspectImageService is implemented by context-first implementations of
+// the InspectImage method, which send their replies with send.
+type InspectImageService interface {
+	InspectImage(ctx context.Context, name string, send func(image string) error) error
+}
+
+// NewInspectImageHandler returns a handler of the InspectImage method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewInspectImageHandler(impl InspectImageService) varlink.Method {
+	return inspectImageAdapter{impl}
+}
+
+type inspectImageAdapter struct {
+	impl InspectImageService
+}
+
+func (inspectImageAdapter) MethodName() string {
+	return `io.podman.InspectImage`
+}
+
+func (a_ inspectImageAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ InspectImageInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*InspectImageOutput) error) error {
+		return a_.impl.InspectImage(ctx_, input_.Name, func(image string) error {
+			var output_ InspectImageOutput
+			output_.Pack(image)
+			return send_(&output_)
+		})
+	})
+}
+
+// HistoryImageHandler is an adapter to allow the use of ordinary
+// functions as handlers of the HistoryImage method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type HistoryImageHandler func(ctx context.Context, name string) (history []ImageHistory, err_ Error)
+
+// MethodName returns the fully-qualified name of the HistoryImage method.
+func (HistoryImageHandler) MethodName() string {
+	return `io.podman.HistoryImage`
+}
+
+func (fn HistoryImageHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  HistoryImageInput
+		output HistoryImageOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.History, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// HistoryImageService is implemented by context-first implementations of
+// the HistoryImage method, which send their replies with send.
+type HistoryImageService interface {
+	HistoryImage(ctx context.Context, name string, send func(history []ImageHistory) error) error
+}
+
+// NewHistoryImageHandler returns a handler of the HistoryImage method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewHistoryImageHandler(impl HistoryImageService) varlink.Method {
+	return historyImageAdapter{impl}
+}
+
+type historyImageAdapter struct {
+	impl HistoryImageService
+}
+
+func (historyImageAdapter) MethodName() string {
+	return `io.podman.HistoryImage`
+}
+
+func (a_ historyImageAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ HistoryImageInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*HistoryImageOutput) error) error {
+		return a_.impl.HistoryImage(ctx_, input_.Name, func(history []ImageHistory) error {
+			var output_ HistoryImageOutput
+			output_.Pack(history)
+			return send_(&output_)
+		})
+	})
+}
+
+// TagImageHandler is an adapter to allow the use of ordinary
+// functions as handlers of the TagImage method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type TagImageHandler func(ctx context.Context, name string, tagged string) (image string, err_ Error)
+
+// MethodName returns the fully-qualified name of the TagImage method.
+func (TagImageHandler) MethodName() string {
+	return `io.podman.TagImage`
+}
+
+func (fn TagImageHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  TagImageInput
+		output TagImageOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Image, err = fn(w.Context(), input.Name, input.Tagged)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// TagImageService is implemented by context-first implementations of
+// the TagImage method, which send their replies with send.
+type TagImageService interface {
+	TagImage(ctx context.Context, name string, tagged string, send func(image string) error) error
+}
+
+// NewTagImageHandler returns a handler of the TagImage method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewTagImageHandler(impl TagImageService) varlink.Method {
+	return tagImageAdapter{impl}
+}
+
+type tagImageAdapter struct {
+	impl TagImageService
+}
+
+func (tagImageAdapter) MethodName() string {
+	return `io.podman.TagImage`
+}
+
+func (a_ tagImageAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ TagImageInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*TagImageOutput) error) error {
+		return a_.impl.TagImage(ctx_, input_.Name, input_.Tagged, func(image string) error {
+			var output_ TagImageOutput
+			output_.Pack(image)
+			return send_(&output_)
+		})
+	})
+}
+
+// RemoveImageHandler is an adapter to allow the use of ordinary
+// functions as handlers of the RemoveImage method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type RemoveImageHandler func(ctx context.Context, name string, force bool) (image string, err_ Error)
+
+// MethodName returns the fully-qualified name of the RemoveImage method.
+func (RemoveImageHandler) MethodName() string {
+	return `io.podman.RemoveImage`
+}
+
+func (fn RemoveImageHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  RemoveImageInput
+		output RemoveImageOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Image, err = fn(w.Context(), input.Name, input.Force)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// RemoveImageService is implemented by context-first implementations of
+// the RemoveImage method, which send their replies with send.
+type RemoveImageService interface {
+	RemoveImage(ctx context.Context, name string, force bool, send func(image string) error) error
+}
+
+// NewRemoveImageHandler returns a handler of the RemoveImage method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewRemoveImageHandler(impl RemoveImageService) varlink.Method {
+	return removeImageAdapter{impl}
+}
+
+type removeImageAdapter struct {
+	impl RemoveImageService
+}
+
+func (removeImageAdapter) MethodName() string {
+	return `io.podman.RemoveImage`
+}
+
+func (a_ removeImageAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ RemoveImageInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*RemoveImageOutput) error) error {
+		return a_.impl.RemoveImage(ctx_, input_.Name, input_.Force, func(image string) error {
+			var output_ RemoveImageOutput
+			output_.Pack(image)
+			return send_(&output_)
+		})
+	})
+}
+
+// SearchImagesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the SearchImages method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type SearchImagesHandler func(ctx context.Context, query string, limit *int, filter ImageSearchFilter) (results []ImageSearchResult, err_ Error)
+
+// MethodName returns the fully-qualified name of the SearchImages method.
+func (SearchImagesHandler) MethodName() string {
+	return `io.podman.SearchImages`
+}
+
+func (fn SearchImagesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  SearchImagesInput
+		output SearchImagesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Results, err = fn(w.Context(), input.Query, input.Limit, input.Filter)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// SearchImagesService is implemented by context-first implementations of
+// the SearchImages method, which send their replies with send.
+type SearchImagesService interface {
+	SearchImages(ctx context.Context, query string, limit *int, filter ImageSearchFilter, send func(results []ImageSearchResult) error) error
+}
+
+// NewSearchImagesHandler returns a handler of the SearchImages method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewSearchImagesHandler(impl SearchImagesService) varlink.Method {
+	return searchImagesAdapter{impl}
+}
+
+type searchImagesAdapter struct {
+	impl SearchImagesService
+}
+
+func (searchImagesAdapter) MethodName() string {
+	return `io.podman.SearchImages`
+}
+
+func (a_ searchImagesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ SearchImagesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*SearchImagesOutput) error) error {
+		return a_.impl.SearchImages(ctx_, input_.Query, input_.Limit, input_.Filter, func(results []ImageSearchResult) error {
+			var output_ SearchImagesOutput
+			output_.Pack(results)
+			return send_(&output_)
+		})
+	})
+}
+
+// DeleteUnusedImagesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the DeleteUnusedImages method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type DeleteUnusedImagesHandler func(ctx context.Context) (images []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the DeleteUnusedImages method.
+func (DeleteUnusedImagesHandler) MethodName() string {
+	return `io.podman.DeleteUnusedImages`
+}
+
+func (fn DeleteUnusedImagesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  DeleteUnusedImagesInput
+		output DeleteUnusedImagesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Images, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// DeleteUnusedImagesService is implemented by context-first implementations of
+// the DeleteUnusedImages method, which send their replies with send.
+type DeleteUnusedImagesService interface {
+	DeleteUnusedImages(ctx context.Context, send func(images []string) error) error
+}
+
+// NewDeleteUnusedImagesHandler returns a handler of the DeleteUnusedImages method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewDeleteUnusedImagesHandler(impl DeleteUnusedImagesService) varlink.Method {
+	return deleteUnusedImagesAdapter{impl}
+}
+
+type deleteUnusedImagesAdapter struct {
+	impl DeleteUnusedImagesService
+}
+
+func (deleteUnusedImagesAdapter) MethodName() string {
+	return `io.podman.DeleteUnusedImages`
+}
+
+func (a_ deleteUnusedImagesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ DeleteUnusedImagesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*DeleteUnusedImagesOutput) error) error {
+		return a_.impl.DeleteUnusedImages(ctx_, func(images []string) error {
+			var output_ DeleteUnusedImagesOutput
+			output_.Pack(images)
+			return send_(&output_)
+		})
+	})
+}
+
+// ImageExistsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ImageExists method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ImageExistsHandler func(ctx context.Context, name string) (exists int, err_ Error)
+
+// MethodName returns the fully-qualified name of the ImageExists method.
+func (ImageExistsHandler) MethodName() string {
+	return `io.podman.ImageExists`
+}
+
+func (fn ImageExistsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ImageExistsInput
+		output ImageExistsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Exists, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ImageExistsService is implemented by context-first implementations of
+// the ImageExists method, which send their replies with send.
+type ImageExistsService interface {
+	ImageExists(ctx context.Context, name string, send func(exists int) error) error
+}
+
+// NewImageExistsHandler returns a handler of the ImageExists method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewImageExistsHandler(impl ImageExistsService) varlink.Method {
+	return imageExistsAdapter{impl}
+}
+
+type imageExistsAdapter struct {
+	impl ImageExistsService
+}
+
+func (imageExistsAdapter) MethodName() string {
+	return `io.podman.ImageExists`
+}
+
+func (a_ imageExistsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ImageExistsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ImageExistsOutput) error) error {
+		return a_.impl.ImageExists(ctx_, input_.Name, func(exists int) error {
+			var output_ ImageExistsOutput
+			output_.Pack(exists)
+			return send_(&output_)
+		})
+	})
+}
+
+// ContainerExistsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ContainerExists method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ContainerExistsHandler func(ctx context.Context, name string) (exists int, err_ Error)
+
+// MethodName returns the fully-qualified name of the ContainerExists method.
+func (ContainerExistsHandler) MethodName() string {
+	return `io.podman.ContainerExists`
+}
+
+func (fn ContainerExistsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ContainerExistsInput
+		output ContainerExistsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Exists, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ContainerExistsService is implemented by context-first implementations of
+// the ContainerExists method, which send their replies with send.
+type ContainerExistsService interface {
+	ContainerExists(ctx context.Context, name string, send func(exists int) error) error
+}
+
+// NewContainerExistsHandler returns a handler of the ContainerExists method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewContainerExistsHandler(impl ContainerExistsService) varlink.Method {
+	return containerExistsAdapter{impl}
+}
+
+type containerExistsAdapter struct {
+	impl ContainerExistsService
+}
+
+func (containerExistsAdapter) MethodName() string {
+	return `io.podman.ContainerExists`
+}
+
+func (a_ containerExistsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ContainerExistsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ContainerExistsOutput) error) error {
+		return a_.impl.ContainerExists(ctx_, input_.Name, func(exists int) error {
+			var output_ ContainerExistsOutput
+			output_.Pack(exists)
+			return send_(&output_)
+		})
+	})
+}
+
+// ListPodsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListPods method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListPodsHandler func(ctx context.Context) (pods []ListPodData, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListPods method.
+func (ListPodsHandler) MethodName() string {
+	return `io.podman.ListPods`
+}
+
+func (fn ListPodsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListPodsInput
+		output ListPodsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Pods, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListPodsService is implemented by context-first implementations of
+// the ListPods method, which send their replies with send.
+type ListPodsService interface {
+	ListPods(ctx context.Context, send func(pods []ListPodData) error) error
+}
+
+// NewListPodsHandler returns a handler of the ListPods method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListPodsHandler(impl ListPodsService) varlink.Method {
+	return listPodsAdapter{impl}
+}
+
+type listPodsAdapter struct {
+	impl ListPodsService
+}
+
+func (listPodsAdapter) MethodName() string {
+	return `io.podman.ListPods`
+}
+
+func (a_ listPodsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListPodsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListPodsOutput) error) error {
+		return a_.impl.ListPods(ctx_, func(pods []ListPodData) error {
+			var output_ ListPodsOutput
+			output_.Pack(pods)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetPodHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetPod method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetPodHandler func(ctx context.Context, name string) (pod ListPodData, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetPod method.
+func (GetPodHandler) MethodName() string {
+	return `io.podman.GetPod`
+}
+
+func (fn GetPodHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetPodInput
+		output GetPodOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Pod, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetPodService is implemented by context-first implementations of
+// the GetPod method, which send their replies with send.
+type GetPodService interface {
+	GetPod(ctx context.Context, name string, send func(pod ListPodData) error) error
+}
+
+// NewGetPodHandler returns a handler of the GetPod method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetPodHandler(impl GetPodService) varlink.Method {
+	return getPodAdapter{impl}
+}
+
+type getPodAdapter struct {
+	impl GetPodService
+}
+
+func (getPodAdapter) MethodName() string {
+	return `io.podman.GetPod`
+}
+
+func (a_ getPodAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetPodInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetPodOutput) error) error {
+		return a_.impl.GetPod(ctx_, input_.Name, func(pod ListPodData) error {
+			var output_ GetPodOutput
+			output_.Pack(pod)
+			return send_(&output_)
+		})
+	})
+}
+
+// StartPodHandler is an adapter to allow the use of ordinary
+// functions as handlers of the StartPod method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type StartPodHandler func(ctx context.Context, name string) (pod string, err_ Error)
+
+// MethodName returns the fully-qualified name of the StartPod method.
+func (StartPodHandler) MethodName() string {
+	return `io.podman.StartPod`
+}
+
+func (fn StartPodHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  StartPodInput
+		output StartPodOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Pod, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// StartPodService is implemented by context-first implementations of
+// the StartPod method, which send their replies with send.
+type StartPodService interface {
+	StartPod(ctx context.Context, name string, send func(pod string) error) error
+}
+
+// NewStartPodHandler returns a handler of the StartPod method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewStartPodHandler(impl StartPodService) varlink.Method {
+	return startPodAdapter{impl}
+}
+
+type startPodAdapter struct {
+	impl StartPodService
+}
+
+func (startPodAdapter) MethodName() string {
+	return `io.podman.StartPod`
+}
+
+func (a_ startPodAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ StartPodInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*StartPodOutput) error) error {
+		return a_.impl.StartPod(ctx_, input_.Name, func(pod string) error {
+			var output_ StartPodOutput
+			output_.Pack(pod)
+			return send_(&output_)
+		})
+	})
+}
+
+// RemovePodHandler is an adapter to allow the use of ordinary
+// functions as handlers of the RemovePod method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type RemovePodHandler func(ctx context.Context, name string, force bool) (pod string, err_ Error)
+
+// MethodName returns the fully-qualified name of the RemovePod method.
+func (RemovePodHandler) MethodName() string {
+	return `io.podman.RemovePod`
+}
+
+func (fn RemovePodHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  RemovePodInput
+		output RemovePodOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Pod, err = fn(w.Context(), input.Name, input.Force)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// RemovePodService is implemented by context-first implementations of
+// the RemovePod method, which send their replies with send.
+type RemovePodService interface {
+	RemovePod(ctx context.Context, name string, force bool, send func(pod string) error) error
+}
+
+// NewRemovePodHandler returns a handler of the RemovePod method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewRemovePodHandler(impl RemovePodService) varlink.Method {
+	return removePodAdapter{impl}
+}
+
+type removePodAdapter struct {
+	impl RemovePodService
+}
+
+func (removePodAdapter) MethodName() string {
+	return `io.podman.RemovePod`
+}
+
+func (a_ removePodAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ RemovePodInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*RemovePodOutput) error) error {
+		return a_.impl.RemovePod(ctx_, input_.Name, input_.Force, func(pod string) error {
+			var output_ RemovePodOutput
+			output_.Pack(pod)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetEventsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetEvents method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetEventsHandler func(ctx context.Context, filter []string, since string, until string) (events Event, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetEvents method.
+func (GetEventsHandler) MethodName() string {
+	return `io.podman.GetEvents`
+}
+
+func (fn GetEventsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetEventsInput
+		output GetEventsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Events, err = fn(w.Context(), input.Filter, input.Since, input.Until)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetEventsService is implemented by context-first implementations of
+// the GetEvents method, which send their replies with send.
+type GetEventsService interface {
+	GetEvents(ctx context.Context, filter []string, since string, until string, send func(events Event) error) error
+}
+
+// NewGetEventsHandler returns a handler of the GetEvents method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetEventsHandler(impl GetEventsService) varlink.Method {
+	return getEventsAdapter{impl}
+}
+
+type getEventsAdapter struct {
+	impl GetEventsService
+}
+
+func (getEventsAdapter) MethodName() string {
+	return `io.podman.GetEvents`
+}
+
+func (a_ getEventsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetEventsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetEventsOutput) error) error {
+		return a_.impl.GetEvents(ctx_, input_.Filter, input_.Since, input_.Until, func(events Event) error {
+			var output_ GetEventsOutput
+			output_.Pack(events)
+			return send_(&output_)
+		})
+	})
+}
+
+// DiffHandler is an adapter to allow the use of ordinary
+// functions as handlers of the Diff method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type DiffHandler func(ctx context.Context, name string) (diffs []DiffInfo, err_ Error)
+
+// MethodName returns the fully-qualified name of the Diff method.
+func (DiffHandler) MethodName() string {
+	return `io.podman.Diff`
+}
+
+func (fn DiffHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  DiffInput
+		output DiffOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Diffs, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// DiffService is implemented by context-first implementations of
+// the Diff method, which send their replies with send.
+type DiffService interface {
+	Diff(ctx context.Context, name string, send func(diffs []DiffInfo) error) error
+}
+
+// NewDiffHandler returns a handler of the Diff method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewDiffHandler(impl DiffService) varlink.Method {
+	return diffAdapter{impl}
+}
+
+type diffAdapter struct {
+	impl DiffService
+}
+
+func (diffAdapter) MethodName() string {
+	return `io.podman.Diff`
+}
+
+func (a_ diffAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ DiffInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*DiffOutput) error) error {
+		return a_.impl.Diff(ctx_, input_.Name, func(diffs []DiffInfo) error {
+			var output_ DiffOutput
+			output_.Pack(diffs)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetLayersMapWithImageInfoHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetLayersMapWithImageInfo method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetLayersMapWithImageInfoHandler func(ctx context.Context) (layerMap string, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetLayersMapWithImageInfo method.
+func (GetLayersMapWithImageInfoHandler) MethodName() string {
+	return `io.podman.GetLayersMapWithImageInfo`
+}
+
+func (fn GetLayersMapWithImageInfoHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetLayersMapWithImageInfoInput
+		output GetLayersMapWithImageInfoOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.LayerMap, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetLayersMapWithImageInfoService is implemented by context-first implementations of
+// the GetLayersMapWithImageInfo method, which send their replies with send.
+type GetLayersMapWithImageInfoService interface {
+	GetLayersMapWithImageInfo(ctx context.Context, send func(layerMap string) error) error
+}
+
+// NewGetLayersMapWithImageInfoHandler returns a handler of the GetLayersMapWithImageInfo method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetLayersMapWithImageInfoHandler(impl GetLayersMapWithImageInfoService) varlink.Method {
+	return getLayersMapWithImageInfoAdapter{impl}
+}
+
+type getLayersMapWithImageInfoAdapter struct {
+	impl GetLayersMapWithImageInfoService
+}
+
+func (getLayersMapWithImageInfoAdapter) MethodName() string {
+	return `io.podman.GetLayersMapWithImageInfo`
+}
+
+func (a_ getLayersMapWithImageInfoAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetLayersMapWithImageInfoInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetLayersMapWithImageInfoOutput) error) error {
+		return a_.impl.GetLayersMapWithImageInfo(ctx_, func(layerMap string) error {
+			var output_ GetLayersMapWithImageInfoOutput
+			output_.Pack(layerMap)
+			return send_(&output_)
+		})
+	})
+}
+
+// VolumeCreateHandler is an adapter to allow the use of ordinary
+// functions as handlers of the VolumeCreate method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type VolumeCreateHandler func(ctx context.Context, options VolumeCreateOpts) (volumeName string, err_ Error)
+
+// MethodName returns the fully-qualified name of the VolumeCreate method.
+func (VolumeCreateHandler) MethodName() string {
+	return `io.podman.VolumeCreate`
+}
+
+func (fn VolumeCreateHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  VolumeCreateInput
+		output VolumeCreateOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.VolumeName, err = fn(w.Context(), input.Options)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// VolumeCreateService is implemented by context-first implementations of
+// the VolumeCreate method, which send their replies with send.
+type VolumeCreateService interface {
+	VolumeCreate(ctx context.Context, options VolumeCreateOpts, send func(volumeName string) error) error
+}
+
+// NewVolumeCreateHandler returns a handler of the VolumeCreate method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewVolumeCreateHandler(impl VolumeCreateService) varlink.Method {
+	return volumeCreateAdapter{impl}
+}
+
+type volumeCreateAdapter struct {
+	impl VolumeCreateService
+}
+
+func (volumeCreateAdapter) MethodName() string {
+	return `io.podman.VolumeCreate`
+}
+
+func (a_ volumeCreateAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ VolumeCreateInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*VolumeCreateOutput) error) error {
+		return a_.impl.VolumeCreate(ctx_, input_.Options, func(volumeName string) error {
+			var output_ VolumeCreateOutput
+			output_.Pack(volumeName)
+			return send_(&output_)
+		})
+	})
+}
+
+// VolumeRemoveHandler is an adapter to allow the use of ordinary
+// functions as handlers of the VolumeRemove method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type VolumeRemoveHandler func(ctx context.Context, options VolumeRemoveOpts) (successes []string, failures map[string]string, err_ Error)
+
+// MethodName returns the fully-qualified name of the VolumeRemove method.
+func (VolumeRemoveHandler) MethodName() string {
+	return `io.podman.VolumeRemove`
+}
+
+func (fn VolumeRemoveHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  VolumeRemoveInput
+		output VolumeRemoveOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Successes, output.Failures, err = fn(w.Context(), input.Options)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// VolumeRemoveService is implemented by context-first implementations of
+// the VolumeRemove method, which send their replies with send.
+type VolumeRemoveService interface {
+	VolumeRemove(ctx context.Context, options VolumeRemoveOpts, send func(successes []string, failures map[string]string) error) error
+}
+
+// NewVolumeRemoveHandler returns a handler of the VolumeRemove method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewVolumeRemoveHandler(impl VolumeRemoveService) varlink.Method {
+	return volumeRemoveAdapter{impl}
+}
+
+type volumeRemoveAdapter struct {
+	impl VolumeRemoveService
+}
+
+func (volumeRemoveAdapter) MethodName() string {
+	return `io.podman.VolumeRemove`
+}
+
+func (a_ volumeRemoveAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ VolumeRemoveInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*VolumeRemoveOutput) error) error {
+		return a_.impl.VolumeRemove(ctx_, input_.Options, func(successes []string, failures map[string]string) error {
+			var output_ VolumeRemoveOutput
+			output_.Pack(successes, failures)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetVolumesHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetVolumes method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetVolumesHandler func(ctx context.Context, args []string, all bool) (volumes []Volume, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetVolumes method.
+func (GetVolumesHandler) MethodName() string {
+	return `io.podman.GetVolumes`
+}
+
+func (fn GetVolumesHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetVolumesInput
+		output GetVolumesOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Volumes, err = fn(w.Context(), input.Args, input.All)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetVolumesService is implemented by context-first implementations of
+// the GetVolumes method, which send their replies with send.
+type GetVolumesService interface {
+	GetVolumes(ctx context.Context, args []string, all bool, send func(volumes []Volume) error) error
+}
+
+// NewGetVolumesHandler returns a handler of the GetVolumes method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetVolumesHandler(impl GetVolumesService) varlink.Method {
+	return getVolumesAdapter{impl}
+}
+
+type getVolumesAdapter struct {
+	impl GetVolumesService
+}
+
+func (getVolumesAdapter) MethodName() string {
+	return `io.podman.GetVolumes`
+}
+
+func (a_ getVolumesAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetVolumesInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetVolumesOutput) error) error {
+		return a_.impl.GetVolumes(ctx_, input_.Args, input_.All, func(volumes []Volume) error {
+			var output_ GetVolumesOutput
+			output_.Pack(volumes)
+			return send_(&output_)
+		})
+	})
+}
+
+// GetContainersSocketsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetContainersSockets method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetContainersSocketsHandler func(ctx context.Context, name string) (sockets Sockets, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetContainersSockets method.
+func (GetContainersSocketsHandler) MethodName() string {
+	return `io.podman.GetContainersSockets`
+}
+
+func (fn GetContainersSocketsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetContainersSocketsInput
+		output GetContainersSocketsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Sockets, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetContainersSocketsService is implemented by context-first implementations of
+// the GetContainersSockets method, which send their replies with send.
+type GetContainersSocketsService interface {
+	GetContainersSockets(ctx context.Context, name string, send func(sockets Sockets) error) error
+}
+
+// NewGetContainersSocketsHandler returns a handler of the GetContainersSockets method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetContainersSocketsHandler(impl GetContainersSocketsService) varlink.Method {
+	return getContainersSocketsAdapter{impl}
+}
+
+type getContainersSocketsAdapter struct {
+	impl GetContainersSocketsService
+}
+
+func (getContainersSocketsAdapter) MethodName() string {
+	return `io.podman.GetContainersSockets`
+}
+
+func (a_ getContainersSocketsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetContainersSocketsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetContainersSocketsOutput) error) error {
+		return a_.impl.GetContainersSockets(ctx_, input_.Name, func(sockets Sockets) error {
+			var output_ GetContainersSocketsOutput
+			output_.Pack(sockets)
+			return send_(&output_)
+		})
+	})
+}
+
+// ExecContainerHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ExecContainer method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ExecContainerHandler func(ctx context.Context, opts ExecOpts) (err_ Error)
+
+// MethodName returns the fully-qualified name of the ExecContainer method.
+func (ExecContainerHandler) MethodName() string {
+	return `io.podman.ExecContainer`
+}
+
+func (fn ExecContainerHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ExecContainerInput
+		output ExecContainerOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	err = fn(w.Context(), input.Opts)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ExecContainerService is implemented by context-first implementations of
+// the ExecContainer method, which send their replies with send.
+type ExecContainerService interface {
+	ExecContainer(ctx context.Context, opts ExecOpts, send func() error) error
+}
+
+// NewExecContainerHandler returns a handler of the ExecContainer method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewExecContainerHandler(impl ExecContainerService) varlink.Method {
+	return execContainerAdapter{impl}
+}
+
+type execContainerAdapter struct {
+	impl ExecContainerService
+}
+
+func (execContainerAdapter) MethodName() string {
+	return `io.podman.ExecContainer`
+}
+
+func (a_ execContainerAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ExecContainerInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ExecContainerOutput) error) error {
+		return a_.impl.ExecContainer(ctx_, input_.Opts, func() error {
+			var output_ ExecContainerOutput
+			return send_(&output_)
+		})
+	})
+}
+
+// ListContainerPortsHandler is an adapter to allow the use of ordinary
+// functions as handlers of the ListContainerPorts method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ListContainerPortsHandler func(ctx context.Context, name string) (notimplemented NotImplemented, err_ Error)
+
+// MethodName returns the fully-qualified name of the ListContainerPorts method.
+func (ListContainerPortsHandler) MethodName() string {
+	return `io.podman.ListContainerPorts`
+}
+
+func (fn ListContainerPortsHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ListContainerPortsInput
+		output ListContainerPortsOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Notimplemented, err = fn(w.Context(), input.Name)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ListContainerPortsService is implemented by context-first implementations of
+// the ListContainerPorts method, which send their replies with send.
+type ListContainerPortsService interface {
+	ListContainerPorts(ctx context.Context, name string, send func(notimplemented NotImplemented) error) error
+}
+
+// NewListContainerPortsHandler returns a handler of the ListContainerPorts method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewListContainerPortsHandler(impl ListContainerPortsService) varlink.Method {
+	return listContainerPortsAdapter{impl}
+}
+
+type listContainerPortsAdapter struct {
+	impl ListContainerPortsService
+}
+
+func (listContainerPortsAdapter) MethodName() string {
+	return `io.podman.ListContainerPorts`
+}
+
+func (a_ listContainerPortsAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ListContainerPortsInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ListContainerPortsOutput) error) error {
+		return a_.impl.ListContainerPorts(ctx_, input_.Name, func(notimplemented NotImplemented) error {
+			var output_ ListContainerPortsOutput
+			output_.Pack(notimplemented)
+			return send_(&output_)
+		})
+	})
+}
+
+// Definition contains the definition of the varlink interface which was parsed from its description.
+var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 1, Offset: 360}, End: syntax.Cursor{Line: 627, Column: 54, Offset: 22820}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Podman Service Interface and API description.  The master version of this document can be found\n", Value: "Podman Service Interface and API description.  The master version of this document can be found", Start: syntax.Cursor{Line: 1, Column: 1, Offset: 0}, End: syntax.Cursor{Line: 1, Column: 98, Offset: 97}}, syntax.Token{Type: "<comment>", Raw: "# in the [API.md](https://github.com/containers/libpod/blob/master/API.md) file in the upstream libpod repository.\n", Value: "in the [API.md](https://github.com/containers/libpod/blob/master/API.md) file in the upstream libpod repository.", Start: syntax.Cursor{Line: 2, Column: 1, Offset: 98}, End: syntax.Cursor{Line: 2, Column: 115, Offset: 212}}, syntax.Token{Type: "<comment>", Raw: "#\n", Value: "", Start: syntax.Cursor{Line: 3, Column: 1, Offset: 213}, End: syntax.Cursor{Line: 3, Column: 2, Offset: 214}}, syntax.Token{Type: "<comment>", Raw: "# This is a subset of the io.podman interface published by libpod v1, kept\n", Value: "This is a subset of the io.podman interface published by libpod v1, kept", Start: syntax.Cursor{Line: 4, Column: 1, Offset: 215}, End: syntax.Cursor{Line: 4, Column: 75, Offset: 289}}, syntax.Token{Type: "<comment>", Raw: "# here to exercise the code generator against a large real-world IDL.\n", Value: "here to exercise the code generator against a large real-world IDL.", Start: syntax.Cursor{Line: 5, Column: 1, Offset: 290}, End: syntax.Cursor{Line: 5, Column: 70, Offset: 359}}}, Tokens: []syntax.Token(nil)}, Name: "io.podman", Types: []syntax.TypeDef{syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 1, Offset: 381}, End: syntax.Cursor{Line: 14, Column: 2, Offset: 504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Volume", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 13, Offset: 393}, End: syntax.Cursor{Line: 14, Column: 2, Offset: 504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 3, Offset: 397}, End: syntax.Cursor{Line: 9, Column: 15, Offset: 409}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 9, Offset: 403}, End: syntax.Cursor{Line: 9, Column: 15, Offset: 409}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 3, Offset: 413}, End: syntax.Cursor{Line: 10, Column: 25, Offset: 435}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 11, Offset: 421}, End: syntax.Cursor{Line: 10, Column: 25, Offset: 435}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 19, Offset: 429}, End: syntax.Cursor{Line: 10, Column: 25, Offset: 435}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 3, Offset: 439}, End: syntax.Cursor{Line: 11, Column: 21, Offset: 457}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mountPoint", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 15, Offset: 451}, End: syntax.Cursor{Line: 11, Column: 21, Offset: 457}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 3, Offset: 461}, End: syntax.Cursor{Line: 12, Column: 17, Offset: 475}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "driver", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 11, Offset: 469}, End: syntax.Cursor{Line: 12, Column: 17, Offset: 475}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 3, Offset: 479}, End: syntax.Cursor{Line: 13, Column: 26, Offset: 502}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "options", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 12, Offset: 488}, End: syntax.Cursor{Line: 13, Column: 26, Offset: 502}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 20, Offset: 496}, End: syntax.Cursor{Line: 13, Column: 26, Offset: 502}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 1, Offset: 506}, End: syntax.Cursor{Line: 18, Column: 2, Offset: 549}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "NotImplemented", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 21, Offset: 526}, End: syntax.Cursor{Line: 18, Column: 2, Offset: 549}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 5, Offset: 532}, End: syntax.Cursor{Line: 17, Column: 20, Offset: 547}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "comment", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 14, Offset: 541}, End: syntax.Cursor{Line: 17, Column: 20, Offset: 547}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 1, Offset: 551}, End: syntax.Cursor{Line: 22, Column: 2, Offset: 594}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "StringResponse", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 21, Offset: 571}, End: syntax.Cursor{Line: 22, Column: 2, Offset: 594}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 21, Column: 5, Offset: 577}, End: syntax.Cursor{Line: 21, Column: 20, Offset: 592}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "message", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 21, Column: 14, Offset: 586}, End: syntax.Cursor{Line: 21, Column: 20, Offset: 592}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 1, Offset: 596}, End: syntax.Cursor{Line: 27, Column: 2, Offset: 668}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "RemoveImageResponse", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 26, Offset: 621}, End: syntax.Cursor{Line: 27, Column: 2, Offset: 668}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 25, Column: 5, Offset: 627}, End: syntax.Cursor{Line: 25, Column: 23, Offset: 645}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "untagged", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 25, Column: 15, Offset: 637}, End: syntax.Cursor{Line: 25, Column: 23, Offset: 645}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 25, Column: 17, Offset: 639}, End: syntax.Cursor{Line: 25, Column: 23, Offset: 645}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 5, Offset: 651}, End: syntax.Cursor{Line: 26, Column: 20, Offset: 666}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "deleted", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 14, Offset: 660}, End: syntax.Cursor{Line: 26, Column: 20, Offset: 666}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 1, Offset: 670}, End: syntax.Cursor{Line: 35, Column: 2, Offset: 784}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "LogLine", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 14, Offset: 683}, End: syntax.Cursor{Line: 35, Column: 2, Offset: 784}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 30, Column: 5, Offset: 689}, End: syntax.Cursor{Line: 30, Column: 19, Offset: 703}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "device", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 30, Column: 13, Offset: 697}, End: syntax.Cursor{Line: 30, Column: 19, Offset: 703}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 31, Column: 5, Offset: 709}, End: syntax.Cursor{Line: 31, Column: 26, Offset: 730}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "parseLogType", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 31, Column: 20, Offset: 724}, End: syntax.Cursor{Line: 31, Column: 26, Offset: 730}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 5, Offset: 736}, End: syntax.Cursor{Line: 32, Column: 17, Offset: 748}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "time", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 11, Offset: 742}, End: syntax.Cursor{Line: 32, Column: 17, Offset: 748}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 33, Column: 5, Offset: 754}, End: syntax.Cursor{Line: 33, Column: 16, Offset: 765}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "msg", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 33, Column: 10, Offset: 759}, End: syntax.Cursor{Line: 33, Column: 16, Offset: 765}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 34, Column: 5, Offset: 771}, End: syntax.Cursor{Line: 34, Column: 16, Offset: 782}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cid", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 34, Column: 10, Offset: 776}, End: syntax.Cursor{Line: 34, Column: 16, Offset: 782}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 38, Column: 1, Offset: 858}, End: syntax.Cursor{Line: 42, Column: 2, Offset: 949}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerChanges describes the return struct for ListContainerChanges\n", Value: "ContainerChanges describes the return struct for ListContainerChanges", Start: syntax.Cursor{Line: 37, Column: 1, Offset: 786}, End: syntax.Cursor{Line: 37, Column: 72, Offset: 857}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerChanges", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 38, Column: 23, Offset: 880}, End: syntax.Cursor{Line: 42, Column: 2, Offset: 949}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 39, Column: 5, Offset: 886}, End: syntax.Cursor{Line: 39, Column: 22, Offset: 903}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "changed", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 39, Column: 14, Offset: 895}, End: syntax.Cursor{Line: 39, Column: 22, Offset: 903}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 39, Column: 16, Offset: 897}, End: syntax.Cursor{Line: 39, Column: 22, Offset: 903}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 40, Column: 5, Offset: 909}, End: syntax.Cursor{Line: 40, Column: 20, Offset: 924}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "added", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 40, Column: 12, Offset: 916}, End: syntax.Cursor{Line: 40, Column: 20, Offset: 924}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 40, Column: 14, Offset: 918}, End: syntax.Cursor{Line: 40, Column: 20, Offset: 924}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 41, Column: 5, Offset: 930}, End: syntax.Cursor{Line: 41, Column: 22, Offset: 947}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "deleted", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 41, Column: 14, Offset: 939}, End: syntax.Cursor{Line: 41, Column: 22, Offset: 947}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 41, Column: 16, Offset: 941}, End: syntax.Cursor{Line: 41, Column: 22, Offset: 947}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 44, Column: 1, Offset: 951}, End: syntax.Cursor{Line: 52, Column: 2, Offset: 1118}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ImageSaveOptions", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 44, Column: 23, Offset: 973}, End: syntax.Cursor{Line: 52, Column: 2, Offset: 1118}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 45, Column: 5, Offset: 979}, End: syntax.Cursor{Line: 45, Column: 17, Offset: 991}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 45, Column: 11, Offset: 985}, End: syntax.Cursor{Line: 45, Column: 17, Offset: 991}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 46, Column: 5, Offset: 997}, End: syntax.Cursor{Line: 46, Column: 19, Offset: 1011}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "format", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 46, Column: 13, Offset: 1005}, End: syntax.Cursor{Line: 46, Column: 19, Offset: 1011}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 47, Column: 5, Offset: 1017}, End: syntax.Cursor{Line: 47, Column: 19, Offset: 1031}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "output", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 47, Column: 13, Offset: 1025}, End: syntax.Cursor{Line: 47, Column: 19, Offset: 1031}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 48, Column: 5, Offset: 1037}, End: syntax.Cursor{Line: 48, Column: 23, Offset: 1055}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "outputType", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 48, Column: 17, Offset: 1049}, End: syntax.Cursor{Line: 48, Column: 23, Offset: 1055}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 49, Column: 5, Offset: 1061}, End: syntax.Cursor{Line: 49, Column: 23, Offset: 1079}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "moreTags", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 49, Column: 15, Offset: 1071}, End: syntax.Cursor{Line: 49, Column: 23, Offset: 1079}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 49, Column: 17, Offset: 1073}, End: syntax.Cursor{Line: 49, Column: 23, Offset: 1079}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 50, Column: 5, Offset: 1085}, End: syntax.Cursor{Line: 50, Column: 16, Offset: 1096}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "quiet", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 50, Column: 12, Offset: 1092}, End: syntax.Cursor{Line: 50, Column: 16, Offset: 1096}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 51, Column: 5, Offset: 1102}, End: syntax.Cursor{Line: 51, Column: 19, Offset: 1116}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "compress", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 51, Column: 15, Offset: 1112}, End: syntax.Cursor{Line: 51, Column: 19, Offset: 1116}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 54, Column: 1, Offset: 1120}, End: syntax.Cursor{Line: 59, Column: 2, Offset: 1245}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "VolumeCreateOpts", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 54, Column: 23, Offset: 1142}, End: syntax.Cursor{Line: 59, Column: 2, Offset: 1245}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 55, Column: 5, Offset: 1148}, End: syntax.Cursor{Line: 55, Column: 23, Offset: 1166}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "volumeName", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 55, Column: 17, Offset: 1160}, End: syntax.Cursor{Line: 55, Column: 23, Offset: 1166}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 56, Column: 5, Offset: 1172}, End: syntax.Cursor{Line: 56, Column: 19, Offset: 1186}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "driver", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 56, Column: 13, Offset: 1180}, End: syntax.Cursor{Line: 56, Column: 19, Offset: 1186}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 57, Column: 5, Offset: 1192}, End: syntax.Cursor{Line: 57, Column: 27, Offset: 1214}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 57, Column: 13, Offset: 1200}, End: syntax.Cursor{Line: 57, Column: 27, Offset: 1214}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 57, Column: 21, Offset: 1208}, End: syntax.Cursor{Line: 57, Column: 27, Offset: 1214}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 58, Column: 5, Offset: 1220}, End: syntax.Cursor{Line: 58, Column: 28, Offset: 1243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "options", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 58, Column: 14, Offset: 1229}, End: syntax.Cursor{Line: 58, Column: 28, Offset: 1243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 58, Column: 22, Offset: 1237}, End: syntax.Cursor{Line: 58, Column: 28, Offset: 1243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 61, Column: 1, Offset: 1247}, End: syntax.Cursor{Line: 65, Column: 2, Offset: 1326}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "VolumeRemoveOpts", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 61, Column: 23, Offset: 1269}, End: syntax.Cursor{Line: 65, Column: 2, Offset: 1326}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 62, Column: 5, Offset: 1275}, End: syntax.Cursor{Line: 62, Column: 22, Offset: 1292}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "volumes", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 62, Column: 14, Offset: 1284}, End: syntax.Cursor{Line: 62, Column: 22, Offset: 1292}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 62, Column: 16, Offset: 1286}, End: syntax.Cursor{Line: 62, Column: 22, Offset: 1292}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 63, Column: 5, Offset: 1298}, End: syntax.Cursor{Line: 63, Column: 14, Offset: 1307}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "all", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 63, Column: 10, Offset: 1303}, End: syntax.Cursor{Line: 63, Column: 14, Offset: 1307}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 64, Column: 5, Offset: 1313}, End: syntax.Cursor{Line: 64, Column: 16, Offset: 1324}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "force", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 64, Column: 12, Offset: 1320}, End: syntax.Cursor{Line: 64, Column: 16, Offset: 1324}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 67, Column: 1, Offset: 1328}, End: syntax.Cursor{Line: 82, Column: 2, Offset: 1642}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Image", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 67, Column: 12, Offset: 1339}, End: syntax.Cursor{Line: 82, Column: 2, Offset: 1642}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 68, Column: 5, Offset: 1345}, End: syntax.Cursor{Line: 68, Column: 15, Offset: 1355}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 68, Column: 9, Offset: 1349}, End: syntax.Cursor{Line: 68, Column: 15, Offset: 1355}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 69, Column: 5, Offset: 1361}, End: syntax.Cursor{Line: 69, Column: 19, Offset: 1375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "digest", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 69, Column: 13, Offset: 1369}, End: syntax.Cursor{Line: 69, Column: 19, Offset: 1375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 70, Column: 5, Offset: 1381}, End: syntax.Cursor{Line: 70, Column: 22, Offset: 1398}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "digests", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 70, Column: 14, Offset: 1390}, End: syntax.Cursor{Line: 70, Column: 22, Offset: 1398}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 70, Column: 16, Offset: 1392}, End: syntax.Cursor{Line: 70, Column: 22, Offset: 1398}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 71, Column: 5, Offset: 1404}, End: syntax.Cursor{Line: 71, Column: 21, Offset: 1420}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "parentId", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 71, Column: 15, Offset: 1414}, End: syntax.Cursor{Line: 71, Column: 21, Offset: 1420}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 72, Column: 5, Offset: 1426}, End: syntax.Cursor{Line: 72, Column: 23, Offset: 1444}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "repoTags", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 72, Column: 15, Offset: 1436}, End: syntax.Cursor{Line: 72, Column: 23, Offset: 1444}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 72, Column: 17, Offset: 1438}, End: syntax.Cursor{Line: 72, Column: 23, Offset: 1444}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 73, Column: 5, Offset: 1450}, End: syntax.Cursor{Line: 73, Column: 26, Offset: 1471}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "repoDigests", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 73, Column: 18, Offset: 1463}, End: syntax.Cursor{Line: 73, Column: 26, Offset: 1471}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 73, Column: 20, Offset: 1465}, End: syntax.Cursor{Line: 73, Column: 26, Offset: 1471}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 74, Column: 5, Offset: 1477}, End: syntax.Cursor{Line: 74, Column: 20, Offset: 1492}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "created", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 74, Column: 14, Offset: 1486}, End: syntax.Cursor{Line: 74, Column: 20, Offset: 1492}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 75, Column: 5, Offset: 1498}, End: syntax.Cursor{Line: 75, Column: 14, Offset: 1507}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "size", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 75, Column: 11, Offset: 1504}, End: syntax.Cursor{Line: 75, Column: 14, Offset: 1507}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 76, Column: 5, Offset: 1513}, End: syntax.Cursor{Line: 76, Column: 21, Offset: 1529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "virtualSize", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 76, Column: 18, Offset: 1526}, End: syntax.Cursor{Line: 76, Column: 21, Offset: 1529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 77, Column: 5, Offset: 1535}, End: syntax.Cursor{Line: 77, Column: 20, Offset: 1550}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 77, Column: 17, Offset: 1547}, End: syntax.Cursor{Line: 77, Column: 20, Offset: 1550}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 78, Column: 5, Offset: 1556}, End: syntax.Cursor{Line: 78, Column: 27, Offset: 1578}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 78, Column: 13, Offset: 1564}, End: syntax.Cursor{Line: 78, Column: 27, Offset: 1578}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 78, Column: 21, Offset: 1572}, End: syntax.Cursor{Line: 78, Column: 27, Offset: 1578}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 79, Column: 5, Offset: 1584}, End: syntax.Cursor{Line: 79, Column: 19, Offset: 1598}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "isParent", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 79, Column: 15, Offset: 1594}, End: syntax.Cursor{Line: 79, Column: 19, Offset: 1598}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 80, Column: 5, Offset: 1604}, End: syntax.Cursor{Line: 80, Column: 21, Offset: 1620}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "topLayer", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 80, Column: 15, Offset: 1614}, End: syntax.Cursor{Line: 80, Column: 21, Offset: 1620}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 81, Column: 5, Offset: 1626}, End: syntax.Cursor{Line: 81, Column: 19, Offset: 1640}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "readOnly", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 81, Column: 15, Offset: 1636}, End: syntax.Cursor{Line: 81, Column: 19, Offset: 1640}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 85, Column: 1, Offset: 1711}, End: syntax.Cursor{Line: 92, Column: 2, Offset: 1847}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ImageHistory describes the returned structure from ImageHistory.\n", Value: "ImageHistory describes the returned structure from ImageHistory.", Start: syntax.Cursor{Line: 84, Column: 1, Offset: 1644}, End: syntax.Cursor{Line: 84, Column: 67, Offset: 1710}}}, Tokens: []syntax.Token(nil)}, Name: "ImageHistory", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 85, Column: 19, Offset: 1729}, End: syntax.Cursor{Line: 92, Column: 2, Offset: 1847}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 86, Column: 5, Offset: 1735}, End: syntax.Cursor{Line: 86, Column: 15, Offset: 1745}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 86, Column: 9, Offset: 1739}, End: syntax.Cursor{Line: 86, Column: 15, Offset: 1745}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 87, Column: 5, Offset: 1751}, End: syntax.Cursor{Line: 87, Column: 20, Offset: 1766}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "created", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 87, Column: 14, Offset: 1760}, End: syntax.Cursor{Line: 87, Column: 20, Offset: 1766}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 88, Column: 5, Offset: 1772}, End: syntax.Cursor{Line: 88, Column: 22, Offset: 1789}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "createdBy", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 88, Column: 16, Offset: 1783}, End: syntax.Cursor{Line: 88, Column: 22, Offset: 1789}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 89, Column: 5, Offset: 1795}, End: syntax.Cursor{Line: 89, Column: 19, Offset: 1809}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "tags", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 89, Column: 11, Offset: 1801}, End: syntax.Cursor{Line: 89, Column: 19, Offset: 1809}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 89, Column: 13, Offset: 1803}, End: syntax.Cursor{Line: 89, Column: 19, Offset: 1809}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 90, Column: 5, Offset: 1815}, End: syntax.Cursor{Line: 90, Column: 14, Offset: 1824}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "size", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 90, Column: 11, Offset: 1821}, End: syntax.Cursor{Line: 90, Column: 14, Offset: 1824}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 91, Column: 5, Offset: 1830}, End: syntax.Cursor{Line: 91, Column: 20, Offset: 1845}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "comment", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 91, Column: 14, Offset: 1839}, End: syntax.Cursor{Line: 91, Column: 20, Offset: 1845}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 95, Column: 1, Offset: 1903}, End: syntax.Cursor{Line: 102, Column: 2, Offset: 2061}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Represents a single search result from SearchImages\n", Value: "Represents a single search result from SearchImages", Start: syntax.Cursor{Line: 94, Column: 1, Offset: 1849}, End: syntax.Cursor{Line: 94, Column: 54, Offset: 1902}}}, Tokens: []syntax.Token(nil)}, Name: "ImageSearchResult", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 95, Column: 24, Offset: 1926}, End: syntax.Cursor{Line: 102, Column: 2, Offset: 2061}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 96, Column: 5, Offset: 1932}, End: syntax.Cursor{Line: 96, Column: 24, Offset: 1951}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "description", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 96, Column: 18, Offset: 1945}, End: syntax.Cursor{Line: 96, Column: 24, Offset: 1951}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 97, Column: 5, Offset: 1957}, End: syntax.Cursor{Line: 97, Column: 22, Offset: 1974}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "is_official", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 97, Column: 18, Offset: 1970}, End: syntax.Cursor{Line: 97, Column: 22, Offset: 1974}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 98, Column: 5, Offset: 1980}, End: syntax.Cursor{Line: 98, Column: 23, Offset: 1998}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "is_automated", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 98, Column: 19, Offset: 1994}, End: syntax.Cursor{Line: 98, Column: 23, Offset: 1998}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 99, Column: 5, Offset: 2004}, End: syntax.Cursor{Line: 99, Column: 21, Offset: 2020}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "registry", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 99, Column: 15, Offset: 2014}, End: syntax.Cursor{Line: 99, Column: 21, Offset: 2020}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 100, Column: 5, Offset: 2026}, End: syntax.Cursor{Line: 100, Column: 17, Offset: 2038}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 100, Column: 11, Offset: 2032}, End: syntax.Cursor{Line: 100, Column: 17, Offset: 2038}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 101, Column: 5, Offset: 2044}, End: syntax.Cursor{Line: 101, Column: 20, Offset: 2059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "star_count", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 101, Column: 17, Offset: 2056}, End: syntax.Cursor{Line: 101, Column: 20, Offset: 2059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 104, Column: 1, Offset: 2063}, End: syntax.Cursor{Line: 108, Column: 2, Offset: 2158}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ImageSearchFilter", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 104, Column: 24, Offset: 2086}, End: syntax.Cursor{Line: 108, Column: 2, Offset: 2158}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 105, Column: 5, Offset: 2092}, End: syntax.Cursor{Line: 105, Column: 23, Offset: 2110}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "is_official", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 105, Column: 18, Offset: 2105}, End: syntax.Cursor{Line: 105, Column: 23, Offset: 2110}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 105, Column: 19, Offset: 2106}, End: syntax.Cursor{Line: 105, Column: 23, Offset: 2110}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 106, Column: 5, Offset: 2116}, End: syntax.Cursor{Line: 106, Column: 24, Offset: 2135}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "is_automated", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 106, Column: 19, Offset: 2130}, End: syntax.Cursor{Line: 106, Column: 24, Offset: 2135}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 106, Column: 20, Offset: 2131}, End: syntax.Cursor{Line: 106, Column: 24, Offset: 2135}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 107, Column: 5, Offset: 2141}, End: syntax.Cursor{Line: 107, Column: 20, Offset: 2156}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "star_count", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 107, Column: 17, Offset: 2153}, End: syntax.Cursor{Line: 107, Column: 20, Offset: 2156}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 110, Column: 1, Offset: 2160}, End: syntax.Cursor{Line: 113, Column: 2, Offset: 2220}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "KubePodService", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 110, Column: 21, Offset: 2180}, End: syntax.Cursor{Line: 113, Column: 2, Offset: 2220}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 111, Column: 5, Offset: 2186}, End: syntax.Cursor{Line: 111, Column: 16, Offset: 2197}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 111, Column: 10, Offset: 2191}, End: syntax.Cursor{Line: 111, Column: 16, Offset: 2197}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 112, Column: 5, Offset: 2203}, End: syntax.Cursor{Line: 112, Column: 20, Offset: 2218}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "service", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 112, Column: 14, Offset: 2212}, End: syntax.Cursor{Line: 112, Column: 20, Offset: 2218}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 115, Column: 1, Offset: 2222}, End: syntax.Cursor{Line: 131, Column: 2, Offset: 2600}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Container", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 115, Column: 16, Offset: 2237}, End: syntax.Cursor{Line: 131, Column: 2, Offset: 2600}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 116, Column: 5, Offset: 2243}, End: syntax.Cursor{Line: 116, Column: 15, Offset: 2253}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 116, Column: 9, Offset: 2247}, End: syntax.Cursor{Line: 116, Column: 15, Offset: 2253}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 117, Column: 5, Offset: 2259}, End: syntax.Cursor{Line: 117, Column: 18, Offset: 2272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 117, Column: 12, Offset: 2266}, End: syntax.Cursor{Line: 117, Column: 18, Offset: 2272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 118, Column: 5, Offset: 2278}, End: syntax.Cursor{Line: 118, Column: 20, Offset: 2293}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "imageid", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 118, Column: 14, Offset: 2287}, End: syntax.Cursor{Line: 118, Column: 20, Offset: 2293}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 119, Column: 5, Offset: 2299}, End: syntax.Cursor{Line: 119, Column: 22, Offset: 2316}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "command", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 119, Column: 14, Offset: 2308}, End: syntax.Cursor{Line: 119, Column: 22, Offset: 2316}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 119, Column: 16, Offset: 2310}, End: syntax.Cursor{Line: 119, Column: 22, Offset: 2316}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 120, Column: 5, Offset: 2322}, End: syntax.Cursor{Line: 120, Column: 22, Offset: 2339}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "createdat", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 120, Column: 16, Offset: 2333}, End: syntax.Cursor{Line: 120, Column: 22, Offset: 2339}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 121, Column: 5, Offset: 2345}, End: syntax.Cursor{Line: 121, Column: 23, Offset: 2363}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "runningfor", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 121, Column: 17, Offset: 2357}, End: syntax.Cursor{Line: 121, Column: 23, Offset: 2363}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 122, Column: 5, Offset: 2369}, End: syntax.Cursor{Line: 122, Column: 19, Offset: 2383}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 122, Column: 13, Offset: 2377}, End: syntax.Cursor{Line: 122, Column: 19, Offset: 2383}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 123, Column: 5, Offset: 2389}, End: syntax.Cursor{Line: 123, Column: 35, Offset: 2419}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ports", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 123, Column: 12, Offset: 2396}, End: syntax.Cursor{Line: 123, Column: 35, Offset: 2419}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 123, Column: 14, Offset: 2398}, End: syntax.Cursor{Line: 123, Column: 35, Offset: 2419}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerPortMappings"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 124, Column: 5, Offset: 2425}, End: syntax.Cursor{Line: 124, Column: 20, Offset: 2440}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "rootfssize", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 124, Column: 17, Offset: 2437}, End: syntax.Cursor{Line: 124, Column: 20, Offset: 2440}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 125, Column: 5, Offset: 2446}, End: syntax.Cursor{Line: 125, Column: 16, Offset: 2457}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "rwsize", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 125, Column: 13, Offset: 2454}, End: syntax.Cursor{Line: 125, Column: 16, Offset: 2457}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 126, Column: 5, Offset: 2463}, End: syntax.Cursor{Line: 126, Column: 18, Offset: 2476}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "names", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 126, Column: 12, Offset: 2470}, End: syntax.Cursor{Line: 126, Column: 18, Offset: 2476}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 127, Column: 5, Offset: 2482}, End: syntax.Cursor{Line: 127, Column: 27, Offset: 2504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 127, Column: 13, Offset: 2490}, End: syntax.Cursor{Line: 127, Column: 27, Offset: 2504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 127, Column: 21, Offset: 2498}, End: syntax.Cursor{Line: 127, Column: 27, Offset: 2504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 128, Column: 5, Offset: 2510}, End: syntax.Cursor{Line: 128, Column: 29, Offset: 2534}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mounts", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 128, Column: 13, Offset: 2518}, End: syntax.Cursor{Line: 128, Column: 29, Offset: 2534}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 128, Column: 15, Offset: 2520}, End: syntax.Cursor{Line: 128, Column: 29, Offset: 2534}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerMount"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 129, Column: 5, Offset: 2540}, End: syntax.Cursor{Line: 129, Column: 27, Offset: 2562}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containerrunning", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 129, Column: 23, Offset: 2558}, End: syntax.Cursor{Line: 129, Column: 27, Offset: 2562}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 130, Column: 5, Offset: 2568}, End: syntax.Cursor{Line: 130, Column: 35, Offset: 2598}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "namespaces", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 130, Column: 17, Offset: 2580}, End: syntax.Cursor{Line: 130, Column: 35, Offset: 2598}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerNameSpace"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 134, Column: 1, Offset: 2669}, End: syntax.Cursor{Line: 148, Column: 2, Offset: 2944}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerStats is the return struct for the stats of a container\n", Value: "ContainerStats is the return struct for the stats of a container", Start: syntax.Cursor{Line: 133, Column: 1, Offset: 2602}, End: syntax.Cursor{Line: 133, Column: 67, Offset: 2668}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerStats", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 134, Column: 21, Offset: 2689}, End: syntax.Cursor{Line: 148, Column: 2, Offset: 2944}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 135, Column: 5, Offset: 2695}, End: syntax.Cursor{Line: 135, Column: 15, Offset: 2705}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 135, Column: 9, Offset: 2699}, End: syntax.Cursor{Line: 135, Column: 15, Offset: 2705}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 136, Column: 5, Offset: 2711}, End: syntax.Cursor{Line: 136, Column: 17, Offset: 2723}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 136, Column: 11, Offset: 2717}, End: syntax.Cursor{Line: 136, Column: 17, Offset: 2723}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 137, Column: 5, Offset: 2729}, End: syntax.Cursor{Line: 137, Column: 15, Offset: 2739}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cpu", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 137, Column: 10, Offset: 2734}, End: syntax.Cursor{Line: 137, Column: 15, Offset: 2739}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "float64"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 138, Column: 5, Offset: 2745}, End: syntax.Cursor{Line: 138, Column: 18, Offset: 2758}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cpu_nano", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 138, Column: 15, Offset: 2755}, End: syntax.Cursor{Line: 138, Column: 18, Offset: 2758}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 139, Column: 5, Offset: 2764}, End: syntax.Cursor{Line: 139, Column: 21, Offset: 2780}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "system_nano", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 139, Column: 18, Offset: 2777}, End: syntax.Cursor{Line: 139, Column: 21, Offset: 2780}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 140, Column: 5, Offset: 2786}, End: syntax.Cursor{Line: 140, Column: 19, Offset: 2800}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mem_usage", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 140, Column: 16, Offset: 2797}, End: syntax.Cursor{Line: 140, Column: 19, Offset: 2800}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 141, Column: 5, Offset: 2806}, End: syntax.Cursor{Line: 141, Column: 19, Offset: 2820}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mem_limit", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 141, Column: 16, Offset: 2817}, End: syntax.Cursor{Line: 141, Column: 19, Offset: 2820}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 142, Column: 5, Offset: 2826}, End: syntax.Cursor{Line: 142, Column: 20, Offset: 2841}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mem_perc", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 142, Column: 15, Offset: 2836}, End: syntax.Cursor{Line: 142, Column: 20, Offset: 2841}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "float64"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 143, Column: 5, Offset: 2847}, End: syntax.Cursor{Line: 143, Column: 19, Offset: 2861}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "net_input", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 143, Column: 16, Offset: 2858}, End: syntax.Cursor{Line: 143, Column: 19, Offset: 2861}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 144, Column: 5, Offset: 2867}, End: syntax.Cursor{Line: 144, Column: 20, Offset: 2882}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "net_output", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 144, Column: 17, Offset: 2879}, End: syntax.Cursor{Line: 144, Column: 20, Offset: 2882}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 145, Column: 5, Offset: 2888}, End: syntax.Cursor{Line: 145, Column: 22, Offset: 2905}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "block_output", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 145, Column: 19, Offset: 2902}, End: syntax.Cursor{Line: 145, Column: 22, Offset: 2905}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 146, Column: 5, Offset: 2911}, End: syntax.Cursor{Line: 146, Column: 21, Offset: 2927}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "block_input", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 146, Column: 18, Offset: 2924}, End: syntax.Cursor{Line: 146, Column: 21, Offset: 2927}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 147, Column: 5, Offset: 2933}, End: syntax.Cursor{Line: 147, Column: 14, Offset: 2942}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pids", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 147, Column: 11, Offset: 2939}, End: syntax.Cursor{Line: 147, Column: 14, Offset: 2942}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 150, Column: 1, Offset: 2946}, End: syntax.Cursor{Line: 161, Column: 2, Offset: 3141}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PsOpts", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 150, Column: 13, Offset: 2958}, End: syntax.Cursor{Line: 161, Column: 2, Offset: 3141}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 151, Column: 5, Offset: 2964}, End: syntax.Cursor{Line: 151, Column: 14, Offset: 2973}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "all", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 151, Column: 10, Offset: 2969}, End: syntax.Cursor{Line: 151, Column: 14, Offset: 2973}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 152, Column: 5, Offset: 2979}, End: syntax.Cursor{Line: 152, Column: 23, Offset: 2997}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "filters", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 152, Column: 14, Offset: 2988}, End: syntax.Cursor{Line: 152, Column: 23, Offset: 2997}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 152, Column: 15, Offset: 2989}, End: syntax.Cursor{Line: 152, Column: 23, Offset: 2997}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 152, Column: 17, Offset: 2991}, End: syntax.Cursor{Line: 152, Column: 23, Offset: 2997}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 153, Column: 5, Offset: 3003}, End: syntax.Cursor{Line: 153, Column: 15, Offset: 3013}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "last", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 153, Column: 11, Offset: 3009}, End: syntax.Cursor{Line: 153, Column: 15, Offset: 3013}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 153, Column: 12, Offset: 3010}, End: syntax.Cursor{Line: 153, Column: 15, Offset: 3013}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 154, Column: 5, Offset: 3019}, End: syntax.Cursor{Line: 154, Column: 18, Offset: 3032}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "latest", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 154, Column: 13, Offset: 3027}, End: syntax.Cursor{Line: 154, Column: 18, Offset: 3032}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 154, Column: 14, Offset: 3028}, End: syntax.Cursor{Line: 154, Column: 18, Offset: 3032}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 155, Column: 5, Offset: 3038}, End: syntax.Cursor{Line: 155, Column: 19, Offset: 3052}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "noTrunc", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 155, Column: 14, Offset: 3047}, End: syntax.Cursor{Line: 155, Column: 19, Offset: 3052}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 155, Column: 15, Offset: 3048}, End: syntax.Cursor{Line: 155, Column: 19, Offset: 3052}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 156, Column: 5, Offset: 3058}, End: syntax.Cursor{Line: 156, Column: 15, Offset: 3068}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 156, Column: 10, Offset: 3063}, End: syntax.Cursor{Line: 156, Column: 15, Offset: 3068}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 156, Column: 11, Offset: 3064}, End: syntax.Cursor{Line: 156, Column: 15, Offset: 3068}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 157, Column: 5, Offset: 3074}, End: syntax.Cursor{Line: 157, Column: 17, Offset: 3086}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "quiet", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 157, Column: 12, Offset: 3081}, End: syntax.Cursor{Line: 157, Column: 17, Offset: 3086}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 157, Column: 13, Offset: 3082}, End: syntax.Cursor{Line: 157, Column: 17, Offset: 3086}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 158, Column: 5, Offset: 3092}, End: syntax.Cursor{Line: 158, Column: 16, Offset: 3103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "size", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 158, Column: 11, Offset: 3098}, End: syntax.Cursor{Line: 158, Column: 16, Offset: 3103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 158, Column: 12, Offset: 3099}, End: syntax.Cursor{Line: 158, Column: 16, Offset: 3103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 159, Column: 5, Offset: 3109}, End: syntax.Cursor{Line: 159, Column: 18, Offset: 3122}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "sort", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 159, Column: 11, Offset: 3115}, End: syntax.Cursor{Line: 159, Column: 18, Offset: 3122}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 159, Column: 12, Offset: 3116}, End: syntax.Cursor{Line: 159, Column: 18, Offset: 3122}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 160, Column: 5, Offset: 3128}, End: syntax.Cursor{Line: 160, Column: 16, Offset: 3139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "sync", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 160, Column: 11, Offset: 3134}, End: syntax.Cursor{Line: 160, Column: 16, Offset: 3139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 160, Column: 12, Offset: 3135}, End: syntax.Cursor{Line: 160, Column: 16, Offset: 3139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 163, Column: 1, Offset: 3143}, End: syntax.Cursor{Line: 190, Column: 2, Offset: 3667}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PsContainer", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 163, Column: 18, Offset: 3160}, End: syntax.Cursor{Line: 190, Column: 2, Offset: 3667}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 164, Column: 5, Offset: 3166}, End: syntax.Cursor{Line: 164, Column: 15, Offset: 3176}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 164, Column: 9, Offset: 3170}, End: syntax.Cursor{Line: 164, Column: 15, Offset: 3176}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 165, Column: 5, Offset: 3182}, End: syntax.Cursor{Line: 165, Column: 18, Offset: 3195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 165, Column: 12, Offset: 3189}, End: syntax.Cursor{Line: 165, Column: 18, Offset: 3195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 166, Column: 5, Offset: 3201}, End: syntax.Cursor{Line: 166, Column: 20, Offset: 3216}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "command", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 166, Column: 14, Offset: 3210}, End: syntax.Cursor{Line: 166, Column: 20, Offset: 3216}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 167, Column: 5, Offset: 3222}, End: syntax.Cursor{Line: 167, Column: 20, Offset: 3237}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "created", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 167, Column: 14, Offset: 3231}, End: syntax.Cursor{Line: 167, Column: 20, Offset: 3237}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 168, Column: 5, Offset: 3243}, End: syntax.Cursor{Line: 168, Column: 18, Offset: 3256}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ports", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 168, Column: 12, Offset: 3250}, End: syntax.Cursor{Line: 168, Column: 18, Offset: 3256}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 169, Column: 5, Offset: 3262}, End: syntax.Cursor{Line: 169, Column: 18, Offset: 3275}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "names", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 169, Column: 12, Offset: 3269}, End: syntax.Cursor{Line: 169, Column: 18, Offset: 3275}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 170, Column: 5, Offset: 3281}, End: syntax.Cursor{Line: 170, Column: 18, Offset: 3294}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "isInfra", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 170, Column: 14, Offset: 3290}, End: syntax.Cursor{Line: 170, Column: 18, Offset: 3294}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 171, Column: 5, Offset: 3300}, End: syntax.Cursor{Line: 171, Column: 19, Offset: 3314}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 171, Column: 13, Offset: 3308}, End: syntax.Cursor{Line: 171, Column: 19, Offset: 3314}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 172, Column: 5, Offset: 3320}, End: syntax.Cursor{Line: 172, Column: 18, Offset: 3333}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "state", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 172, Column: 12, Offset: 3327}, End: syntax.Cursor{Line: 172, Column: 18, Offset: 3333}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 173, Column: 5, Offset: 3339}, End: syntax.Cursor{Line: 173, Column: 16, Offset: 3350}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pidNum", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 173, Column: 13, Offset: 3347}, End: syntax.Cursor{Line: 173, Column: 16, Offset: 3350}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 174, Column: 5, Offset: 3356}, End: syntax.Cursor{Line: 174, Column: 20, Offset: 3371}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "rootFsSize", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 174, Column: 17, Offset: 3368}, End: syntax.Cursor{Line: 174, Column: 20, Offset: 3371}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 175, Column: 5, Offset: 3377}, End: syntax.Cursor{Line: 175, Column: 16, Offset: 3388}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "rwSize", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 175, Column: 13, Offset: 3385}, End: syntax.Cursor{Line: 175, Column: 16, Offset: 3388}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 176, Column: 5, Offset: 3394}, End: syntax.Cursor{Line: 176, Column: 16, Offset: 3405}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 176, Column: 10, Offset: 3399}, End: syntax.Cursor{Line: 176, Column: 16, Offset: 3405}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 177, Column: 5, Offset: 3411}, End: syntax.Cursor{Line: 177, Column: 22, Offset: 3428}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "createdAt", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 177, Column: 16, Offset: 3422}, End: syntax.Cursor{Line: 177, Column: 22, Offset: 3428}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 178, Column: 5, Offset: 3434}, End: syntax.Cursor{Line: 178, Column: 21, Offset: 3450}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "exitedAt", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 178, Column: 15, Offset: 3444}, End: syntax.Cursor{Line: 178, Column: 21, Offset: 3450}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 179, Column: 5, Offset: 3456}, End: syntax.Cursor{Line: 179, Column: 22, Offset: 3473}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "startedAt", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 179, Column: 16, Offset: 3467}, End: syntax.Cursor{Line: 179, Column: 22, Offset: 3473}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 180, Column: 5, Offset: 3479}, End: syntax.Cursor{Line: 180, Column: 27, Offset: 3501}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 180, Column: 13, Offset: 3487}, End: syntax.Cursor{Line: 180, Column: 27, Offset: 3501}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 180, Column: 21, Offset: 3495}, End: syntax.Cursor{Line: 180, Column: 27, Offset: 3501}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 181, Column: 5, Offset: 3507}, End: syntax.Cursor{Line: 181, Column: 18, Offset: 3520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "nsPid", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 181, Column: 12, Offset: 3514}, End: syntax.Cursor{Line: 181, Column: 18, Offset: 3520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 182, Column: 5, Offset: 3526}, End: syntax.Cursor{Line: 182, Column: 19, Offset: 3540}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cgroup", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 182, Column: 13, Offset: 3534}, End: syntax.Cursor{Line: 182, Column: 19, Offset: 3540}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 183, Column: 5, Offset: 3546}, End: syntax.Cursor{Line: 183, Column: 16, Offset: 3557}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ipc", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 183, Column: 10, Offset: 3551}, End: syntax.Cursor{Line: 183, Column: 16, Offset: 3557}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 184, Column: 5, Offset: 3563}, End: syntax.Cursor{Line: 184, Column: 16, Offset: 3574}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mnt", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 184, Column: 10, Offset: 3568}, End: syntax.Cursor{Line: 184, Column: 16, Offset: 3574}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 185, Column: 5, Offset: 3580}, End: syntax.Cursor{Line: 185, Column: 16, Offset: 3591}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "net", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 185, Column: 10, Offset: 3585}, End: syntax.Cursor{Line: 185, Column: 16, Offset: 3591}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 186, Column: 5, Offset: 3597}, End: syntax.Cursor{Line: 186, Column: 18, Offset: 3610}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pidNs", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 186, Column: 12, Offset: 3604}, End: syntax.Cursor{Line: 186, Column: 18, Offset: 3610}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 187, Column: 5, Offset: 3616}, End: syntax.Cursor{Line: 187, Column: 17, Offset: 3628}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "user", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 187, Column: 11, Offset: 3622}, End: syntax.Cursor{Line: 187, Column: 17, Offset: 3628}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 188, Column: 5, Offset: 3634}, End: syntax.Cursor{Line: 188, Column: 16, Offset: 3645}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "uts", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 188, Column: 10, Offset: 3639}, End: syntax.Cursor{Line: 188, Column: 16, Offset: 3645}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 189, Column: 5, Offset: 3651}, End: syntax.Cursor{Line: 189, Column: 19, Offset: 3665}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mounts", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 189, Column: 13, Offset: 3659}, End: syntax.Cursor{Line: 189, Column: 19, Offset: 3665}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 193, Column: 1, Offset: 3733}, End: syntax.Cursor{Line: 198, Column: 2, Offset: 3841}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerMount describes the struct for mounts in a container\n", Value: "ContainerMount describes the struct for mounts in a container", Start: syntax.Cursor{Line: 192, Column: 1, Offset: 3669}, End: syntax.Cursor{Line: 192, Column: 64, Offset: 3732}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerMount", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 193, Column: 21, Offset: 3753}, End: syntax.Cursor{Line: 198, Column: 2, Offset: 3841}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 194, Column: 5, Offset: 3759}, End: syntax.Cursor{Line: 194, Column: 24, Offset: 3778}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "destination", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 194, Column: 18, Offset: 3772}, End: syntax.Cursor{Line: 194, Column: 24, Offset: 3778}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 195, Column: 5, Offset: 3784}, End: syntax.Cursor{Line: 195, Column: 17, Offset: 3796}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "type", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 195, Column: 11, Offset: 3790}, End: syntax.Cursor{Line: 195, Column: 17, Offset: 3796}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 196, Column: 5, Offset: 3802}, End: syntax.Cursor{Line: 196, Column: 19, Offset: 3816}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "source", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 196, Column: 13, Offset: 3810}, End: syntax.Cursor{Line: 196, Column: 19, Offset: 3816}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 197, Column: 5, Offset: 3822}, End: syntax.Cursor{Line: 197, Column: 22, Offset: 3839}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "options", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 197, Column: 14, Offset: 3831}, End: syntax.Cursor{Line: 197, Column: 22, Offset: 3839}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 197, Column: 16, Offset: 3833}, End: syntax.Cursor{Line: 197, Column: 22, Offset: 3839}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 201, Column: 1, Offset: 3930}, End: syntax.Cursor{Line: 206, Column: 2, Offset: 4053}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerPortMappings describes the struct for portmappings in an existing container\n", Value: "ContainerPortMappings describes the struct for portmappings in an existing container", Start: syntax.Cursor{Line: 200, Column: 1, Offset: 3843}, End: syntax.Cursor{Line: 200, Column: 87, Offset: 3929}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerPortMappings", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 201, Column: 28, Offset: 3957}, End: syntax.Cursor{Line: 206, Column: 2, Offset: 4053}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 202, Column: 5, Offset: 3963}, End: syntax.Cursor{Line: 202, Column: 22, Offset: 3980}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "host_port", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 202, Column: 16, Offset: 3974}, End: syntax.Cursor{Line: 202, Column: 22, Offset: 3980}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 203, Column: 5, Offset: 3986}, End: syntax.Cursor{Line: 203, Column: 20, Offset: 4001}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "host_ip", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 203, Column: 14, Offset: 3995}, End: syntax.Cursor{Line: 203, Column: 20, Offset: 4001}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 204, Column: 5, Offset: 4007}, End: syntax.Cursor{Line: 204, Column: 21, Offset: 4023}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "protocol", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 204, Column: 15, Offset: 4017}, End: syntax.Cursor{Line: 204, Column: 21, Offset: 4023}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 205, Column: 5, Offset: 4029}, End: syntax.Cursor{Line: 205, Column: 27, Offset: 4051}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container_port", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 205, Column: 21, Offset: 4045}, End: syntax.Cursor{Line: 205, Column: 27, Offset: 4051}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 209, Column: 1, Offset: 4136}, End: syntax.Cursor{Line: 218, Column: 2, Offset: 4304}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerNamespace describes the namespace structure for an existing container\n", Value: "ContainerNamespace describes the namespace structure for an existing container", Start: syntax.Cursor{Line: 208, Column: 1, Offset: 4055}, End: syntax.Cursor{Line: 208, Column: 81, Offset: 4135}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerNameSpace", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 209, Column: 25, Offset: 4160}, End: syntax.Cursor{Line: 218, Column: 2, Offset: 4304}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 210, Column: 5, Offset: 4166}, End: syntax.Cursor{Line: 210, Column: 17, Offset: 4178}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "user", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 210, Column: 11, Offset: 4172}, End: syntax.Cursor{Line: 210, Column: 17, Offset: 4178}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 211, Column: 5, Offset: 4184}, End: syntax.Cursor{Line: 211, Column: 16, Offset: 4195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "uts", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 211, Column: 10, Offset: 4189}, End: syntax.Cursor{Line: 211, Column: 16, Offset: 4195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 212, Column: 5, Offset: 4201}, End: syntax.Cursor{Line: 212, Column: 18, Offset: 4214}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pidns", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 212, Column: 12, Offset: 4208}, End: syntax.Cursor{Line: 212, Column: 18, Offset: 4214}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 213, Column: 5, Offset: 4220}, End: syntax.Cursor{Line: 213, Column: 16, Offset: 4231}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pid", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 213, Column: 10, Offset: 4225}, End: syntax.Cursor{Line: 213, Column: 16, Offset: 4231}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 214, Column: 5, Offset: 4237}, End: syntax.Cursor{Line: 214, Column: 19, Offset: 4251}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cgroup", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 214, Column: 13, Offset: 4245}, End: syntax.Cursor{Line: 214, Column: 19, Offset: 4251}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 215, Column: 5, Offset: 4257}, End: syntax.Cursor{Line: 215, Column: 16, Offset: 4268}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "net", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 215, Column: 10, Offset: 4262}, End: syntax.Cursor{Line: 215, Column: 16, Offset: 4268}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 216, Column: 5, Offset: 4274}, End: syntax.Cursor{Line: 216, Column: 16, Offset: 4285}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mnt", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 216, Column: 10, Offset: 4279}, End: syntax.Cursor{Line: 216, Column: 16, Offset: 4285}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 217, Column: 5, Offset: 4291}, End: syntax.Cursor{Line: 217, Column: 16, Offset: 4302}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ipc", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 217, Column: 10, Offset: 4296}, End: syntax.Cursor{Line: 217, Column: 16, Offset: 4302}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 221, Column: 1, Offset: 4359}, End: syntax.Cursor{Line: 224, Column: 2, Offset: 4430}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InfoDistribution describes the host's distribution\n", Value: "InfoDistribution describes the host's distribution", Start: syntax.Cursor{Line: 220, Column: 1, Offset: 4306}, End: syntax.Cursor{Line: 220, Column: 53, Offset: 4358}}}, Tokens: []syntax.Token(nil)}, Name: "InfoDistribution", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 221, Column: 23, Offset: 4381}, End: syntax.Cursor{Line: 224, Column: 2, Offset: 4430}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 222, Column: 5, Offset: 4387}, End: syntax.Cursor{Line: 222, Column: 25, Offset: 4407}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "distribution", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 222, Column: 19, Offset: 4401}, End: syntax.Cursor{Line: 222, Column: 25, Offset: 4407}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 223, Column: 5, Offset: 4413}, End: syntax.Cursor{Line: 223, Column: 20, Offset: 4428}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 223, Column: 14, Offset: 4422}, End: syntax.Cursor{Line: 223, Column: 20, Offset: 4428}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 227, Column: 1, Offset: 4490}, End: syntax.Cursor{Line: 241, Column: 2, Offset: 4787}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InfoHost describes the host stats portion of PodmanInfo\n", Value: "InfoHost describes the host stats portion of PodmanInfo", Start: syntax.Cursor{Line: 226, Column: 1, Offset: 4432}, End: syntax.Cursor{Line: 226, Column: 58, Offset: 4489}}}, Tokens: []syntax.Token(nil)}, Name: "InfoHost", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 227, Column: 15, Offset: 4504}, End: syntax.Cursor{Line: 241, Column: 2, Offset: 4787}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 228, Column: 5, Offset: 4510}, End: syntax.Cursor{Line: 228, Column: 28, Offset: 4533}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "buildah_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 228, Column: 22, Offset: 4527}, End: syntax.Cursor{Line: 228, Column: 28, Offset: 4533}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 229, Column: 5, Offset: 4539}, End: syntax.Cursor{Line: 229, Column: 35, Offset: 4569}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "distribution", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 229, Column: 19, Offset: 4553}, End: syntax.Cursor{Line: 229, Column: 35, Offset: 4569}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "InfoDistribution"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 230, Column: 5, Offset: 4575}, End: syntax.Cursor{Line: 230, Column: 18, Offset: 4588}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mem_free", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 230, Column: 15, Offset: 4585}, End: syntax.Cursor{Line: 230, Column: 18, Offset: 4588}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 231, Column: 5, Offset: 4594}, End: syntax.Cursor{Line: 231, Column: 19, Offset: 4608}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "mem_total", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 231, Column: 16, Offset: 4605}, End: syntax.Cursor{Line: 231, Column: 19, Offset: 4608}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 232, Column: 5, Offset: 4614}, End: syntax.Cursor{Line: 232, Column: 19, Offset: 4628}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "swap_free", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 232, Column: 16, Offset: 4625}, End: syntax.Cursor{Line: 232, Column: 19, Offset: 4628}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 233, Column: 5, Offset: 4634}, End: syntax.Cursor{Line: 233, Column: 20, Offset: 4649}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "swap_total", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 233, Column: 17, Offset: 4646}, End: syntax.Cursor{Line: 233, Column: 20, Offset: 4649}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 234, Column: 5, Offset: 4655}, End: syntax.Cursor{Line: 234, Column: 17, Offset: 4667}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "arch", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 234, Column: 11, Offset: 4661}, End: syntax.Cursor{Line: 234, Column: 17, Offset: 4667}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 235, Column: 5, Offset: 4673}, End: syntax.Cursor{Line: 235, Column: 14, Offset: 4682}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cpus", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 235, Column: 11, Offset: 4679}, End: syntax.Cursor{Line: 235, Column: 14, Offset: 4682}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 236, Column: 5, Offset: 4688}, End: syntax.Cursor{Line: 236, Column: 21, Offset: 4704}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "hostname", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 236, Column: 15, Offset: 4698}, End: syntax.Cursor{Line: 236, Column: 21, Offset: 4704}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 237, Column: 5, Offset: 4710}, End: syntax.Cursor{Line: 237, Column: 19, Offset: 4724}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "kernel", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 237, Column: 13, Offset: 4718}, End: syntax.Cursor{Line: 237, Column: 19, Offset: 4724}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 238, Column: 5, Offset: 4730}, End: syntax.Cursor{Line: 238, Column: 15, Offset: 4740}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "os", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 238, Column: 9, Offset: 4734}, End: syntax.Cursor{Line: 238, Column: 15, Offset: 4740}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 239, Column: 5, Offset: 4746}, End: syntax.Cursor{Line: 239, Column: 19, Offset: 4760}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "uptime", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 239, Column: 13, Offset: 4754}, End: syntax.Cursor{Line: 239, Column: 19, Offset: 4760}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 240, Column: 5, Offset: 4766}, End: syntax.Cursor{Line: 240, Column: 24, Offset: 4785}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "eventlogger", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 240, Column: 18, Offset: 4779}, End: syntax.Cursor{Line: 240, Column: 24, Offset: 4785}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 244, Column: 1, Offset: 4859}, End: syntax.Cursor{Line: 248, Column: 2, Offset: 4976}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InfoGraphStatus describes the detailed status of the storage driver\n", Value: "InfoGraphStatus describes the detailed status of the storage driver", Start: syntax.Cursor{Line: 243, Column: 1, Offset: 4789}, End: syntax.Cursor{Line: 243, Column: 70, Offset: 4858}}}, Tokens: []syntax.Token(nil)}, Name: "InfoGraphStatus", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 244, Column: 22, Offset: 4880}, End: syntax.Cursor{Line: 248, Column: 2, Offset: 4976}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 245, Column: 5, Offset: 4886}, End: syntax.Cursor{Line: 245, Column: 31, Offset: 4912}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "backing_filesystem", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 245, Column: 25, Offset: 4906}, End: syntax.Cursor{Line: 245, Column: 31, Offset: 4912}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 246, Column: 5, Offset: 4918}, End: syntax.Cursor{Line: 246, Column: 32, Offset: 4945}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "native_overlay_diff", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 246, Column: 26, Offset: 4939}, End: syntax.Cursor{Line: 246, Column: 32, Offset: 4945}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 247, Column: 5, Offset: 4951}, End: syntax.Cursor{Line: 247, Column: 28, Offset: 4974}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "supports_d_type", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 247, Column: 22, Offset: 4968}, End: syntax.Cursor{Line: 247, Column: 28, Offset: 4974}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 251, Column: 1, Offset: 5031}, End: syntax.Cursor{Line: 259, Column: 2, Offset: 5232}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InfoStore describes the host's storage informatoin\n", Value: "InfoStore describes the host's storage informatoin", Start: syntax.Cursor{Line: 250, Column: 1, Offset: 4978}, End: syntax.Cursor{Line: 250, Column: 53, Offset: 5030}}}, Tokens: []syntax.Token(nil)}, Name: "InfoStore", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 251, Column: 16, Offset: 5046}, End: syntax.Cursor{Line: 259, Column: 2, Offset: 5232}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 252, Column: 5, Offset: 5052}, End: syntax.Cursor{Line: 252, Column: 20, Offset: 5067}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 252, Column: 17, Offset: 5064}, End: syntax.Cursor{Line: 252, Column: 20, Offset: 5067}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 253, Column: 5, Offset: 5073}, End: syntax.Cursor{Line: 253, Column: 16, Offset: 5084}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "images", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 253, Column: 13, Offset: 5081}, End: syntax.Cursor{Line: 253, Column: 16, Offset: 5084}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 254, Column: 5, Offset: 5090}, End: syntax.Cursor{Line: 254, Column: 30, Offset: 5115}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "graph_driver_name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 254, Column: 24, Offset: 5109}, End: syntax.Cursor{Line: 254, Column: 30, Offset: 5115}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 255, Column: 5, Offset: 5121}, End: syntax.Cursor{Line: 255, Column: 33, Offset: 5149}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "graph_driver_options", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 255, Column: 27, Offset: 5143}, End: syntax.Cursor{Line: 255, Column: 33, Offset: 5149}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 256, Column: 5, Offset: 5155}, End: syntax.Cursor{Line: 256, Column: 23, Offset: 5173}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "graph_root", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 256, Column: 17, Offset: 5167}, End: syntax.Cursor{Line: 256, Column: 23, Offset: 5173}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 257, Column: 5, Offset: 5179}, End: syntax.Cursor{Line: 257, Column: 34, Offset: 5208}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "graph_status", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 257, Column: 19, Offset: 5193}, End: syntax.Cursor{Line: 257, Column: 34, Offset: 5208}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "InfoGraphStatus"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 258, Column: 5, Offset: 5214}, End: syntax.Cursor{Line: 258, Column: 21, Offset: 5230}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "run_root", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 258, Column: 15, Offset: 5224}, End: syntax.Cursor{Line: 258, Column: 21, Offset: 5230}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 262, Column: 1, Offset: 5285}, End: syntax.Cursor{Line: 267, Column: 2, Offset: 5407}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InfoPodman provides details on the podman binary\n", Value: "InfoPodman provides details on the podman binary", Start: syntax.Cursor{Line: 261, Column: 1, Offset: 5234}, End: syntax.Cursor{Line: 261, Column: 51, Offset: 5284}}}, Tokens: []syntax.Token(nil)}, Name: "InfoPodmanBinary", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 262, Column: 23, Offset: 5307}, End: syntax.Cursor{Line: 267, Column: 2, Offset: 5407}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 263, Column: 5, Offset: 5313}, End: syntax.Cursor{Line: 263, Column: 21, Offset: 5329}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "compiler", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 263, Column: 15, Offset: 5323}, End: syntax.Cursor{Line: 263, Column: 21, Offset: 5329}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 264, Column: 5, Offset: 5335}, End: syntax.Cursor{Line: 264, Column: 23, Offset: 5353}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "go_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 264, Column: 17, Offset: 5347}, End: syntax.Cursor{Line: 264, Column: 23, Offset: 5353}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 265, Column: 5, Offset: 5359}, End: syntax.Cursor{Line: 265, Column: 27, Offset: 5381}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "podman_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 265, Column: 21, Offset: 5375}, End: syntax.Cursor{Line: 265, Column: 27, Offset: 5381}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 266, Column: 5, Offset: 5387}, End: syntax.Cursor{Line: 266, Column: 23, Offset: 5405}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "git_commit", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 266, Column: 17, Offset: 5399}, End: syntax.Cursor{Line: 266, Column: 23, Offset: 5405}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 270, Column: 1, Offset: 5458}, End: syntax.Cursor{Line: 276, Column: 2, Offset: 5609}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# PodmanInfo describes the Podman host and build\n", Value: "PodmanInfo describes the Podman host and build", Start: syntax.Cursor{Line: 269, Column: 1, Offset: 5409}, End: syntax.Cursor{Line: 269, Column: 49, Offset: 5457}}}, Tokens: []syntax.Token(nil)}, Name: "PodmanInfo", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 270, Column: 17, Offset: 5474}, End: syntax.Cursor{Line: 276, Column: 2, Offset: 5609}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 271, Column: 5, Offset: 5480}, End: syntax.Cursor{Line: 271, Column: 19, Offset: 5494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "host", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 271, Column: 11, Offset: 5486}, End: syntax.Cursor{Line: 271, Column: 19, Offset: 5494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "InfoHost"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 272, Column: 5, Offset: 5500}, End: syntax.Cursor{Line: 272, Column: 25, Offset: 5520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "registries", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 272, Column: 17, Offset: 5512}, End: syntax.Cursor{Line: 272, Column: 25, Offset: 5520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 272, Column: 19, Offset: 5514}, End: syntax.Cursor{Line: 272, Column: 25, Offset: 5520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 273, Column: 5, Offset: 5526}, End: syntax.Cursor{Line: 273, Column: 34, Offset: 5555}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "insecure_registries", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 273, Column: 26, Offset: 5547}, End: syntax.Cursor{Line: 273, Column: 34, Offset: 5555}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 273, Column: 28, Offset: 5549}, End: syntax.Cursor{Line: 273, Column: 34, Offset: 5555}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 274, Column: 5, Offset: 5561}, End: syntax.Cursor{Line: 274, Column: 21, Offset: 5577}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "store", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 274, Column: 12, Offset: 5568}, End: syntax.Cursor{Line: 274, Column: 21, Offset: 5577}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "InfoStore"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 275, Column: 5, Offset: 5583}, End: syntax.Cursor{Line: 275, Column: 29, Offset: 5607}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "podman", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 275, Column: 13, Offset: 5591}, End: syntax.Cursor{Line: 275, Column: 29, Offset: 5607}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "InfoPodmanBinary"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 279, Column: 1, Offset: 5664}, End: syntax.Cursor{Line: 283, Column: 2, Offset: 5755}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Sockets describes sockets location for a container\n", Value: "Sockets describes sockets location for a container", Start: syntax.Cursor{Line: 278, Column: 1, Offset: 5611}, End: syntax.Cursor{Line: 278, Column: 53, Offset: 5663}}}, Tokens: []syntax.Token(nil)}, Name: "Sockets", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 279, Column: 13, Offset: 5676}, End: syntax.Cursor{Line: 283, Column: 2, Offset: 5755}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 280, Column: 5, Offset: 5682}, End: syntax.Cursor{Line: 280, Column: 25, Offset: 5702}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container_id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 280, Column: 19, Offset: 5696}, End: syntax.Cursor{Line: 280, Column: 25, Offset: 5702}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 281, Column: 5, Offset: 5708}, End: syntax.Cursor{Line: 281, Column: 22, Offset: 5725}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "io_socket", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 281, Column: 16, Offset: 5719}, End: syntax.Cursor{Line: 281, Column: 22, Offset: 5725}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 282, Column: 5, Offset: 5731}, End: syntax.Cursor{Line: 282, Column: 27, Offset: 5753}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "control_socket", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 282, Column: 21, Offset: 5747}, End: syntax.Cursor{Line: 282, Column: 27, Offset: 5753}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 287, Column: 1, Offset: 5839}, End: syntax.Cursor{Line: 291, Column: 2, Offset: 5921}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListPodContainerInfo is a returned struct for describing containers\n", Value: "ListPodContainerInfo is a returned struct for describing containers", Start: syntax.Cursor{Line: 285, Column: 1, Offset: 5757}, End: syntax.Cursor{Line: 285, Column: 70, Offset: 5826}}, syntax.Token{Type: "<comment>", Raw: "# in a pod.\n", Value: "in a pod.", Start: syntax.Cursor{Line: 286, Column: 1, Offset: 5827}, End: syntax.Cursor{Line: 286, Column: 12, Offset: 5838}}}, Tokens: []syntax.Token(nil)}, Name: "ListPodContainerInfo", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 287, Column: 27, Offset: 5865}, End: syntax.Cursor{Line: 291, Column: 2, Offset: 5921}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 288, Column: 5, Offset: 5871}, End: syntax.Cursor{Line: 288, Column: 17, Offset: 5883}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 288, Column: 11, Offset: 5877}, End: syntax.Cursor{Line: 288, Column: 17, Offset: 5883}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 289, Column: 5, Offset: 5889}, End: syntax.Cursor{Line: 289, Column: 15, Offset: 5899}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 289, Column: 9, Offset: 5893}, End: syntax.Cursor{Line: 289, Column: 15, Offset: 5899}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 290, Column: 5, Offset: 5905}, End: syntax.Cursor{Line: 290, Column: 19, Offset: 5919}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 290, Column: 13, Offset: 5913}, End: syntax.Cursor{Line: 290, Column: 19, Offset: 5919}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 294, Column: 1, Offset: 5982}, End: syntax.Cursor{Line: 303, Column: 2, Offset: 6202}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListPodData is the returned struct for an individual pod\n", Value: "ListPodData is the returned struct for an individual pod", Start: syntax.Cursor{Line: 293, Column: 1, Offset: 5923}, End: syntax.Cursor{Line: 293, Column: 59, Offset: 5981}}}, Tokens: []syntax.Token(nil)}, Name: "ListPodData", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 294, Column: 18, Offset: 5999}, End: syntax.Cursor{Line: 303, Column: 2, Offset: 6202}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 295, Column: 5, Offset: 6005}, End: syntax.Cursor{Line: 295, Column: 15, Offset: 6015}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 295, Column: 9, Offset: 6009}, End: syntax.Cursor{Line: 295, Column: 15, Offset: 6015}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 296, Column: 5, Offset: 6021}, End: syntax.Cursor{Line: 296, Column: 17, Offset: 6033}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 296, Column: 11, Offset: 6027}, End: syntax.Cursor{Line: 296, Column: 17, Offset: 6033}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 297, Column: 5, Offset: 6039}, End: syntax.Cursor{Line: 297, Column: 22, Offset: 6056}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "createdat", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 297, Column: 16, Offset: 6050}, End: syntax.Cursor{Line: 297, Column: 22, Offset: 6056}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 298, Column: 5, Offset: 6062}, End: syntax.Cursor{Line: 298, Column: 19, Offset: 6076}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "cgroup", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 298, Column: 13, Offset: 6070}, End: syntax.Cursor{Line: 298, Column: 19, Offset: 6076}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 299, Column: 5, Offset: 6082}, End: syntax.Cursor{Line: 299, Column: 19, Offset: 6096}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 299, Column: 13, Offset: 6090}, End: syntax.Cursor{Line: 299, Column: 19, Offset: 6096}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 300, Column: 5, Offset: 6102}, End: syntax.Cursor{Line: 300, Column: 27, Offset: 6124}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "labels", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 300, Column: 13, Offset: 6110}, End: syntax.Cursor{Line: 300, Column: 27, Offset: 6124}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 300, Column: 21, Offset: 6118}, End: syntax.Cursor{Line: 300, Column: 27, Offset: 6124}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 301, Column: 5, Offset: 6130}, End: syntax.Cursor{Line: 301, Column: 31, Offset: 6156}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "numberofcontainers", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 301, Column: 25, Offset: 6150}, End: syntax.Cursor{Line: 301, Column: 31, Offset: 6156}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 302, Column: 5, Offset: 6162}, End: syntax.Cursor{Line: 302, Column: 43, Offset: 6200}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containersinfo", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 302, Column: 21, Offset: 6178}, End: syntax.Cursor{Line: 302, Column: 43, Offset: 6200}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 302, Column: 23, Offset: 6180}, End: syntax.Cursor{Line: 302, Column: 43, Offset: 6200}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ListPodContainerInfo"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 305, Column: 1, Offset: 6204}, End: syntax.Cursor{Line: 308, Column: 2, Offset: 6278}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PodContainerErrorData", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 305, Column: 28, Offset: 6231}, End: syntax.Cursor{Line: 308, Column: 2, Offset: 6278}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 306, Column: 5, Offset: 6237}, End: syntax.Cursor{Line: 306, Column: 24, Offset: 6256}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containerid", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 306, Column: 18, Offset: 6250}, End: syntax.Cursor{Line: 306, Column: 24, Offset: 6256}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 307, Column: 5, Offset: 6262}, End: syntax.Cursor{Line: 307, Column: 19, Offset: 6276}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 307, Column: 13, Offset: 6270}, End: syntax.Cursor{Line: 307, Column: 19, Offset: 6276}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 310, Column: 1, Offset: 6280}, End: syntax.Cursor{Line: 317, Column: 2, Offset: 6439}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PodmanVersion", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 310, Column: 20, Offset: 6299}, End: syntax.Cursor{Line: 317, Column: 2, Offset: 6439}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 311, Column: 5, Offset: 6305}, End: syntax.Cursor{Line: 311, Column: 20, Offset: 6320}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 311, Column: 14, Offset: 6314}, End: syntax.Cursor{Line: 311, Column: 20, Offset: 6320}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 312, Column: 5, Offset: 6326}, End: syntax.Cursor{Line: 312, Column: 23, Offset: 6344}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "go_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 312, Column: 17, Offset: 6338}, End: syntax.Cursor{Line: 312, Column: 23, Offset: 6344}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 313, Column: 5, Offset: 6350}, End: syntax.Cursor{Line: 313, Column: 23, Offset: 6368}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "git_commit", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 313, Column: 17, Offset: 6362}, End: syntax.Cursor{Line: 313, Column: 23, Offset: 6368}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 314, Column: 5, Offset: 6374}, End: syntax.Cursor{Line: 314, Column: 18, Offset: 6387}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "built", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 314, Column: 12, Offset: 6381}, End: syntax.Cursor{Line: 314, Column: 18, Offset: 6387}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 315, Column: 5, Offset: 6393}, End: syntax.Cursor{Line: 315, Column: 20, Offset: 6408}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "os_arch", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 315, Column: 14, Offset: 6402}, End: syntax.Cursor{Line: 315, Column: 20, Offset: 6408}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 316, Column: 5, Offset: 6414}, End: syntax.Cursor{Line: 316, Column: 28, Offset: 6437}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "remote_api_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 316, Column: 25, Offset: 6434}, End: syntax.Cursor{Line: 316, Column: 28, Offset: 6437}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 320, Column: 1, Offset: 6475}, End: syntax.Cursor{Line: 334, Column: 2, Offset: 6977}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Event describes a libpod struct\n", Value: "Event describes a libpod struct", Start: syntax.Cursor{Line: 319, Column: 1, Offset: 6441}, End: syntax.Cursor{Line: 319, Column: 34, Offset: 6474}}}, Tokens: []syntax.Token(nil)}, Name: "Event", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 320, Column: 11, Offset: 6485}, End: syntax.Cursor{Line: 334, Column: 2, Offset: 6977}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 323, Column: 5, Offset: 6595}, End: syntax.Cursor{Line: 323, Column: 15, Offset: 6605}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# TODO: make status and type a enum at some point?\n", Value: "TODO: make status and type a enum at some point?", Start: syntax.Cursor{Line: 321, Column: 5, Offset: 6491}, End: syntax.Cursor{Line: 321, Column: 55, Offset: 6541}}, syntax.Token{Type: "<comment>", Raw: "# id is the container, volume, pod, image ID\n", Value: "id is the container, volume, pod, image ID", Start: syntax.Cursor{Line: 322, Column: 5, Offset: 6546}, End: syntax.Cursor{Line: 322, Column: 49, Offset: 6590}}}, Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 323, Column: 9, Offset: 6599}, End: syntax.Cursor{Line: 323, Column: 15, Offset: 6605}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 325, Column: 5, Offset: 6658}, End: syntax.Cursor{Line: 325, Column: 18, Offset: 6671}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# image is the image name where applicable\n", Value: "image is the image name where applicable", Start: syntax.Cursor{Line: 324, Column: 5, Offset: 6611}, End: syntax.Cursor{Line: 324, Column: 47, Offset: 6653}}}, Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 325, Column: 12, Offset: 6665}, End: syntax.Cursor{Line: 325, Column: 18, Offset: 6671}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 327, Column: 5, Offset: 6729}, End: syntax.Cursor{Line: 327, Column: 17, Offset: 6741}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# name is the name of the pod, container, image\n", Value: "name is the name of the pod, container, image", Start: syntax.Cursor{Line: 326, Column: 5, Offset: 6677}, End: syntax.Cursor{Line: 326, Column: 52, Offset: 6724}}}, Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 327, Column: 11, Offset: 6735}, End: syntax.Cursor{Line: 327, Column: 17, Offset: 6741}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 329, Column: 5, Offset: 6821}, End: syntax.Cursor{Line: 329, Column: 19, Offset: 6835}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# status describes the event that happened (i.e. create, remove, ...)\n", Value: "status describes the event that happened (i.e. create, remove, ...)", Start: syntax.Cursor{Line: 328, Column: 5, Offset: 6747}, End: syntax.Cursor{Line: 328, Column: 74, Offset: 6816}}}, Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 329, Column: 13, Offset: 6829}, End: syntax.Cursor{Line: 329, Column: 19, Offset: 6835}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 331, Column: 5, Offset: 6871}, End: syntax.Cursor{Line: 331, Column: 17, Offset: 6883}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# time the event happened\n", Value: "time the event happened", Start: syntax.Cursor{Line: 330, Column: 5, Offset: 6841}, End: syntax.Cursor{Line: 330, Column: 30, Offset: 6866}}}, Tokens: []syntax.Token(nil)}, Name: "time", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 331, Column: 11, Offset: 6877}, End: syntax.Cursor{Line: 331, Column: 17, Offset: 6883}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 333, Column: 5, Offset: 6963}, End: syntax.Cursor{Line: 333, Column: 17, Offset: 6975}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# type describes object the event happened with (image, container...)\n", Value: "type describes object the event happened with (image, container...)", Start: syntax.Cursor{Line: 332, Column: 5, Offset: 6889}, End: syntax.Cursor{Line: 332, Column: 74, Offset: 6958}}}, Tokens: []syntax.Token(nil)}, Name: "type", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 333, Column: 11, Offset: 6969}, End: syntax.Cursor{Line: 333, Column: 17, Offset: 6975}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 336, Column: 1, Offset: 6979}, End: syntax.Cursor{Line: 341, Column: 2, Offset: 7083}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "DiffInfo", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 336, Column: 14, Offset: 6992}, End: syntax.Cursor{Line: 341, Column: 2, Offset: 7083}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 338, Column: 3, Offset: 7023}, End: syntax.Cursor{Line: 338, Column: 15, Offset: 7035}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# path that is different\n", Value: "path that is different", Start: syntax.Cursor{Line: 337, Column: 3, Offset: 6996}, End: syntax.Cursor{Line: 337, Column: 27, Offset: 7020}}}, Tokens: []syntax.Token(nil)}, Name: "path", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 338, Column: 9, Offset: 7029}, End: syntax.Cursor{Line: 338, Column: 15, Offset: 7035}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 340, Column: 3, Offset: 7063}, End: syntax.Cursor{Line: 340, Column: 21, Offset: 7081}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Add, Delete, Modify\n", Value: "Add, Delete, Modify", Start: syntax.Cursor{Line: 339, Column: 3, Offset: 7039}, End: syntax.Cursor{Line: 339, Column: 24, Offset: 7060}}}, Tokens: []syntax.Token(nil)}, Name: "changeType", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 340, Column: 15, Offset: 7075}, End: syntax.Cursor{Line: 340, Column: 21, Offset: 7081}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 343, Column: 1, Offset: 7085}, End: syntax.Cursor{Line: 360, Column: 2, Offset: 7506}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ExecOpts", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 343, Column: 14, Offset: 7098}, End: syntax.Cursor{Line: 360, Column: 2, Offset: 7506}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 345, Column: 3, Offset: 7127}, End: syntax.Cursor{Line: 345, Column: 15, Offset: 7139}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# container name or id\n", Value: "container name or id", Start: syntax.Cursor{Line: 344, Column: 3, Offset: 7102}, End: syntax.Cursor{Line: 344, Column: 25, Offset: 7124}}}, Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 345, Column: 9, Offset: 7133}, End: syntax.Cursor{Line: 345, Column: 15, Offset: 7139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 347, Column: 3, Offset: 7165}, End: syntax.Cursor{Line: 347, Column: 12, Offset: 7174}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Create pseudo tty\n", Value: "Create pseudo tty", Start: syntax.Cursor{Line: 346, Column: 3, Offset: 7143}, End: syntax.Cursor{Line: 346, Column: 22, Offset: 7162}}}, Tokens: []syntax.Token(nil)}, Name: "tty", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 347, Column: 8, Offset: 7170}, End: syntax.Cursor{Line: 347, Column: 12, Offset: 7174}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 349, Column: 3, Offset: 7213}, End: syntax.Cursor{Line: 349, Column: 19, Offset: 7229}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# privileged access in container\n", Value: "privileged access in container", Start: syntax.Cursor{Line: 348, Column: 3, Offset: 7178}, End: syntax.Cursor{Line: 348, Column: 35, Offset: 7210}}}, Tokens: []syntax.Token(nil)}, Name: "privileged", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 349, Column: 15, Offset: 7225}, End: syntax.Cursor{Line: 349, Column: 19, Offset: 7229}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 351, Column: 3, Offset: 7269}, End: syntax.Cursor{Line: 351, Column: 16, Offset: 7282}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# command to execute in container\n", Value: "command to execute in container", Start: syntax.Cursor{Line: 350, Column: 3, Offset: 7233}, End: syntax.Cursor{Line: 350, Column: 36, Offset: 7266}}}, Tokens: []syntax.Token(nil)}, Name: "cmd", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 351, Column: 8, Offset: 7274}, End: syntax.Cursor{Line: 351, Column: 16, Offset: 7282}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 351, Column: 10, Offset: 7276}, End: syntax.Cursor{Line: 351, Column: 16, Offset: 7282}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 353, Column: 3, Offset: 7315}, End: syntax.Cursor{Line: 353, Column: 16, Offset: 7328}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# user to use in container\n", Value: "user to use in container", Start: syntax.Cursor{Line: 352, Column: 3, Offset: 7286}, End: syntax.Cursor{Line: 352, Column: 29, Offset: 7312}}}, Tokens: []syntax.Token(nil)}, Name: "user", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 353, Column: 9, Offset: 7321}, End: syntax.Cursor{Line: 353, Column: 16, Offset: 7328}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 353, Column: 10, Offset: 7322}, End: syntax.Cursor{Line: 353, Column: 16, Offset: 7328}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 355, Column: 3, Offset: 7372}, End: syntax.Cursor{Line: 355, Column: 19, Offset: 7388}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# workdir to run command in container\n", Value: "workdir to run command in container", Start: syntax.Cursor{Line: 354, Column: 3, Offset: 7332}, End: syntax.Cursor{Line: 354, Column: 40, Offset: 7369}}}, Tokens: []syntax.Token(nil)}, Name: "workdir", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 355, Column: 12, Offset: 7381}, End: syntax.Cursor{Line: 355, Column: 19, Offset: 7388}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 355, Column: 13, Offset: 7382}, End: syntax.Cursor{Line: 355, Column: 19, Offset: 7388}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 357, Column: 3, Offset: 7441}, End: syntax.Cursor{Line: 357, Column: 17, Offset: 7455}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# slice of keyword=value environment variables\n", Value: "slice of keyword=value environment variables", Start: syntax.Cursor{Line: 356, Column: 3, Offset: 7392}, End: syntax.Cursor{Line: 356, Column: 49, Offset: 7438}}}, Tokens: []syntax.Token(nil)}, Name: "env", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 357, Column: 8, Offset: 7446}, End: syntax.Cursor{Line: 357, Column: 17, Offset: 7455}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 357, Column: 9, Offset: 7447}, End: syntax.Cursor{Line: 357, Column: 17, Offset: 7455}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 357, Column: 11, Offset: 7449}, End: syntax.Cursor{Line: 357, Column: 17, Offset: 7455}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 359, Column: 3, Offset: 7485}, End: syntax.Cursor{Line: 359, Column: 22, Offset: 7504}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# string of detach keys\n", Value: "string of detach keys", Start: syntax.Cursor{Line: 358, Column: 3, Offset: 7459}, End: syntax.Cursor{Line: 358, Column: 26, Offset: 7482}}}, Tokens: []syntax.Token(nil)}, Name: "detachKeys", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 359, Column: 15, Offset: 7497}, End: syntax.Cursor{Line: 359, Column: 22, Offset: 7504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 359, Column: 16, Offset: 7498}, End: syntax.Cursor{Line: 359, Column: 22, Offset: 7504}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}}, Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 363, Column: 1, Offset: 7581}, End: syntax.Cursor{Line: 370, Column: 2, Offset: 7744}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetVersion returns version and build information of the podman service\n", Value: "GetVersion returns version and build information of the podman service", Start: syntax.Cursor{Line: 362, Column: 1, Offset: 7508}, End: syntax.Cursor{Line: 362, Column: 73, Offset: 7580}}}, Tokens: []syntax.Token(nil)}, Name: "GetVersion", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 363, Column: 18, Offset: 7598}, End: syntax.Cursor{Line: 363, Column: 20, Offset: 7600}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 363, Column: 24, Offset: 7604}, End: syntax.Cursor{Line: 370, Column: 2, Offset: 7744}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 364, Column: 5, Offset: 7610}, End: syntax.Cursor{Line: 364, Column: 20, Offset: 7625}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 364, Column: 14, Offset: 7619}, End: syntax.Cursor{Line: 364, Column: 20, Offset: 7625}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 365, Column: 5, Offset: 7631}, End: syntax.Cursor{Line: 365, Column: 23, Offset: 7649}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "go_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 365, Column: 17, Offset: 7643}, End: syntax.Cursor{Line: 365, Column: 23, Offset: 7649}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 366, Column: 5, Offset: 7655}, End: syntax.Cursor{Line: 366, Column: 23, Offset: 7673}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "git_commit", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 366, Column: 17, Offset: 7667}, End: syntax.Cursor{Line: 366, Column: 23, Offset: 7673}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 367, Column: 5, Offset: 7679}, End: syntax.Cursor{Line: 367, Column: 18, Offset: 7692}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "built", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 367, Column: 12, Offset: 7686}, End: syntax.Cursor{Line: 367, Column: 18, Offset: 7692}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 368, Column: 5, Offset: 7698}, End: syntax.Cursor{Line: 368, Column: 20, Offset: 7713}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "os_arch", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 368, Column: 14, Offset: 7707}, End: syntax.Cursor{Line: 368, Column: 20, Offset: 7713}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 369, Column: 5, Offset: 7719}, End: syntax.Cursor{Line: 369, Column: 28, Offset: 7742}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "remote_api_version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 369, Column: 25, Offset: 7739}, End: syntax.Cursor{Line: 369, Column: 28, Offset: 7742}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 374, Column: 1, Offset: 7916}, End: syntax.Cursor{Line: 374, Column: 39, Offset: 7954}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,\n", Value: "GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,", Start: syntax.Cursor{Line: 372, Column: 1, Offset: 7746}, End: syntax.Cursor{Line: 372, Column: 111, Offset: 7856}}, syntax.Token{Type: "<comment>", Raw: "# build information of Podman, and system-wide registries.\n", Value: "build information of Podman, and system-wide registries.", Start: syntax.Cursor{Line: 373, Column: 1, Offset: 7857}, End: syntax.Cursor{Line: 373, Column: 59, Offset: 7915}}}, Tokens: []syntax.Token(nil)}, Name: "GetInfo", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 374, Column: 15, Offset: 7930}, End: syntax.Cursor{Line: 374, Column: 17, Offset: 7932}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 374, Column: 21, Offset: 7936}, End: syntax.Cursor{Line: 374, Column: 39, Offset: 7954}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 374, Column: 22, Offset: 7937}, End: syntax.Cursor{Line: 374, Column: 38, Offset: 7953}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "info", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 374, Column: 28, Offset: 7943}, End: syntax.Cursor{Line: 374, Column: 38, Offset: 7953}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PodmanInfo"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 1, Offset: 8057}, End: syntax.Cursor{Line: 378, Column: 53, Offset: 8109}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListContainers returns information about all containers.\n", Value: "ListContainers returns information about all containers.", Start: syntax.Cursor{Line: 376, Column: 1, Offset: 7956}, End: syntax.Cursor{Line: 376, Column: 59, Offset: 8014}}, syntax.Token{Type: "<comment>", Raw: "# See also [GetContainer](#GetContainer).\n", Value: "See also [GetContainer](#GetContainer).", Start: syntax.Cursor{Line: 377, Column: 1, Offset: 8015}, End: syntax.Cursor{Line: 377, Column: 42, Offset: 8056}}}, Tokens: []syntax.Token(nil)}, Name: "ListContainers", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 22, Offset: 8078}, End: syntax.Cursor{Line: 378, Column: 24, Offset: 8080}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 28, Offset: 8084}, End: syntax.Cursor{Line: 378, Column: 53, Offset: 8109}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 29, Offset: 8085}, End: syntax.Cursor{Line: 378, Column: 52, Offset: 8108}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 41, Offset: 8097}, End: syntax.Cursor{Line: 378, Column: 52, Offset: 8108}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 378, Column: 43, Offset: 8099}, End: syntax.Cursor{Line: 378, Column: 52, Offset: 8108}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Container"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 1, Offset: 8111}, End: syntax.Cursor{Line: 380, Column: 55, Offset: 8165}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Ps", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 10, Offset: 8120}, End: syntax.Cursor{Line: 380, Column: 24, Offset: 8134}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 11, Offset: 8121}, End: syntax.Cursor{Line: 380, Column: 23, Offset: 8133}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "opts", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 17, Offset: 8127}, End: syntax.Cursor{Line: 380, Column: 23, Offset: 8133}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PsOpts"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 28, Offset: 8138}, End: syntax.Cursor{Line: 380, Column: 55, Offset: 8165}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 29, Offset: 8139}, End: syntax.Cursor{Line: 380, Column: 54, Offset: 8164}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 41, Offset: 8151}, End: syntax.Cursor{Line: 380, Column: 54, Offset: 8164}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 380, Column: 43, Offset: 8153}, End: syntax.Cursor{Line: 380, Column: 54, Offset: 8164}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PsContainer"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 1, Offset: 8167}, End: syntax.Cursor{Line: 382, Column: 76, Offset: 8242}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "GetContainersByStatus", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 29, Offset: 8195}, End: syntax.Cursor{Line: 382, Column: 47, Offset: 8213}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 30, Offset: 8196}, End: syntax.Cursor{Line: 382, Column: 46, Offset: 8212}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "status", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 38, Offset: 8204}, End: syntax.Cursor{Line: 382, Column: 46, Offset: 8212}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 40, Offset: 8206}, End: syntax.Cursor{Line: 382, Column: 46, Offset: 8212}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 51, Offset: 8217}, End: syntax.Cursor{Line: 382, Column: 76, Offset: 8242}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 52, Offset: 8218}, End: syntax.Cursor{Line: 382, Column: 75, Offset: 8241}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containerS", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 64, Offset: 8230}, End: syntax.Cursor{Line: 382, Column: 75, Offset: 8241}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 382, Column: 66, Offset: 8232}, End: syntax.Cursor{Line: 382, Column: 75, Offset: 8241}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Container"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 1, Offset: 8244}, End: syntax.Cursor{Line: 384, Column: 72, Offset: 8315}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Top", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 12, Offset: 8255}, End: syntax.Cursor{Line: 384, Column: 53, Offset: 8296}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 13, Offset: 8256}, End: syntax.Cursor{Line: 384, Column: 29, Offset: 8272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "nameOrID", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 23, Offset: 8266}, End: syntax.Cursor{Line: 384, Column: 29, Offset: 8272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 31, Offset: 8274}, End: syntax.Cursor{Line: 384, Column: 52, Offset: 8295}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "descriptors", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 44, Offset: 8287}, End: syntax.Cursor{Line: 384, Column: 52, Offset: 8295}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 46, Offset: 8289}, End: syntax.Cursor{Line: 384, Column: 52, Offset: 8295}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 57, Offset: 8300}, End: syntax.Cursor{Line: 384, Column: 72, Offset: 8315}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 58, Offset: 8301}, End: syntax.Cursor{Line: 384, Column: 71, Offset: 8314}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "top", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 63, Offset: 8306}, End: syntax.Cursor{Line: 384, Column: 71, Offset: 8314}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 384, Column: 65, Offset: 8308}, End: syntax.Cursor{Line: 384, Column: 71, Offset: 8314}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 1, Offset: 8428}, End: syntax.Cursor{Line: 388, Column: 72, Offset: 8499}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# HealthCheckRun executes defined container's healthcheck command\n", Value: "HealthCheckRun executes defined container's healthcheck command", Start: syntax.Cursor{Line: 386, Column: 1, Offset: 8317}, End: syntax.Cursor{Line: 386, Column: 66, Offset: 8382}}, syntax.Token{Type: "<comment>", Raw: "# and returns the container's health status.\n", Value: "and returns the container's health status.", Start: syntax.Cursor{Line: 387, Column: 1, Offset: 8383}, End: syntax.Cursor{Line: 387, Column: 45, Offset: 8427}}}, Tokens: []syntax.Token(nil)}, Name: "HealthCheckRun", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 23, Offset: 8450}, End: syntax.Cursor{Line: 388, Column: 41, Offset: 8468}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 24, Offset: 8451}, End: syntax.Cursor{Line: 388, Column: 40, Offset: 8467}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "nameOrID", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 34, Offset: 8461}, End: syntax.Cursor{Line: 388, Column: 40, Offset: 8467}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 45, Offset: 8472}, End: syntax.Cursor{Line: 388, Column: 72, Offset: 8499}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 46, Offset: 8473}, End: syntax.Cursor{Line: 388, Column: 71, Offset: 8498}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "healthCheckStatus", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 388, Column: 65, Offset: 8492}, End: syntax.Cursor{Line: 388, Column: 71, Offset: 8498}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 1, Offset: 8769}, End: syntax.Cursor{Line: 394, Column: 58, Offset: 8826}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainer returns information about a single container.  If a container\n", Value: "GetContainer returns information about a single container.  If a container", Start: syntax.Cursor{Line: 390, Column: 1, Offset: 8501}, End: syntax.Cursor{Line: 390, Column: 77, Offset: 8577}}, syntax.Token{Type: "<comment>", Raw: "# with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)\n", Value: "with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)", Start: syntax.Cursor{Line: 391, Column: 1, Offset: 8578}, End: syntax.Cursor{Line: 391, Column: 77, Offset: 8654}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned.  See also [ListContainers](ListContainers) and\n", Value: "error will be returned.  See also [ListContainers](ListContainers) and", Start: syntax.Cursor{Line: 392, Column: 1, Offset: 8655}, End: syntax.Cursor{Line: 392, Column: 73, Offset: 8727}}, syntax.Token{Type: "<comment>", Raw: "# [InspectContainer](#InspectContainer).\n", Value: "[InspectContainer](#InspectContainer).", Start: syntax.Cursor{Line: 393, Column: 1, Offset: 8728}, End: syntax.Cursor{Line: 393, Column: 41, Offset: 8768}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 20, Offset: 8788}, End: syntax.Cursor{Line: 394, Column: 32, Offset: 8800}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 21, Offset: 8789}, End: syntax.Cursor{Line: 394, Column: 31, Offset: 8799}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 25, Offset: 8793}, End: syntax.Cursor{Line: 394, Column: 31, Offset: 8799}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 36, Offset: 8804}, End: syntax.Cursor{Line: 394, Column: 58, Offset: 8826}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 37, Offset: 8805}, End: syntax.Cursor{Line: 394, Column: 57, Offset: 8825}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 394, Column: 48, Offset: 8816}, End: syntax.Cursor{Line: 394, Column: 57, Offset: 8825}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Container"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 1, Offset: 9100}, End: syntax.Cursor{Line: 399, Column: 97, Offset: 9196}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of\n", Value: "GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of", Start: syntax.Cursor{Line: 396, Column: 1, Offset: 8828}, End: syntax.Cursor{Line: 396, Column: 106, Offset: 8933}}, syntax.Token{Type: "<comment>", Raw: "# container names.  The definition of latest container means the latest by creation date.  In a multi-\n", Value: "container names.  The definition of latest container means the latest by creation date.  In a multi-", Start: syntax.Cursor{Line: 397, Column: 1, Offset: 8934}, End: syntax.Cursor{Line: 397, Column: 103, Offset: 9036}}, syntax.Token{Type: "<comment>", Raw: "# user environment, results might differ from what you expect.\n", Value: "user environment, results might differ from what you expect.", Start: syntax.Cursor{Line: 398, Column: 1, Offset: 9037}, End: syntax.Cursor{Line: 398, Column: 63, Offset: 9099}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainersByContext", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 30, Offset: 9129}, End: syntax.Cursor{Line: 399, Column: 71, Offset: 9170}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 31, Offset: 9130}, End: syntax.Cursor{Line: 399, Column: 40, Offset: 9139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "all", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 36, Offset: 9135}, End: syntax.Cursor{Line: 399, Column: 40, Offset: 9139}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 42, Offset: 9141}, End: syntax.Cursor{Line: 399, Column: 54, Offset: 9153}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "latest", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 50, Offset: 9149}, End: syntax.Cursor{Line: 399, Column: 54, Offset: 9153}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 56, Offset: 9155}, End: syntax.Cursor{Line: 399, Column: 70, Offset: 9169}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "args", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 62, Offset: 9161}, End: syntax.Cursor{Line: 399, Column: 70, Offset: 9169}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 64, Offset: 9163}, End: syntax.Cursor{Line: 399, Column: 70, Offset: 9169}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 75, Offset: 9174}, End: syntax.Cursor{Line: 399, Column: 97, Offset: 9196}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 76, Offset: 9175}, End: syntax.Cursor{Line: 399, Column: 96, Offset: 9195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 88, Offset: 9187}, End: syntax.Cursor{Line: 399, Column: 96, Offset: 9195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 399, Column: 90, Offset: 9189}, End: syntax.Cursor{Line: 399, Column: 96, Offset: 9195}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 1, Offset: 9492}, End: syntax.Cursor{Line: 404, Column: 61, Offset: 9552}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InspectContainer data takes a name or ID of a container returns the inspection\n", Value: "InspectContainer data takes a name or ID of a container returns the inspection", Start: syntax.Cursor{Line: 401, Column: 1, Offset: 9198}, End: syntax.Cursor{Line: 401, Column: 81, Offset: 9278}}, syntax.Token{Type: "<comment>", Raw: "# data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)\n", Value: "data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)", Start: syntax.Cursor{Line: 402, Column: 1, Offset: 9279}, End: syntax.Cursor{Line: 402, Column: 114, Offset: 9392}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).\n", Value: "error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).", Start: syntax.Cursor{Line: 403, Column: 1, Offset: 9393}, End: syntax.Cursor{Line: 403, Column: 99, Offset: 9491}}}, Tokens: []syntax.Token(nil)}, Name: "InspectContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 24, Offset: 9515}, End: syntax.Cursor{Line: 404, Column: 38, Offset: 9529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 25, Offset: 9516}, End: syntax.Cursor{Line: 404, Column: 37, Offset: 9528}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 31, Offset: 9522}, End: syntax.Cursor{Line: 404, Column: 37, Offset: 9528}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 42, Offset: 9533}, End: syntax.Cursor{Line: 404, Column: 61, Offset: 9552}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 43, Offset: 9534}, End: syntax.Cursor{Line: 404, Column: 60, Offset: 9551}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 404, Column: 54, Offset: 9545}, End: syntax.Cursor{Line: 404, Column: 60, Offset: 9551}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 1, Offset: 9868}, End: syntax.Cursor{Line: 410, Column: 85, Offset: 9952}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListContainerProcesses takes a name or ID of a container and returns the processes\n", Value: "ListContainerProcesses takes a name or ID of a container and returns the processes", Start: syntax.Cursor{Line: 406, Column: 1, Offset: 9554}, End: syntax.Cursor{Line: 406, Column: 85, Offset: 9638}}, syntax.Token{Type: "<comment>", Raw: "# running inside the container as array of strings.  It will accept an array of string\n", Value: "running inside the container as array of strings.  It will accept an array of string", Start: syntax.Cursor{Line: 407, Column: 1, Offset: 9639}, End: syntax.Cursor{Line: 407, Column: 87, Offset: 9725}}, syntax.Token{Type: "<comment>", Raw: "# arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)\n", Value: "arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)", Start: syntax.Cursor{Line: 408, Column: 1, Offset: 9726}, End: syntax.Cursor{Line: 408, Column: 116, Offset: 9841}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned.\n", Value: "error will be returned.", Start: syntax.Cursor{Line: 409, Column: 1, Offset: 9842}, End: syntax.Cursor{Line: 409, Column: 26, Offset: 9867}}}, Tokens: []syntax.Token(nil)}, Name: "ListContainerProcesses", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 30, Offset: 9897}, End: syntax.Cursor{Line: 410, Column: 60, Offset: 9927}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 31, Offset: 9898}, End: syntax.Cursor{Line: 410, Column: 43, Offset: 9910}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 37, Offset: 9904}, End: syntax.Cursor{Line: 410, Column: 43, Offset: 9910}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 45, Offset: 9912}, End: syntax.Cursor{Line: 410, Column: 59, Offset: 9926}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "opts", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 51, Offset: 9918}, End: syntax.Cursor{Line: 410, Column: 59, Offset: 9926}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 53, Offset: 9920}, End: syntax.Cursor{Line: 410, Column: 59, Offset: 9926}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 64, Offset: 9931}, End: syntax.Cursor{Line: 410, Column: 85, Offset: 9952}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 65, Offset: 9932}, End: syntax.Cursor{Line: 410, Column: 84, Offset: 9951}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 76, Offset: 9943}, End: syntax.Cursor{Line: 410, Column: 84, Offset: 9951}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 410, Column: 78, Offset: 9945}, End: syntax.Cursor{Line: 410, Column: 84, Offset: 9951}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 1, Offset: 10300}, End: syntax.Cursor{Line: 416, Column: 63, Offset: 10362}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainerLogs takes a name or ID of a container and returns the logs of that container.\n", Value: "GetContainerLogs takes a name or ID of a container and returns the logs of that container.", Start: syntax.Cursor{Line: 412, Column: 1, Offset: 9954}, End: syntax.Cursor{Line: 412, Column: 93, Offset: 10046}}, syntax.Token{Type: "<comment>", Raw: "# If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.\n", Value: "If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.", Start: syntax.Cursor{Line: 413, Column: 1, Offset: 10047}, End: syntax.Cursor{Line: 413, Column: 102, Offset: 10148}}, syntax.Token{Type: "<comment>", Raw: "# The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming\n", Value: "The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming", Start: syntax.Cursor{Line: 414, Column: 1, Offset: 10149}, End: syntax.Cursor{Line: 414, Column: 101, Offset: 10249}}, syntax.Token{Type: "<comment>", Raw: "# capability of varlink if the client invokes it.\n", Value: "capability of varlink if the client invokes it.", Start: syntax.Cursor{Line: 415, Column: 1, Offset: 10250}, End: syntax.Cursor{Line: 415, Column: 50, Offset: 10299}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainerLogs", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 24, Offset: 10323}, End: syntax.Cursor{Line: 416, Column: 38, Offset: 10337}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 25, Offset: 10324}, End: syntax.Cursor{Line: 416, Column: 37, Offset: 10336}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 31, Offset: 10330}, End: syntax.Cursor{Line: 416, Column: 37, Offset: 10336}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 42, Offset: 10341}, End: syntax.Cursor{Line: 416, Column: 63, Offset: 10362}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 43, Offset: 10342}, End: syntax.Cursor{Line: 416, Column: 62, Offset: 10361}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 54, Offset: 10353}, End: syntax.Cursor{Line: 416, Column: 62, Offset: 10361}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 416, Column: 56, Offset: 10355}, End: syntax.Cursor{Line: 416, Column: 62, Offset: 10361}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 1, Offset: 10364}, End: syntax.Cursor{Line: 418, Column: 132, Offset: 10495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "GetContainersLogs", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 25, Offset: 10388}, End: syntax.Cursor{Line: 418, Column: 114, Offset: 10477}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 26, Offset: 10389}, End: syntax.Cursor{Line: 418, Column: 41, Offset: 10404}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "names", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 33, Offset: 10396}, End: syntax.Cursor{Line: 418, Column: 41, Offset: 10404}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 35, Offset: 10398}, End: syntax.Cursor{Line: 418, Column: 41, Offset: 10404}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 43, Offset: 10406}, End: syntax.Cursor{Line: 418, Column: 55, Offset: 10418}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "follow", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 51, Offset: 10414}, End: syntax.Cursor{Line: 418, Column: 55, Offset: 10418}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 57, Offset: 10420}, End: syntax.Cursor{Line: 418, Column: 69, Offset: 10432}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "latest", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 65, Offset: 10428}, End: syntax.Cursor{Line: 418, Column: 69, Offset: 10432}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 71, Offset: 10434}, End: syntax.Cursor{Line: 418, Column: 84, Offset: 10447}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "since", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 78, Offset: 10441}, End: syntax.Cursor{Line: 418, Column: 84, Offset: 10447}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 86, Offset: 10449}, End: syntax.Cursor{Line: 418, Column: 95, Offset: 10458}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "tail", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 92, Offset: 10455}, End: syntax.Cursor{Line: 418, Column: 95, Offset: 10458}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 97, Offset: 10460}, End: syntax.Cursor{Line: 418, Column: 113, Offset: 10476}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "timestamps", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 109, Offset: 10472}, End: syntax.Cursor{Line: 418, Column: 113, Offset: 10476}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 118, Offset: 10481}, End: syntax.Cursor{Line: 418, Column: 132, Offset: 10495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 119, Offset: 10482}, End: syntax.Cursor{Line: 418, Column: 131, Offset: 10494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "log", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 418, Column: 124, Offset: 10487}, End: syntax.Cursor{Line: 418, Column: 131, Offset: 10494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "LogLine"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 1, Offset: 10681}, End: syntax.Cursor{Line: 422, Column: 75, Offset: 10755}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListContainerChanges takes a name or ID of a container and returns changes between the container and\n", Value: "ListContainerChanges takes a name or ID of a container and returns changes between the container and", Start: syntax.Cursor{Line: 420, Column: 1, Offset: 10497}, End: syntax.Cursor{Line: 420, Column: 103, Offset: 10599}}, syntax.Token{Type: "<comment>", Raw: "# its base image. It returns a struct of changed, deleted, and added path names.\n", Value: "its base image. It returns a struct of changed, deleted, and added path names.", Start: syntax.Cursor{Line: 421, Column: 1, Offset: 10600}, End: syntax.Cursor{Line: 421, Column: 81, Offset: 10680}}}, Tokens: []syntax.Token(nil)}, Name: "ListContainerChanges", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 28, Offset: 10708}, End: syntax.Cursor{Line: 422, Column: 42, Offset: 10722}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 29, Offset: 10709}, End: syntax.Cursor{Line: 422, Column: 41, Offset: 10721}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 35, Offset: 10715}, End: syntax.Cursor{Line: 422, Column: 41, Offset: 10721}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 46, Offset: 10726}, End: syntax.Cursor{Line: 422, Column: 75, Offset: 10755}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 47, Offset: 10727}, End: syntax.Cursor{Line: 422, Column: 74, Offset: 10754}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 422, Column: 58, Offset: 10738}, End: syntax.Cursor{Line: 422, Column: 74, Offset: 10754}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerChanges"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 1, Offset: 11016}, End: syntax.Cursor{Line: 427, Column: 72, Offset: 11087}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ExportContainer creates an image from a container.  It takes the name or ID of a container and a\n", Value: "ExportContainer creates an image from a container.  It takes the name or ID of a container and a", Start: syntax.Cursor{Line: 424, Column: 1, Offset: 10757}, End: syntax.Cursor{Line: 424, Column: 99, Offset: 10855}}, syntax.Token{Type: "<comment>", Raw: "# path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary\n", Value: "path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary", Start: syntax.Cursor{Line: 425, Column: 1, Offset: 10856}, End: syntax.Cursor{Line: 425, Column: 105, Offset: 10960}}, syntax.Token{Type: "<comment>", Raw: "# location and the path to that file will be returned.\n", Value: "location and the path to that file will be returned.", Start: syntax.Cursor{Line: 426, Column: 1, Offset: 10961}, End: syntax.Cursor{Line: 426, Column: 55, Offset: 11015}}}, Tokens: []syntax.Token(nil)}, Name: "ExportContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 23, Offset: 11038}, End: syntax.Cursor{Line: 427, Column: 51, Offset: 11066}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 24, Offset: 11039}, End: syntax.Cursor{Line: 427, Column: 36, Offset: 11051}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 30, Offset: 11045}, End: syntax.Cursor{Line: 427, Column: 36, Offset: 11051}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 38, Offset: 11053}, End: syntax.Cursor{Line: 427, Column: 50, Offset: 11065}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "path", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 44, Offset: 11059}, End: syntax.Cursor{Line: 427, Column: 50, Offset: 11065}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 55, Offset: 11070}, End: syntax.Cursor{Line: 427, Column: 72, Offset: 11087}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 56, Offset: 11071}, End: syntax.Cursor{Line: 427, Column: 71, Offset: 11086}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "tarfile", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 427, Column: 65, Offset: 11080}, End: syntax.Cursor{Line: 427, Column: 71, Offset: 11086}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 1, Offset: 11451}, End: syntax.Cursor{Line: 433, Column: 70, Offset: 11520}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which\n", Value: "GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which", Start: syntax.Cursor{Line: 429, Column: 1, Offset: 11089}, End: syntax.Cursor{Line: 429, Column: 108, Offset: 11196}}, syntax.Token{Type: "<comment>", Raw: "# contains attributes like memory and cpu usage.  If the container cannot be found, a\n", Value: "contains attributes like memory and cpu usage.  If the container cannot be found, a", Start: syntax.Cursor{Line: 430, Column: 1, Offset: 11197}, End: syntax.Cursor{Line: 430, Column: 86, Offset: 11282}}, syntax.Token{Type: "<comment>", Raw: "# [ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)\n", Value: "[ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)", Start: syntax.Cursor{Line: 431, Column: 1, Offset: 11283}, End: syntax.Cursor{Line: 431, Column: 143, Offset: 11425}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned\n", Value: "error will be returned", Start: syntax.Cursor{Line: 432, Column: 1, Offset: 11426}, End: syntax.Cursor{Line: 432, Column: 25, Offset: 11450}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainerStats", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 25, Offset: 11475}, End: syntax.Cursor{Line: 433, Column: 39, Offset: 11489}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 26, Offset: 11476}, End: syntax.Cursor{Line: 433, Column: 38, Offset: 11488}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 32, Offset: 11482}, End: syntax.Cursor{Line: 433, Column: 38, Offset: 11488}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 43, Offset: 11493}, End: syntax.Cursor{Line: 433, Column: 70, Offset: 11520}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 44, Offset: 11494}, End: syntax.Cursor{Line: 433, Column: 69, Offset: 11519}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 433, Column: 55, Offset: 11505}, End: syntax.Cursor{Line: 433, Column: 69, Offset: 11519}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerStats"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 1, Offset: 11709}, End: syntax.Cursor{Line: 437, Column: 98, Offset: 11806}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions\n", Value: "GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions", Start: syntax.Cursor{Line: 435, Column: 1, Offset: 11522}, End: syntax.Cursor{Line: 435, Column: 102, Offset: 11623}}, syntax.Token{Type: "<comment>", Raw: "# to calculate the containers statistics based on current and previous measurements.\n", Value: "to calculate the containers statistics based on current and previous measurements.", Start: syntax.Cursor{Line: 436, Column: 1, Offset: 11624}, End: syntax.Cursor{Line: 436, Column: 85, Offset: 11708}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainerStatsWithHistory", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 36, Offset: 11744}, End: syntax.Cursor{Line: 437, Column: 67, Offset: 11775}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 37, Offset: 11745}, End: syntax.Cursor{Line: 437, Column: 66, Offset: 11774}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "previousStats", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 52, Offset: 11760}, End: syntax.Cursor{Line: 437, Column: 66, Offset: 11774}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerStats"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 71, Offset: 11779}, End: syntax.Cursor{Line: 437, Column: 98, Offset: 11806}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 72, Offset: 11780}, End: syntax.Cursor{Line: 437, Column: 97, Offset: 11805}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 437, Column: 83, Offset: 11791}, End: syntax.Cursor{Line: 437, Column: 97, Offset: 11805}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ContainerStats"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 1, Offset: 12097}, End: syntax.Cursor{Line: 442, Column: 59, Offset: 12155}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns\n", Value: "StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns", Start: syntax.Cursor{Line: 439, Column: 1, Offset: 11808}, End: syntax.Cursor{Line: 439, Column: 106, Offset: 11913}}, syntax.Token{Type: "<comment>", Raw: "# the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)\n", Value: "the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)", Start: syntax.Cursor{Line: 440, Column: 1, Offset: 11914}, End: syntax.Cursor{Line: 440, Column: 110, Offset: 12023}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned.  See also [CreateContainer](#CreateContainer).\n", Value: "error will be returned.  See also [CreateContainer](#CreateContainer).", Start: syntax.Cursor{Line: 441, Column: 1, Offset: 12024}, End: syntax.Cursor{Line: 441, Column: 73, Offset: 12096}}}, Tokens: []syntax.Token(nil)}, Name: "StartContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 22, Offset: 12118}, End: syntax.Cursor{Line: 442, Column: 36, Offset: 12132}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 23, Offset: 12119}, End: syntax.Cursor{Line: 442, Column: 35, Offset: 12131}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 29, Offset: 12125}, End: syntax.Cursor{Line: 442, Column: 35, Offset: 12131}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 40, Offset: 12136}, End: syntax.Cursor{Line: 442, Column: 59, Offset: 12155}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 41, Offset: 12137}, End: syntax.Cursor{Line: 442, Column: 58, Offset: 12154}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 442, Column: 52, Offset: 12148}, End: syntax.Cursor{Line: 442, Column: 58, Offset: 12154}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 1, Offset: 12554}, End: syntax.Cursor{Line: 448, Column: 72, Offset: 12625}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a\n", Value: "StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a", Start: syntax.Cursor{Line: 444, Column: 1, Offset: 12157}, End: syntax.Cursor{Line: 444, Column: 104, Offset: 12260}}, syntax.Token{Type: "<comment>", Raw: "# timeout value.  The timeout value the time before a forcible stop to the container is applied.  It\n", Value: "timeout value.  The timeout value the time before a forcible stop to the container is applied.  It", Start: syntax.Cursor{Line: 445, Column: 1, Offset: 12261}, End: syntax.Cursor{Line: 445, Column: 101, Offset: 12361}}, syntax.Token{Type: "<comment>", Raw: "# returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)\n", Value: "returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)", Start: syntax.Cursor{Line: 446, Column: 1, Offset: 12362}, End: syntax.Cursor{Line: 446, Column: 117, Offset: 12478}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned instead. See also [KillContainer](KillContainer).\n", Value: "error will be returned instead. See also [KillContainer](KillContainer).", Start: syntax.Cursor{Line: 447, Column: 1, Offset: 12479}, End: syntax.Cursor{Line: 447, Column: 75, Offset: 12553}}}, Tokens: []syntax.Token(nil)}, Name: "StopContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 21, Offset: 12574}, End: syntax.Cursor{Line: 448, Column: 49, Offset: 12602}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 22, Offset: 12575}, End: syntax.Cursor{Line: 448, Column: 34, Offset: 12587}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 28, Offset: 12581}, End: syntax.Cursor{Line: 448, Column: 34, Offset: 12587}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 36, Offset: 12589}, End: syntax.Cursor{Line: 448, Column: 48, Offset: 12601}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "timeout", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 45, Offset: 12598}, End: syntax.Cursor{Line: 448, Column: 48, Offset: 12601}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 53, Offset: 12606}, End: syntax.Cursor{Line: 448, Column: 72, Offset: 12625}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 54, Offset: 12607}, End: syntax.Cursor{Line: 448, Column: 71, Offset: 12624}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 448, Column: 65, Offset: 12618}, End: syntax.Cursor{Line: 448, Column: 71, Offset: 12624}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 1, Offset: 12986}, End: syntax.Cursor{Line: 454, Column: 75, Offset: 13060}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# RestartContainer will restart a running container given a container name or ID and timeout value. The timeout\n", Value: "RestartContainer will restart a running container given a container name or ID and timeout value. The timeout", Start: syntax.Cursor{Line: 450, Column: 1, Offset: 12627}, End: syntax.Cursor{Line: 450, Column: 112, Offset: 12738}}, syntax.Token{Type: "<comment>", Raw: "# value is the time before a forcible stop is used to stop the container.  If the container cannot be found by\n", Value: "value is the time before a forcible stop is used to stop the container.  If the container cannot be found by", Start: syntax.Cursor{Line: 451, Column: 1, Offset: 12739}, End: syntax.Cursor{Line: 451, Column: 111, Offset: 12849}}, syntax.Token{Type: "<comment>", Raw: "# name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the\n", Value: "name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the", Start: syntax.Cursor{Line: 452, Column: 1, Offset: 12850}, End: syntax.Cursor{Line: 452, Column: 106, Offset: 12955}}, syntax.Token{Type: "<comment>", Raw: "# container will be returned.\n", Value: "container will be returned.", Start: syntax.Cursor{Line: 453, Column: 1, Offset: 12956}, End: syntax.Cursor{Line: 453, Column: 30, Offset: 12985}}}, Tokens: []syntax.Token(nil)}, Name: "RestartContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 24, Offset: 13009}, End: syntax.Cursor{Line: 454, Column: 52, Offset: 13037}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 25, Offset: 13010}, End: syntax.Cursor{Line: 454, Column: 37, Offset: 13022}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 31, Offset: 13016}, End: syntax.Cursor{Line: 454, Column: 37, Offset: 13022}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 39, Offset: 13024}, End: syntax.Cursor{Line: 454, Column: 51, Offset: 13036}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "timeout", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 48, Offset: 13033}, End: syntax.Cursor{Line: 454, Column: 51, Offset: 13036}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 56, Offset: 13041}, End: syntax.Cursor{Line: 454, Column: 75, Offset: 13060}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 57, Offset: 13042}, End: syntax.Cursor{Line: 454, Column: 74, Offset: 13059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 454, Column: 68, Offset: 13053}, End: syntax.Cursor{Line: 454, Column: 74, Offset: 13059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 1, Offset: 13376}, End: syntax.Cursor{Line: 459, Column: 71, Offset: 13446}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the\n", Value: "KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the", Start: syntax.Cursor{Line: 456, Column: 1, Offset: 13062}, End: syntax.Cursor{Line: 456, Column: 114, Offset: 13175}}, syntax.Token{Type: "<comment>", Raw: "# container has been killed, the container's ID is returned.  If the container cannot be found, a\n", Value: "container has been killed, the container's ID is returned.  If the container cannot be found, a", Start: syntax.Cursor{Line: 457, Column: 1, Offset: 13176}, End: syntax.Cursor{Line: 457, Column: 98, Offset: 13273}}, syntax.Token{Type: "<comment>", Raw: "# [ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).\n", Value: "[ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).", Start: syntax.Cursor{Line: 458, Column: 1, Offset: 13274}, End: syntax.Cursor{Line: 458, Column: 102, Offset: 13375}}}, Tokens: []syntax.Token(nil)}, Name: "KillContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 21, Offset: 13396}, End: syntax.Cursor{Line: 459, Column: 48, Offset: 13423}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 22, Offset: 13397}, End: syntax.Cursor{Line: 459, Column: 34, Offset: 13409}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 28, Offset: 13403}, End: syntax.Cursor{Line: 459, Column: 34, Offset: 13409}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 36, Offset: 13411}, End: syntax.Cursor{Line: 459, Column: 47, Offset: 13422}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "signal", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 44, Offset: 13419}, End: syntax.Cursor{Line: 459, Column: 47, Offset: 13422}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 52, Offset: 13427}, End: syntax.Cursor{Line: 459, Column: 71, Offset: 13446}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 53, Offset: 13428}, End: syntax.Cursor{Line: 459, Column: 70, Offset: 13445}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 459, Column: 64, Offset: 13439}, End: syntax.Cursor{Line: 459, Column: 70, Offset: 13445}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 1, Offset: 13714}, End: syntax.Cursor{Line: 464, Column: 59, Offset: 13772}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,\n", Value: "PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,", Start: syntax.Cursor{Line: 461, Column: 1, Offset: 13448}, End: syntax.Cursor{Line: 461, Column: 101, Offset: 13548}}, syntax.Token{Type: "<comment>", Raw: "# a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.\n", Value: "a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.", Start: syntax.Cursor{Line: 462, Column: 1, Offset: 13549}, End: syntax.Cursor{Line: 462, Column: 115, Offset: 13663}}, syntax.Token{Type: "<comment>", Raw: "# See also [UnpauseContainer](#UnpauseContainer).\n", Value: "See also [UnpauseContainer](#UnpauseContainer).", Start: syntax.Cursor{Line: 463, Column: 1, Offset: 13664}, End: syntax.Cursor{Line: 463, Column: 50, Offset: 13713}}}, Tokens: []syntax.Token(nil)}, Name: "PauseContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 22, Offset: 13735}, End: syntax.Cursor{Line: 464, Column: 36, Offset: 13749}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 23, Offset: 13736}, End: syntax.Cursor{Line: 464, Column: 35, Offset: 13748}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 29, Offset: 13742}, End: syntax.Cursor{Line: 464, Column: 35, Offset: 13748}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 40, Offset: 13753}, End: syntax.Cursor{Line: 464, Column: 59, Offset: 13772}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 41, Offset: 13754}, End: syntax.Cursor{Line: 464, Column: 58, Offset: 13771}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 464, Column: 52, Offset: 13765}, End: syntax.Cursor{Line: 464, Column: 58, Offset: 13771}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 1, Offset: 14056}, End: syntax.Cursor{Line: 469, Column: 61, Offset: 14116}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be\n", Value: "UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be", Start: syntax.Cursor{Line: 466, Column: 1, Offset: 13774}, End: syntax.Cursor{Line: 466, Column: 114, Offset: 13887}}, syntax.Token{Type: "<comment>", Raw: "# found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.\n", Value: "found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.", Start: syntax.Cursor{Line: 467, Column: 1, Offset: 13888}, End: syntax.Cursor{Line: 467, Column: 122, Offset: 14009}}, syntax.Token{Type: "<comment>", Raw: "# See also [PauseContainer](#PauseContainer).\n", Value: "See also [PauseContainer](#PauseContainer).", Start: syntax.Cursor{Line: 468, Column: 1, Offset: 14010}, End: syntax.Cursor{Line: 468, Column: 46, Offset: 14055}}}, Tokens: []syntax.Token(nil)}, Name: "UnpauseContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 24, Offset: 14079}, End: syntax.Cursor{Line: 469, Column: 38, Offset: 14093}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 25, Offset: 14080}, End: syntax.Cursor{Line: 469, Column: 37, Offset: 14092}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 31, Offset: 14086}, End: syntax.Cursor{Line: 469, Column: 37, Offset: 14092}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 42, Offset: 14097}, End: syntax.Cursor{Line: 469, Column: 61, Offset: 14116}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 43, Offset: 14098}, End: syntax.Cursor{Line: 469, Column: 60, Offset: 14115}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 469, Column: 54, Offset: 14109}, End: syntax.Cursor{Line: 469, Column: 60, Offset: 14115}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 1, Offset: 14427}, End: syntax.Cursor{Line: 474, Column: 69, Offset: 14495}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container\n", Value: "WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container", Start: syntax.Cursor{Line: 471, Column: 1, Offset: 14118}, End: syntax.Cursor{Line: 471, Column: 117, Offset: 14234}}, syntax.Token{Type: "<comment>", Raw: "# stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID\n", Value: "stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID", Start: syntax.Cursor{Line: 472, Column: 1, Offset: 14235}, End: syntax.Cursor{Line: 472, Column: 120, Offset: 14354}}, syntax.Token{Type: "<comment>", Raw: "# or name, a [ContainerNotFound](#ContainerNotFound) error is returned.\n", Value: "or name, a [ContainerNotFound](#ContainerNotFound) error is returned.", Start: syntax.Cursor{Line: 473, Column: 1, Offset: 14355}, End: syntax.Cursor{Line: 473, Column: 72, Offset: 14426}}}, Tokens: []syntax.Token(nil)}, Name: "WaitContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 21, Offset: 14447}, End: syntax.Cursor{Line: 474, Column: 50, Offset: 14476}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 22, Offset: 14448}, End: syntax.Cursor{Line: 474, Column: 34, Offset: 14460}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 28, Offset: 14454}, End: syntax.Cursor{Line: 474, Column: 34, Offset: 14460}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 36, Offset: 14462}, End: syntax.Cursor{Line: 474, Column: 49, Offset: 14475}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "interval", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 46, Offset: 14472}, End: syntax.Cursor{Line: 474, Column: 49, Offset: 14475}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 54, Offset: 14480}, End: syntax.Cursor{Line: 474, Column: 69, Offset: 14495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 55, Offset: 14481}, End: syntax.Cursor{Line: 474, Column: 68, Offset: 14494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "exitcode", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 474, Column: 65, Offset: 14491}, End: syntax.Cursor{Line: 474, Column: 68, Offset: 14494}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 1, Offset: 14953}, End: syntax.Cursor{Line: 482, Column: 94, Offset: 15046}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# RemoveContainer requires the name or ID of a container as well as a boolean that\n", Value: "RemoveContainer requires the name or ID of a container as well as a boolean that", Start: syntax.Cursor{Line: 476, Column: 1, Offset: 14497}, End: syntax.Cursor{Line: 476, Column: 83, Offset: 14579}}, syntax.Token{Type: "<comment>", Raw: "# indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean\n", Value: "indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean", Start: syntax.Cursor{Line: 477, Column: 1, Offset: 14580}, End: syntax.Cursor{Line: 477, Column: 99, Offset: 14678}}, syntax.Token{Type: "<comment>", Raw: "# indicating whether to remove builtin volumes. Upon successful removal of the\n", Value: "indicating whether to remove builtin volumes. Upon successful removal of the", Start: syntax.Cursor{Line: 478, Column: 1, Offset: 14679}, End: syntax.Cursor{Line: 478, Column: 79, Offset: 14757}}, syntax.Token{Type: "<comment>", Raw: "# container, its ID is returned.  If the\n", Value: "container, its ID is returned.  If the", Start: syntax.Cursor{Line: 479, Column: 1, Offset: 14758}, End: syntax.Cursor{Line: 479, Column: 41, Offset: 14798}}, syntax.Token{Type: "<comment>", Raw: "# container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.\n", Value: "container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.", Start: syntax.Cursor{Line: 480, Column: 1, Offset: 14799}, End: syntax.Cursor{Line: 480, Column: 109, Offset: 14907}}, syntax.Token{Type: "<comment>", Raw: "# See also [EvictContainer](EvictContainer).\n", Value: "See also [EvictContainer](EvictContainer).", Start: syntax.Cursor{Line: 481, Column: 1, Offset: 14908}, End: syntax.Cursor{Line: 481, Column: 45, Offset: 14952}}}, Tokens: []syntax.Token(nil)}, Name: "RemoveContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 23, Offset: 14975}, End: syntax.Cursor{Line: 482, Column: 71, Offset: 15023}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 24, Offset: 14976}, End: syntax.Cursor{Line: 482, Column: 36, Offset: 14988}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 30, Offset: 14982}, End: syntax.Cursor{Line: 482, Column: 36, Offset: 14988}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 38, Offset: 14990}, End: syntax.Cursor{Line: 482, Column: 49, Offset: 15001}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "force", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 45, Offset: 14997}, End: syntax.Cursor{Line: 482, Column: 49, Offset: 15001}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 51, Offset: 15003}, End: syntax.Cursor{Line: 482, Column: 70, Offset: 15022}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "removeVolumes", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 66, Offset: 15018}, End: syntax.Cursor{Line: 482, Column: 70, Offset: 15022}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 75, Offset: 15027}, End: syntax.Cursor{Line: 482, Column: 94, Offset: 15046}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 76, Offset: 15028}, End: syntax.Cursor{Line: 482, Column: 93, Offset: 15045}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "container", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 482, Column: 87, Offset: 15039}, End: syntax.Cursor{Line: 482, Column: 93, Offset: 15045}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 1, Offset: 15220}, End: syntax.Cursor{Line: 486, Column: 59, Offset: 15278}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted\n", Value: "DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted", Start: syntax.Cursor{Line: 484, Column: 1, Offset: 15048}, End: syntax.Cursor{Line: 484, Column: 109, Offset: 15156}}, syntax.Token{Type: "<comment>", Raw: "# container IDs.  See also [RemoveContainer](RemoveContainer).\n", Value: "container IDs.  See also [RemoveContainer](RemoveContainer).", Start: syntax.Cursor{Line: 485, Column: 1, Offset: 15157}, End: syntax.Cursor{Line: 485, Column: 63, Offset: 15219}}}, Tokens: []syntax.Token(nil)}, Name: "DeleteStoppedContainers", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 31, Offset: 15250}, End: syntax.Cursor{Line: 486, Column: 33, Offset: 15252}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 37, Offset: 15256}, End: syntax.Cursor{Line: 486, Column: 59, Offset: 15278}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 38, Offset: 15257}, End: syntax.Cursor{Line: 486, Column: 58, Offset: 15277}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "containers", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 50, Offset: 15269}, End: syntax.Cursor{Line: 486, Column: 58, Offset: 15277}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 486, Column: 52, Offset: 15271}, End: syntax.Cursor{Line: 486, Column: 58, Offset: 15277}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 1, Offset: 15403}, End: syntax.Cursor{Line: 490, Column: 41, Offset: 15443}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListImages returns information about the images that are currently in storage.\n", Value: "ListImages returns information about the images that are currently in storage.", Start: syntax.Cursor{Line: 488, Column: 1, Offset: 15280}, End: syntax.Cursor{Line: 488, Column: 81, Offset: 15360}}, syntax.Token{Type: "<comment>", Raw: "# See also [InspectImage](#InspectImage).\n", Value: "See also [InspectImage](#InspectImage).", Start: syntax.Cursor{Line: 489, Column: 1, Offset: 15361}, End: syntax.Cursor{Line: 489, Column: 42, Offset: 15402}}}, Tokens: []syntax.Token(nil)}, Name: "ListImages", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 18, Offset: 15420}, End: syntax.Cursor{Line: 490, Column: 20, Offset: 15422}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 24, Offset: 15426}, End: syntax.Cursor{Line: 490, Column: 41, Offset: 15443}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 25, Offset: 15427}, End: syntax.Cursor{Line: 490, Column: 40, Offset: 15442}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "images", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 33, Offset: 15435}, End: syntax.Cursor{Line: 490, Column: 40, Offset: 15442}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 490, Column: 35, Offset: 15437}, End: syntax.Cursor{Line: 490, Column: 40, Offset: 15442}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Image"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 1, Offset: 15603}, End: syntax.Cursor{Line: 494, Column: 46, Offset: 15648}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetImage returns information about a single image in storage.\n", Value: "GetImage returns information about a single image in storage.", Start: syntax.Cursor{Line: 492, Column: 1, Offset: 15445}, End: syntax.Cursor{Line: 492, Column: 64, Offset: 15508}}, syntax.Token{Type: "<comment>", Raw: "# If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.\n", Value: "If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.", Start: syntax.Cursor{Line: 493, Column: 1, Offset: 15509}, End: syntax.Cursor{Line: 493, Column: 94, Offset: 15602}}}, Tokens: []syntax.Token(nil)}, Name: "GetImage", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 16, Offset: 15618}, End: syntax.Cursor{Line: 494, Column: 28, Offset: 15630}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 17, Offset: 15619}, End: syntax.Cursor{Line: 494, Column: 27, Offset: 15629}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 21, Offset: 15623}, End: syntax.Cursor{Line: 494, Column: 27, Offset: 15629}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 32, Offset: 15634}, End: syntax.Cursor{Line: 494, Column: 46, Offset: 15648}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 33, Offset: 15635}, End: syntax.Cursor{Line: 494, Column: 45, Offset: 15647}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 494, Column: 40, Offset: 15642}, End: syntax.Cursor{Line: 494, Column: 45, Offset: 15647}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Image"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 1, Offset: 15921}, End: syntax.Cursor{Line: 499, Column: 53, Offset: 15973}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InspectImage takes the name or ID of an image and returns a string representation of data associated with the\n", Value: "InspectImage takes the name or ID of an image and returns a string representation of data associated with the", Start: syntax.Cursor{Line: 496, Column: 1, Offset: 15650}, End: syntax.Cursor{Line: 496, Column: 112, Offset: 15761}}, syntax.Token{Type: "<comment>", Raw: "#image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will\n", Value: "image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will", Start: syntax.Cursor{Line: 497, Column: 1, Offset: 15762}, End: syntax.Cursor{Line: 497, Column: 115, Offset: 15876}}, syntax.Token{Type: "<comment>", Raw: "# be returned if the image cannot be found.\n", Value: "be returned if the image cannot be found.", Start: syntax.Cursor{Line: 498, Column: 1, Offset: 15877}, End: syntax.Cursor{Line: 498, Column: 44, Offset: 15920}}}, Tokens: []syntax.Token(nil)}, Name: "InspectImage", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 20, Offset: 15940}, End: syntax.Cursor{Line: 499, Column: 34, Offset: 15954}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 21, Offset: 15941}, End: syntax.Cursor{Line: 499, Column: 33, Offset: 15953}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 27, Offset: 15947}, End: syntax.Cursor{Line: 499, Column: 33, Offset: 15953}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 38, Offset: 15958}, End: syntax.Cursor{Line: 499, Column: 53, Offset: 15973}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 39, Offset: 15959}, End: syntax.Cursor{Line: 499, Column: 52, Offset: 15972}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 499, Column: 46, Offset: 15966}, End: syntax.Cursor{Line: 499, Column: 52, Offset: 15972}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 1, Offset: 16243}, End: syntax.Cursor{Line: 504, Column: 63, Offset: 16305}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned\n", Value: "HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned", Start: syntax.Cursor{Line: 501, Column: 1, Offset: 15975}, End: syntax.Cursor{Line: 501, Column: 116, Offset: 16090}}, syntax.Token{Type: "<comment>", Raw: "# history is in the form of an array of ImageHistory structures.  If the image cannot be found, an\n", Value: "history is in the form of an array of ImageHistory structures.  If the image cannot be found, an", Start: syntax.Cursor{Line: 502, Column: 1, Offset: 16091}, End: syntax.Cursor{Line: 502, Column: 99, Offset: 16189}}, syntax.Token{Type: "<comment>", Raw: "# [ImageNotFound](#ImageNotFound) error is returned.\n", Value: "[ImageNotFound](#ImageNotFound) error is returned.", Start: syntax.Cursor{Line: 503, Column: 1, Offset: 16190}, End: syntax.Cursor{Line: 503, Column: 53, Offset: 16242}}}, Tokens: []syntax.Token(nil)}, Name: "HistoryImage", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 20, Offset: 16262}, End: syntax.Cursor{Line: 504, Column: 34, Offset: 16276}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 21, Offset: 16263}, End: syntax.Cursor{Line: 504, Column: 33, Offset: 16275}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 27, Offset: 16269}, End: syntax.Cursor{Line: 504, Column: 33, Offset: 16275}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 38, Offset: 16280}, End: syntax.Cursor{Line: 504, Column: 63, Offset: 16305}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 39, Offset: 16281}, End: syntax.Cursor{Line: 504, Column: 62, Offset: 16304}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "history", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 48, Offset: 16290}, End: syntax.Cursor{Line: 504, Column: 62, Offset: 16304}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 504, Column: 50, Offset: 16292}, End: syntax.Cursor{Line: 504, Column: 62, Offset: 16304}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ImageHistory"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 1, Offset: 16548}, End: syntax.Cursor{Line: 508, Column: 65, Offset: 16612}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot\n", Value: "TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot", Start: syntax.Cursor{Line: 506, Column: 1, Offset: 16307}, End: syntax.Cursor{Line: 506, Column: 115, Offset: 16421}}, syntax.Token{Type: "<comment>", Raw: "# be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.\n", Value: "be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.", Start: syntax.Cursor{Line: 507, Column: 1, Offset: 16422}, End: syntax.Cursor{Line: 507, Column: 126, Offset: 16547}}}, Tokens: []syntax.Token(nil)}, Name: "TagImage", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 16, Offset: 16563}, End: syntax.Cursor{Line: 508, Column: 46, Offset: 16593}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 17, Offset: 16564}, End: syntax.Cursor{Line: 508, Column: 29, Offset: 16576}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 23, Offset: 16570}, End: syntax.Cursor{Line: 508, Column: 29, Offset: 16576}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 31, Offset: 16578}, End: syntax.Cursor{Line: 508, Column: 45, Offset: 16592}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "tagged", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 39, Offset: 16586}, End: syntax.Cursor{Line: 508, Column: 45, Offset: 16592}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 50, Offset: 16597}, End: syntax.Cursor{Line: 508, Column: 65, Offset: 16612}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 51, Offset: 16598}, End: syntax.Cursor{Line: 508, Column: 64, Offset: 16611}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 508, Column: 58, Offset: 16605}, End: syntax.Cursor{Line: 508, Column: 64, Offset: 16611}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 1, Offset: 16950}, End: syntax.Cursor{Line: 513, Column: 65, Offset: 17014}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image\n", Value: "RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image", Start: syntax.Cursor{Line: 510, Column: 1, Offset: 16614}, End: syntax.Cursor{Line: 510, Column: 115, Offset: 16728}}, syntax.Token{Type: "<comment>", Raw: "# should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The\n", Value: "should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The", Start: syntax.Cursor{Line: 511, Column: 1, Offset: 16729}, End: syntax.Cursor{Line: 511, Column: 116, Offset: 16844}}, syntax.Token{Type: "<comment>", Raw: "# ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).\n", Value: "ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).", Start: syntax.Cursor{Line: 512, Column: 1, Offset: 16845}, End: syntax.Cursor{Line: 512, Column: 105, Offset: 16949}}}, Tokens: []syntax.Token(nil)}, Name: "RemoveImage", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 19, Offset: 16968}, End: syntax.Cursor{Line: 513, Column: 46, Offset: 16995}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 20, Offset: 16969}, End: syntax.Cursor{Line: 513, Column: 32, Offset: 16981}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 26, Offset: 16975}, End: syntax.Cursor{Line: 513, Column: 32, Offset: 16981}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 34, Offset: 16983}, End: syntax.Cursor{Line: 513, Column: 45, Offset: 16994}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "force", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 41, Offset: 16990}, End: syntax.Cursor{Line: 513, Column: 45, Offset: 16994}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 50, Offset: 16999}, End: syntax.Cursor{Line: 513, Column: 65, Offset: 17014}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 51, Offset: 17000}, End: syntax.Cursor{Line: 513, Column: 64, Offset: 17013}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "image", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 513, Column: 58, Offset: 17007}, End: syntax.Cursor{Line: 513, Column: 64, Offset: 17013}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 1, Offset: 17199}, End: syntax.Cursor{Line: 518, Column: 109, Offset: 17307}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# SearchImages searches available registries for images that contain the\n", Value: "SearchImages searches available registries for images that contain the", Start: syntax.Cursor{Line: 515, Column: 1, Offset: 17016}, End: syntax.Cursor{Line: 515, Column: 73, Offset: 17088}}, syntax.Token{Type: "<comment>", Raw: "# contents of \"query\" in their name. If \"limit\" is given, limits the amount of\n", Value: "contents of \"query\" in their name. If \"limit\" is given, limits the amount of", Start: syntax.Cursor{Line: 516, Column: 1, Offset: 17089}, End: syntax.Cursor{Line: 516, Column: 79, Offset: 17167}}, syntax.Token{Type: "<comment>", Raw: "# search results per registry.\n", Value: "search results per registry.", Start: syntax.Cursor{Line: 517, Column: 1, Offset: 17168}, End: syntax.Cursor{Line: 517, Column: 31, Offset: 17198}}}, Tokens: []syntax.Token(nil)}, Name: "SearchImages", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 20, Offset: 17218}, End: syntax.Cursor{Line: 518, Column: 75, Offset: 17273}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 21, Offset: 17219}, End: syntax.Cursor{Line: 518, Column: 34, Offset: 17232}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "query", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 28, Offset: 17226}, End: syntax.Cursor{Line: 518, Column: 34, Offset: 17232}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 36, Offset: 17234}, End: syntax.Cursor{Line: 518, Column: 47, Offset: 17245}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "limit", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 43, Offset: 17241}, End: syntax.Cursor{Line: 518, Column: 47, Offset: 17245}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 44, Offset: 17242}, End: syntax.Cursor{Line: 518, Column: 47, Offset: 17245}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 49, Offset: 17247}, End: syntax.Cursor{Line: 518, Column: 74, Offset: 17272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "filter", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 57, Offset: 17255}, End: syntax.Cursor{Line: 518, Column: 74, Offset: 17272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ImageSearchFilter"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 79, Offset: 17277}, End: syntax.Cursor{Line: 518, Column: 109, Offset: 17307}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 80, Offset: 17278}, End: syntax.Cursor{Line: 518, Column: 108, Offset: 17306}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "results", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 89, Offset: 17287}, End: syntax.Cursor{Line: 518, Column: 108, Offset: 17306}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 518, Column: 91, Offset: 17289}, End: syntax.Cursor{Line: 518, Column: 108, Offset: 17306}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ImageSearchResult"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 1, Offset: 17447}, End: syntax.Cursor{Line: 522, Column: 50, Offset: 17496}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned\n", Value: "DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned", Start: syntax.Cursor{Line: 520, Column: 1, Offset: 17309}, End: syntax.Cursor{Line: 520, Column: 117, Offset: 17425}}, syntax.Token{Type: "<comment>", Raw: "# in a string array.\n", Value: "in a string array.", Start: syntax.Cursor{Line: 521, Column: 1, Offset: 17426}, End: syntax.Cursor{Line: 521, Column: 21, Offset: 17446}}}, Tokens: []syntax.Token(nil)}, Name: "DeleteUnusedImages", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 26, Offset: 17472}, End: syntax.Cursor{Line: 522, Column: 28, Offset: 17474}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 32, Offset: 17478}, End: syntax.Cursor{Line: 522, Column: 50, Offset: 17496}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 33, Offset: 17479}, End: syntax.Cursor{Line: 522, Column: 49, Offset: 17495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "images", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 41, Offset: 17487}, End: syntax.Cursor{Line: 522, Column: 49, Offset: 17495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 522, Column: 43, Offset: 17489}, End: syntax.Cursor{Line: 522, Column: 49, Offset: 17495}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 1, Offset: 17753}, End: syntax.Cursor{Line: 527, Column: 50, Offset: 17802}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ImageExists talks a full or partial image ID or name and returns an int as to whether\n", Value: "ImageExists talks a full or partial image ID or name and returns an int as to whether", Start: syntax.Cursor{Line: 524, Column: 1, Offset: 17498}, End: syntax.Cursor{Line: 524, Column: 88, Offset: 17585}}, syntax.Token{Type: "<comment>", Raw: "# the image exists in local storage. An int result of 0 means the image does exist in\n", Value: "the image exists in local storage. An int result of 0 means the image does exist in", Start: syntax.Cursor{Line: 525, Column: 1, Offset: 17586}, End: syntax.Cursor{Line: 525, Column: 86, Offset: 17671}}, syntax.Token{Type: "<comment>", Raw: "# local storage; whereas 1 indicates the image does not exists in local storage.\n", Value: "local storage; whereas 1 indicates the image does not exists in local storage.", Start: syntax.Cursor{Line: 526, Column: 1, Offset: 17672}, End: syntax.Cursor{Line: 526, Column: 81, Offset: 17752}}}, Tokens: []syntax.Token(nil)}, Name: "ImageExists", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 19, Offset: 17771}, End: syntax.Cursor{Line: 527, Column: 33, Offset: 17785}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 20, Offset: 17772}, End: syntax.Cursor{Line: 527, Column: 32, Offset: 17784}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 26, Offset: 17778}, End: syntax.Cursor{Line: 527, Column: 32, Offset: 17784}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 37, Offset: 17789}, End: syntax.Cursor{Line: 527, Column: 50, Offset: 17802}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 38, Offset: 17790}, End: syntax.Cursor{Line: 527, Column: 49, Offset: 17801}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "exists", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 527, Column: 46, Offset: 17798}, End: syntax.Cursor{Line: 527, Column: 49, Offset: 17801}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 1, Offset: 18042}, End: syntax.Cursor{Line: 532, Column: 54, Offset: 18095}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerExists takes a full or partial container ID or name and returns an int as to\n", Value: "ContainerExists takes a full or partial container ID or name and returns an int as to", Start: syntax.Cursor{Line: 529, Column: 1, Offset: 17804}, End: syntax.Cursor{Line: 529, Column: 88, Offset: 17891}}, syntax.Token{Type: "<comment>", Raw: "# whether the container exists in local storage.  A result of 0 means the container does\n", Value: "whether the container exists in local storage.  A result of 0 means the container does", Start: syntax.Cursor{Line: 530, Column: 1, Offset: 17892}, End: syntax.Cursor{Line: 530, Column: 89, Offset: 17980}}, syntax.Token{Type: "<comment>", Raw: "# exists; whereas a result of 1 means it could not be found.\n", Value: "exists; whereas a result of 1 means it could not be found.", Start: syntax.Cursor{Line: 531, Column: 1, Offset: 17981}, End: syntax.Cursor{Line: 531, Column: 61, Offset: 18041}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerExists", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 23, Offset: 18064}, End: syntax.Cursor{Line: 532, Column: 37, Offset: 18078}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 24, Offset: 18065}, End: syntax.Cursor{Line: 532, Column: 36, Offset: 18077}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 30, Offset: 18071}, End: syntax.Cursor{Line: 532, Column: 36, Offset: 18077}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 41, Offset: 18082}, End: syntax.Cursor{Line: 532, Column: 54, Offset: 18095}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 42, Offset: 18083}, End: syntax.Cursor{Line: 532, Column: 53, Offset: 18094}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "exists", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 532, Column: 50, Offset: 18091}, End: syntax.Cursor{Line: 532, Column: 53, Offset: 18094}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 1, Offset: 18241}, End: syntax.Cursor{Line: 536, Column: 43, Offset: 18283}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ListPods returns a list of pods in no particular order.  They are\n", Value: "ListPods returns a list of pods in no particular order.  They are", Start: syntax.Cursor{Line: 534, Column: 1, Offset: 18097}, End: syntax.Cursor{Line: 534, Column: 68, Offset: 18164}}, syntax.Token{Type: "<comment>", Raw: "# returned as an array of ListPodData structs.  See also [GetPod](#GetPod).\n", Value: "returned as an array of ListPodData structs.  See also [GetPod](#GetPod).", Start: syntax.Cursor{Line: 535, Column: 1, Offset: 18165}, End: syntax.Cursor{Line: 535, Column: 76, Offset: 18240}}}, Tokens: []syntax.Token(nil)}, Name: "ListPods", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 16, Offset: 18256}, End: syntax.Cursor{Line: 536, Column: 18, Offset: 18258}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 22, Offset: 18262}, End: syntax.Cursor{Line: 536, Column: 43, Offset: 18283}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 23, Offset: 18263}, End: syntax.Cursor{Line: 536, Column: 42, Offset: 18282}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pods", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 29, Offset: 18269}, End: syntax.Cursor{Line: 536, Column: 42, Offset: 18282}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 536, Column: 31, Offset: 18271}, End: syntax.Cursor{Line: 536, Column: 42, Offset: 18282}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ListPodData"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 1, Offset: 18497}, End: syntax.Cursor{Line: 541, Column: 50, Offset: 18546}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)\n", Value: "GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)", Start: syntax.Cursor{Line: 538, Column: 1, Offset: 18285}, End: syntax.Cursor{Line: 538, Column: 84, Offset: 18368}}, syntax.Token{Type: "<comment>", Raw: "# structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.\n", Value: "structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.", Start: syntax.Cursor{Line: 539, Column: 1, Offset: 18369}, End: syntax.Cursor{Line: 539, Column: 95, Offset: 18463}}, syntax.Token{Type: "<comment>", Raw: "# See also [ListPods](ListPods).\n", Value: "See also [ListPods](ListPods).", Start: syntax.Cursor{Line: 540, Column: 1, Offset: 18464}, End: syntax.Cursor{Line: 540, Column: 33, Offset: 18496}}}, Tokens: []syntax.Token(nil)}, Name: "GetPod", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 14, Offset: 18510}, End: syntax.Cursor{Line: 541, Column: 28, Offset: 18524}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 15, Offset: 18511}, End: syntax.Cursor{Line: 541, Column: 27, Offset: 18523}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 21, Offset: 18517}, End: syntax.Cursor{Line: 541, Column: 27, Offset: 18523}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 32, Offset: 18528}, End: syntax.Cursor{Line: 541, Column: 50, Offset: 18546}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 33, Offset: 18529}, End: syntax.Cursor{Line: 541, Column: 49, Offset: 18545}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 541, Column: 38, Offset: 18534}, End: syntax.Cursor{Line: 541, Column: 49, Offset: 18545}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ListPodData"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 1, Offset: 19031}, End: syntax.Cursor{Line: 548, Column: 47, Offset: 19077}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)\n", Value: "StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)", Start: syntax.Cursor{Line: 543, Column: 1, Offset: 18548}, End: syntax.Cursor{Line: 543, Column: 131, Offset: 18678}}, syntax.Token{Type: "<comment>", Raw: "# error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers\n", Value: "error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers", Start: syntax.Cursor{Line: 544, Column: 1, Offset: 18679}, End: syntax.Cursor{Line: 544, Column: 146, Offset: 18824}}, syntax.Token{Type: "<comment>", Raw: "# will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).\n", Value: "will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).", Start: syntax.Cursor{Line: 545, Column: 1, Offset: 18825}, End: syntax.Cursor{Line: 545, Column: 105, Offset: 18929}}, syntax.Token{Type: "<comment>", Raw: "# If the pod was started with no errors, the pod ID is returned.\n", Value: "If the pod was started with no errors, the pod ID is returned.", Start: syntax.Cursor{Line: 546, Column: 1, Offset: 18930}, End: syntax.Cursor{Line: 546, Column: 65, Offset: 18994}}, syntax.Token{Type: "<comment>", Raw: "# See also [CreatePod](#CreatePod).\n", Value: "See also [CreatePod](#CreatePod).", Start: syntax.Cursor{Line: 547, Column: 1, Offset: 18995}, End: syntax.Cursor{Line: 547, Column: 36, Offset: 19030}}}, Tokens: []syntax.Token(nil)}, Name: "StartPod", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 16, Offset: 19046}, End: syntax.Cursor{Line: 548, Column: 30, Offset: 19060}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 17, Offset: 19047}, End: syntax.Cursor{Line: 548, Column: 29, Offset: 19059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 23, Offset: 19053}, End: syntax.Cursor{Line: 548, Column: 29, Offset: 19059}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 34, Offset: 19064}, End: syntax.Cursor{Line: 548, Column: 47, Offset: 19077}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 35, Offset: 19065}, End: syntax.Cursor{Line: 548, Column: 46, Offset: 19076}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 548, Column: 40, Offset: 19070}, End: syntax.Cursor{Line: 548, Column: 46, Offset: 19076}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 1, Offset: 19701}, End: syntax.Cursor{Line: 557, Column: 61, Offset: 19761}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# RemovePod takes the name or ID of a pod as well a boolean representing whether a running\n", Value: "RemovePod takes the name or ID of a pod as well a boolean representing whether a running", Start: syntax.Cursor{Line: 550, Column: 1, Offset: 19079}, End: syntax.Cursor{Line: 550, Column: 91, Offset: 19169}}, syntax.Token{Type: "<comment>", Raw: "# container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,\n", Value: "container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,", Start: syntax.Cursor{Line: 551, Column: 1, Offset: 19170}, End: syntax.Cursor{Line: 551, Column: 119, Offset: 19288}}, syntax.Token{Type: "<comment>", Raw: "# an error will occur.\n", Value: "an error will occur.", Start: syntax.Cursor{Line: 552, Column: 1, Offset: 19289}, End: syntax.Cursor{Line: 552, Column: 23, Offset: 19311}}, syntax.Token{Type: "<comment>", Raw: "# If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.\n", Value: "If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.", Start: syntax.Cursor{Line: 553, Column: 1, Offset: 19312}, End: syntax.Cursor{Line: 553, Column: 98, Offset: 19409}}, syntax.Token{Type: "<comment>", Raw: "# Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers\n", Value: "Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers", Start: syntax.Cursor{Line: 554, Column: 1, Offset: 19410}, End: syntax.Cursor{Line: 554, Column: 121, Offset: 19530}}, syntax.Token{Type: "<comment>", Raw: "# will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).\n", Value: "will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).", Start: syntax.Cursor{Line: 555, Column: 1, Offset: 19531}, End: syntax.Cursor{Line: 555, Column: 105, Offset: 19635}}, syntax.Token{Type: "<comment>", Raw: "# If the pod was removed with no errors, the pod ID is returned.\n", Value: "If the pod was removed with no errors, the pod ID is returned.", Start: syntax.Cursor{Line: 556, Column: 1, Offset: 19636}, End: syntax.Cursor{Line: 556, Column: 65, Offset: 19700}}}, Tokens: []syntax.Token(nil)}, Name: "RemovePod", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 17, Offset: 19717}, End: syntax.Cursor{Line: 557, Column: 44, Offset: 19744}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 18, Offset: 19718}, End: syntax.Cursor{Line: 557, Column: 30, Offset: 19730}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 24, Offset: 19724}, End: syntax.Cursor{Line: 557, Column: 30, Offset: 19730}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 32, Offset: 19732}, End: syntax.Cursor{Line: 557, Column: 43, Offset: 19743}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "force", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 39, Offset: 19739}, End: syntax.Cursor{Line: 557, Column: 43, Offset: 19743}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 48, Offset: 19748}, End: syntax.Cursor{Line: 557, Column: 61, Offset: 19761}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 49, Offset: 19749}, End: syntax.Cursor{Line: 557, Column: 60, Offset: 19760}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pod", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 557, Column: 54, Offset: 19754}, End: syntax.Cursor{Line: 557, Column: 60, Offset: 19760}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 1, Offset: 19837}, End: syntax.Cursor{Line: 560, Column: 84, Offset: 19920}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetEvents returns known libpod events filtered by the options provided.\n", Value: "GetEvents returns known libpod events filtered by the options provided.", Start: syntax.Cursor{Line: 559, Column: 1, Offset: 19763}, End: syntax.Cursor{Line: 559, Column: 74, Offset: 19836}}}, Tokens: []syntax.Token(nil)}, Name: "GetEvents", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 17, Offset: 19853}, End: syntax.Cursor{Line: 560, Column: 65, Offset: 19901}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 18, Offset: 19854}, End: syntax.Cursor{Line: 560, Column: 34, Offset: 19870}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "filter", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 26, Offset: 19862}, End: syntax.Cursor{Line: 560, Column: 34, Offset: 19870}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 28, Offset: 19864}, End: syntax.Cursor{Line: 560, Column: 34, Offset: 19870}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 36, Offset: 19872}, End: syntax.Cursor{Line: 560, Column: 49, Offset: 19885}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "since", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 43, Offset: 19879}, End: syntax.Cursor{Line: 560, Column: 49, Offset: 19885}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 51, Offset: 19887}, End: syntax.Cursor{Line: 560, Column: 64, Offset: 19900}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "until", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 58, Offset: 19894}, End: syntax.Cursor{Line: 560, Column: 64, Offset: 19900}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 69, Offset: 19905}, End: syntax.Cursor{Line: 560, Column: 84, Offset: 19920}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 70, Offset: 19906}, End: syntax.Cursor{Line: 560, Column: 83, Offset: 19919}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "events", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 560, Column: 78, Offset: 19914}, End: syntax.Cursor{Line: 560, Column: 83, Offset: 19919}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Event"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 1, Offset: 19967}, End: syntax.Cursor{Line: 563, Column: 49, Offset: 20015}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Diff returns a diff between libpod objects\n", Value: "Diff returns a diff between libpod objects", Start: syntax.Cursor{Line: 562, Column: 1, Offset: 19922}, End: syntax.Cursor{Line: 562, Column: 45, Offset: 19966}}}, Tokens: []syntax.Token(nil)}, Name: "Diff", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 12, Offset: 19978}, End: syntax.Cursor{Line: 563, Column: 26, Offset: 19992}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 13, Offset: 19979}, End: syntax.Cursor{Line: 563, Column: 25, Offset: 19991}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 19, Offset: 19985}, End: syntax.Cursor{Line: 563, Column: 25, Offset: 19991}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 30, Offset: 19996}, End: syntax.Cursor{Line: 563, Column: 49, Offset: 20015}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 31, Offset: 19997}, End: syntax.Cursor{Line: 563, Column: 48, Offset: 20014}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "diffs", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 38, Offset: 20004}, End: syntax.Cursor{Line: 563, Column: 48, Offset: 20014}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 563, Column: 40, Offset: 20006}, End: syntax.Cursor{Line: 563, Column: 48, Offset: 20014}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "DiffInfo"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 566, Column: 1, Offset: 20102}, End: syntax.Cursor{Line: 566, Column: 57, Offset: 20158}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetLayersMapWithImageInfo is for the development of Podman and should not be used.\n", Value: "GetLayersMapWithImageInfo is for the development of Podman and should not be used.", Start: syntax.Cursor{Line: 565, Column: 1, Offset: 20017}, End: syntax.Cursor{Line: 565, Column: 85, Offset: 20101}}}, Tokens: []syntax.Token(nil)}, Name: "GetLayersMapWithImageInfo", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 566, Column: 33, Offset: 20134}, End: syntax.Cursor{Line: 566, Column: 35, Offset: 20136}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 566, Column: 39, Offset: 20140}, End: syntax.Cursor{Line: 566, Column: 57, Offset: 20158}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 566, Column: 40, Offset: 20141}, End: syntax.Cursor{Line: 566, Column: 56, Offset: 20157}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "layerMap", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 566, Column: 50, Offset: 20151}, End: syntax.Cursor{Line: 566, Column: 56, Offset: 20157}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 1, Offset: 20209}, End: syntax.Cursor{Line: 569, Column: 71, Offset: 20279}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# VolumeCreate creates a volume on a remote host\n", Value: "VolumeCreate creates a volume on a remote host", Start: syntax.Cursor{Line: 568, Column: 1, Offset: 20160}, End: syntax.Cursor{Line: 568, Column: 49, Offset: 20208}}}, Tokens: []syntax.Token(nil)}, Name: "VolumeCreate", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 20, Offset: 20228}, End: syntax.Cursor{Line: 569, Column: 47, Offset: 20255}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 21, Offset: 20229}, End: syntax.Cursor{Line: 569, Column: 46, Offset: 20254}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "options", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 30, Offset: 20238}, End: syntax.Cursor{Line: 569, Column: 46, Offset: 20254}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "VolumeCreateOpts"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 51, Offset: 20259}, End: syntax.Cursor{Line: 569, Column: 71, Offset: 20279}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 52, Offset: 20260}, End: syntax.Cursor{Line: 569, Column: 70, Offset: 20278}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "volumeName", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 569, Column: 64, Offset: 20272}, End: syntax.Cursor{Line: 569, Column: 70, Offset: 20278}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 1, Offset: 20330}, End: syntax.Cursor{Line: 572, Column: 98, Offset: 20427}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# VolumeRemove removes a volume on a remote host\n", Value: "VolumeRemove removes a volume on a remote host", Start: syntax.Cursor{Line: 571, Column: 1, Offset: 20281}, End: syntax.Cursor{Line: 571, Column: 49, Offset: 20329}}}, Tokens: []syntax.Token(nil)}, Name: "VolumeRemove", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 20, Offset: 20349}, End: syntax.Cursor{Line: 572, Column: 47, Offset: 20376}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 21, Offset: 20350}, End: syntax.Cursor{Line: 572, Column: 46, Offset: 20375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "options", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 30, Offset: 20359}, End: syntax.Cursor{Line: 572, Column: 46, Offset: 20375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "VolumeRemoveOpts"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 51, Offset: 20380}, End: syntax.Cursor{Line: 572, Column: 98, Offset: 20427}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 52, Offset: 20381}, End: syntax.Cursor{Line: 572, Column: 71, Offset: 20400}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "successes", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 63, Offset: 20392}, End: syntax.Cursor{Line: 572, Column: 71, Offset: 20400}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 65, Offset: 20394}, End: syntax.Cursor{Line: 572, Column: 71, Offset: 20400}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 73, Offset: 20402}, End: syntax.Cursor{Line: 572, Column: 97, Offset: 20426}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "failures", Type: syntax.DictType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 83, Offset: 20412}, End: syntax.Cursor{Line: 572, Column: 97, Offset: 20426}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 572, Column: 91, Offset: 20420}, End: syntax.Cursor{Line: 572, Column: 97, Offset: 20426}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 1, Offset: 20485}, End: syntax.Cursor{Line: 575, Column: 68, Offset: 20552}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetVolumes gets slice of the volumes on a remote host\n", Value: "GetVolumes gets slice of the volumes on a remote host", Start: syntax.Cursor{Line: 574, Column: 1, Offset: 20429}, End: syntax.Cursor{Line: 574, Column: 56, Offset: 20484}}}, Tokens: []syntax.Token(nil)}, Name: "GetVolumes", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 18, Offset: 20502}, End: syntax.Cursor{Line: 575, Column: 45, Offset: 20529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 19, Offset: 20503}, End: syntax.Cursor{Line: 575, Column: 33, Offset: 20517}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "args", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 25, Offset: 20509}, End: syntax.Cursor{Line: 575, Column: 33, Offset: 20517}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 27, Offset: 20511}, End: syntax.Cursor{Line: 575, Column: 33, Offset: 20517}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 35, Offset: 20519}, End: syntax.Cursor{Line: 575, Column: 44, Offset: 20528}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "all", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 40, Offset: 20524}, End: syntax.Cursor{Line: 575, Column: 44, Offset: 20528}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 49, Offset: 20533}, End: syntax.Cursor{Line: 575, Column: 68, Offset: 20552}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 50, Offset: 20534}, End: syntax.Cursor{Line: 575, Column: 67, Offset: 20551}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "volumes", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 59, Offset: 20543}, End: syntax.Cursor{Line: 575, Column: 67, Offset: 20551}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 575, Column: 61, Offset: 20545}, End: syntax.Cursor{Line: 575, Column: 67, Offset: 20551}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Volume"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 1, Offset: 20685}, End: syntax.Cursor{Line: 579, Column: 64, Offset: 20748}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# GetContainerSockets takes a name or ID of a container and returns the sockets\n", Value: "GetContainerSockets takes a name or ID of a container and returns the sockets", Start: syntax.Cursor{Line: 577, Column: 1, Offset: 20554}, End: syntax.Cursor{Line: 577, Column: 80, Offset: 20633}}, syntax.Token{Type: "<comment>", Raw: "# that are used to communicate with the container.\n", Value: "that are used to communicate with the container.", Start: syntax.Cursor{Line: 578, Column: 1, Offset: 20634}, End: syntax.Cursor{Line: 578, Column: 51, Offset: 20684}}}, Tokens: []syntax.Token(nil)}, Name: "GetContainersSockets", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 28, Offset: 20712}, End: syntax.Cursor{Line: 579, Column: 42, Offset: 20726}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 29, Offset: 20713}, End: syntax.Cursor{Line: 579, Column: 41, Offset: 20725}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 35, Offset: 20719}, End: syntax.Cursor{Line: 579, Column: 41, Offset: 20725}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 46, Offset: 20730}, End: syntax.Cursor{Line: 579, Column: 64, Offset: 20748}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 47, Offset: 20731}, End: syntax.Cursor{Line: 579, Column: 63, Offset: 20747}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "sockets", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 579, Column: 56, Offset: 20740}, End: syntax.Cursor{Line: 579, Column: 63, Offset: 20747}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Sockets"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 582, Column: 1, Offset: 20809}, End: syntax.Cursor{Line: 582, Column: 43, Offset: 20851}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ExecContainer executes a command in the given container.\n", Value: "ExecContainer executes a command in the given container.", Start: syntax.Cursor{Line: 581, Column: 1, Offset: 20750}, End: syntax.Cursor{Line: 581, Column: 59, Offset: 20808}}}, Tokens: []syntax.Token(nil)}, Name: "ExecContainer", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 582, Column: 21, Offset: 20829}, End: syntax.Cursor{Line: 582, Column: 37, Offset: 20845}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 582, Column: 22, Offset: 20830}, End: syntax.Cursor{Line: 582, Column: 36, Offset: 20844}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "opts", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 582, Column: 28, Offset: 20836}, End: syntax.Cursor{Line: 582, Column: 36, Offset: 20844}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ExecOpts"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 582, Column: 41, Offset: 20849}, End: syntax.Cursor{Line: 582, Column: 43, Offset: 20851}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 1, Offset: 20893}, End: syntax.Cursor{Line: 585, Column: 76, Offset: 20968}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# This function is not implemented yet.\n", Value: "This function is not implemented yet.", Start: syntax.Cursor{Line: 584, Column: 1, Offset: 20853}, End: syntax.Cursor{Line: 584, Column: 40, Offset: 20892}}}, Tokens: []syntax.Token(nil)}, Name: "ListContainerPorts", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 26, Offset: 20918}, End: syntax.Cursor{Line: 585, Column: 40, Offset: 20932}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 27, Offset: 20919}, End: syntax.Cursor{Line: 585, Column: 39, Offset: 20931}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 33, Offset: 20925}, End: syntax.Cursor{Line: 585, Column: 39, Offset: 20931}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 44, Offset: 20936}, End: syntax.Cursor{Line: 585, Column: 76, Offset: 20968}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 45, Offset: 20937}, End: syntax.Cursor{Line: 585, Column: 75, Offset: 20967}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "notimplemented", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 585, Column: 61, Offset: 20953}, End: syntax.Cursor{Line: 585, Column: 75, Offset: 20967}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "NotImplemented"}}}}}}, Errors: []syntax.ErrorDef{syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 1, Offset: 21066}, End: syntax.Cursor{Line: 588, Column: 49, Offset: 21114}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ImageNotFound means the image could not be found by the provided name or ID in local storage.\n", Value: "ImageNotFound means the image could not be found by the provided name or ID in local storage.", Start: syntax.Cursor{Line: 587, Column: 1, Offset: 20970}, End: syntax.Cursor{Line: 587, Column: 96, Offset: 21065}}}, Tokens: []syntax.Token(nil)}, Name: "ImageNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 21, Offset: 21086}, End: syntax.Cursor{Line: 588, Column: 49, Offset: 21114}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 22, Offset: 21087}, End: syntax.Cursor{Line: 588, Column: 32, Offset: 21097}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 26, Offset: 21091}, End: syntax.Cursor{Line: 588, Column: 32, Offset: 21097}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 34, Offset: 21099}, End: syntax.Cursor{Line: 588, Column: 48, Offset: 21113}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 588, Column: 42, Offset: 21107}, End: syntax.Cursor{Line: 588, Column: 48, Offset: 21113}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 1, Offset: 21220}, End: syntax.Cursor{Line: 591, Column: 53, Offset: 21272}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ContainerNotFound means the container could not be found by the provided name or ID in local storage.\n", Value: "ContainerNotFound means the container could not be found by the provided name or ID in local storage.", Start: syntax.Cursor{Line: 590, Column: 1, Offset: 21116}, End: syntax.Cursor{Line: 590, Column: 104, Offset: 21219}}}, Tokens: []syntax.Token(nil)}, Name: "ContainerNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 25, Offset: 21244}, End: syntax.Cursor{Line: 591, Column: 53, Offset: 21272}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 26, Offset: 21245}, End: syntax.Cursor{Line: 591, Column: 36, Offset: 21255}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 30, Offset: 21249}, End: syntax.Cursor{Line: 591, Column: 36, Offset: 21255}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 38, Offset: 21257}, End: syntax.Cursor{Line: 591, Column: 52, Offset: 21271}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 591, Column: 46, Offset: 21265}, End: syntax.Cursor{Line: 591, Column: 52, Offset: 21271}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 594, Column: 1, Offset: 21394}, End: syntax.Cursor{Line: 594, Column: 28, Offset: 21421}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# NoContainerRunning means none of the containers requested are running in a command that requires a running container.\n", Value: "NoContainerRunning means none of the containers requested are running in a command that requires a running container.", Start: syntax.Cursor{Line: 593, Column: 1, Offset: 21274}, End: syntax.Cursor{Line: 593, Column: 120, Offset: 21393}}}, Tokens: []syntax.Token(nil)}, Name: "NoContainerRunning", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 594, Column: 26, Offset: 21419}, End: syntax.Cursor{Line: 594, Column: 28, Offset: 21421}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 1, Offset: 21515}, End: syntax.Cursor{Line: 597, Column: 49, Offset: 21563}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# PodNotFound means the pod could not be found by the provided name or ID in local storage.\n", Value: "PodNotFound means the pod could not be found by the provided name or ID in local storage.", Start: syntax.Cursor{Line: 596, Column: 1, Offset: 21423}, End: syntax.Cursor{Line: 596, Column: 92, Offset: 21514}}}, Tokens: []syntax.Token(nil)}, Name: "PodNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 19, Offset: 21533}, End: syntax.Cursor{Line: 597, Column: 49, Offset: 21563}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 20, Offset: 21534}, End: syntax.Cursor{Line: 597, Column: 32, Offset: 21546}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 26, Offset: 21540}, End: syntax.Cursor{Line: 597, Column: 32, Offset: 21546}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 34, Offset: 21548}, End: syntax.Cursor{Line: 597, Column: 48, Offset: 21562}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 597, Column: 42, Offset: 21556}, End: syntax.Cursor{Line: 597, Column: 48, Offset: 21562}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 1, Offset: 21654}, End: syntax.Cursor{Line: 600, Column: 50, Offset: 21703}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# VolumeNotFound means the volume could not be found by the name or ID in local storage.\n", Value: "VolumeNotFound means the volume could not be found by the name or ID in local storage.", Start: syntax.Cursor{Line: 599, Column: 1, Offset: 21565}, End: syntax.Cursor{Line: 599, Column: 89, Offset: 21653}}}, Tokens: []syntax.Token(nil)}, Name: "VolumeNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 22, Offset: 21675}, End: syntax.Cursor{Line: 600, Column: 50, Offset: 21703}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 23, Offset: 21676}, End: syntax.Cursor{Line: 600, Column: 33, Offset: 21686}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 27, Offset: 21680}, End: syntax.Cursor{Line: 600, Column: 33, Offset: 21686}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 35, Offset: 21688}, End: syntax.Cursor{Line: 600, Column: 49, Offset: 21702}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 600, Column: 43, Offset: 21696}, End: syntax.Cursor{Line: 600, Column: 49, Offset: 21702}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 1, Offset: 21856}, End: syntax.Cursor{Line: 604, Column: 75, Offset: 21930}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# PodContainerError means a container associated with a pod failed to perform an operation. It contains\n", Value: "PodContainerError means a container associated with a pod failed to perform an operation. It contains", Start: syntax.Cursor{Line: 602, Column: 1, Offset: 21705}, End: syntax.Cursor{Line: 602, Column: 104, Offset: 21808}}, syntax.Token{Type: "<comment>", Raw: "# a container ID of the container that failed.\n", Value: "a container ID of the container that failed.", Start: syntax.Cursor{Line: 603, Column: 1, Offset: 21809}, End: syntax.Cursor{Line: 603, Column: 47, Offset: 21855}}}, Tokens: []syntax.Token(nil)}, Name: "PodContainerError", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 25, Offset: 21880}, End: syntax.Cursor{Line: 604, Column: 75, Offset: 21930}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 26, Offset: 21881}, End: syntax.Cursor{Line: 604, Column: 41, Offset: 21896}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "podname", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 35, Offset: 21890}, End: syntax.Cursor{Line: 604, Column: 41, Offset: 21896}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 43, Offset: 21898}, End: syntax.Cursor{Line: 604, Column: 74, Offset: 21929}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "errors", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 51, Offset: 21906}, End: syntax.Cursor{Line: 604, Column: 74, Offset: 21929}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 604, Column: 53, Offset: 21908}, End: syntax.Cursor{Line: 604, Column: 74, Offset: 21929}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "PodContainerErrorData"}}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 608, Column: 1, Offset: 22043}, End: syntax.Cursor{Line: 608, Column: 39, Offset: 22081}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# NoContainersInPod means a pod has no containers on which to perform the operation. It contains\n", Value: "NoContainersInPod means a pod has no containers on which to perform the operation. It contains", Start: syntax.Cursor{Line: 606, Column: 1, Offset: 21932}, End: syntax.Cursor{Line: 606, Column: 97, Offset: 22028}}, syntax.Token{Type: "<comment>", Raw: "# the pod ID.\n", Value: "the pod ID.", Start: syntax.Cursor{Line: 607, Column: 1, Offset: 22029}, End: syntax.Cursor{Line: 607, Column: 14, Offset: 22042}}}, Tokens: []syntax.Token(nil)}, Name: "NoContainersInPod", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 608, Column: 25, Offset: 22067}, End: syntax.Cursor{Line: 608, Column: 39, Offset: 22081}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 608, Column: 26, Offset: 22068}, End: syntax.Cursor{Line: 608, Column: 38, Offset: 22080}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 608, Column: 32, Offset: 22074}, End: syntax.Cursor{Line: 608, Column: 38, Offset: 22080}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 1, Offset: 22185}, End: syntax.Cursor{Line: 611, Column: 48, Offset: 22232}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# InvalidState indicates that a container or pod was in an improper state for the requested operation\n", Value: "InvalidState indicates that a container or pod was in an improper state for the requested operation", Start: syntax.Cursor{Line: 610, Column: 1, Offset: 22083}, End: syntax.Cursor{Line: 610, Column: 102, Offset: 22184}}}, Tokens: []syntax.Token(nil)}, Name: "InvalidState", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 20, Offset: 22204}, End: syntax.Cursor{Line: 611, Column: 48, Offset: 22232}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 21, Offset: 22205}, End: syntax.Cursor{Line: 611, Column: 31, Offset: 22215}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 25, Offset: 22209}, End: syntax.Cursor{Line: 611, Column: 31, Offset: 22215}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 33, Offset: 22217}, End: syntax.Cursor{Line: 611, Column: 47, Offset: 22231}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 611, Column: 41, Offset: 22225}, End: syntax.Cursor{Line: 611, Column: 47, Offset: 22231}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 615, Column: 1, Offset: 22385}, End: syntax.Cursor{Line: 615, Column: 37, Offset: 22421}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# ErrorOccurred is a generic error for an error that occurs during the execution.  The actual error message\n", Value: "ErrorOccurred is a generic error for an error that occurs during the execution.  The actual error message", Start: syntax.Cursor{Line: 613, Column: 1, Offset: 22234}, End: syntax.Cursor{Line: 613, Column: 108, Offset: 22341}}, syntax.Token{Type: "<comment>", Raw: "# is includes as part of the error's text.\n", Value: "is includes as part of the error's text.", Start: syntax.Cursor{Line: 614, Column: 1, Offset: 22342}, End: syntax.Cursor{Line: 614, Column: 43, Offset: 22384}}}, Tokens: []syntax.Token(nil)}, Name: "ErrorOccurred", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 615, Column: 21, Offset: 22405}, End: syntax.Cursor{Line: 615, Column: 37, Offset: 22421}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 615, Column: 22, Offset: 22406}, End: syntax.Cursor{Line: 615, Column: 36, Offset: 22420}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 615, Column: 30, Offset: 22414}, End: syntax.Cursor{Line: 615, Column: 36, Offset: 22420}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 618, Column: 1, Offset: 22495}, End: syntax.Cursor{Line: 618, Column: 36, Offset: 22530}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# RuntimeErrors generally means a runtime could not be found or gotten.\n", Value: "RuntimeErrors generally means a runtime could not be found or gotten.", Start: syntax.Cursor{Line: 617, Column: 1, Offset: 22423}, End: syntax.Cursor{Line: 617, Column: 72, Offset: 22494}}}, Tokens: []syntax.Token(nil)}, Name: "RuntimeError", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 618, Column: 20, Offset: 22514}, End: syntax.Cursor{Line: 618, Column: 36, Offset: 22530}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 618, Column: 21, Offset: 22515}, End: syntax.Cursor{Line: 618, Column: 35, Offset: 22529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 618, Column: 29, Offset: 22523}, End: syntax.Cursor{Line: 618, Column: 35, Offset: 22529}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 621, Column: 1, Offset: 22600}, End: syntax.Cursor{Line: 621, Column: 41, Offset: 22640}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The Podman endpoint requires that you use a streaming connection.\n", Value: "The Podman endpoint requires that you use a streaming connection.", Start: syntax.Cursor{Line: 620, Column: 1, Offset: 22532}, End: syntax.Cursor{Line: 620, Column: 68, Offset: 22599}}}, Tokens: []syntax.Token(nil)}, Name: "WantsMoreRequired", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 621, Column: 25, Offset: 22624}, End: syntax.Cursor{Line: 621, Column: 41, Offset: 22640}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 621, Column: 26, Offset: 22625}, End: syntax.Cursor{Line: 621, Column: 40, Offset: 22639}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 621, Column: 34, Offset: 22633}, End: syntax.Cursor{Line: 621, Column: 40, Offset: 22639}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 624, Column: 1, Offset: 22673}, End: syntax.Cursor{Line: 624, Column: 33, Offset: 22705}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Container is already stopped\n", Value: "Container is already stopped", Start: syntax.Cursor{Line: 623, Column: 1, Offset: 22642}, End: syntax.Cursor{Line: 623, Column: 31, Offset: 22672}}}, Tokens: []syntax.Token(nil)}, Name: "ErrCtrStopped", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 624, Column: 21, Offset: 22693}, End: syntax.Cursor{Line: 624, Column: 33, Offset: 22705}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 624, Column: 22, Offset: 22694}, End: syntax.Cursor{Line: 624, Column: 32, Offset: 22704}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "id", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 624, Column: 26, Offset: 22698}, End: syntax.Cursor{Line: 624, Column: 32, Offset: 22704}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 627, Column: 1, Offset: 22767}, End: syntax.Cursor{Line: 627, Column: 54, Offset: 22820}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# This function requires CGroupsV2 to run in rootless mode.\n", Value: "This function requires CGroupsV2 to run in rootless mode.", Start: syntax.Cursor{Line: 626, Column: 1, Offset: 22707}, End: syntax.Cursor{Line: 626, Column: 60, Offset: 22766}}}, Tokens: []syntax.Token(nil)}, Name: "ErrRequiresCgroupsV2ForRootless", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 627, Column: 38, Offset: 22804}, End: syntax.Cursor{Line: 627, Column: 54, Offset: 22820}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 627, Column: 39, Offset: 22805}, End: syntax.Cursor{Line: 627, Column: 53, Offset: 22819}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "reason", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 627, Column: 47, Offset: 22813}, End: syntax.Cursor{Line: 627, Column: 53, Offset: 22819}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}}
+
+// Description contains the description of the varlink interface, expressed in the IDL.
+var Description = `# Podman Service Interface and API description.  The master version of this document can be found
+# in the [API.md](https://github.com/containers/libpod/blob/master/API.md) file in the upstream libpod repository.
+#
+# This is a subset of the io.podman interface published by libpod v1, kept
+# here to exercise the code generator against a large real-world IDL.
+interface io.podman
+
+type Volume (
+  name: string,
+  labels: [string]string,
+  mountPoint: string,
+  driver: string,
+  options: [string]string
+)
+
+type NotImplemented (
+    comment: string
+)
+
+type StringResponse (
+    message: string
+)
+
+type RemoveImageResponse (
+    untagged: []string,
+    deleted: string
+)
+
+type LogLine (
+    device: string,
+    parseLogType : string,
+    time: string,
+    msg: string,
+    cid: string
+)
+
+# ContainerChanges describes the return struct for ListContainerChanges
+type ContainerChanges (
+    changed: []string,
+    added: []string,
+    deleted: []string
+)
+
+type ImageSaveOptions (
+    name: string,
+    format: string,
+    output: string,
+    outputType: string,
+    moreTags: []string,
+    quiet: bool,
+    compress: bool
+)
+
+type VolumeCreateOpts (
+    volumeName: string,
+    driver: string,
+    labels: [string]string,
+    options: [string]string
+)
+
+type VolumeRemoveOpts (
+    volumes: []string,
+    all: bool,
+    force: bool
+)
+
+type Image (
+    id: string,
+    digest: string,
+    digests: []string,
+    parentId: string,
+    repoTags: []string,
+    repoDigests: []string,
+    created: string,
+    size: int,
+    virtualSize: int,
+    containers: int,
+    labels: [string]string,
+    isParent: bool,
+    topLayer: string,
+    readOnly: bool
+)
+
+# ImageHistory describes the returned structure from ImageHistory.
+type ImageHistory (
+    id: string,
+    created: string,
+    createdBy: string,
+    tags: []string,
+    size: int,
+    comment: string
+)
+
+# Represents a single search result from SearchImages
+type ImageSearchResult (
+    description: string,
+    is_official: bool,
+    is_automated: bool,
+    registry: string,
+    name: string,
+    star_count: int
+)
+
+type ImageSearchFilter (
+    is_official: ?bool,
+    is_automated: ?bool,
+    star_count: int
+)
+
+type KubePodService (
+    pod: string,
+    service: string
+)
+
+type Container (
+    id: string,
+    image: string,
+    imageid: string,
+    command: []string,
+    createdat: string,
+    runningfor: string,
+    status: string,
+    ports: []ContainerPortMappings,
+    rootfssize: int,
+    rwsize: int,
+    names: string,
+    labels: [string]string,
+    mounts: []ContainerMount,
+    containerrunning: bool,
+    namespaces: ContainerNameSpace
+)
+
+# ContainerStats is the return struct for the stats of a container
+type ContainerStats (
+    id: string,
+    name: string,
+    cpu: float,
+    cpu_nano: int,
+    system_nano: int,
+    mem_usage: int,
+    mem_limit: int,
+    mem_perc: float,
+    net_input: int,
+    net_output: int,
+    block_output: int,
+    block_input: int,
+    pids: int
+)
+
+type PsOpts (
+    all: bool,
+    filters: ?[]string,
+    last: ?int,
+    latest: ?bool,
+    noTrunc: ?bool,
+    pod: ?bool,
+    quiet: ?bool,
+    size: ?bool,
+    sort: ?string,
+    sync: ?bool
+)
+
+type PsContainer (
+    id: string,
+    image: string,
+    command: string,
+    created: string,
+    ports: string,
+    names: string,
+    isInfra: bool,
+    status: string,
+    state: string,
+    pidNum: int,
+    rootFsSize: int,
+    rwSize: int,
+    pod: string,
+    createdAt: string,
+    exitedAt: string,
+    startedAt: string,
+    labels: [string]string,
+    nsPid: string,
+    cgroup: string,
+    ipc: string,
+    mnt: string,
+    net: string,
+    pidNs: string,
+    user: string,
+    uts: string,
+    mounts: string
+)
+
+# ContainerMount describes the struct for mounts in a container
+type ContainerMount (
+    destination: string,
+    type: string,
+    source: string,
+    options: []string
+)
+
+# ContainerPortMappings describes the struct for portmappings in an existing container
+type ContainerPortMappings (
+    host_port: string,
+    host_ip: string,
+    protocol: string,
+    container_port: string
+)
+
+# ContainerNamespace describes the namespace structure for an existing container
+type ContainerNameSpace (
+    user: string,
+    uts: string,
+    pidns: string,
+    pid: string,
+    cgroup: string,
+    net: string,
+    mnt: string,
+    ipc: string
+)
+
+# InfoDistribution describes the host's distribution
+type InfoDistribution (
+    distribution: string,
+    version: string
+)
+
+# InfoHost describes the host stats portion of PodmanInfo
+type InfoHost (
+    buildah_version: string,
+    distribution: InfoDistribution,
+    mem_free: int,
+    mem_total: int,
+    swap_free: int,
+    swap_total: int,
+    arch: string,
+    cpus: int,
+    hostname: string,
+    kernel: string,
+    os: string,
+    uptime: string,
+    eventlogger: string
+)
+
+# InfoGraphStatus describes the detailed status of the storage driver
+type InfoGraphStatus (
+    backing_filesystem: string,
+    native_overlay_diff: string,
+    supports_d_type: string
+)
+
+# InfoStore describes the host's storage informatoin
+type InfoStore (
+    containers: int,
+    images: int,
+    graph_driver_name: string,
+    graph_driver_options: string,
+    graph_root: string,
+    graph_status: InfoGraphStatus,
+    run_root: string
+)
+
+# InfoPodman provides details on the podman binary
+type InfoPodmanBinary (
+    compiler: string,
+    go_version: string,
+    podman_version: string,
+    git_commit: string
+)
+
+# PodmanInfo describes the Podman host and build
+type PodmanInfo (
+    host: InfoHost,
+    registries: []string,
+    insecure_registries: []string,
+    store: InfoStore,
+    podman: InfoPodmanBinary
+)
+
+# Sockets describes sockets location for a container
+type Sockets(
+    container_id: string,
+    io_socket: string,
+    control_socket: string
+)
+
+# ListPodContainerInfo is a returned struct for describing containers
+# in a pod.
+type ListPodContainerInfo (
+    name: string,
+    id: string,
+    status: string
+)
+
+# ListPodData is the returned struct for an individual pod
+type ListPodData (
+    id: string,
+    name: string,
+    createdat: string,
+    cgroup: string,
+    status: string,
+    labels: [string]string,
+    numberofcontainers: string,
+    containersinfo: []ListPodContainerInfo
+)
+
+type PodContainerErrorData (
+    containerid: string,
+    reason: string
+)
+
+type PodmanVersion (
+    version: string,
+    go_version: string,
+    git_commit: string,
+    built: string,
+    os_arch: string,
+    remote_api_version: int
+)
+
+# Event describes a libpod struct
+type Event(
+    # TODO: make status and type a enum at some point?
+    # id is the container, volume, pod, image ID
+    id: string,
+    # image is the image name where applicable
+    image: string,
+    # name is the name of the pod, container, image
+    name: string,
+    # status describes the event that happened (i.e. create, remove, ...)
+    status: string,
+    # time the event happened
+    time: string,
+    # type describes object the event happened with (image, container...)
+    type: string
+)
+
+type DiffInfo(
+  # path that is different
+  path: string,
+  # Add, Delete, Modify
+  changeType: string
+)
+
+type ExecOpts(
+  # container name or id
+  name: string,
+  # Create pseudo tty
+  tty: bool,
+  # privileged access in container
+  privileged: bool,
+  # command to execute in container
+  cmd: []string,
+  # user to use in container
+  user: ?string,
+  # workdir to run command in container
+  workdir: ?string,
+  # slice of keyword=value environment variables
+  env: ?[]string,
+  # string of detach keys
+  detachKeys: ?string
+)
+
+# GetVersion returns version and build information of the podman service
+method GetVersion() -> (
+    version: string,
+    go_version: string,
+    git_commit: string,
+    built: string,
+    os_arch: string,
+    remote_api_version: int
+)
+
+# GetInfo returns a [PodmanInfo](#PodmanInfo) struct that describes podman and its host such as storage stats,
+# build information of Podman, and system-wide registries.
+method GetInfo() -> (info: PodmanInfo)
+
+# ListContainers returns information about all containers.
+# See also [GetContainer](#GetContainer).
+method ListContainers() -> (containers: []Container)
+
+method Ps(opts: PsOpts) -> (containers: []PsContainer)
+
+method GetContainersByStatus(status: []string) -> (containerS: []Container)
+
+method Top (nameOrID: string, descriptors: []string) -> (top: []string)
+
+# HealthCheckRun executes defined container's healthcheck command
+# and returns the container's health status.
+method HealthCheckRun (nameOrID: string) -> (healthCheckStatus: string)
+
+# GetContainer returns information about a single container.  If a container
+# with the given id doesn't exist, a [ContainerNotFound](#ContainerNotFound)
+# error will be returned.  See also [ListContainers](ListContainers) and
+# [InspectContainer](#InspectContainer).
+method GetContainer(id: string) -> (container: Container)
+
+# GetContainersByContext allows you to get a list of container ids depending on all, latest, or a list of
+# container names.  The definition of latest container means the latest by creation date.  In a multi-
+# user environment, results might differ from what you expect.
+method GetContainersByContext(all: bool, latest: bool, args: []string) -> (containers: []string)
+
+# InspectContainer data takes a name or ID of a container returns the inspection
+# data in string format.  You can then serialize the string into JSON.  A [ContainerNotFound](#ContainerNotFound)
+# error will be returned if the container cannot be found. See also [InspectImage](#InspectImage).
+method InspectContainer(name: string) -> (container: string)
+
+# ListContainerProcesses takes a name or ID of a container and returns the processes
+# running inside the container as array of strings.  It will accept an array of string
+# arguments that represent ps options.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+# error will be returned.
+method ListContainerProcesses(name: string, opts: []string) -> (container: []string)
+
+# GetContainerLogs takes a name or ID of a container and returns the logs of that container.
+# If the container cannot be found, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+# The container logs are returned as an array of strings.  GetContainerLogs will honor the streaming
+# capability of varlink if the client invokes it.
+method GetContainerLogs(name: string) -> (container: []string)
+
+method GetContainersLogs(names: []string, follow: bool, latest: bool, since: string, tail: int, timestamps: bool) -> (log: LogLine)
+
+# ListContainerChanges takes a name or ID of a container and returns changes between the container and
+# its base image. It returns a struct of changed, deleted, and added path names.
+method ListContainerChanges(name: string) -> (container: ContainerChanges)
+
+# ExportContainer creates an image from a container.  It takes the name or ID of a container and a
+# path representing the target tarfile.  If the tarfile name is empty, it will be created in a temporary
+# location and the path to that file will be returned.
+method ExportContainer(name: string, path: string) -> (tarfile: string)
+
+# GetContainerStats takes the name or ID of a container and returns a single ContainerStats structure which
+# contains attributes like memory and cpu usage.  If the container cannot be found, a
+# [ContainerNotFound](#ContainerNotFound) error will be returned. If the container is not running, a [NoContainerRunning](#NoContainerRunning)
+# error will be returned
+method GetContainerStats(name: string) -> (container: ContainerStats)
+
+# GetContainerStatsWithHistory takes a previous set of container statistics and uses libpod functions
+# to calculate the containers statistics based on current and previous measurements.
+method GetContainerStatsWithHistory(previousStats: ContainerStats) -> (container: ContainerStats)
+
+# StartContainer starts a created or stopped container. It takes the name or ID of container.  It returns
+# the container ID once started.  If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+# error will be returned.  See also [CreateContainer](#CreateContainer).
+method StartContainer(name: string) -> (container: string)
+
+# StopContainer stops a container given a timeout.  It takes the name or ID of a container as well as a
+# timeout value.  The timeout value the time before a forcible stop to the container is applied.  It
+# returns the container ID once stopped. If the container cannot be found, a [ContainerNotFound](#ContainerNotFound)
+# error will be returned instead. See also [KillContainer](KillContainer).
+method StopContainer(name: string, timeout: int) -> (container: string)
+
+# RestartContainer will restart a running container given a container name or ID and timeout value. The timeout
+# value is the time before a forcible stop is used to stop the container.  If the container cannot be found by
+# name or ID, a [ContainerNotFound](#ContainerNotFound)  error will be returned; otherwise, the ID of the
+# container will be returned.
+method RestartContainer(name: string, timeout: int) -> (container: string)
+
+# KillContainer takes the name or ID of a container as well as a signal to be applied to the container.  Once the
+# container has been killed, the container's ID is returned.  If the container cannot be found, a
+# [ContainerNotFound](#ContainerNotFound) error is returned. See also [StopContainer](StopContainer).
+method KillContainer(name: string, signal: int) -> (container: string)
+
+# PauseContainer takes the name or ID of container and pauses it.  If the container cannot be found,
+# a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+# See also [UnpauseContainer](#UnpauseContainer).
+method PauseContainer(name: string) -> (container: string)
+
+# UnpauseContainer takes the name or ID of container and unpauses a paused container.  If the container cannot be
+# found, a [ContainerNotFound](#ContainerNotFound) error will be returned; otherwise the ID of the container is returned.
+# See also [PauseContainer](#PauseContainer).
+method UnpauseContainer(name: string) -> (container: string)
+
+# WaitContainer takes the name or ID of a container and waits the given interval in milliseconds until the container
+# stops.  Upon stopping, the return code of the container is returned. If the container container cannot be found by ID
+# or name, a [ContainerNotFound](#ContainerNotFound) error is returned.
+method WaitContainer(name: string, interval: int) -> (exitcode: int)
+
+# RemoveContainer requires the name or ID of a container as well as a boolean that
+# indicates whether a container should be forcefully removed (e.g., by stopping it), and a boolean
+# indicating whether to remove builtin volumes. Upon successful removal of the
+# container, its ID is returned.  If the
+# container cannot be found by name or ID, a [ContainerNotFound](#ContainerNotFound) error will be returned.
+# See also [EvictContainer](EvictContainer).
+method RemoveContainer(name: string, force: bool, removeVolumes: bool) -> (container: string)
+
+# DeleteStoppedContainers will delete all containers that are not running. It will return a list the deleted
+# container IDs.  See also [RemoveContainer](RemoveContainer).
+method DeleteStoppedContainers() -> (containers: []string)
+
+# ListImages returns information about the images that are currently in storage.
+# See also [InspectImage](#InspectImage).
+method ListImages() -> (images: []Image)
+
+# GetImage returns information about a single image in storage.
+# If the image caGetImage returns be found, [ImageNotFound](#ImageNotFound) will be returned.
+method GetImage(id: string) -> (image: Image)
+
+# InspectImage takes the name or ID of an image and returns a string representation of data associated with the
+#image.  You must serialize the string into JSON to use it further.  An [ImageNotFound](#ImageNotFound) error will
+# be returned if the image cannot be found.
+method InspectImage(name: string) -> (image: string)
+
+# HistoryImage takes the name or ID of an image and returns information about its history and layers.  The returned
+# history is in the form of an array of ImageHistory structures.  If the image cannot be found, an
+# [ImageNotFound](#ImageNotFound) error is returned.
+method HistoryImage(name: string) -> (history: []ImageHistory)
+
+# TagImage takes the name or ID of an image in local storage as well as the desired tag name.  If the image cannot
+# be found, an [ImageNotFound](#ImageNotFound) error will be returned; otherwise, the ID of the image is returned on success.
+method TagImage(name: string, tagged: string) -> (image: string)
+
+# RemoveImage takes the name or ID of an image as well as a boolean that determines if containers using that image
+# should be deleted.  If the image cannot be found, an [ImageNotFound](#ImageNotFound) error will be returned.  The
+# ID of the removed image is returned when complete.  See also [DeleteUnusedImages](DeleteUnusedImages).
+method RemoveImage(name: string, force: bool) -> (image: string)
+
+# SearchImages searches available registries for images that contain the
+# contents of "query" in their name. If "limit" is given, limits the amount of
+# search results per registry.
+method SearchImages(query: string, limit: ?int, filter: ImageSearchFilter) -> (results: []ImageSearchResult)
+
+# DeleteUnusedImages deletes any images not associated with a container.  The IDs of the deleted images are returned
+# in a string array.
+method DeleteUnusedImages() -> (images: []string)
+
+# ImageExists talks a full or partial image ID or name and returns an int as to whether
+# the image exists in local storage. An int result of 0 means the image does exist in
+# local storage; whereas 1 indicates the image does not exists in local storage.
+method ImageExists(name: string) -> (exists: int)
+
+# ContainerExists takes a full or partial container ID or name and returns an int as to
+# whether the container exists in local storage.  A result of 0 means the container does
+# exists; whereas a result of 1 means it could not be found.
+method ContainerExists(name: string) -> (exists: int)
+
+# ListPods returns a list of pods in no particular order.  They are
+# returned as an array of ListPodData structs.  See also [GetPod](#GetPod).
+method ListPods() -> (pods: []ListPodData)
+
+# GetPod takes a name or ID of a pod and returns single [ListPodData](#ListPodData)
+# structure.  A [PodNotFound](#PodNotFound) error will be returned if the pod cannot be found.
+# See also [ListPods](ListPods).
+method GetPod(name: string) -> (pod: ListPodData)
+
+# StartPod starts containers in a pod.  It takes the name or ID of pod.  If the pod cannot be found, a [PodNotFound](#PodNotFound)
+# error will be returned.  Containers in a pod are started independently. If there is an error starting one container, the ID of those containers
+# will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+# If the pod was started with no errors, the pod ID is returned.
+# See also [CreatePod](#CreatePod).
+method StartPod(name: string) -> (pod: string)
+
+# RemovePod takes the name or ID of a pod as well a boolean representing whether a running
+# container in the pod can be stopped and removed.  If a pod has containers associated with it, and force is not true,
+# an error will occur.
+# If the pod cannot be found by name or ID, a [PodNotFound](#PodNotFound) error will be returned.
+# Containers in a pod are removed independently. If there is an error removing any container, the ID of those containers
+# will be returned in a list, along with the ID of the pod in a [PodContainerError](#PodContainerError).
+# If the pod was removed with no errors, the pod ID is returned.
+method RemovePod(name: string, force: bool) -> (pod: string)
+
+# GetEvents returns known libpod events filtered by the options provided.
+method GetEvents(filter: []string, since: string, until: string) -> (events: Event)
+
+# Diff returns a diff between libpod objects
+method Diff(name: string) -> (diffs: []DiffInfo)
+
+# GetLayersMapWithImageInfo is for the development of Podman and should not be used.
+method GetLayersMapWithImageInfo() -> (layerMap: string)
+
+# VolumeCreate creates a volume on a remote host
+method VolumeCreate(options: VolumeCreateOpts) -> (volumeName: string)
+
+# VolumeRemove removes a volume on a remote host
+method VolumeRemove(options: VolumeRemoveOpts) -> (successes: []string, failures: [string]string)
+
+# GetVolumes gets slice of the volumes on a remote host
+method GetVolumes(args: []string, all: bool) -> (volumes: []Volume)
+
+# GetContainerSockets takes a name or ID of a container and returns the sockets
+# that are used to communicate with the container.
+method GetContainersSockets(name: string) -> (sockets: Sockets)
+
+# ExecContainer executes a command in the given container.
+method ExecContainer(opts: ExecOpts) -> ()
+
+# This function is not implemented yet.
+method ListContainerPorts(name: string) -> (notimplemented: NotImplemented)
+
+# ImageNotFound means the image could not be found by the provided name or ID in local storage.
+error ImageNotFound (id: string, reason: string)
+
+# ContainerNotFound means the container could not be found by the provided name or ID in local storage.
+error ContainerNotFound (id: string, reason: string)
+
+# NoContainerRunning means none of the containers requested are running in a command that requires a running container.
+error NoContainerRunning ()
+
+# PodNotFound means the pod could not be found by the provided name or ID in local storage.
+error PodNotFound (name: string, reason: string)
+
+# VolumeNotFound means the volume could not be found by the name or ID in local storage.
+error VolumeNotFound (id: string, reason: string)
+
+# PodContainerError means a container associated with a pod failed to perform an operation. It contains
+# a container ID of the container that failed.
+error PodContainerError (podname: string, errors: []PodContainerErrorData)
+
+# NoContainersInPod means a pod has no containers on which to perform the operation. It contains
+# the pod ID.
+error NoContainersInPod (name: string)
+
+# InvalidState indicates that a container or pod was in an improper state for the requested operation
+error InvalidState (id: string, reason: string)
+
+# ErrorOccurred is a generic error for an error that occurs during the execution.  The actual error message
+# is includes as part of the error's text.
+error ErrorOccurred (reason: string)
+
+# RuntimeErrors generally means a runtime could not be found or gotten.
+error RuntimeError (reason: string)
+
+# The Podman endpoint requires that you use a streaming connection.
+error WantsMoreRequired (reason: string)
+
+# Container is already stopped
+error ErrCtrStopped (id: string)
+
+# This function requires CGroupsV2 to run in rootless mode.
+error ErrRequiresCgroupsV2ForRootless(reason: string)
+`
+
+// Fully-qualified names of the methods of the interface.
+const (
+	MethodGetVersion                   = `io.podman.GetVersion`
+	MethodGetInfo                      = `io.podman.GetInfo`
+	MethodListContainers               = `io.podman.ListContainers`
+	MethodPs                           = `io.podman.Ps`
+	MethodGetContainersByStatus        = `io.podman.GetContainersByStatus`
+	MethodTop                          = `io.podman.Top`
+	MethodHealthCheckRun               = `io.podman.HealthCheckRun`
+	MethodGetContainer                 = `io.podman.GetContainer`
+	MethodGetContainersByContext       = `io.podman.GetContainersByContext`
+	MethodInspectContainer             = `io.podman.InspectContainer`
+	MethodListContainerProcesses       = `io.podman.ListContainerProcesses`
+	MethodGetContainerLogs             = `io.podman.GetContainerLogs`
+	MethodGetContainersLogs            = `io.podman.GetContainersLogs`
+	MethodListContainerChanges         = `io.podman.ListContainerChanges`
+	MethodExportContainer              = `io.podman.ExportContainer`
+	MethodGetContainerStats            = `io.podman.GetContainerStats`
+	MethodGetContainerStatsWithHistory = `io.podman.GetContainerStatsWithHistory`
+	MethodStartContainer               = `io.podman.StartContainer`
+	MethodStopContainer                = `io.podman.StopContainer`
+	MethodRestartContainer             = `io.podman.RestartContainer`
+	MethodKillContainer                = `io.podman.KillContainer`
+	MethodPauseContainer               = `io.podman.PauseContainer`
+	MethodUnpauseContainer             = `io.podman.UnpauseContainer`
+	MethodWaitContainer                = `io.podman.WaitContainer`
+	MethodRemoveContainer              = `io.podman.RemoveContainer`
+	MethodDeleteStoppedContainers      = `io.podman.DeleteStoppedContainers`
+	MethodListImages                   = `io.podman.ListImages`
+	MethodGetImage                     = `io.podman.GetImage`
+	MethodInspectImage                 = `io.podman.InspectImage`
+	MethodHistoryImage                 = `io.podman.HistoryImage`
+	MethodTagImage                     = `io.podman.TagImage`
+	MethodRemoveImage                  = `io.podman.RemoveImage`
+	MethodSearchImages                 = `io.podman.SearchImages`
+	MethodDeleteUnusedImages           = `io.podman.DeleteUnusedImages`
+	MethodImageExists                  = `io.podman.ImageExists`
+	MethodContainerExists              = `io.podman.ContainerExists`
+	MethodListPods                     = `io.podman.ListPods`
+	MethodGetPod                       = `io.podman.GetPod`
+	MethodStartPod                     = `io.podman.StartPod`
+	MethodRemovePod                    = `io.podman.RemovePod`
+	MethodGetEvents                    = `io.podman.GetEvents`
+	MethodDiff                         = `io.podman.Diff`
+	MethodGetLayersMapWithImageInfo    = `io.podman.GetLayersMapWithImageInfo`
+	MethodVolumeCreate                 = `io.podman.VolumeCreate`
+	MethodVolumeRemove                 = `io.podman.VolumeRemove`
+	MethodGetVolumes                   = `io.podman.GetVolumes`
+	MethodGetContainersSockets         = `io.podman.GetContainersSockets`
+	MethodExecContainer                = `io.podman.ExecContainer`
+	MethodListContainerPorts           = `io.podman.ListContainerPorts`
+)
+
+// Fingerprint is the fingerprint of the varlink interface definition, as
+// computed by syntax.Fingerprint.
+const Fingerprint = `bec195ec3b244ab538bd896bb3b2a86c4bd7be3de40479511bc86004d8a3bd5f`
+
+// VerifyAgainst checks that the specified description, typically obtained
+// from a service via org.varlink.service.GetInterfaceDescription, defines
+// the same interface that this code was generated from.
+func VerifyAgainst(description string) error {
+	return syntax.VerifyFingerprint(description, Fingerprint)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package podman
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"snai.pe/go-varlink"
+)
+
+//go:generate go run snai.pe/go-varlink/cmd/codegen io.podman.varlink
+
+// RootfulSocketPath is the path of the socket of the system-wide podman
+// service.
+const RootfulSocketPath = "/run/podman/io.podman"
+
+// ErrNoSocket is returned by Discover when no podman socket could be found.
+var ErrNoSocket = errors.New("no podman socket found")
+
+// RootlessSocketPath returns the path of the socket of the podman service
+// running for the current user.
+func RootlessSocketPath() string {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = fmt.Sprintf("/run/user/%d", os.Getuid())
+	}
+	return filepath.Join(dir, "podman", "io.podman")
+}
+
+// SocketURIs returns the URIs of the well-known podman sockets, in order of
+// preference for the current user.
+//
+// Rootless sockets are preferred for unprivileged users, and rootful sockets
+// are preferred for root.
+func SocketURIs() []string {
+	rootful := "unix:" + RootfulSocketPath
+	rootless := "unix:" + RootlessSocketPath()
+	if os.Geteuid() == 0 {
+		return []string{rootful, rootless}
+	}
+	return []string{rootless, rootful}
+}
+
+// Discover returns the URI of the first well-known podman socket that
+// exists, as listed by SocketURIs.
+//
+// The PODMAN_VARLINK_ADDRESS environment variable, if set, takes precedence
+// over the well-known sockets.
+func Discover() (string, error) {
+	if addr := os.Getenv("PODMAN_VARLINK_ADDRESS"); addr != "" {
+		return addr, nil
+	}
+	for _, uri := range SocketURIs() {
+		path := strings.TrimPrefix(uri, "unix:")
+		fi, err := os.Stat(path)
+		if err == nil && fi.Mode().Type() == fs.ModeSocket {
+			return uri, nil
+		}
+	}
+	return "", ErrNoSocket
+}
+
+// NewClient returns a client for the podman service listening on the
+// discovered socket.
+func NewClient() (*Client, error) {
+	addr, err := Discover()
+	if err != nil {
+		return nil, err
+	}
+	uri, err := varlink.ParseURI(addr)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{}
+	client.URI = uri
+	return client, nil
+}
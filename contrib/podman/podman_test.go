@@ -0,0 +1,53 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package podman_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/contrib/podman"
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestGeneratedDefinition(t *testing.T) {
+	intf, err := syntax.NewParser(strings.NewReader(podman.Description)).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(podman.Definition, intf) {
+		t.Fatalf("generated definition of %v is different from its parsed description", intf.Name)
+	}
+	if intf.Name != podman.InterfaceName {
+		t.Fatalf("interface name is %q, expected %q", intf.Name, podman.InterfaceName)
+	}
+}
+
+func TestSocketURIs(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1234")
+
+	uris := podman.SocketURIs()
+	if !slices.Contains(uris, "unix:/run/user/1234/podman/io.podman") {
+		t.Fatalf("rootless socket missing from %v", uris)
+	}
+	if !slices.Contains(uris, "unix:"+podman.RootfulSocketPath) {
+		t.Fatalf("rootful socket missing from %v", uris)
+	}
+}
+
+func TestDiscoverEnv(t *testing.T) {
+	t.Setenv("PODMAN_VARLINK_ADDRESS", "tcp:127.0.0.1:12345")
+
+	uri, err := podman.Discover()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uri != "tcp:127.0.0.1:12345" {
+		t.Fatalf("discovered %q, expected address from environment", uri)
+	}
+}
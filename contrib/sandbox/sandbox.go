@@ -0,0 +1,135 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package sandbox restricts what the handlers of a varlink service can do,
+// with Landlock and seccomp, for system services that process untrusted
+// input over their sockets.
+//
+// Restrictions are set declaratively by a Policy, and applied either to the
+// whole process with Restrict, once the service is set up, or to the calls
+// matching method patterns with Interceptor:
+//
+//	server := varlink.Server{
+//		Handler: &mux,
+//		Interceptors: []varlink.Interceptor{
+//			sandbox.Interceptor(sandbox.Rule{
+//				Pattern: "org.example.parser.*",
+//				Policy: sandbox.Policy{
+//					RestrictFilesystem: true,
+//					ReadOnly:           []string{"/usr/share/example"},
+//					DenySyscalls:       []uintptr{syscall.SYS_EXECVE, syscall.SYS_EXECVEAT},
+//				},
+//			}),
+//		},
+//	}
+//
+// Restrictions are irreversible. Interceptor serves each matching call on
+// an operating system thread of its own, which is restricted and discarded
+// after the call, so the restrictions do not leak into other calls; the
+// goroutines that handlers start run on other threads, without them.
+//
+// The package is only supported on Linux; elsewhere, restricting returns an
+// error wrapping errors.ErrUnsupported.
+package sandbox
+
+import (
+	"path"
+	"runtime"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+// Policy describes the restrictions of a sandbox.
+type Policy struct {
+	// RestrictFilesystem, if true, denies all filesystem access, except
+	// beneath the ReadOnly and ReadWrite paths. It requires Landlock.
+	RestrictFilesystem bool
+
+	// ReadOnly are the paths beneath which files can be read and executed,
+	// and directories listed.
+	ReadOnly []string
+
+	// ReadWrite are the paths beneath which all filesystem access is
+	// allowed.
+	ReadWrite []string
+
+	// DenySyscalls are the numbers of the system calls that fail with
+	// EPERM, as found in the syscall package. The Go runtime needs some
+	// system calls, like futex and mmap, which must not be denied.
+	DenySyscalls []uintptr
+
+	// BestEffort, if true, skips the filesystem restrictions when the
+	// kernel does not support Landlock, rather than failing.
+	BestEffort bool
+}
+
+// Restrict applies the policy to all the threads of the process, which is
+// meant to be done once the service is set up, before it serves calls.
+//
+// On Linux, Restrict is not supported by binaries using cgo, whose threads
+// cannot all be restricted.
+func Restrict(policy Policy) error {
+	return restrictProcess(policy)
+}
+
+// Rule applies a policy to the calls to the methods matching a pattern, in
+// the syntax of path.Match, like the patterns of varlink.ServeMux.
+type Rule struct {
+	Pattern string
+	Policy  Policy
+}
+
+// Interceptor returns an interceptor serving the calls matching the
+// pattern of a rule in a sandbox restricted by the policy of the first such
+// rule. Other calls are passed through.
+//
+// Calls that cannot be sandboxed get an
+// org.varlink.service.PermissionDenied error, and are not served.
+func Interceptor(rules ...Rule) varlink.Interceptor {
+	for _, rule := range rules {
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			panic(err)
+		}
+	}
+	return func(next varlink.MethodHandler) varlink.MethodHandler {
+		return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+			for _, rule := range rules {
+				if matched, _ := path.Match(rule.Pattern, call.Method); matched {
+					serve(rule.Policy, next, w, call)
+					return
+				}
+			}
+			next.ServeMethod(w, call)
+		})
+	}
+}
+
+// Handler returns a handler serving all calls in a sandbox restricted by
+// the policy.
+func Handler(policy Policy, handler varlink.MethodHandler) varlink.MethodHandler {
+	return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		serve(policy, handler, w, call)
+	})
+}
+
+// serve serves a call on a thread of its own, which is restricted before
+// the call, and terminated after it.
+func serve(policy Policy, handler varlink.MethodHandler, w varlink.ReplyWriter, call *varlink.Call) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		// The goroutine exits without unlocking the thread, which makes
+		// the runtime terminate it rather than reuse it.
+		runtime.LockOSThread()
+		if err := restrictThread(policy); err != nil {
+			w.WriteError(service.PermissionDenied())
+			return
+		}
+		handler.ServeMethod(w, call)
+	}()
+	<-done
+}
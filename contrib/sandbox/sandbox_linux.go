@@ -0,0 +1,247 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package sandbox
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// The Landlock system calls have the same numbers on all architectures.
+const (
+	sysLandlockCreateRuleset = 444
+	sysLandlockAddRule       = 445
+	sysLandlockRestrictSelf  = 446
+)
+
+const (
+	// The syscall package does not define O_PATH.
+	oPath = 0x200000
+
+	landlockCreateRulesetVersion = 1
+	landlockRulePathBeneath      = 1
+)
+
+// Landlock filesystem access rights.
+const (
+	accessExecute    = 1 << 0
+	accessWriteFile  = 1 << 1
+	accessReadFile   = 1 << 2
+	accessReadDir    = 1 << 3
+	accessTruncate   = 1 << 14
+	accessIoctlDev   = 1 << 15
+	accessFileRights = accessExecute | accessWriteFile | accessReadFile | accessTruncate | accessIoctlDev
+	accessReadOnly   = accessExecute | accessReadFile | accessReadDir
+)
+
+// handledAccess returns the filesystem access rights known to a version of
+// the Landlock ABI.
+func handledAccess(abi int) uint64 {
+	switch {
+	case abi >= 5:
+		return 1<<16 - 1
+	case abi >= 3:
+		return 1<<15 - 1
+	case abi == 2:
+		return 1<<14 - 1
+	default:
+		return 1<<13 - 1
+	}
+}
+
+const (
+	prSetNoNewPrivs   = 38
+	prSetSeccomp      = 22
+	seccompModeFilter = 2
+
+	seccompRetAllow = 0x7fff0000
+	seccompRetErrno = 0x00050000
+
+	bpfLd  = 0x00
+	bpfW   = 0x00
+	bpfAbs = 0x20
+	bpfJmp = 0x05
+	bpfJeq = 0x10
+	bpfJge = 0x30
+	bpfK   = 0x00
+	bpfRet = 0x06
+)
+
+// auditArch holds the AUDIT_ARCH values of the architectures whose system
+// calls can be filtered.
+var auditArch = map[string]uint32{
+	"386":     0x40000003,
+	"amd64":   0xc000003e,
+	"arm":     0x40000028,
+	"arm64":   0xc00000b7,
+	"loong64": 0xc0000102,
+	"ppc64le": 0xc0000015,
+	"riscv64": 0xc00000f3,
+	"s390x":   0x80000016,
+}
+
+type sockFilter struct {
+	code uint16
+	jt   uint8
+	jf   uint8
+	k    uint32
+}
+
+type sockFprog struct {
+	len    uint16
+	filter *sockFilter
+}
+
+// filter returns the seccomp program failing the denied system calls with
+// EPERM.
+func (policy Policy) filter() ([]sockFilter, error) {
+	arch, ok := auditArch[runtime.GOARCH]
+	if !ok {
+		return nil, fmt.Errorf("filtering system calls on %s: %w", runtime.GOARCH, errors.ErrUnsupported)
+	}
+	deny := sockFilter{code: bpfRet | bpfK, k: seccompRetErrno | uint32(syscall.EPERM)}
+
+	prog := []sockFilter{
+		{code: bpfLd | bpfW | bpfAbs, k: 4}, // seccomp_data.arch
+		{code: bpfJmp | bpfJeq | bpfK, jt: 1, k: arch},
+		deny,
+		{code: bpfLd | bpfW | bpfAbs, k: 0}, // seccomp_data.nr
+	}
+	if runtime.GOARCH == "amd64" {
+		// System calls of the x32 ABI have their own numbers.
+		prog = append(prog, sockFilter{code: bpfJmp | bpfJge | bpfK, jf: 1, k: 0x40000000}, deny)
+	}
+	for _, nr := range policy.DenySyscalls {
+		prog = append(prog, sockFilter{code: bpfJmp | bpfJeq | bpfK, jf: 1, k: uint32(nr)}, deny)
+	}
+	prog = append(prog, sockFilter{code: bpfRet | bpfK, k: seccompRetAllow})
+	return prog, nil
+}
+
+// ruleset returns a Landlock ruleset allowing access beneath the paths of
+// the policy, or -1 if the filesystem is not restricted.
+func (policy Policy) ruleset() (int, error) {
+	if !policy.RestrictFilesystem {
+		return -1, nil
+	}
+	abi, _, errno := syscall.Syscall(sysLandlockCreateRuleset, 0, 0, landlockCreateRulesetVersion)
+	if errno != 0 {
+		if policy.BestEffort {
+			return -1, nil
+		}
+		return -1, fmt.Errorf("landlock: %w", errno)
+	}
+	handled := handledAccess(int(abi))
+
+	attr := handled
+	fd, _, errno := syscall.Syscall(sysLandlockCreateRuleset, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return -1, fmt.Errorf("landlock: creating ruleset: %w", errno)
+	}
+
+	add := func(path string, access uint64) error {
+		f, err := os.OpenFile(path, oPath|syscall.O_CLOEXEC, 0)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			access &= accessFileRights
+		}
+
+		// struct landlock_path_beneath_attr is packed.
+		var rule [12]byte
+		binary.NativeEndian.PutUint64(rule[:], access&handled)
+		binary.NativeEndian.PutUint32(rule[8:], uint32(f.Fd()))
+		_, _, errno := syscall.Syscall6(sysLandlockAddRule, fd, landlockRulePathBeneath, uintptr(unsafe.Pointer(&rule)), 0, 0, 0)
+		if errno != 0 {
+			return fmt.Errorf("landlock: allowing access beneath %s: %w", path, errno)
+		}
+		return nil
+	}
+	for _, path := range policy.ReadOnly {
+		if err := add(path, accessReadOnly); err != nil {
+			syscall.Close(int(fd))
+			return -1, err
+		}
+	}
+	for _, path := range policy.ReadWrite {
+		if err := add(path, handled); err != nil {
+			syscall.Close(int(fd))
+			return -1, err
+		}
+	}
+	return int(fd), nil
+}
+
+// restrictThread applies the policy to the calling thread.
+func restrictThread(policy Policy) error {
+	return restrict(policy, false)
+}
+
+// restrictProcess applies the policy to all the threads of the process.
+func restrictProcess(policy Policy) error {
+	return restrict(policy, true)
+}
+
+func restrict(policy Policy, allThreads bool) error {
+	var prog []sockFilter
+	if len(policy.DenySyscalls) > 0 {
+		var err error
+		if prog, err = policy.filter(); err != nil {
+			return err
+		}
+	}
+	ruleset, err := policy.ruleset()
+	if err != nil {
+		return err
+	}
+	if ruleset >= 0 {
+		defer syscall.Close(ruleset)
+	}
+	if ruleset < 0 && prog == nil {
+		return nil
+	}
+
+	call := syscall.RawSyscall
+	if allThreads {
+		call = syscall.AllThreadsSyscall
+	}
+
+	// Both Landlock and seccomp require the thread to not gain
+	// privileges, for instance by executing setuid programs.
+	if _, _, errno := call(syscall.SYS_PRCTL, prSetNoNewPrivs, 1, 0); errno != 0 {
+		return fmt.Errorf("setting no_new_privs: %w", errno)
+	}
+	if ruleset >= 0 {
+		if _, _, errno := call(sysLandlockRestrictSelf, uintptr(ruleset), 0, 0); errno != 0 {
+			return fmt.Errorf("landlock: restricting: %w", errno)
+		}
+	}
+	if prog != nil {
+		fprog := &sockFprog{len: uint16(len(prog)), filter: &prog[0]}
+		var errno syscall.Errno
+		if allThreads {
+			_, _, errno = syscall.AllThreadsSyscall(syscall.SYS_PRCTL, prSetSeccomp, seccompModeFilter, uintptr(unsafe.Pointer(fprog)))
+		} else {
+			_, _, errno = syscall.RawSyscall(syscall.SYS_PRCTL, prSetSeccomp, seccompModeFilter, uintptr(unsafe.Pointer(fprog)))
+		}
+		runtime.KeepAlive(fprog)
+		if errno != 0 {
+			return fmt.Errorf("seccomp: %w", errno)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+func restrictThread(policy Policy) error {
+	return fmt.Errorf("sandboxing on %s: %w", runtime.GOOS, errors.ErrUnsupported)
+}
+
+func restrictProcess(policy Policy) error {
+	return restrictThread(policy)
+}
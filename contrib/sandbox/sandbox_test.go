@@ -0,0 +1,82 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build linux
+
+package sandbox_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/contrib/sandbox"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+// run serves a call with the handler function in a sandbox, and returns the
+// error it returned.
+func run(t *testing.T, policy sandbox.Policy, fn func() error) error {
+	t.Helper()
+	var err error
+	handler := varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		err = fn()
+		w.WriteReply(nil)
+	})
+	intercept := sandbox.Interceptor(sandbox.Rule{Pattern: "org.example.*", Policy: policy})
+
+	w := varlinktest.NewReplyWriter(t)
+	intercept(handler).ServeMethod(w, &varlink.Call{Method: "org.example.Run"})
+	if replies := w.Replies(); len(replies) != 1 || replies[0].Error != "" {
+		t.Fatalf("got replies %v, expected a single reply", replies)
+	}
+	return err
+}
+
+func TestDenySyscalls(t *testing.T) {
+	policy := sandbox.Policy{DenySyscalls: []uintptr{syscall.SYS_UNAME}}
+
+	var uts syscall.Utsname
+	err := run(t, policy, func() error { return syscall.Uname(&uts) })
+	if !errors.Is(err, syscall.EPERM) {
+		t.Fatalf("uname in the sandbox returned %v, expected EPERM", err)
+	}
+	if err := syscall.Uname(&uts); err != nil {
+		t.Fatalf("uname outside of the sandbox returned %v", err)
+	}
+}
+
+func TestRestrictFilesystem(t *testing.T) {
+	// landlock_create_ruleset(NULL, 0, LANDLOCK_CREATE_RULESET_VERSION)
+	if _, _, errno := syscall.Syscall(444, 0, 0, 1); errno != 0 {
+		t.Skipf("landlock is not supported: %v", errno)
+	}
+
+	allowed := filepath.Join(t.TempDir(), "allowed")
+	denied := filepath.Join(t.TempDir(), "denied")
+	for _, path := range []string{allowed, denied} {
+		if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	policy := sandbox.Policy{RestrictFilesystem: true, ReadOnly: []string{allowed}}
+	err := run(t, policy, func() error {
+		if _, err := os.ReadFile(allowed); err != nil {
+			return err
+		}
+		_, err := os.ReadFile(denied)
+		return err
+	})
+	if !errors.Is(err, os.ErrPermission) {
+		t.Fatalf("reading a denied file returned %v, expected a permission error", err)
+	}
+	if _, err := os.ReadFile(denied); err != nil {
+		t.Fatalf("reading outside of the sandbox returned %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrWriteTimeout is returned, wrapped along with its cause, by the writes of
+// a session that did not complete in time: before the deadline of their
+// context, before the write timeout of the session, or before their context
+// was canceled, as when a server stops serving.
+//
+// The message may have been partially written, after which the session
+// must be closed. Servers close the sessions of clients whose replies time
+// out, which evicts consumers that do not keep up.
+var ErrWriteTimeout = errors.New("write timed out")
+
+// SetWriteTimeout sets the time that writing a message may take before
+// failing with ErrWriteTimeout. A duration of zero, the default, means that
+// writes are only bounded by their context.
+func (session *Session) SetWriteTimeout(d time.Duration) {
+	session.writeTimeout.Store(int64(d))
+}
+
+// writeDeadline sets the write deadline of the connection from the deadline
+// of ctx and the write timeout of the session, and interrupts the writes
+// once ctx is done. It must be called with wmu held, and returns a function
+// to call once the writes are done, which clears the write deadline and
+// turns the error of the writes into ErrWriteTimeout if they were
+// interrupted.
+func (session *Session) writeDeadline(ctx context.Context) func(error) error {
+	deadline, ok := ctx.Deadline()
+	if d := time.Duration(session.writeTimeout.Load()); d > 0 {
+		if t := time.Now().Add(d); !ok || t.Before(deadline) {
+			deadline, ok = t, true
+		}
+	}
+	if !ok && ctx.Done() == nil {
+		return func(err error) error { return err }
+	}
+
+	conn := session.conn
+	if ok {
+		conn.SetWriteDeadline(deadline)
+	}
+
+	var (
+		mu   sync.Mutex
+		done bool
+	)
+	stop := context.AfterFunc(ctx, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if !done {
+			conn.SetWriteDeadline(aLongTimeAgo)
+		}
+	})
+
+	return func(err error) error {
+		stop()
+		mu.Lock()
+		done = true
+		mu.Unlock()
+		conn.SetWriteDeadline(time.Time{})
+
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			return err
+		}
+		if cause := context.Cause(ctx); cause != nil {
+			err = cause
+		}
+		return fmt.Errorf("%w: %w", ErrWriteTimeout, err)
+	}
+}
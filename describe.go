@@ -0,0 +1,45 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"strings"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// Description returns the description of the specified interface, as
+// returned by org.varlink.service.GetInterfaceDescription on the service at
+// the URI set by opts, or the default URI of the client.
+//
+// The call is made with the `more` flag, so that services like ServeMux
+// can split large descriptions into several replies, each within the
+// message size limit of the client, which Description concatenates.
+// Services replying once return the description whole.
+func (client *Client) Description(ctx context.Context, intf string, opts ...CallOption) (string, error) {
+	in := service.GetInterfaceDescriptionInput{Interface: intf}
+	rs, err := client.Call(ctx, "org.varlink.service.GetInterfaceDescription", &in, append([]CallOption{More()}, opts...)...)
+	if err != nil {
+		return "", err
+	}
+
+	var desc strings.Builder
+	for rs.Next() {
+		if err := rs.Error(); err != nil {
+			return "", err
+		}
+		var out service.GetInterfaceDescriptionOutput
+		if err := rs.Unmarshal(&out); err != nil {
+			return "", err
+		}
+		desc.WriteString(out.Description)
+	}
+	if err := rs.Error(); err != nil {
+		return "", err
+	}
+	return desc.String(), nil
+}
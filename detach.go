@@ -0,0 +1,265 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+// SessionState is the state of a detached session that is needed to resume
+// it, in addition to its socket. It can be marshaled to JSON, for instance
+// to hand it over to a re-executed process.
+type SessionState struct {
+
+	// Buffered holds the data that was read from the socket, but not
+	// processed yet.
+	Buffered []byte `json:"buffered,omitempty"`
+
+	// Framing is the framing used by the session. An empty value means the
+	// standard NUL-delimited framing.
+	Framing string `json:"framing,omitempty"`
+
+	// Compression is the compression of the messages written on the
+	// session, if any.
+	Compression *CompressionOptions `json:"compression,omitempty"`
+
+	// Calls are the calls that were received, but not served yet. These
+	// are returned first by ReadCall on the resumed session.
+	Calls []Call `json:"calls,omitempty"`
+}
+
+// aLongTimeAgo is a deadline in the past, used to interrupt blocked reads
+// and writes.
+var aLongTimeAgo = time.Unix(1, 0)
+
+// Detach stops the session and hands over its underlying socket, so that it
+// can be resumed with ResumeSession, possibly in another process. This is
+// typically used by daemons to restart without dropping their clients.
+//
+// Detach drains the session before detaching it:
+//
+//   - ReadCall stops returning calls, and returns ErrSessionDetached
+//     instead; the calls received from then on are kept in the state, to be
+//     served by the resumed session.
+//   - Calls previously returned by ReadCall must be replied to. Detach waits
+//     until they are.
+//   - Calls written with WriteCall must receive their final reply. Detach
+//     waits until they do, and new calls fail with ErrSessionDetached.
+//
+// A Server serving the session stops reading calls when ReadCall returns
+// ErrSessionDetached, and ServeSession returns once the calls it already
+// read have been served.
+//
+// The socket is returned as a new file, and the session is closed. It is
+// up to the caller to make sure the file is inherited by the process
+// resuming the session, for instance via os/exec.Cmd.ExtraFiles.
+//
+// If ctx becomes done before the session is drained, Detach returns the
+// context error. The session can then no longer be used to serve calls,
+// and should be closed.
+func (session *Session) Detach(ctx context.Context) (*os.File, *SessionState, error) {
+	filer, ok := session.conn.(interface{ File() (*os.File, error) })
+	if uc, isUnix := session.conn.(*UnixConn); isUnix {
+		filer, ok = uc.conn, true
+	}
+	if !ok {
+		return nil, nil, errors.New("detach: underlying connection does not expose its file descriptor")
+	}
+
+	session.detaching.Store(true)
+
+	if err := session.interruptReader(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	// Wait for the session to drain, and keep the session locked once it
+	// is so that nothing can change under our feet.
+	for {
+		session.cond.L.Lock()
+		for session.unreplied > 0 || len(session.inflight) > 0 {
+			if err := session.cond.Wait(ctx); err != nil {
+				session.cond.L.Unlock()
+				return nil, nil, err
+			}
+		}
+		session.cond.L.Unlock()
+
+		session.wmu.Lock()
+		session.rcond.L.Lock()
+		session.cond.L.Lock()
+		if session.unreplied == 0 && len(session.inflight) == 0 && !session.reading {
+			break
+		}
+		session.cond.L.Unlock()
+		session.rcond.L.Unlock()
+		session.wmu.Unlock()
+	}
+	defer session.wmu.Unlock()
+	defer session.rcond.L.Unlock()
+	defer session.cond.L.Unlock()
+
+	if err := session.flushUnlocked(); err != nil {
+		return nil, nil, err
+	}
+	state, err := session.state()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := filer.File()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session.cond.Broadcast()
+	session.conn.Close()
+	return file, state, nil
+}
+
+// interruptReader interrupts the goroutine blocked in ReadCall, if any.
+// Goroutines reading replies for calls in flight resume reading once it
+// is done.
+func (session *Session) interruptReader(ctx context.Context) error {
+	session.rcond.L.Lock()
+	defer session.rcond.L.Unlock()
+
+	session.rinterrupt = true
+	defer func() {
+		session.conn.SetReadDeadline(time.Time{})
+		session.rinterrupt = false
+		session.rcond.Broadcast()
+	}()
+
+	if err := session.conn.SetReadDeadline(aLongTimeAgo); err != nil {
+		return err
+	}
+	session.rcond.Broadcast()
+
+	for session.reading {
+		if err := session.rcond.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// interrupted returns whether err results from interruptReader. It must be
+// called with rcond.L held.
+func (session *Session) interrupted(err error) bool {
+	return session.rinterrupt && errors.Is(err, os.ErrDeadlineExceeded)
+}
+
+// waitInterrupt lets interruptReader complete, and waits until the caller
+// can resume reading. It must be called by the goroutine currently reading,
+// with rcond.L held.
+func (session *Session) waitInterrupt(ctx context.Context) error {
+	session.reading = false
+	session.rcond.Broadcast()
+
+	for session.rinterrupt || session.reading {
+		if err := session.rcond.Wait(ctx); err != nil {
+			session.reading = true
+			return err
+		}
+	}
+	session.reading = true
+	return nil
+}
+
+// state captures the state of a drained session. It must be called with
+// all session locks held.
+func (session *Session) state() (*SessionState, error) {
+	if uc, ok := session.conn.(*UnixConn); ok && len(uc.rfds) > 0 {
+		return nil, errors.New("detach: session has received file descriptors that were not collected")
+	}
+
+	var state SessionState
+	for _, call := range session.cq {
+		if len(call.FileDescriptors) > 0 {
+			return nil, errors.New("detach: received calls carry file descriptors")
+		}
+		state.Calls = append(state.Calls, call)
+	}
+
+	// Reconstruct the data of any partially read frame.
+	var buffered bytes.Buffer
+	switch session.rframing {
+	case framingLengthPrefixed:
+		state.Framing = framingNameLengthPrefixed
+		state.Compression = session.wcompress
+		if session.rframe != nil {
+			length := uint32(len(session.rframe))
+			if session.rframeCompressed {
+				length |= frameCompressed
+			}
+			buffered.Write(binary.BigEndian.AppendUint32(nil, length))
+			buffered.Write(session.rframe[:session.rframeOff])
+		}
+	default:
+		buffered.Write(session.rbuf)
+	}
+
+	rbuf, err := session.rw.Peek(session.rw.Reader.Buffered())
+	if err != nil {
+		return nil, err
+	}
+	buffered.Write(rbuf)
+
+	if buffered.Len() > 0 {
+		state.Buffered = buffered.Bytes()
+	}
+	return &state, nil
+}
+
+// ResumeSession reconstructs a session detached with Session.Detach from
+// its socket file and state.
+//
+// The file is duplicated, and it is the responsibility of the caller to
+// close it.
+func ResumeSession(file *os.File, state *SessionState) (*Session, error) {
+	conn, err := net.FileConn(file)
+	if err != nil {
+		return nil, err
+	}
+
+	session := NewSession(conn)
+	if state == nil {
+		return session, nil
+	}
+
+	switch state.Framing {
+	case "":
+	case framingNameLengthPrefixed:
+		session.rframing = framingLengthPrefixed
+		session.wframing = framingLengthPrefixed
+		if state.Compression != nil {
+			if err := state.Compression.validate(); err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("resume: %w", err)
+			}
+			session.wcompress = state.Compression
+		}
+	default:
+		conn.Close()
+		return nil, errors.New("resume: unknown framing " + state.Framing)
+	}
+
+	if len(state.Buffered) > 0 {
+		r := io.MultiReader(bytes.NewReader(state.Buffered), session.conn)
+		session.rw.Reader = bufio.NewReader(r)
+	}
+	session.cq = append(session.cq, state.Calls...)
+	return session, nil
+}
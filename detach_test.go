@@ -0,0 +1,127 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix
+
+package varlink
+
+import (
+	"context"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func socketpair(t *testing.T) (net.Conn, net.Conn) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conns [2]net.Conn
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conns[i], err = net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return conns[0], conns[1]
+}
+
+func TestDetachResume(t *testing.T) {
+	cconn, sconn := socketpair(t)
+	client := NewSession(cconn)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	release := make(chan struct{})
+	served := make(chan string, 2)
+	echo := HandlerFunc(func(w ReplyWriter, call *Call) {
+		if call.Method == "org.example.Slow" {
+			<-release
+		}
+		served <- call.Method
+		w.WriteReply(nil)
+	})
+
+	server := NewSession(sconn)
+	srv := Server{Handler: echo}
+	serveDone := make(chan struct{})
+	go func() {
+		srv.ServeSession(ctx, server)
+		close(serveDone)
+	}()
+
+	slow, _ := MakeCall("org.example.Slow", nil)
+	if err := client.WriteCall(ctx, &slow); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cconn.Write([]byte(`{"method":"org.exa`)); err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		file  *os.File
+		state *SessionState
+		err   error
+	}
+	detached := make(chan result)
+	go func() {
+		// Make sure the server is handling the slow call before detaching.
+		time.Sleep(10 * time.Millisecond)
+		f, s, err := server.Detach(ctx)
+		detached <- result{f, s, err}
+	}()
+
+	select {
+	case <-detached:
+		t.Fatal("Detach returned before the slow call was replied to")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+
+	res := <-detached
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+	defer res.file.Close()
+	<-serveDone
+
+	var reply Reply
+	if err := client.ReadReply(ctx, &slow, &reply); err != nil {
+		t.Fatal(err)
+	}
+
+	resumed, err := ResumeSession(res.file, res.state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resumed.Close()
+	go srv.ServeSession(ctx, resumed)
+
+	// Finish the call that was partially written when the session was
+	// detached.
+	fast := Call{Method: "org.example.Fast"}
+	client.cond.L.Lock()
+	client.inflight = append(client.inflight, &fast)
+	client.cond.L.Unlock()
+	if _, err := cconn.Write([]byte("mple.Fast\"}\x00")); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ReadReply(ctx, &fast, &reply); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{"org.example.Slow", "org.example.Fast"} {
+		if method := <-served; method != expected {
+			t.Fatalf("served %q, expected %q", method, expected)
+		}
+	}
+}
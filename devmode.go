@@ -0,0 +1,90 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Limits on the size of the debug information included in error replies in
+// development mode.
+const (
+	maxDebugStack      = 8192
+	maxDebugParameters = 1024
+)
+
+// errInternal is the error code of internal errors.
+const errInternal = `snai.pe.varlink.InternalError`
+
+type devModeKey struct{}
+
+// debugInfo is the debug information carried by internal errors in
+// development mode.
+type debugInfo struct {
+	Message string        `json:"message"`
+	Stack   string        `json:"stack,omitempty"`
+	Call    *callSnapshot `json:"call,omitempty"`
+}
+
+type callSnapshot struct {
+	Method     string `json:"method"`
+	Parameters string `json:"parameters,omitempty"`
+	More       bool   `json:"more,omitempty"`
+	OneWay     bool   `json:"oneway,omitempty"`
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + fmt.Sprintf("... (%d bytes truncated)", len(s)-n)
+}
+
+// devModeCall returns the call being served in development mode, or nil if
+// the server serving ctx is not in development mode.
+func devModeCall(ctx context.Context) *Call {
+	call, _ := ctx.Value(devModeKey{}).(*Call)
+	return call
+}
+
+// debugError returns an internal error carrying debug information about the
+// failed call.
+func debugError(call *Call, message string, stack []byte) Error {
+	info := debugInfo{
+		Message: message,
+		Stack:   truncate(string(stack), maxDebugStack),
+	}
+	if call != nil {
+		info.Call = &callSnapshot{
+			Method:     call.Method,
+			Parameters: truncate(string(call.Parameters), maxDebugParameters),
+			More:       call.More,
+			OneWay:     call.OneWay,
+		}
+	}
+	verr, err := NewErrorParams(errInternal, &info)
+	if err != nil {
+		return NewError(errInternal)
+	}
+	return verr
+}
+
+// recoverHandler recovers from a panic of the handler serving the call, and
+// replies with an internal error carrying the panic value and stack trace,
+// unless a final reply was already written.
+func recoverHandler(w *replyWriter) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	stack := debug.Stack()
+	if w.hasReplied() {
+		return
+	}
+	w.writeInternal(debugError(w.call, fmt.Sprintf("panic: %v", v), stack))
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+)
+
+// Dialer contains options for opening varlink sessions.
+//
+// The zero value for each field is equivalent to dialing without that option.
+type Dialer struct {
+
+	// NamespacePid, if non-zero, makes the dialer connect to the address as
+	// seen from the namespaces of the specified process.
+	//
+	// Pathname unix sockets are resolved relative to the root directory of
+	// the process (via /proc/<pid>/root), while abstract unix sockets and
+	// tcp addresses are dialed from within the network namespace of the
+	// process.
+	//
+	// This is only supported on Linux.
+	NamespacePid int
+
+	// NamespacePidfd, if non-nil, is a pidfd referring to the process whose
+	// namespaces should be used to dial the address. It behaves like
+	// NamespacePid, and takes precedence over it.
+	//
+	// This is only supported on Linux.
+	NamespacePidfd *os.File
+}
+
+// A DialOption is any option that applies to dialing a session.
+type DialOption interface {
+	SetDialOption(*Dialer) error
+}
+
+type funcDialOpt func(*Dialer) error
+
+func (fn funcDialOpt) SetDialOption(opts *Dialer) error {
+	return fn(opts)
+}
+
+// InNamespaceOf makes the session connect to the address as seen from the
+// namespaces of the process identified by pid. See [Dialer.NamespacePid].
+func InNamespaceOf(pid int) DialOption {
+	return funcDialOpt(func(opts *Dialer) error {
+		opts.NamespacePid = pid
+		return nil
+	})
+}
+
+// InNamespaceOfPidfd makes the session connect to the address as seen from
+// the namespaces of the process referred to by pidfd.
+// See [Dialer.NamespacePidfd].
+func InNamespaceOfPidfd(pidfd *os.File) DialOption {
+	return funcDialOpt(func(opts *Dialer) error {
+		opts.NamespacePidfd = pidfd
+		return nil
+	})
+}
+
+// Dial opens a session for the specified uri.
+func Dial(ctx context.Context, uri string, opts ...DialOption) (*Session, error) {
+	var d Dialer
+	for _, opt := range opts {
+		if err := opt.SetDialOption(&d); err != nil {
+			return nil, err
+		}
+	}
+	return d.Dial(ctx, uri)
+}
+
+// Dial opens a session for the specified uri.
+func (d *Dialer) Dial(ctx context.Context, uri string) (*Session, error) {
+	u, err := ParseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	return d.DialURI(ctx, u)
+}
+
+// DialURI opens a session for the specified parsed uri.
+func (d *Dialer) DialURI(ctx context.Context, u URI) (*Session, error) {
+	var (
+		conn net.Conn
+		err  error
+	)
+	switch u.Scheme {
+	case "tcp", "unix":
+		if d.NamespacePid != 0 || d.NamespacePidfd != nil {
+			conn, err = d.dialNamespaced(ctx, u.Scheme, u.Address)
+		} else {
+			var nd net.Dialer
+			conn, err = nd.DialContext(ctx, u.Scheme, u.Address)
+		}
+	case "serial", "char":
+		conn, err = d.dialDevice(ctx, u)
+	case "fd":
+		conn, err = dialFd(u)
+	default:
+		err = fmt.Errorf("dial %v: %w", u, ErrUnsupportedScheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSession(conn), nil
+}
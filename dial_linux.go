@@ -0,0 +1,138 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+func (d *Dialer) dialNamespaced(ctx context.Context, network, address string) (net.Conn, error) {
+	var nd net.Dialer
+
+	// Pathname sockets live in the filesystem, so all we need to do is to
+	// resolve them from the root of the target process. This avoids the need
+	// to join its mount namespace, which Go programs cannot do anyway since
+	// all of their threads share the same filesystem attributes.
+	if network == "unix" && !strings.HasPrefix(address, "@") {
+		pid, err := d.namespacePid()
+		if err != nil {
+			return nil, err
+		}
+		if path.IsAbs(address) {
+			address = fmt.Sprintf("/proc/%d/root%s", pid, address)
+		} else {
+			address = fmt.Sprintf("/proc/%d/cwd/%s", pid, address)
+		}
+		return nd.DialContext(ctx, network, address)
+	}
+
+	var nsfd int
+	if d.NamespacePidfd != nil {
+		nsfd = int(d.NamespacePidfd.Fd())
+	} else {
+		f, err := os.Open(fmt.Sprintf("/proc/%d/ns/net", d.NamespacePid))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		nsfd = int(f.Fd())
+	}
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	done := make(chan result, 1)
+
+	// Sockets are created in the network namespace of the calling thread,
+	// so we need to switch namespaces on a dedicated, locked thread. If
+	// switching back fails, the goroutine exits without unlocking, which
+	// causes the runtime to terminate the tainted thread.
+	go func() {
+		runtime.LockOSThread()
+
+		orig, err := os.Open("/proc/thread-self/ns/net")
+		if err != nil {
+			runtime.UnlockOSThread()
+			done <- result{err: err}
+			return
+		}
+		defer orig.Close()
+
+		if err := setns(nsfd, syscall.CLONE_NEWNET); err != nil {
+			runtime.UnlockOSThread()
+			done <- result{err: err}
+			return
+		}
+
+		conn, err := nd.DialContext(ctx, network, address)
+
+		if rerr := setns(int(orig.Fd()), syscall.CLONE_NEWNET); rerr != nil {
+			if conn != nil {
+				conn.Close()
+			}
+			done <- result{err: errors.Join(err, rerr)}
+			return
+		}
+		runtime.UnlockOSThread()
+		done <- result{conn: conn, err: err}
+	}()
+
+	res := <-done
+	return res.conn, res.err
+}
+
+func (d *Dialer) namespacePid() (int, error) {
+	if d.NamespacePidfd == nil {
+		return d.NamespacePid, nil
+	}
+
+	// There is no syscall to get the pid of a pidfd, but the kernel exposes
+	// it in the fdinfo of the descriptor.
+	f, err := os.Open(fmt.Sprintf("/proc/self/fdinfo/%d", d.NamespacePidfd.Fd()))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		val, ok := strings.CutPrefix(scanner.Text(), "Pid:")
+		if !ok {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			return 0, fmt.Errorf("parsing pidfd info: %w", err)
+		}
+		if pid <= 0 {
+			return 0, fmt.Errorf("pidfd refers to a process that has exited or is in another pid namespace")
+		}
+		return pid, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("fd %d is not a pidfd", d.NamespacePidfd.Fd())
+}
+
+func setns(fd int, nstype int) error {
+	_, _, errno := syscall.RawSyscall(sysSetns, uintptr(fd), uintptr(nstype), 0)
+	if errno != 0 {
+		return &os.SyscallError{Syscall: "setns", Err: errno}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build !linux
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+func (d *Dialer) dialNamespaced(ctx context.Context, network, address string) (net.Conn, error) {
+	return nil, errors.New("dialing into the namespaces of another process is not supported on this platform")
+}
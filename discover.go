@@ -0,0 +1,148 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// LocalService is a varlink service running on the local host, as found by
+// DiscoverLocal.
+type LocalService struct {
+	// URI is the address of the service.
+	URI URI
+
+	// Information about the service, as returned by its
+	// org.varlink.service.GetInfo method.
+	Vendor     string
+	Product    string
+	Version    string
+	URL        string
+	Interfaces []string
+}
+
+// DiscoverProbeTimeout is the time that DiscoverLocal waits for each
+// candidate service to reply before skipping it.
+const DiscoverProbeTimeout = 500 * time.Millisecond
+
+// DiscoverLocal enumerates the varlink services running on the local host.
+//
+// The candidate services are the varlink resolver at
+// /run/org.varlink.resolver, the unix sockets in $XDG_RUNTIME_DIR/varlink,
+// and on Linux, the listening unix sockets of the abstract namespace, as
+// listed in /proc/net/unix. Each candidate is probed concurrently with a
+// call to org.varlink.service.GetInfo, and candidates that do not reply
+// within DiscoverProbeTimeout, or that are not varlink services, are
+// skipped.
+//
+// The services are returned sorted by URI. DiscoverLocal only returns an
+// error if ctx becomes done, in which case the services found so far are
+// returned along with ctx.Err().
+func DiscoverLocal(ctx context.Context) ([]LocalService, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		services []LocalService
+	)
+	for _, uri := range discoverCandidates() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			svc, ok := probeService(ctx, uri)
+			if !ok {
+				return
+			}
+			mu.Lock()
+			services = append(services, svc)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].URI.String() < services[j].URI.String()
+	})
+	return services, ctx.Err()
+}
+
+// discoverCandidates returns the URIs of the sockets that may be varlink
+// services.
+func discoverCandidates() []URI {
+	var uris []URI
+	add := func(path string) {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return
+		}
+		switch {
+		case fi.Mode()&os.ModeSocket != 0:
+			uris = append(uris, URI{Scheme: "unix", Address: path})
+		case fi.IsDir():
+			entries, _ := os.ReadDir(path)
+			for _, entry := range entries {
+				if entry.Type()&os.ModeSocket != 0 {
+					uris = append(uris, URI{Scheme: "unix", Address: filepath.Join(path, entry.Name())})
+				}
+			}
+		}
+	}
+
+	add("/run/org.varlink.resolver")
+	if rundir := os.Getenv("XDG_RUNTIME_DIR"); rundir != "" {
+		add(filepath.Join(rundir, "varlink"))
+	}
+	for _, name := range abstractSockets() {
+		uris = append(uris, AbstractUnixURI(name))
+	}
+	return uris
+}
+
+// probeService calls org.varlink.service.GetInfo on the service at the
+// specified URI.
+func probeService(ctx context.Context, uri URI) (LocalService, bool) {
+	ctx, cancel := context.WithTimeout(ctx, DiscoverProbeTimeout)
+	defer cancel()
+
+	session, err := new(Dialer).DialURI(ctx, uri)
+	if err != nil {
+		return LocalService{}, false
+	}
+	defer session.Close()
+
+	call, err := MakeCall("org.varlink.service.GetInfo", nil)
+	if err != nil {
+		return LocalService{}, false
+	}
+	if err := session.WriteCall(ctx, &call); err != nil {
+		return LocalService{}, false
+	}
+
+	var reply Reply
+	if err := session.ReadReply(ctx, &call, &reply); err != nil || reply.Error != "" {
+		return LocalService{}, false
+	}
+
+	var info service.GetInfoOutput
+	if err := reply.Unmarshal(&info); err != nil {
+		return LocalService{}, false
+	}
+	return LocalService{
+		URI:        uri,
+		Vendor:     info.Vendor,
+		Product:    info.Product,
+		Version:    info.Version,
+		URL:        info.Url,
+		Interfaces: info.Interfaces,
+	}, true
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// abstractSockets returns the names of the listening stream sockets of the
+// abstract namespace, without the leading "@".
+func abstractSockets() []string {
+	f, err := os.Open("/proc/net/unix")
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	const (
+		acceptCon  = 0x10000 // __SO_ACCEPTCON: the socket is listening
+		sockStream = 1
+		fieldFlags = 3
+		fieldType  = 4
+		fieldPath  = 7
+	)
+
+	var names []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // Skip the header.
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) <= fieldPath {
+			continue
+		}
+		flags, err := strconv.ParseUint(fields[fieldFlags], 16, 32)
+		if err != nil || flags&acceptCon == 0 {
+			continue
+		}
+		typ, err := strconv.ParseUint(fields[fieldType], 16, 16)
+		if err != nil || typ != sockStream {
+			continue
+		}
+		name, ok := strings.CutPrefix(fields[fieldPath], "@")
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
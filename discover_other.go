@@ -0,0 +1,14 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build !linux
+
+package varlink
+
+// abstractSockets returns nil, since abstract unix sockets are specific to
+// Linux.
+func abstractSockets() []string {
+	return nil
+}
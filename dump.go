@@ -0,0 +1,192 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DefaultDumpMaxParameters is the default limit on the size of the
+// parameters in dumps.
+const DefaultDumpMaxParameters = 1024
+
+// DumpOptions control the dumps produced by Sdump.
+type DumpOptions struct {
+	// MaxParameters is the size in bytes above which parameters are
+	// truncated. If zero, DefaultDumpMaxParameters is used; if negative,
+	// parameters are never truncated.
+	MaxParameters int
+
+	// Redact lists the names of fields whose values are replaced with
+	// "<redacted>", at any depth in the parameters.
+	Redact []string
+
+	// Indent, if true, makes the parameters span multiple indented lines.
+	Indent bool
+}
+
+// Sdump returns a human-readable dump of a Call, a Reply, or an Error, or
+// of a pointer to one, meant for logs and test failure messages:
+//
+//	call org.example.Ping {"ping":"hello"} more
+//	reply {"pong":"hello"} continues
+//	error org.example.NotFound {"name":"foo"}
+//
+// Dumps are stable: the keys of JSON objects are sorted, and insignificant
+// whitespace is removed, so that the dump of a message does not depend on
+// how its peer encoded it. Other values are formatted with fmt.Sprint.
+//
+// If opts is nil, the default options are used.
+func Sdump(v any, opts *DumpOptions) string {
+	if opts == nil {
+		opts = &DumpOptions{}
+	}
+
+	var out strings.Builder
+	switch v := v.(type) {
+	case Call:
+		dumpCall(&out, &v, opts)
+	case *Call:
+		dumpCall(&out, v, opts)
+	case Reply:
+		dumpReply(&out, &v, opts)
+	case *Reply:
+		dumpReply(&out, v, opts)
+	case Error:
+		out.WriteString("error ")
+		out.WriteString(v.ErrorCode())
+		if params, err := json.Marshal(v); err == nil {
+			out.WriteByte(' ')
+			dumpParameters(&out, params, opts)
+		}
+	default:
+		return fmt.Sprint(v)
+	}
+	return out.String()
+}
+
+func dumpCall(out *strings.Builder, call *Call, opts *DumpOptions) {
+	out.WriteString("call ")
+	out.WriteString(call.Method)
+	out.WriteByte(' ')
+	dumpParameters(out, call.Parameters, opts)
+
+	for _, flag := range []struct {
+		set  bool
+		name string
+	}{
+		{call.More, "more"},
+		{call.OneWay, "oneway"},
+		{call.Upgrade, "upgrade"},
+	} {
+		if flag.set {
+			out.WriteByte(' ')
+			out.WriteString(flag.name)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(call.Extensions)) {
+		fmt.Fprintf(out, " %s=", name)
+		dumpParameters(out, call.Extensions[name], opts)
+	}
+	if n := len(call.FileDescriptors); n > 0 {
+		fmt.Fprintf(out, " fds=%d", n)
+	}
+}
+
+func dumpReply(out *strings.Builder, reply *Reply, opts *DumpOptions) {
+	if reply.Error != "" {
+		out.WriteString("error ")
+		out.WriteString(reply.Error)
+	} else {
+		out.WriteString("reply")
+	}
+	out.WriteByte(' ')
+	dumpParameters(out, reply.Parameters, opts)
+
+	if reply.Continues {
+		out.WriteString(" continues")
+	}
+	if n := len(reply.FileDescriptors); n > 0 {
+		fmt.Fprintf(out, " fds=%d", n)
+	}
+}
+
+// dumpParameters writes the normalized, redacted and truncated form of the
+// specified JSON value. Invalid JSON is written as a quoted string.
+func dumpParameters(out *strings.Builder, params json.RawMessage, opts *DumpOptions) {
+	if len(bytes.TrimSpace(params)) == 0 {
+		out.WriteString("{}")
+		return
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		fmt.Fprintf(out, "%q", params)
+		return
+	}
+	if len(opts.Redact) > 0 {
+		v = redact(v, opts.Redact)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if opts.Indent {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(v); err != nil {
+		fmt.Fprintf(out, "%q", params)
+		return
+	}
+
+	max := opts.MaxParameters
+	if max == 0 {
+		max = DefaultDumpMaxParameters
+	}
+	s := strings.TrimSuffix(buf.String(), "\n")
+	if max > 0 {
+		s = truncate(s, max)
+	}
+	out.WriteString(s)
+}
+
+func redact(v any, fields []string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if slices.Contains(fields, k) {
+				v[k] = "<redacted>"
+			} else {
+				v[k] = redact(e, fields)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redact(e, fields)
+		}
+	}
+	return v
+}
+
+// String returns a dump of the call, as returned by Sdump with the default
+// options.
+func (c *Call) String() string {
+	return Sdump(c, nil)
+}
+
+// String returns a dump of the reply, as returned by Sdump with the default
+// options.
+func (r *Reply) String() string {
+	return Sdump(r, nil)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestSdump(t *testing.T) {
+	tests := []struct {
+		v    any
+		opts *varlink.DumpOptions
+		dump string
+	}{
+		{
+			v: &varlink.Call{
+				Method:     "org.example.Login",
+				More:       true,
+				Parameters: json.RawMessage(`{ "user": "root", "password": "hunter2", "n": 1.50 }`),
+			},
+			opts: &varlink.DumpOptions{Redact: []string{"password"}},
+			dump: `call org.example.Login {"n":1.50,"password":"<redacted>","user":"root"} more`,
+		},
+		{
+			v:    varlink.Reply{Parameters: json.RawMessage(`{"b":[1,2],"a":"<>"}`), Continues: true},
+			dump: `reply {"a":"<>","b":[1,2]} continues`,
+		},
+		{
+			v:    &varlink.Reply{Error: "org.example.NotFound", Parameters: json.RawMessage(`{"name":"foo"}`)},
+			dump: `error org.example.NotFound {"name":"foo"}`,
+		},
+		{
+			v:    varlink.NewError("org.example.NotFound", "name", "foo"),
+			dump: `error org.example.NotFound {"name":"foo"}`,
+		},
+		{
+			v:    &varlink.Reply{Parameters: json.RawMessage(`{"data":"0123456789"}`)},
+			opts: &varlink.DumpOptions{MaxParameters: 10},
+			dump: `reply {"data":"0... (11 bytes truncated)`,
+		},
+		{
+			v:    &varlink.Reply{Parameters: json.RawMessage(`{not json`)},
+			dump: `reply "{not json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		if dump := varlink.Sdump(tt.v, tt.opts); dump != tt.dump {
+			t.Errorf("got dump %s, expected %s", dump, tt.dump)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables configuring DefaultTransport and DefaultClient.
+const (
+	// EnvAddress is the environment variable holding the addresses of the
+	// services called by DefaultTransport; see AddressFromEnvironment.
+	EnvAddress = "VARLINK_ADDRESS"
+
+	// EnvTimeout is the environment variable holding the timeout of the
+	// calls made by DefaultClient, in the format of time.ParseDuration.
+	EnvTimeout = "VARLINK_TIMEOUT"
+)
+
+// addressRoute maps the interfaces starting with prefix to a URI.
+type addressRoute struct {
+	prefix string
+	uri    URI
+}
+
+var envRoutes = sync.OnceValues(func() ([]addressRoute, error) {
+	return parseRoutes(os.Getenv(EnvAddress))
+})
+
+// parseRoutes parses a comma-separated list of <prefix>=<uri> entries and
+// URIs. URIs without a prefix apply to all interfaces.
+func parseRoutes(s string) ([]addressRoute, error) {
+	var routes []addressRoute
+	for entry := range strings.SplitSeq(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		// The properties of URIs may contain "=", but interface names
+		// do not contain ":".
+		var route addressRoute
+		prefix, rawuri, ok := strings.Cut(entry, "=")
+		if !ok || strings.Contains(prefix, ":") {
+			prefix, rawuri = "", entry
+		}
+		route.prefix = prefix
+
+		uri, err := ParseURI(rawuri)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", EnvAddress, err)
+		}
+		route.uri = uri
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// lookupRoute returns the URI of the longest prefix matching the interface,
+// or the zero URI if none does. Prefixes match whole components of
+// interface names, so that the org.example prefix matches org.example.foo,
+// but not org.examples.
+func lookupRoute(routes []addressRoute, intf string) URI {
+	var best URI
+	n := -1
+	for _, route := range routes {
+		match := route.prefix == "" || intf == route.prefix ||
+			strings.HasPrefix(intf, route.prefix+".")
+		if match && len(route.prefix) > n {
+			best, n = route.uri, len(route.prefix)
+		}
+	}
+	return best
+}
+
+// AddressFromEnvironment returns the URI of the service implementing the
+// interface of the call, as configured by the VARLINK_ADDRESS environment
+// variable, or the zero URI if the interface is not configured. It is the
+// Address function of DefaultTransport, which lets operators redirect
+// tools to other services, for instance test sockets, without code
+// changes.
+//
+// VARLINK_ADDRESS is a comma-separated list of entries of the form
+// <prefix>=<uri>, which send the calls to the interfaces starting with
+// prefix to uri, the longest prefix winning. Entries that are a bare URI
+// apply to all the interfaces:
+//
+//	VARLINK_ADDRESS=org.example=unix:/tmp/example.sock,tcp:127.0.0.1:12345
+//
+// The environment is read once, on the first call.
+func AddressFromEnvironment(call *Call) (URI, error) {
+	routes, err := envRoutes()
+	if err != nil || len(routes) == 0 {
+		return URI{}, err
+	}
+	i := strings.LastIndexByte(call.Method, '.')
+	if i == -1 {
+		return URI{}, nil
+	}
+	return lookupRoute(routes, call.Method[:i]), nil
+}
+
+// parseTimeout parses the value of VARLINK_TIMEOUT. An empty value means no
+// timeout.
+func parseTimeout(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", EnvTimeout, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("%s: negative timeout %q", EnvTimeout, s)
+	}
+	return d, nil
+}
+
+// newDefaultClient returns the client configured by the environment. If
+// VARLINK_TIMEOUT is invalid, the calls of the client fail with the error,
+// like those routed through an invalid VARLINK_ADDRESS.
+func newDefaultClient() *Client {
+	timeout, err := parseTimeout(os.Getenv(EnvTimeout))
+	return &Client{Timeout: timeout, err: err}
+}
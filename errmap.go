@@ -0,0 +1,183 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"sync"
+)
+
+// ErrorMapper converts Go errors into varlink errors, based on a list of
+// rules mapping error predicates to error constructors.
+//
+// For instance, the following mapper turns errors wrapping os.ErrNotExist
+// into org.example.NotFound errors:
+//
+//	var mapper varlink.ErrorMapper
+//	mapper.Map(os.ErrNotExist, func(err error) varlink.Error {
+//		return varlink.NewError("org.example.NotFound")
+//	})
+//
+// The zero value is an empty mapper ready to use.
+type ErrorMapper struct {
+	rules []errorRule
+
+	// Default, if set, converts errors that no rule matched. If nil,
+	// unmatched errors are converted to a snai.pe.varlink.InternalError
+	// without parameters, to avoid leaking internal details to clients.
+	Default func(error) Error
+}
+
+type errorRule struct {
+	match   func(error) bool
+	convert func(error) Error
+}
+
+// Map registers a rule converting errors that match target, in the sense
+// of errors.Is, using fn.
+func (m *ErrorMapper) Map(target error, fn func(error) Error) {
+	m.MapFunc(func(err error) bool { return errors.Is(err, target) }, fn)
+}
+
+// MapFunc registers a rule converting errors for which pred returns true
+// using fn.
+func (m *ErrorMapper) MapFunc(pred func(error) bool, fn func(error) Error) {
+	m.rules = append(m.rules, errorRule{match: pred, convert: fn})
+}
+
+// MapErrorAs registers a rule into m converting errors that wrap an error
+// of type T, in the sense of errors.As, using fn.
+func MapErrorAs[T error](m *ErrorMapper, fn func(T) Error) {
+	m.MapFunc(
+		func(err error) bool {
+			var target T
+			return errors.As(err, &target)
+		},
+		func(err error) Error {
+			var target T
+			errors.As(err, &target)
+			return fn(target)
+		},
+	)
+}
+
+// Convert converts err into a varlink error.
+//
+// If err already wraps a varlink Error, that error is returned. Otherwise,
+// rules are tried in registration order, and the first matching rule
+// converts the error. If no rule matches, the Default function is used.
+//
+// Convert returns nil if err is nil. It is safe to call Convert on a nil
+// ErrorMapper.
+func (m *ErrorMapper) Convert(err error) Error {
+	if err == nil {
+		return nil
+	}
+	var verr Error
+	if errors.As(err, &verr) {
+		return verr
+	}
+	if m != nil {
+		for _, rule := range m.rules {
+			if rule.match(err) {
+				return rule.convert(err)
+			}
+		}
+		if m.Default != nil {
+			return m.Default(err)
+		}
+	}
+	return NewError(errInternal)
+}
+
+type errorMapperKey struct{}
+
+// ConvertError converts err into a varlink error using the ErrorMapper of
+// the server that is serving the call that ctx belongs to.
+//
+// See [ErrorMapper.Convert] for the conversion rules. If the server is in
+// development mode, internal errors carry the error message, the stack
+// trace and a snapshot of the call.
+func ConvertError(ctx context.Context, err error) Error {
+	mapper, _ := ctx.Value(errorMapperKey{}).(*ErrorMapper)
+	verr := mapper.Convert(err)
+	if call := devModeCall(ctx); call != nil && verr != nil && verr.ErrorCode() == errInternal {
+		return debugError(call, err.Error(), debug.Stack())
+	}
+	return verr
+}
+
+// HandlerFuncErr is an adapter to allow the use of ordinary functions
+// returning errors as method handlers.
+//
+// If the function returns a non-nil error without having written a final
+// reply, the error is converted with ConvertError and written back to the
+// client.
+type HandlerFuncErr func(w ReplyWriter, call *Call) error
+
+func (fn HandlerFuncErr) ServeMethod(w ReplyWriter, call *Call) {
+	tw := &trackingReplyWriter{ReplyWriter: w, call: call}
+	err := fn(tw, call)
+	if err == nil || tw.hasReplied() {
+		return
+	}
+	w.WriteError(ConvertError(w.Context(), err))
+}
+
+// trackingReplyWriter records whether a final reply was written through
+// it, since writers wrapped by interceptors or by the concurrency policies
+// do not tell.
+type trackingReplyWriter struct {
+	ReplyWriter
+	call *Call
+
+	mu      sync.Mutex
+	replied bool
+}
+
+func (w *trackingReplyWriter) WriteError(err Error) error {
+	return w.track(true, w.ReplyWriter.WriteError(err))
+}
+
+func (w *trackingReplyWriter) WriteReply(parameters any, opts ...ReplyOption) error {
+	var reply Reply
+	for _, opt := range opts {
+		opt.SetReplyOption(&reply)
+	}
+	return w.track(!reply.Continues, w.ReplyWriter.WriteReply(parameters, opts...))
+}
+
+func (w *trackingReplyWriter) WriteMore(parameters any, opts ...ReplyOption) error {
+	return w.track(!w.call.More, w.ReplyWriter.WriteMore(parameters, opts...))
+}
+
+func (w *trackingReplyWriter) WriteFinal(parameters any, opts ...ReplyOption) error {
+	return w.track(true, w.ReplyWriter.WriteFinal(parameters, opts...))
+}
+
+// track records the outcome of writing a reply. ErrReplied means that a
+// final reply was written by someone else.
+func (w *trackingReplyWriter) track(final bool, err error) error {
+	if (final && err == nil) || errors.Is(err, ErrReplied) {
+		w.mu.Lock()
+		w.replied = true
+		w.mu.Unlock()
+	}
+	return err
+}
+
+func (w *trackingReplyWriter) hasReplied() bool {
+	w.mu.Lock()
+	replied := w.replied
+	w.mu.Unlock()
+	if replied {
+		return true
+	}
+	rw, ok := w.ReplyWriter.(interface{ hasReplied() bool })
+	return ok && rw.hasReplied()
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestErrorMapper(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sock")
+	l, err := varlink.Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var mapper varlink.ErrorMapper
+	mapper.Map(os.ErrNotExist, func(err error) varlink.Error {
+		return varlink.NewError("org.example.NotFound")
+	})
+	varlink.MapErrorAs(&mapper, func(err *fs.PathError) varlink.Error {
+		return varlink.NewError("org.example.BadPath", "path", err.Path)
+	})
+
+	var mux varlink.ServeMux
+	mux.Handle("org.example.Fail", varlink.HandlerFuncErr(func(w varlink.ReplyWriter, call *varlink.Call) error {
+		var in struct{ Kind string }
+		if err := call.Unmarshal(&in); err != nil {
+			return err
+		}
+		switch in.Kind {
+		case "mapped":
+			return fmt.Errorf("loading: %w", os.ErrNotExist)
+		case "mapped-as":
+			return &fs.PathError{Op: "open", Path: "/etc/example", Err: fs.ErrPermission}
+		case "unmapped":
+			return errors.New("something broke")
+		case "replied":
+			// The error is dropped, since the call was replied to.
+			w.WriteReply(map[string]string{"kind": in.Kind})
+			return errors.New("after the reply")
+		}
+		return w.WriteReply(map[string]string{"kind": in.Kind})
+	}))
+	go (&varlink.Server{Handler: &mux, ErrorMapper: &mapper}).Serve(l)
+
+	uri, err := varlink.ParseURI("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &varlink.Client{Transport: &varlink.Transport{}, URI: uri}
+
+	tests := []struct {
+		kind string
+		code string
+	}{
+		{"mapped", "org.example.NotFound"},
+		{"mapped-as", "org.example.BadPath"},
+		{"unmapped", "snai.pe.varlink.InternalError"},
+		{"replied", ""},
+		{"nil", ""},
+	}
+	for _, tc := range tests {
+		rs, err := client.Call(context.Background(), "org.example.Fail", map[string]string{"Kind": tc.kind})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rs.Next() {
+			t.Fatalf("%s: %v", tc.kind, rs.Error())
+		}
+		if code := rs.Reply().Error; code != tc.code {
+			t.Errorf("%s: got error %q, expected %q", tc.kind, code, tc.code)
+		}
+		if rs.Next() {
+			t.Errorf("%s: got more than one reply", tc.kind)
+		}
+	}
+
+	if verr := mapper.Convert(nil); verr != nil {
+		t.Fatalf("converting a nil error returned %v", verr)
+	}
+	if verr := (*varlink.ErrorMapper)(nil).Convert(errors.New("broken")); verr.ErrorCode() != "snai.pe.varlink.InternalError" {
+		t.Fatalf("nil mapper converted an error to %s", verr.ErrorCode())
+	}
+}
+
+// countingWriter counts the error replies written through it.
+type countingWriter struct {
+	varlink.ReplyWriter
+	errors *atomic.Int32
+}
+
+func (w countingWriter) WriteError(err varlink.Error) error {
+	w.errors.Add(1)
+	return w.ReplyWriter.WriteError(err)
+}
+
+func TestHandlerFuncErrWrapped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sock")
+	l, err := varlink.Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var errorReplies atomic.Int32
+	counting := func(next varlink.MethodHandler) varlink.MethodHandler {
+		return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+			next.ServeMethod(countingWriter{w, &errorReplies}, call)
+		})
+	}
+
+	var mux varlink.ServeMux
+	mux.Handle("org.example.Fail", varlink.HandlerFuncErr(func(w varlink.ReplyWriter, call *varlink.Call) error {
+		var in struct{ Kind string }
+		if err := call.Unmarshal(&in); err != nil {
+			return err
+		}
+		switch in.Kind {
+		case "replied":
+			w.WriteReply(map[string]string{"kind": in.Kind})
+		case "final":
+			w.WriteFinal(map[string]string{"kind": in.Kind})
+		case "more":
+			w.WriteMore(map[string]string{"kind": in.Kind})
+		}
+		return errors.New("something broke")
+	}), varlink.Coalesced())
+	go (&varlink.Server{Handler: varlink.Chain(&mux, counting)}).Serve(l)
+
+	uri, err := varlink.ParseURI("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &varlink.Client{Transport: &varlink.Transport{}, URI: uri}
+
+	tests := []struct {
+		kind   string
+		code   string
+		errors int32
+	}{
+		{"replied", "", 0},
+		{"final", "", 0},
+		{"more", "", 0},
+		{"unreplied", "snai.pe.varlink.InternalError", 1},
+	}
+	for _, tc := range tests {
+		errorReplies.Store(0)
+
+		rs, err := client.Call(context.Background(), "org.example.Fail", map[string]string{"Kind": tc.kind})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rs.Next() {
+			t.Fatalf("%s: %v", tc.kind, rs.Error())
+		}
+		if code := rs.Reply().Error; code != tc.code {
+			t.Errorf("%s: got error %q, expected %q", tc.kind, code, tc.code)
+		}
+		if rs.Next() {
+			t.Errorf("%s: got more than one reply", tc.kind)
+		}
+		if n := errorReplies.Load(); n != tc.errors {
+			t.Errorf("%s: wrote %d error replies, expected %d", tc.kind, n, tc.errors)
+		}
+	}
+}
@@ -7,11 +7,17 @@ package varlink
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 )
 
 var ErrPeerDisconnected errDisconnected
 
+// ErrReplied is returned when attempting to write a reply to a method call
+// that has already received its final reply.
+var ErrReplied = errors.New("method call has already been replied to")
+
 // Error represents all varlink errors. Errors consist of a fully qualified
 // error code in the form of (e.g. org.interface.ErrorType), and parameters.
 //
@@ -23,6 +29,21 @@ type Error interface {
 	ErrorCode() string
 }
 
+// ErrorBusy is the error code of calls rejected because the service, or
+// the share of the service allotted to the client, is exhausted. Such calls
+// may succeed if retried later.
+const ErrorBusy = `snai.pe.varlink.Busy`
+
+// BusyError returns an error with the ErrorBusy code. If retryAfter is
+// positive, it is passed to the client in milliseconds, as the
+// retry_after_ms parameter.
+func BusyError(retryAfter time.Duration) Error {
+	if retryAfter <= 0 {
+		return NewError(ErrorBusy)
+	}
+	return NewError(ErrorBusy, "retry_after_ms", retryAfter.Milliseconds())
+}
+
 type varlinkError struct {
 	Code       string
 	Parameters json.RawMessage
@@ -36,6 +57,9 @@ type varlinkError struct {
 //
 // Users are encouraged to define their own error types instead of using
 // NewError.
+//
+// NewError panics if the values do not marshal; use NewErrorParams when the
+// values are not known to marshal.
 func NewError(code string, kvs ...any) Error {
 	if len(kvs)%2 != 0 {
 		panic("programming error: key-value pair list has odd number of elements")
@@ -61,6 +85,32 @@ func NewError(code string, kvs ...any) Error {
 	return verr
 }
 
+// NewErrorParams builds a varlink error from the given error code and
+// parameters, which are marshaled to a JSON object. The parameters may be
+// a map, or a struct, like the types generated from interface definitions.
+//
+// Unlike NewError, NewErrorParams returns an error instead of panicking if
+// the parameters do not marshal, or do not marshal to a JSON object.
+func NewErrorParams(code string, params any) (Error, error) {
+	verr := &varlinkError{Code: code}
+	if params == nil {
+		return verr, nil
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error %s: %w", code, err)
+	}
+	switch {
+	case string(data) == "null":
+		return verr, nil
+	case len(data) == 0 || data[0] != '{':
+		return nil, fmt.Errorf("error %s: parameters must marshal to a JSON object, not %s", code, data)
+	}
+	verr.Parameters = json.RawMessage(data)
+	return verr, nil
+}
+
 func (err *varlinkError) Error() string {
 	return err.Code
 }
@@ -70,5 +120,8 @@ func (err *varlinkError) ErrorCode() string {
 }
 
 func (err *varlinkError) MarshalJSON() ([]byte, error) {
+	if len(err.Parameters) == 0 {
+		return []byte("{}"), nil
+	}
 	return []byte(err.Parameters), nil
 }
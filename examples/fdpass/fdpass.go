@@ -93,7 +93,6 @@ func client(uri string) {
 
 	if err := r.Error(); err != nil {
 		if e, ok := r.Error().(varlink.Error); ok {
-			log.Fatal(string(r.Reply().Parameters))
 			switch e.ErrorCode() {
 			case "org.example.fdpass.Error":
 				r.Unmarshal(&osErr)
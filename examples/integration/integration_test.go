@@ -0,0 +1,164 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package integration runs the examples against each other, which makes
+// them regression tests of the public API.
+package integration
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bin is the directory holding the compiled examples.
+var bin string
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	dir, err := os.MkdirTemp("", "varlink-examples")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	bin = dir
+	return m.Run()
+}
+
+// build compiles the specified examples once per test binary.
+func build(t *testing.T, names ...string) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("building examples is slow")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(bin, name)); err == nil {
+			continue
+		}
+		cmd := exec.Command(gobin, "build", "-o", filepath.Join(bin, name), "../"+name)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("building %s: %v\n%s", name, err, out)
+		}
+	}
+}
+
+// serve starts an example in server mode on a fresh unix socket, and
+// returns the URI of the socket once the server accepts connections.
+func serve(t *testing.T, name string, args ...string) string {
+	t.Helper()
+
+	sock := filepath.Join(t.TempDir(), "sock")
+	uri := "unix:" + sock
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(filepath.Join(bin, name), append([]string{"-serve", "-uri", uri}, args...)...)
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+		if t.Failed() {
+			t.Logf("%s server output:\n%s", name, stderr.String())
+		}
+	})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		conn, err := net.Dial("unix", sock)
+		if err == nil {
+			conn.Close()
+			return uri
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s server did not start: %v", name, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// client runs an example in client mode, and returns its combined output.
+func client(t *testing.T, name, uri string, args ...string) string {
+	t.Helper()
+	out, err := runClient(name, uri, args...)
+	if err != nil {
+		t.Fatalf("%s client failed: %v\n%s", name, err, out)
+	}
+	return out
+}
+
+// failingClient is like client, but expects the client to fail.
+func failingClient(t *testing.T, name, uri string, args ...string) string {
+	t.Helper()
+	out, err := runClient(name, uri, args...)
+	if err == nil {
+		t.Fatalf("%s client succeeded, expected it to fail:\n%s", name, out)
+	}
+	return out
+}
+
+func runClient(name, uri string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, filepath.Join(bin, name), append([]string{"-uri", uri}, args...)...)
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func expectOutput(t *testing.T, out string, expected ...string) {
+	t.Helper()
+	for _, s := range expected {
+		if !strings.Contains(out, s) {
+			t.Errorf("output does not contain %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestPing(t *testing.T) {
+	build(t, "ping")
+	uri := serve(t, "ping")
+
+	expectOutput(t, client(t, "ping", uri), "Ping!")
+}
+
+func TestFib(t *testing.T) {
+	build(t, "fib")
+	uri := serve(t, "fib")
+
+	expectOutput(t, client(t, "fib", uri, "10"), " 55\n")
+	expectOutput(t, client(t, "fib", uri, "100"), " 354224848179261915075\n")
+	expectOutput(t, client(t, "fib", uri, "--", "-1"), "org.varlink.service.InvalidParameter")
+}
+
+func TestFdpass(t *testing.T) {
+	build(t, "fdpass")
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "hello.txt"), []byte("hello through a file descriptor\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	uri := serve(t, "fdpass", "-root", root)
+
+	out := client(t, "fdpass", uri, "/hello.txt", "cat", "/dev/fd/3")
+	expectOutput(t, out, "hello through a file descriptor\n")
+
+	out = failingClient(t, "fdpass", uri, "/missing.txt", "cat", "/dev/fd/3")
+	expectOutput(t, out, "org.example.fdpass.Error: ", "missing.txt")
+}
@@ -50,14 +50,11 @@ func server(uri string) {
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
 
-		var opts []varlink.ReplyOption
-		if call.More {
-			opts = append(opts, varlink.Continues())
-		}
-
 		for {
 			log.Println("sending ping")
-			rw.WriteReply(params, opts...)
+			if err := rw.WriteMore(params); err != nil {
+				return
+			}
 
 			if !call.More {
 				return
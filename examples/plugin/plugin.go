@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+	"snai.pe/go-varlink/plugin"
+)
+
+const description = `# Greets people.
+interface org.example.greeter
+
+# Writes a greeting into the passed file descriptor.
+method Greet(name: string) -> ()
+`
+
+func main() {
+	if plugin.IsPlugin() {
+		greeter()
+	} else {
+		host()
+	}
+}
+
+// greeter is the plugin side. The host launches the same executable.
+func greeter() {
+	var mux varlink.ServeMux
+	mux.SetDescription("org.example.greeter", description)
+
+	mux.HandleFunc("org.example.greeter.Greet", func(rw varlink.ReplyWriter, call *varlink.Call) {
+		var params struct {
+			Name string `json:"name"`
+		}
+		if err := call.Unmarshal(&params); err != nil {
+			rw.WriteError(err)
+			return
+		}
+		if len(call.FileDescriptors) != 1 {
+			rw.WriteError(service.InvalidParameter("name"))
+			return
+		}
+
+		out := os.NewFile(call.FileDescriptors[0], "out")
+		defer out.Close()
+
+		fmt.Fprintf(out, "Hello, %s! (from plugin process %d)\n", params.Name, os.Getpid())
+		rw.WriteReply(nil)
+	})
+
+	if err := plugin.Serve(context.Background(), &mux); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func host() {
+	ctx := context.Background()
+
+	self, err := os.Executable()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cmd := exec.Command(self)
+	cmd.Stderr = os.Stderr
+
+	p, err := plugin.Start(ctx, cmd, &plugin.Config{
+		Interfaces: map[string]string{"org.example.greeter": ""},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer p.Close()
+
+	fmt.Println("plugin implements:", p.Interfaces())
+
+	intf, err := p.Describe(ctx, "org.example.greeter")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, method := range intf.Methods {
+		fmt.Println("  method:", method.Name)
+	}
+
+	name := "world"
+	if len(os.Args) > 1 {
+		name = os.Args[1]
+	}
+
+	// The plugin writes directly to our standard output, which we pass it.
+	rs, err := p.Client().Call(ctx, "org.example.greeter.Greet", map[string]string{"name": name},
+		varlink.Fd(os.Stdout.Fd()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for rs.Next() {
+	}
+	if err := rs.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"math/rand/v2"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+var (
+	uri      = flag.String("uri", "unix:/run/systemd/io.systemd.Hostname", "address of the service to call")
+	count    = flag.Int("count", 10, "number of calls to make")
+	failRate = flag.Float64("fail-rate", 0.2, "fraction of calls to fail")
+	maxDelay = flag.Duration("max-delay", 100*time.Millisecond, "maximum delay added to calls")
+)
+
+// loggingTransport logs the calls made through another RoundTripper, and
+// how long it took to get their first reply.
+type loggingTransport struct {
+	next varlink.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(ctx context.Context, session *varlink.Session, call *varlink.Call) (*varlink.ReplyStream, error) {
+	log.Printf("-> %s", call.Method)
+	rs, err := t.next.RoundTrip(ctx, session, call)
+	if err != nil {
+		log.Printf("<- %s: %v", call.Method, err)
+		return nil, err
+	}
+	rs.OnStall(time.Second, func(stats varlink.ReplyStreamStats) {
+		log.Printf("   %s: no reply after %v", call.Method, stats.SinceLastReply())
+	})
+	return rs, nil
+}
+
+// errChaos is the error of the calls failed by chaosTransport.
+var errChaos = errors.New("call failed by chaos transport")
+
+// chaosTransport delays calls, and fails some of them before they are
+// written, to check how clients cope with unreliable services.
+type chaosTransport struct {
+	next     varlink.RoundTripper
+	failRate float64
+	maxDelay time.Duration
+}
+
+func (t *chaosTransport) RoundTrip(ctx context.Context, session *varlink.Session, call *varlink.Call) (*varlink.ReplyStream, error) {
+	if t.maxDelay > 0 {
+		select {
+		case <-time.After(rand.N(t.maxDelay)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if rand.Float64() < t.failRate {
+		return nil, errChaos
+	}
+	return t.next.RoundTrip(ctx, session, call)
+}
+
+func main() {
+	flag.Parse()
+
+	addr, err := varlink.ParseURI(*uri)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+
+	svc := service.Client{Client: varlink.Client{
+		URI: addr,
+		Transport: &loggingTransport{
+			next: &chaosTransport{
+				next:     transport,
+				failRate: *failRate,
+				maxDelay: *maxDelay,
+			},
+		},
+	}}
+
+	for range *count {
+		vendor, product, _, _, _, err := svc.GetInfo(context.Background())
+		if err != nil {
+			log.Println("error:", err)
+			continue
+		}
+		log.Printf("<- %s %s", vendor, product)
+	}
+}
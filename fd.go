@@ -0,0 +1,122 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// parseFd returns the file descriptor number of a fd:<n> uri.
+func parseFd(u URI) (int, error) {
+	fd, err := strconv.Atoi(u.Address)
+	if err != nil || fd < 0 {
+		return 0, fmt.Errorf("%v: invalid file descriptor %q", u, u.Address)
+	}
+	return fd, nil
+}
+
+// dialFd opens a connection over an inherited file descriptor. If the
+// descriptor is a socket, it must be connected; otherwise, it must be open
+// for both reading and writing.
+func dialFd(u URI) (net.Conn, error) {
+	fd, err := parseFd(u)
+	if err != nil {
+		return nil, fmt.Errorf("dial %w", err)
+	}
+	conn, err := fileToConn(inheritedFile(fd, u.String()), u.Scheme)
+	if err != nil {
+		return nil, fmt.Errorf("dial %v: %w", u, err)
+	}
+	return conn, nil
+}
+
+// listenFd returns a listener for an inherited listening socket.
+func listenFd(u URI) (net.Listener, error) {
+	fd, err := parseFd(u)
+	if err != nil {
+		return nil, fmt.Errorf("listen %w", err)
+	}
+	file := inheritedFile(fd, u.String())
+	defer file.Close()
+
+	l, err := net.FileListener(file)
+	if err != nil {
+		return nil, fmt.Errorf("listen %v: %w", u, err)
+	}
+	return l, nil
+}
+
+// ServeStdio serves a single session over the standard input and output of
+// the process, which is how per-connection activated services (systemd
+// services with Accept=yes, inetd services) and subprocess plugins
+// typically talk to their client.
+//
+// If the standard input is a socket, it is used for both directions, as
+// set up by inetd and systemd; otherwise, calls are read from the standard
+// input and replies written to the standard output, as with pipes.
+//
+// The method handler must not write anything to the standard output, since
+// it would corrupt the session.
+//
+// ServeStdio returns once the session ends, or ctx becomes done.
+func ServeStdio(ctx context.Context, handler MethodHandler) error {
+	server := Server{Handler: handler}
+	return server.ServeStdio(ctx)
+}
+
+// ServeStdio serves a single session over the standard input and output of
+// the process. See the ServeStdio function.
+func (s *Server) ServeStdio(ctx context.Context) error {
+	conn, err := stdioConn()
+	if err != nil {
+		return err
+	}
+	s.ServeConn(ctx, conn)
+	return nil
+}
+
+func stdioConn() (net.Conn, error) {
+	// Work on duplicates of the standard descriptors, since os.Stdin and
+	// os.Stdout are not necessarily registered with the runtime poller.
+	stdin, err := dupFile(0, "stdin")
+	if err != nil {
+		return nil, err
+	}
+
+	if conn, err := net.FileConn(stdin); err == nil {
+		stdin.Close()
+		return conn, nil
+	}
+
+	stdout, err := dupFile(1, "stdout")
+	if err != nil {
+		stdin.Close()
+		return nil, err
+	}
+	return &pipeConn{
+		r:    stdin,
+		w:    stdout,
+		addr: connAddr{network: "fd", address: "stdio"},
+	}, nil
+}
+
+func dupFile(fd int, name string) (*os.File, error) {
+	syscall.ForkLock.RLock()
+	nfd, err := syscall.Dup(fd)
+	if err == nil {
+		syscall.CloseOnExec(nfd)
+	}
+	syscall.ForkLock.RUnlock()
+	if err != nil {
+		return nil, os.NewSyscallError("dup", err)
+	}
+	return inheritedFile(nfd, name), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// FieldsExtension is the name of the call extension selecting the output
+// fields that the client is interested in, as a list of top-level field
+// names. Servers supporting it may omit the other fields from their
+// replies, and skip computing them.
+//
+// Servers advertise their support of the extension by listing it among
+// their interfaces, which they do by registering FieldsDescription:
+//
+//	mux.SetDescription(varlink.FieldsExtension, varlink.FieldsDescription)
+//
+// Clients may check for it with Client.SupportsFields. Peers that do not
+// know about the extension ignore it, and reply with all fields.
+const FieldsExtension = `snai.pe.varlink.Fields`
+
+// FieldsDescription is the description of the FieldsExtension interface,
+// which has no methods.
+const FieldsDescription = `# Calls carrying the snai.pe.varlink.Fields extension, a list of output
+# field names, may get replies omitting the other output fields.
+interface snai.pe.varlink.Fields
+`
+
+// SelectFields makes the call request only the specified output fields
+// from servers supporting FieldsExtension.
+func SelectFields(names ...string) CallOption {
+	return funcCallOpt(func(opts *Call) error {
+		data, err := json.Marshal(names)
+		if err != nil {
+			return err
+		}
+		if opts.Extensions == nil {
+			opts.Extensions = make(map[string]json.RawMessage)
+		}
+		opts.Extensions[FieldsExtension] = json.RawMessage(data)
+		return nil
+	})
+}
+
+// SelectedFields returns the output fields selected by the call with
+// SelectFields, and whether it selects fields at all. Calls without the
+// extension, or with a malformed one, select all fields.
+func (c *Call) SelectedFields() ([]string, bool) {
+	raw, ok := c.Extensions[FieldsExtension]
+	if !ok {
+		return nil, false
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(raw), &names); err != nil {
+		return nil, false
+	}
+	return names, true
+}
+
+type fieldsKey struct{}
+
+// FieldSelected returns whether the output field with the specified name
+// is wanted by the client making the call served with ctx, which handlers
+// use to skip computing expensive fields. It returns true unless the call
+// selects output fields without that one.
+func FieldSelected(ctx context.Context, name string) bool {
+	names, ok := ctx.Value(fieldsKey{}).([]string)
+	return !ok || slices.Contains(names, name)
+}
+
+// SupportsFields returns whether the service at the URI set by opts, or the
+// default URI of the client, advertises support for FieldsExtension.
+func (client *Client) SupportsFields(ctx context.Context, opts ...CallOption) (bool, error) {
+	rs, err := client.Call(ctx, "org.varlink.service.GetInfo", nil, opts...)
+	if err != nil {
+		return false, err
+	}
+	var info service.GetInfoOutput
+	if !rs.Next() || rs.Error() != nil {
+		return false, rs.Error()
+	}
+	if err := rs.Unmarshal(&info); err != nil {
+		return false, err
+	}
+	return slices.Contains(info.Interfaces, FieldsExtension), nil
+}
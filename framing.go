@@ -0,0 +1,330 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"snai.pe/go-varlink/internal/service"
+)
+
+// framing represents how messages are delimited on the wire.
+type framing int
+
+const (
+	// Standard varlink framing: JSON documents terminated by a NUL byte.
+	framingNUL framing = iota
+
+	// Each JSON document is preceded by its length, as a 32-bit big-endian
+	// unsigned integer.
+	framingLengthPrefixed
+)
+
+const (
+	framingMethod = `snai.pe.varlink.SetFraming`
+
+	framingNameLengthPrefixed = "length-prefixed"
+
+	// maxFrameLength protects against peers announcing absurdly large
+	// frames to make us allocate memory.
+	maxFrameLength = 1 << 28
+)
+
+type framingParams struct {
+	Framing     string   `json:"framing"`
+	Compression []string `json:"compression,omitempty"`
+}
+
+type framingReply struct {
+	Compression string `json:"compression,omitempty"`
+}
+
+// NegotiateFraming attempts to switch the session from the standard
+// NUL-delimited framing to length-prefixed frames, which are cheaper to
+// read since they do not require scanning for the delimiter.
+//
+// The negotiation is only understood by go-varlink peers; other peers reply
+// with an error, in which case the session keeps using the standard framing
+// and NegotiateFraming returns false with a nil error.
+//
+// NegotiateFraming must be called when there are no calls in flight on the
+// session, typically right after it has been established, and no other call
+// may be written on the session until it returns.
+func (session *Session) NegotiateFraming(ctx context.Context) (bool, error) {
+	params := framingParams{Framing: framingNameLengthPrefixed}
+	reply, err := session.negotiateFraming(ctx, params, nil)
+	if err != nil {
+		return false, err
+	}
+	return reply.Error == "", nil
+}
+
+// negotiateFraming sends a framing negotiation call, and returns its reply.
+// If the negotiation succeeds and the peer accepts the compression
+// algorithm, the write side of the session is compressed with the
+// specified options.
+func (session *Session) negotiateFraming(ctx context.Context, params framingParams, compression *CompressionOptions) (*Reply, error) {
+	call, err := MakeCall(framingMethod, params, Upgrade())
+	if err != nil {
+		return nil, err
+	}
+
+	session.cond.L.Lock()
+	if len(session.inflight) > 0 {
+		session.cond.L.Unlock()
+		return nil, errors.New("cannot negotiate framing while calls are in flight")
+	}
+	session.framingCall = &call
+	session.framingCompression = compression
+	session.cond.L.Unlock()
+
+	defer func() {
+		session.cond.L.Lock()
+		session.framingCall = nil
+		session.framingCompression = nil
+		session.cond.L.Unlock()
+	}()
+
+	if err := session.WriteCall(ctx, &call); err != nil {
+		return nil, err
+	}
+
+	var reply Reply
+	if err := session.ReadReply(ctx, &call, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// holdFraming is called by the reader when the peer requests a framing
+// change, and holds further reads until the request is replied to. It must
+// only be called by the goroutine currently reading.
+func (session *Session) holdFraming() {
+	session.rcond.L.Lock()
+	session.framingPending = true
+	session.framingAccepted = false
+	session.rcond.L.Unlock()
+}
+
+// settleFraming is called once a reply was written while a framing change
+// is pending, and resumes reads. The read side of the session switches to
+// the new framing if the reply was written by replyFraming to accept the
+// change; any other reply rejects it.
+func (session *Session) settleFraming(reply *Reply) {
+	session.rcond.L.Lock()
+	defer session.rcond.L.Unlock()
+
+	if !session.framingPending {
+		return
+	}
+	if session.framingAccepted && reply.Error == "" {
+		session.rframing = framingLengthPrefixed
+	}
+	session.framingPending = false
+	session.framingAccepted = false
+	session.rcond.Broadcast()
+}
+
+// replyFraming replies to a framing negotiation call, and switches the write
+// side of the session to the new framing if it has been accepted. If the
+// peer supports the algorithm of the specified compression options, the
+// write side is compressed as well.
+func (session *Session) replyFraming(ctx context.Context, call *Call, compression *CompressionOptions) error {
+	var in framingParams
+	if err := call.Unmarshal(&in); err != nil {
+		reply, _ := MakeReply(err, ErrorCode(err.ErrorCode()))
+		return session.WriteReply(ctx, &reply)
+	}
+	if in.Framing != framingNameLengthPrefixed {
+		err := service.InvalidParameter("framing")
+		reply, _ := MakeReply(err, ErrorCode(err.ErrorCode()))
+		return session.WriteReply(ctx, &reply)
+	}
+
+	var out framingReply
+	compression = acceptCompression(compression, in.Compression)
+	if compression != nil {
+		out.Compression = compression.Algorithm
+	}
+
+	session.rcond.L.Lock()
+	session.framingAccepted = true
+	session.rcond.L.Unlock()
+
+	// The write side switches under the same lock as the reply is written
+	// with, so that no other message is written in between, and before
+	// reads resume, so that no reply to a call made with the new framing
+	// is written with the old one.
+	reply, _ := MakeReply(out)
+	return session.writeReply(ctx, &reply, func() {
+		session.wframing = framingLengthPrefixed
+		session.wcompress = compression
+	})
+}
+
+// switchFraming switches both sides of the session to the specified framing,
+// and the write side to the specified compression, if any. It must only be
+// called by the goroutine currently reading.
+func (session *Session) switchFraming(f framing, compression *CompressionOptions) {
+	session.rframing = f
+
+	session.wmu.Lock()
+	session.wframing = f
+	session.wcompress = compression
+	session.wmu.Unlock()
+}
+
+func (session *Session) writeFrameUnlocked(msg []byte, fds []uintptr, fdpass FdPasser) error {
+	msg, compressed, err := session.compressUnlocked(msg)
+	if err != nil {
+		return err
+	}
+
+	length := uint32(len(msg))
+	if compressed {
+		length |= frameCompressed
+	}
+
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], length)
+
+	if _, err := session.rw.Write(hdr[:]); err != nil {
+		return err
+	}
+	// Like with NUL-delimited framing, file descriptors must be sent with the
+	// last byte of the message, so the last byte is written separately.
+	last := len(msg) - 1
+	if _, err := session.rw.Write(msg[:last]); err != nil {
+		return err
+	}
+
+	if len(fds) > 0 {
+		fdpass.PassFds(fds...)
+	}
+
+	_, err = session.rw.Write(msg[last:])
+	return err
+}
+
+// readFrameUnlocked reads a length-prefixed frame. Like scanFrame, the
+// returned slice is only valid until the next read.
+//
+// If a read error occurs in the middle of a frame, no data is lost: the
+// next call resumes reading the frame where the failed call left off.
+//
+// Compressed frames are transparently decompressed.
+func (session *Session) readFrameUnlocked() ([]byte, error) {
+	if session.rframe == nil {
+		hdr, err := session.rw.Peek(4)
+		if err != nil {
+			if err == io.EOF && len(hdr) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		length := binary.BigEndian.Uint32(hdr)
+		session.rframeCompressed = length&frameCompressed != 0
+		length &^= frameCompressed
+		if max := session.maxFrameLength(); length > uint32(max) {
+			return nil, fmt.Errorf("%w: frame length %d exceeds maximum of %d bytes", ErrMessageTooLarge, length, max)
+		}
+
+		// Avoid copying when the whole frame fits in the buffer.
+		if 4+int(length) <= session.rw.Reader.Size() {
+			msg, err := session.rw.Peek(4 + int(length))
+			if err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+			session.rw.Discard(len(msg))
+			if session.rframeCompressed {
+				return session.decompressFrame(msg[4:])
+			}
+			return msg[4:], nil
+		}
+
+		session.rw.Discard(4)
+		if cap(session.rbuf) < int(length) {
+			session.rbuf = make([]byte, length)
+		}
+		session.rframe = session.rbuf[:length]
+		session.rframeOff = 0
+	}
+
+	n, err := io.ReadFull(session.rw, session.rframe[session.rframeOff:])
+	session.rframeOff += n
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	msg := session.rframe
+	session.rframe = nil
+	if session.rframeCompressed {
+		return session.decompressFrame(msg)
+	}
+	return msg, nil
+}
+
+// maxFrameLength returns the maximum length of the frames read from the
+// session.
+func (session *Session) maxFrameLength() int {
+	if session.maxMessage > 0 && session.maxMessage < maxFrameLength {
+		return session.maxMessage
+	}
+	return maxFrameLength
+}
+
+// scanFrame reads a NUL-terminated frame from r, and returns it without its
+// delimiter. If max is positive, frames longer than max bytes are rejected
+// with ErrMessageTooLarge.
+//
+// When the frame fits in the buffer of r, the returned slice is a view into
+// that buffer and no copy is made. Otherwise, the frame is accumulated into
+// buf, which is grown as needed and returned for reuse by later calls.
+// In both cases, the frame is only valid until the next read on r.
+//
+// If a read error occurs in the middle of a frame, the partial frame is left
+// in the returned buffer, and the next call resumes reading the frame from
+// there.
+func scanFrame(r *bufio.Reader, buf []byte, max int) (frame, newbuf []byte, err error) {
+	if len(buf) == 0 {
+		frame, err = r.ReadSlice('\x00')
+		if err == nil {
+			if max > 0 && len(frame)-1 > max {
+				return nil, buf, ErrMessageTooLarge
+			}
+			return frame[:len(frame)-1], buf, nil
+		}
+	} else {
+		err = bufio.ErrBufferFull
+	}
+
+	for err == bufio.ErrBufferFull {
+		buf = append(buf, frame...)
+		if max > 0 && len(buf) > max {
+			return nil, buf[:0], ErrMessageTooLarge
+		}
+		frame, err = r.ReadSlice('\x00')
+	}
+	buf = append(buf, frame...)
+	if err != nil {
+		return nil, buf, err
+	}
+	if max > 0 && len(buf)-1 > max {
+		return nil, buf[:0], ErrMessageTooLarge
+	}
+	return buf[:len(buf)-1], buf[:0], nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+)
+
+// MessageHooks are functions that a session calls on the messages it reads
+// and writes, to inspect or modify them. This enables cross-cutting
+// features at the message level, like redacting fields, enforcing schemas,
+// or working around the quirks of some peers.
+//
+// Unlike interceptors, which only wrap the handling of calls by a server,
+// hooks see all messages of a session, in both directions, on clients and
+// servers alike.
+//
+// All hooks are optional.
+type MessageHooks struct {
+	// OutboundCall is called on calls before they are written. If it
+	// returns an error, the call is not written, and WriteCall returns
+	// the error.
+	OutboundCall func(ctx context.Context, call *Call) error
+
+	// OutboundReply is called on replies before they are written. If it
+	// returns an error, the reply is not written, and WriteReply returns
+	// the error.
+	OutboundReply func(ctx context.Context, reply *Reply) error
+
+	// InboundCall is called on calls once they are read, before they are
+	// returned by ReadCall. If it returns an error, the call is rejected:
+	// it is replied to with the error, converted with ConvertError, and
+	// ReadCall moves on to the next call.
+	InboundCall func(ctx context.Context, call *Call) error
+
+	// InboundReply is called on replies once they are read, before they
+	// are returned by ReadReply. If it returns an error, the reply is
+	// replaced with an error reply carrying the error, converted with
+	// ConvertError.
+	InboundReply func(ctx context.Context, reply *Reply) error
+}
+
+// AddHooks adds message hooks to the session. Hooks are called in the order
+// in which they were added.
+//
+// AddHooks must be called before the session is used.
+func (session *Session) AddHooks(hooks ...MessageHooks) {
+	if len(hooks) == 0 {
+		return
+	}
+	session.hooks = append(session.hooks, hooks...)
+}
+
+func (session *Session) outboundCall(ctx context.Context, call *Call) error {
+	for _, h := range session.hooks {
+		if h.OutboundCall == nil {
+			continue
+		}
+		if err := h.OutboundCall(ctx, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (session *Session) outboundReply(ctx context.Context, reply *Reply) error {
+	for _, h := range session.hooks {
+		if h.OutboundReply == nil {
+			continue
+		}
+		if err := h.OutboundReply(ctx, reply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (session *Session) inboundCall(ctx context.Context, call *Call) error {
+	if call.Method == framingMethod {
+		return nil
+	}
+	for _, h := range session.hooks {
+		if h.InboundCall == nil {
+			continue
+		}
+		if err := h.InboundCall(ctx, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (session *Session) inboundReply(ctx context.Context, reply *Reply) {
+	for _, h := range session.hooks {
+		if h.InboundReply == nil {
+			continue
+		}
+		if err := h.InboundReply(ctx, reply); err != nil {
+			verr := ConvertError(ctx, err)
+			*reply = Reply{Error: verr.ErrorCode(), Continues: reply.Continues}
+			if r, err := MakeReply(verr); err == nil {
+				reply.Parameters = r.Parameters
+			}
+			return
+		}
+	}
+}
+
+// rejectCall replies to a call rejected by an inbound hook.
+func (session *Session) rejectCall(ctx context.Context, call *Call, err error) error {
+	if call.OneWay {
+		return nil
+	}
+	verr := ConvertError(ctx, err)
+	reply, merr := MakeReply(verr, ErrorCode(verr.ErrorCode()))
+	if merr != nil {
+		reply, _ = MakeReply(nil, ErrorCode(errInternal))
+	}
+	return session.WriteReply(ctx, &reply)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestMessageHooks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		observed []string
+	)
+	observe := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		observed = append(observed, event)
+	}
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Echo", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(call.Parameters)
+	})
+	mux.HandleFunc("org.example.Forbidden", func(w varlink.ReplyWriter, call *varlink.Call) {
+		t.Error("rejected call reached its handler")
+		w.WriteReply(nil)
+	})
+	server := varlink.Server{
+		Handler: &mux,
+		MessageHooks: []varlink.MessageHooks{{
+			InboundCall: func(ctx context.Context, call *varlink.Call) error {
+				observe("server received " + call.Method)
+				if call.Method == "org.example.Forbidden" {
+					return varlink.NewError("org.example.Denied")
+				}
+				return nil
+			},
+			OutboundReply: func(ctx context.Context, reply *varlink.Reply) error {
+				observe("server replied " + string(reply.Parameters))
+				return nil
+			},
+		}},
+	}
+
+	sconn, cconn := net.Pipe()
+	go server.ServeSession(ctx, varlink.NewSession(sconn))
+
+	client := varlink.NewSession(cconn)
+	defer client.Close()
+
+	errBlocked := errors.New("blocked")
+	client.AddHooks(varlink.MessageHooks{
+		OutboundCall: func(ctx context.Context, call *varlink.Call) error {
+			if call.Method == "org.example.Blocked" {
+				return errBlocked
+			}
+			return nil
+		},
+		InboundReply: func(ctx context.Context, reply *varlink.Reply) error {
+			observe("client received " + string(reply.Parameters))
+			return nil
+		},
+	})
+
+	call := func(method string) varlink.Reply {
+		t.Helper()
+
+		call, _ := varlink.MakeCall(method, map[string]int{"n": 1})
+		if err := client.WriteCall(ctx, &call); err != nil {
+			t.Fatal(err)
+		}
+		var reply varlink.Reply
+		if err := client.ReadReply(ctx, &call, &reply); err != nil {
+			t.Fatal(err)
+		}
+		return reply
+	}
+
+	if reply := call("org.example.Forbidden"); reply.Error != "org.example.Denied" {
+		t.Fatalf("got reply %q %s, expected org.example.Denied", reply.Error, reply.Parameters)
+	}
+	if reply := call("org.example.Echo"); reply.Error != "" || string(reply.Parameters) != `{"n":1}` {
+		t.Fatalf("got reply %q %s, expected the parameters to be echoed", reply.Error, reply.Parameters)
+	}
+
+	blocked, _ := varlink.MakeCall("org.example.Blocked", nil)
+	if err := client.WriteCall(ctx, &blocked); !errors.Is(err, errBlocked) {
+		t.Fatalf("writing a blocked call returned %v, expected the error of the hook", err)
+	}
+
+	expected := []string{
+		"server received org.example.Forbidden",
+		"server replied {}",
+		"client received {}",
+		"server received org.example.Echo",
+		"server replied " + `{"n":1}`,
+		"client received " + `{"n":1}`,
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(observed, expected) {
+		got, _ := json.MarshalIndent(observed, "", "\t")
+		t.Fatalf("hooks observed %s", got)
+	}
+}
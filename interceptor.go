@@ -0,0 +1,23 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+// An Interceptor wraps a method handler to run code around the handling of
+// calls, for cross-cutting concerns like access control, logging, or
+// accounting.
+//
+// An interceptor typically returns a handler that inspects the call, and
+// either replies to it directly, or passes it to next.
+type Interceptor func(next MethodHandler) MethodHandler
+
+// Chain wraps the handler with the specified interceptors. The first
+// interceptor is the outermost, and sees calls first.
+func Chain(handler MethodHandler, interceptors ...Interceptor) MethodHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		handler = interceptors[i](handler)
+	}
+	return handler
+}
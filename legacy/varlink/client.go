@@ -0,0 +1,189 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package varlink mirrors the API of the github.com/varlink/go/varlink
+// package on top of snai.pe/go-varlink, to ease the migration of existing
+// code bases: replacing the import path of the former by the import path of
+// this package is enough for the subset of its API used by most services
+// and clients, including the code generated by its generator.
+//
+// New code should use snai.pe/go-varlink directly. Services migrated with
+// this package can be moved over one interface at a time, since
+// Service.Mux is a varlink.ServeMux on which new-style handlers can be
+// registered alongside legacy interfaces.
+package varlink
+
+import (
+	"context"
+	"errors"
+
+	"snai.pe/go-varlink"
+)
+
+// Flags of the calls sent and the replies received with Connection.Send.
+const (
+	More      = 1 << iota // the call accepts multiple replies
+	Oneway                // the call does not expect a reply
+	Continues             // more replies follow the received one
+	Upgrade               // the connection is upgraded after the call
+)
+
+// Error is an error reply received by a Connection.
+type Error struct {
+	Name       string
+	Parameters interface{}
+}
+
+func (e *Error) Error() string {
+	return e.Name
+}
+
+// ErrorCode returns the name of the error, which makes Error a
+// varlink.Error.
+func (e *Error) ErrorCode() string {
+	return e.Name
+}
+
+var errNoMoreReplies = errors.New("varlink: no more replies")
+
+// Connection is a connection to a varlink service.
+type Connection struct {
+	session *varlink.Session
+	address string
+}
+
+// NewConnection returns a new connection to the service at the specified
+// address, like unix:/run/org.example.ftl or tcp:127.0.0.1:12345.
+func NewConnection(ctx context.Context, address string) (*Connection, error) {
+	uri, err := varlink.ParseURI(address)
+	if err != nil {
+		return nil, err
+	}
+	session, err := new(varlink.Dialer).DialURI(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	return &Connection{session: session, address: address}, nil
+}
+
+// Address returns the address the connection was opened to.
+func (c *Connection) Address() string {
+	return c.address
+}
+
+// Send sends a method call, with the More, Oneway and Upgrade flags, and
+// returns a function reading its replies into outparameters. The function
+// returns the Continues flag if more replies follow, and an *Error for
+// error replies. It is nil for one-way calls.
+func (c *Connection) Send(ctx context.Context, method string, parameters interface{}, flags uint64) (func(ctx context.Context, outparameters interface{}) (uint64, error), error) {
+	var opts []varlink.CallOption
+	if flags&More != 0 {
+		opts = append(opts, varlink.More())
+	}
+	if flags&Oneway != 0 {
+		opts = append(opts, varlink.OneWay())
+	}
+	if flags&Upgrade != 0 {
+		opts = append(opts, varlink.Upgrade())
+	}
+	if parameters == nil {
+		parameters = struct{}{}
+	}
+	call, err := varlink.MakeCall(method, parameters, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.session.WriteCall(ctx, &call); err != nil {
+		return nil, err
+	}
+	if call.OneWay {
+		return nil, nil
+	}
+
+	rs := varlink.NewReplyStream(ctx, &call, c.session)
+	receive := func(ctx context.Context, outparameters interface{}) (uint64, error) {
+		if !rs.Next() {
+			if err := rs.Error(); err != nil {
+				return 0, err
+			}
+			return 0, errNoMoreReplies
+		}
+		reply := rs.Reply()
+		if reply.Error != "" {
+			var params interface{}
+			if len(reply.Parameters) > 0 {
+				params = reply.Parameters
+			}
+			return 0, &Error{Name: reply.Error, Parameters: params}
+		}
+		if outparameters != nil {
+			if err := reply.Unmarshal(outparameters); err != nil {
+				return 0, err
+			}
+		}
+		if reply.Continues {
+			return Continues, nil
+		}
+		return 0, nil
+	}
+	return receive, nil
+}
+
+// Call sends a method call, and reads its single reply into outparameters.
+func (c *Connection) Call(ctx context.Context, method string, parameters interface{}, outparameters interface{}) error {
+	receive, err := c.Send(ctx, method, parameters, 0)
+	if err != nil {
+		return err
+	}
+	_, err = receive(ctx, outparameters)
+	return err
+}
+
+// GetInterfaceDescription returns the description of the specified
+// interface of the service.
+func (c *Connection) GetInterfaceDescription(ctx context.Context, name string) (string, error) {
+	var out struct {
+		Description string `json:"description"`
+	}
+	in := struct {
+		Interface string `json:"interface"`
+	}{name}
+	if err := c.Call(ctx, "org.varlink.service.GetInterfaceDescription", in, &out); err != nil {
+		return "", err
+	}
+	return out.Description, nil
+}
+
+// GetInfo fills in the information about the service. Any of the pointers
+// may be nil.
+func (c *Connection) GetInfo(ctx context.Context, vendor *string, product *string, version *string, url *string, interfaces *[]string) error {
+	var out struct {
+		Vendor     string   `json:"vendor"`
+		Product    string   `json:"product"`
+		Version    string   `json:"version"`
+		URL        string   `json:"url"`
+		Interfaces []string `json:"interfaces"`
+	}
+	if err := c.Call(ctx, "org.varlink.service.GetInfo", nil, &out); err != nil {
+		return err
+	}
+	for _, f := range []struct {
+		dst *string
+		src string
+	}{{vendor, out.Vendor}, {product, out.Product}, {version, out.Version}, {url, out.URL}} {
+		if f.dst != nil {
+			*f.dst = f.src
+		}
+	}
+	if interfaces != nil {
+		*interfaces = out.Interfaces
+	}
+	return nil
+}
+
+// Close closes the connection.
+func (c *Connection) Close() error {
+	return c.session.Close()
+}
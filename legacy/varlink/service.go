@@ -0,0 +1,259 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// Call is a method call received by a Service, passed to the VarlinkDispatch
+// method of the interface it was made to.
+type Call struct {
+	// Method is the fully-qualified name of the called method.
+	Method string
+
+	// Parameters are the parameters of the call.
+	Parameters *json.RawMessage
+
+	// Continues, if set, makes Reply write a reply with the continues
+	// flag, for calls made with the more flag.
+	Continues bool
+
+	w    varlink.ReplyWriter
+	call *varlink.Call
+}
+
+// WantsMore returns whether the call was made with the more flag.
+func (c *Call) WantsMore() bool {
+	return c.call.More
+}
+
+// IsOneway returns whether the call was made with the oneway flag.
+func (c *Call) IsOneway() bool {
+	return c.call.OneWay
+}
+
+// WantsUpgrade returns whether the call was made with the upgrade flag.
+func (c *Call) WantsUpgrade() bool {
+	return c.call.Upgrade
+}
+
+// Reply writes a reply to the call, with the continues flag if Continues is
+// set.
+func (c *Call) Reply(ctx context.Context, parameters interface{}) error {
+	if parameters == nil {
+		parameters = struct{}{}
+	}
+	if c.Continues {
+		return c.w.WriteMore(parameters)
+	}
+	return c.w.WriteReply(parameters)
+}
+
+// ReplyError writes an error reply to the call.
+func (c *Call) ReplyError(ctx context.Context, name string, parameters interface{}) error {
+	if parameters == nil {
+		return c.w.WriteError(varlink.NewError(name))
+	}
+	verr, err := varlink.NewErrorParams(name, parameters)
+	if err != nil {
+		return err
+	}
+	return c.w.WriteError(verr)
+}
+
+// ReplyInterfaceNotFound replies with org.varlink.service.InterfaceNotFound.
+func (c *Call) ReplyInterfaceNotFound(ctx context.Context, interfaceA string) error {
+	return c.ReplyError(ctx, "org.varlink.service.InterfaceNotFound", map[string]string{"interface": interfaceA})
+}
+
+// ReplyMethodNotFound replies with org.varlink.service.MethodNotFound.
+func (c *Call) ReplyMethodNotFound(ctx context.Context, method string) error {
+	return c.ReplyError(ctx, "org.varlink.service.MethodNotFound", map[string]string{"method": method})
+}
+
+// ReplyMethodNotImplemented replies with
+// org.varlink.service.MethodNotImplemented.
+func (c *Call) ReplyMethodNotImplemented(ctx context.Context, method string) error {
+	return c.ReplyError(ctx, "org.varlink.service.MethodNotImplemented", map[string]string{"method": method})
+}
+
+// ReplyInvalidParameter replies with org.varlink.service.InvalidParameter.
+func (c *Call) ReplyInvalidParameter(ctx context.Context, parameter string) error {
+	return c.ReplyError(ctx, "org.varlink.service.InvalidParameter", map[string]string{"parameter": parameter})
+}
+
+// dispatcher is implemented by the interfaces registered on a Service, as
+// generated by the legacy generator.
+type dispatcher interface {
+	VarlinkDispatch(ctx context.Context, c Call, methodname string) error
+	VarlinkGetName() string
+	VarlinkGetDescription() string
+}
+
+// Service is a varlink service serving the interfaces registered on it.
+type Service struct {
+	// Mux routes the calls made to the service. Handlers of
+	// snai.pe/go-varlink can be registered on it alongside the legacy
+	// interfaces.
+	Mux varlink.ServeMux
+
+	mu       sync.Mutex
+	listener net.Listener
+}
+
+// NewService returns a new service with the specified information, as
+// returned by org.varlink.service.GetInfo.
+func NewService(vendor string, product string, version string, url string) (*Service, error) {
+	s := &Service{}
+	s.Mux.SetInfo(vendor, product, version, url)
+	return s, nil
+}
+
+// RegisterInterface registers an interface on the service, whose calls are
+// passed to its VarlinkDispatch method with the name of the called method,
+// relative to the interface. Errors returned by VarlinkDispatch are
+// converted with varlink.ConvertError, and replied if the call was not
+// replied to yet.
+func (s *Service) RegisterInterface(iface dispatcher) error {
+	name := iface.VarlinkGetName()
+	if err := setDescription(&s.Mux, name, iface.VarlinkGetDescription()); err != nil {
+		return err
+	}
+	s.Mux.Handle(name+".*", varlink.HandlerFuncErr(func(w varlink.ReplyWriter, call *varlink.Call) error {
+		params := call.Parameters
+		if len(params) == 0 {
+			params = json.RawMessage("{}")
+		}
+		c := Call{
+			Method:     call.Method,
+			Parameters: &params,
+			w:          w,
+			call:       call,
+		}
+		return iface.VarlinkDispatch(w.Context(), c, call.Method[len(name)+1:])
+	}))
+	return nil
+}
+
+// setDescription sets the description of an interface, turning the panics
+// of ServeMux.SetDescription on invalid descriptions into errors.
+func setDescription(mux *varlink.ServeMux, name, desc string) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("%v", e)
+		}
+	}()
+	mux.SetDescription(name, desc)
+	return nil
+}
+
+// Listen listens on the specified address, like unix:/run/org.example.ftl,
+// and serves the calls of the clients connecting to it until Shutdown is
+// called, or ctx is done.
+//
+// If timeout is not zero, Listen also returns once no client has been
+// connected for that long, which services activated on demand use to exit
+// when they are not used anymore.
+func (s *Service) Listen(ctx context.Context, address string, timeout time.Duration) error {
+	l, err := varlink.Listen(address)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.listener != nil {
+		s.mu.Unlock()
+		l.Close()
+		return errors.New("varlink: service is already listening")
+	}
+	s.listener = l
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.listener = nil
+		s.mu.Unlock()
+	}()
+
+	stop := context.AfterFunc(ctx, func() { l.Close() })
+	defer stop()
+
+	if timeout > 0 {
+		l = newIdleListener(l, timeout)
+	}
+	server := varlink.Server{Handler: &s.Mux}
+	if err := server.Serve(l); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// Shutdown stops the service from listening. Listen returns once the
+// connections of the clients already connected have been closed.
+func (s *Service) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener != nil {
+		s.listener.Close()
+	}
+}
+
+// idleListener closes itself once it has had no open connection for a
+// while.
+type idleListener struct {
+	net.Listener
+	timeout time.Duration
+
+	mu     sync.Mutex
+	active int
+	timer  *time.Timer
+}
+
+func newIdleListener(l net.Listener, timeout time.Duration) *idleListener {
+	il := &idleListener{Listener: l, timeout: timeout}
+	il.timer = time.AfterFunc(timeout, func() { l.Close() })
+	return il
+}
+
+func (l *idleListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	l.active++
+	l.timer.Stop()
+	l.mu.Unlock()
+	return &idleConn{Conn: conn, l: l}, nil
+}
+
+func (l *idleListener) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.active--; l.active == 0 {
+		l.timer.Reset(l.timeout)
+	}
+}
+
+type idleConn struct {
+	net.Conn
+	l    *idleListener
+	once sync.Once
+}
+
+func (c *idleConn) Close() error {
+	c.once.Do(c.l.release)
+	return c.Conn.Close()
+}
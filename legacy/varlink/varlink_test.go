@@ -0,0 +1,138 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink/legacy/varlink"
+)
+
+// count mimics the code generated by the legacy generator for the
+// following interface.
+type count struct{}
+
+func (count) VarlinkGetName() string { return "org.example.count" }
+
+func (count) VarlinkGetDescription() string {
+	return `interface org.example.count
+
+method Count(n: int) -> (i: int)
+
+error Negative (n: int)
+`
+}
+
+func (count) VarlinkDispatch(ctx context.Context, c varlink.Call, methodname string) error {
+	if methodname != "Count" {
+		return c.ReplyMethodNotFound(ctx, methodname)
+	}
+	var in struct {
+		N int `json:"n"`
+	}
+	if err := json.Unmarshal(*c.Parameters, &in); err != nil {
+		return c.ReplyInvalidParameter(ctx, "parameters")
+	}
+	if in.N < 0 {
+		return c.ReplyError(ctx, "org.example.count.Negative", in)
+	}
+	for i := range in.N {
+		c.Continues = c.WantsMore() && i < in.N-1
+		if err := c.Reply(ctx, map[string]int{"i": i}); err != nil {
+			return err
+		}
+		if !c.Continues {
+			break
+		}
+	}
+	return nil
+}
+
+func TestLegacy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc, err := varlink.NewService("Example", "Count", "1", "https://example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.RegisterInterface(count{}); err != nil {
+		t.Fatal(err)
+	}
+
+	address := "unix:" + filepath.Join(t.TempDir(), "sock")
+	done := make(chan error)
+	go func() { done <- svc.Listen(ctx, address, 0) }()
+
+	var conn *varlink.Connection
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		conn, err = varlink.NewConnection(ctx, address)
+		if err == nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var product string
+	var interfaces []string
+	if err := conn.GetInfo(ctx, nil, &product, nil, nil, &interfaces); err != nil {
+		t.Fatal(err)
+	}
+	if product != "Count" {
+		t.Fatalf("got product %q, expected Count", product)
+	}
+	desc, err := conn.GetInterfaceDescription(ctx, "org.example.count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc != (count{}).VarlinkGetDescription() {
+		t.Fatalf("got description %q", desc)
+	}
+
+	receive, err := conn.Send(ctx, "org.example.count.Count", map[string]int{"n": 3}, varlink.More)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; ; i++ {
+		var out struct {
+			I int `json:"i"`
+		}
+		flags, err := receive(ctx, &out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.I != i {
+			t.Fatalf("got reply %d, expected %d", out.I, i)
+		}
+		if flags&varlink.Continues == 0 {
+			if i != 2 {
+				t.Fatalf("got %d replies, expected 3", i+1)
+			}
+			break
+		}
+	}
+
+	err = conn.Call(ctx, "org.example.count.Count", map[string]int{"n": -1}, nil)
+	var verr *varlink.Error
+	if !errors.As(err, &verr) || verr.Name != "org.example.count.Negative" {
+		t.Fatalf("call failed with %v, expected org.example.count.Negative", err)
+	}
+
+	conn.Close()
+	svc.Shutdown()
+	if err := <-done; err != nil {
+		t.Fatalf("Listen returned %v after Shutdown", err)
+	}
+}
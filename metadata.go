@@ -0,0 +1,158 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"slices"
+)
+
+// MetadataExtension is the name of the call extension carrying call
+// metadata.
+const MetadataExtension = `snai.pe.varlink.Metadata`
+
+// Well-known metadata keys.
+const (
+	// MetadataRequestID identifies a single request, and is typically
+	// propagated to the calls made to serve that request.
+	MetadataRequestID = "request-id"
+
+	// MetadataTraceID identifies a trace spanning multiple requests and
+	// services.
+	MetadataTraceID = "trace-id"
+)
+
+// Metadata is a set of key-value pairs propagated alongside calls, for
+// purposes like request correlation across services.
+//
+// Metadata is carried by calls in the MetadataExtension extension field.
+// Extensions are not part of the varlink specification, and services
+// implemented with other libraries may reject calls carrying them: only
+// attach metadata to calls made to services known to accept it. Metadata is
+// never attached implicitly, unless the Client has PropagateMetadata set.
+type Metadata map[string]string
+
+type metadataKey struct{}
+
+// ContextWithMetadata returns a copy of ctx carrying the specified metadata
+// in addition to any metadata already carried by ctx.
+//
+// Calls made with the returned context by a Client with PropagateMetadata
+// set carry the metadata.
+func ContextWithMetadata(ctx context.Context, md Metadata) context.Context {
+	merged := maps.Clone(MetadataFromContext(ctx))
+	if merged == nil {
+		merged = make(Metadata, len(md))
+	}
+	maps.Copy(merged, md)
+	return context.WithValue(ctx, metadataKey{}, merged)
+}
+
+// MetadataFromContext returns the metadata carried by ctx, or nil if there
+// is none.
+//
+// The context of a ReplyWriter carries the metadata of the call being
+// served, which means that calls made with that context by a Client with
+// PropagateMetadata set propagate the metadata further.
+func MetadataFromContext(ctx context.Context) Metadata {
+	md, _ := ctx.Value(metadataKey{}).(Metadata)
+	return md
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	return MetadataFromContext(ctx)[MetadataRequestID]
+}
+
+// TraceIDFromContext returns the trace ID carried by ctx, if any.
+func TraceIDFromContext(ctx context.Context) string {
+	return MetadataFromContext(ctx)[MetadataTraceID]
+}
+
+// LogValue implements slog.LogValuer, which allows metadata to be logged as
+// a group of attributes.
+func (md Metadata) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(md))
+	for _, k := range slices.Sorted(maps.Keys(md)) {
+		attrs = append(attrs, slog.String(k, md[k]))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// Metadata returns the metadata carried by the call, or nil if there is none
+// or the extension is malformed.
+func (c *Call) Metadata() Metadata {
+	raw, ok := c.Extensions[MetadataExtension]
+	if !ok {
+		return nil
+	}
+	var md Metadata
+	if err := json.Unmarshal([]byte(raw), &md); err != nil {
+		return nil
+	}
+	return md
+}
+
+func (c *Call) setMetadata(md Metadata) error {
+	data, err := json.Marshal(md)
+	if err != nil {
+		return err
+	}
+	if c.Extensions == nil {
+		c.Extensions = make(map[string]json.RawMessage)
+	}
+	c.Extensions[MetadataExtension] = json.RawMessage(data)
+	return nil
+}
+
+// WithMetadata attaches the specified metadata to the call, in addition to
+// any metadata already attached.
+func WithMetadata(md Metadata) CallOption {
+	return funcCallOpt(func(opts *Call) error {
+		merged := opts.Metadata()
+		if merged == nil {
+			merged = make(Metadata, len(md))
+		}
+		maps.Copy(merged, md)
+		return opts.setMetadata(merged)
+	})
+}
+
+// RequestID attaches a request ID to the call.
+func RequestID(id string) CallOption {
+	return WithMetadata(Metadata{MetadataRequestID: id})
+}
+
+// TraceID attaches a trace ID to the call.
+func TraceID(id string) CallOption {
+	return WithMetadata(Metadata{MetadataTraceID: id})
+}
+
+// injectMetadata attaches the metadata carried by ctx to the call. Metadata
+// explicitly attached to the call takes precedence.
+func injectMetadata(ctx context.Context, call *Call) error {
+	md := MetadataFromContext(ctx)
+	if md == nil {
+		return nil
+	}
+	merged := maps.Clone(md)
+	maps.Copy(merged, call.Metadata())
+	return call.setMetadata(merged)
+}
+
+// callContext returns the context in which the call is served.
+func callContext(ctx context.Context, call *Call) context.Context {
+	if md := call.Metadata(); md != nil {
+		ctx = context.WithValue(ctx, metadataKey{}, md)
+	}
+	if names, ok := call.SelectedFields(); ok {
+		ctx = context.WithValue(ctx, fieldsKey{}, names)
+	}
+	return ctx
+}
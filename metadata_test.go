@@ -0,0 +1,147 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"path/filepath"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestContextWithMetadata(t *testing.T) {
+	ctx := context.Background()
+	if md := varlink.MetadataFromContext(ctx); md != nil {
+		t.Fatalf("got metadata %v from an empty context", md)
+	}
+
+	ctx = varlink.ContextWithMetadata(ctx, varlink.Metadata{
+		varlink.MetadataRequestID: "req-1",
+		"tenant":                  "a",
+	})
+	child := varlink.ContextWithMetadata(ctx, varlink.Metadata{
+		varlink.MetadataTraceID: "trace-1",
+		"tenant":                "b",
+	})
+
+	expected := varlink.Metadata{
+		varlink.MetadataRequestID: "req-1",
+		varlink.MetadataTraceID:   "trace-1",
+		"tenant":                  "b",
+	}
+	if md := varlink.MetadataFromContext(child); !maps.Equal(md, expected) {
+		t.Fatalf("got metadata %v, expected %v", md, expected)
+	}
+	if id := varlink.RequestIDFromContext(child); id != "req-1" {
+		t.Fatalf("got request ID %q, expected req-1", id)
+	}
+	if id := varlink.TraceIDFromContext(child); id != "trace-1" {
+		t.Fatalf("got trace ID %q, expected trace-1", id)
+	}
+
+	// The parent context is left untouched.
+	if md := varlink.MetadataFromContext(ctx); md["tenant"] != "a" || md[varlink.MetadataTraceID] != "" {
+		t.Fatalf("parent context metadata changed to %v", md)
+	}
+}
+
+func TestCallMetadata(t *testing.T) {
+	call, err := varlink.MakeCall("org.example.Ping", nil,
+		varlink.RequestID("req-1"),
+		varlink.TraceID("trace-1"),
+		varlink.WithMetadata(varlink.Metadata{"tenant": "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := call.Message()
+	raw, err := json.Marshal(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"method":"org.example.Ping","extensions":{"snai.pe.varlink.Metadata":{"request-id":"req-1","tenant":"a","trace-id":"trace-1"}}}`
+	if string(raw) != expected {
+		t.Fatalf("got %s, expected %s", raw, expected)
+	}
+
+	var decoded varlink.Message
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	received := decoded.Call()
+	if id := received.Metadata()[varlink.MetadataRequestID]; id != "req-1" {
+		t.Fatalf("got request ID %q after decoding, expected req-1", id)
+	}
+
+	received.Extensions[varlink.MetadataExtension] = json.RawMessage(`"malformed"`)
+	if md := received.Metadata(); md != nil {
+		t.Fatalf("got metadata %v from a malformed extension", md)
+	}
+}
+
+func TestMetadataPropagation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sock")
+	l, err := varlink.Listen("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Metadata", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(varlink.MetadataFromContext(w.Context()))
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	uri, err := varlink.ParseURI("unix:" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &varlink.Client{Transport: &varlink.Transport{}, URI: uri}
+
+	ctx := varlink.ContextWithMetadata(context.Background(), varlink.Metadata{
+		varlink.MetadataRequestID: "from-context",
+		varlink.MetadataTraceID:   "trace-1",
+	})
+	call := func(client *varlink.Client, opts ...varlink.CallOption) varlink.Metadata {
+		t.Helper()
+		rs, err := client.Call(ctx, "org.example.Metadata", nil, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rs.Next() || rs.Error() != nil {
+			t.Fatalf("call failed: %v", rs.Error())
+		}
+		var md varlink.Metadata
+		if err := rs.Unmarshal(&md); err != nil {
+			t.Fatal(err)
+		}
+		return md
+	}
+
+	// The metadata of the context is not propagated by default.
+	if md := call(client); md != nil {
+		t.Fatalf("server got metadata %v, expected none", md)
+	}
+	expected := varlink.Metadata{varlink.MetadataRequestID: "from-call"}
+	if md := call(client, varlink.RequestID("from-call")); !maps.Equal(md, expected) {
+		t.Fatalf("server got metadata %v, expected %v", md, expected)
+	}
+
+	// With propagation, metadata attached to the call takes precedence over
+	// the metadata carried by the context.
+	client.PropagateMetadata = true
+	expected = varlink.Metadata{
+		varlink.MetadataRequestID: "from-call",
+		varlink.MetadataTraceID:   "trace-1",
+	}
+	if md := call(client, varlink.RequestID("from-call")); !maps.Equal(md, expected) {
+		t.Fatalf("server got metadata %v, expected %v", md, expected)
+	}
+}
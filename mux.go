@@ -11,6 +11,7 @@ import (
 	"runtime/debug"
 	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"snai.pe/go-varlink/internal/service"
 	"snai.pe/go-varlink/syntax"
@@ -28,6 +29,15 @@ func (fn HandlerFunc) ServeMethod(w ReplyWriter, call *Call) {
 // method name of each incoming call against a list of registered patterns
 // and calls the handler for the pattern that matches.
 type ServeMux struct {
+	// DescriptionChunkSize is the size, in bytes, above which the replies
+	// to org.varlink.service.GetInterfaceDescription calls made with the
+	// `more` flag split the description into several replies, so that the
+	// descriptions of large interfaces fit within the MaxMessageSize of
+	// clients. Clients concatenate the pieces, as Client.Description does.
+	// Calls without the flag get the whole description. If zero,
+	// DefaultChunkSize is used.
+	DescriptionChunkSize int
+
 	patterns     []string
 	handlers     map[string]MethodHandler
 	descriptions map[string]string
@@ -35,16 +45,34 @@ type ServeMux struct {
 }
 
 // HandlerFunc registers a handler function to the specified pattern.
-func (mux *ServeMux) HandleFunc(pattern string, handler HandlerFunc) {
-	mux.Handle(pattern, handler)
+func (mux *ServeMux) HandleFunc(pattern string, handler HandlerFunc, opts ...HandleOption) {
+	mux.Handle(pattern, handler, opts...)
 }
 
 // HandlerFunc registers a handler to the specified pattern.
-func (mux *ServeMux) Handle(pattern string, handler MethodHandler) {
+//
+// Options may be specified to control how calls to the handler are run;
+// for instance, mux.Handle(pattern, h, varlink.Serialized()) makes calls
+// matching pattern run one at a time.
+func (mux *ServeMux) Handle(pattern string, handler MethodHandler, opts ...HandleOption) {
 	if _, err := path.Match(pattern, ""); err != nil {
 		panic(err)
 	}
 
+	var options HandleOptions
+	for _, opt := range opts {
+		if err := opt.SetHandleOption(&options); err != nil {
+			panic(err)
+		}
+	}
+
+	switch options.Concurrency {
+	case ConcurrencySerialized:
+		handler = &serializedHandler{handler: handler}
+	case ConcurrencyCoalesced:
+		handler = &coalescedHandler{handler: handler}
+	}
+
 	mux.patterns = append(mux.patterns, pattern)
 	slices.Sort(mux.patterns)
 	if mux.handlers == nil {
@@ -53,6 +81,26 @@ func (mux *ServeMux) Handle(pattern string, handler MethodHandler) {
 	mux.handlers[pattern] = handler
 }
 
+// Method is a method handler bound to the fully-qualified name of the method
+// it serves. The code generated by cmd/codegen defines a Method adapter for
+// each method of an interface, which checks at compile time that handlers
+// have the signature of the method they are registered for.
+type Method interface {
+	MethodHandler
+
+	// MethodName returns the fully-qualified name of the method.
+	MethodName() string
+}
+
+// HandleMethod registers the method handler to the name of its method.
+//
+//	mux.HandleMethod(fib.FibonacciHandler(func(ctx context.Context, n int64) (int64, fib.Error) {
+//		...
+//	}))
+func (mux *ServeMux) HandleMethod(method Method, opts ...HandleOption) {
+	mux.Handle(method.MethodName(), method, opts...)
+}
+
 // SetDescription sets the varlink service description for the specified
 // interface name.
 //
@@ -61,7 +109,7 @@ func (mux *ServeMux) Handle(pattern string, handler MethodHandler) {
 func (mux *ServeMux) SetDescription(intf string, desc string) {
 	_, err := syntax.NewParser(strings.NewReader(desc)).Parse()
 	if err != nil {
-		panic(fmt.Sprintf("description for %q isn't written in the Varlink IDL: %v", err))
+		panic(fmt.Sprintf("description for %q isn't written in the Varlink IDL: %v", intf, err))
 	}
 
 	if mux.descriptions == nil {
@@ -138,6 +186,10 @@ func (mux *ServeMux) ServeMethod(w ReplyWriter, call *Call) {
 			out.Description = desc
 		}
 
+		if call.More {
+			mux.writeDescription(w, out.Description)
+			return
+		}
 		w.WriteReply(&out)
 		return
 	}
@@ -149,3 +201,26 @@ func (mux *ServeMux) ServeMethod(w ReplyWriter, call *Call) {
 	}
 	w.WriteError(service.MethodNotFound(call.Method))
 }
+
+// writeDescription writes an interface description in pieces of at most
+// DescriptionChunkSize bytes, cut at rune boundaries.
+func (mux *ServeMux) writeDescription(w ReplyWriter, desc string) {
+	size := mux.DescriptionChunkSize
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	for len(desc) > size {
+		n := size
+		for n > 0 && !utf8.RuneStart(desc[n]) {
+			n--
+		}
+		if n == 0 {
+			n = size
+		}
+		if w.WriteMore(&service.GetInterfaceDescriptionOutput{Description: desc[:n]}) != nil {
+			return
+		}
+		desc = desc[n:]
+	}
+	w.WriteFinal(&service.GetInterfaceDescriptionOutput{Description: desc})
+}
@@ -32,6 +32,15 @@ func More() CallOption {
 	})
 }
 
+// Idempotent marks the call as safe to make more than once, which lets a
+// Transport with a RetryPolicy retry it.
+func Idempotent() CallOption {
+	return funcCallOpt(func(opts *Call) error {
+		opts.Idempotent = true
+		return nil
+	})
+}
+
 // Upgrade requests the connection to be taken over by a custom protocol/payload.
 func Upgrade() CallOption {
 	return funcCallOpt(func(opts *Call) error {
@@ -112,3 +121,77 @@ func Fd(fd uintptr) MethodOption {
 		},
 	}
 }
+
+// HandleOptions contains the options that apply to a handler registered in a
+// ServeMux.
+type HandleOptions struct {
+
+	// Concurrency is the policy used when multiple calls to the handler
+	// happen at the same time.
+	Concurrency ConcurrencyPolicy
+}
+
+// A HandleOption is any option that applies to the registration of a handler
+// in a ServeMux.
+type HandleOption interface {
+	SetHandleOption(*HandleOptions) error
+}
+
+type funcHandleOpt func(*HandleOptions) error
+
+func (fn funcHandleOpt) SetHandleOption(opts *HandleOptions) error {
+	return fn(opts)
+}
+
+// ConcurrencyPolicy defines how concurrent calls to a handler are run.
+type ConcurrencyPolicy int
+
+const (
+	// ConcurrencyConcurrent runs calls from different sessions concurrently.
+	// This is the default.
+	ConcurrencyConcurrent ConcurrencyPolicy = iota
+
+	// ConcurrencySerialized runs calls one at a time, across all sessions.
+	ConcurrencySerialized
+
+	// ConcurrencyCoalesced runs calls one at a time, across all sessions,
+	// but only the latest of the calls waiting for their turn gets to run.
+	// The calls it supersedes receive the same replies.
+	ConcurrencyCoalesced
+)
+
+// Concurrent lets calls from different sessions run concurrently.
+//
+// Regardless of the policy, calls received on a single session are always
+// handled in order.
+func Concurrent() HandleOption {
+	return funcHandleOpt(func(opts *HandleOptions) error {
+		opts.Concurrency = ConcurrencyConcurrent
+		return nil
+	})
+}
+
+// Serialized makes calls to the handler run one at a time, across all
+// sessions.
+func Serialized() HandleOption {
+	return funcHandleOpt(func(opts *HandleOptions) error {
+		opts.Concurrency = ConcurrencySerialized
+		return nil
+	})
+}
+
+// Coalesced makes calls to the handler run one at a time, across all
+// sessions, and only runs the latest call out of those waiting for their
+// turn. Superseded calls receive the replies of the call that superseded
+// them, which makes this policy suitable for methods where only the latest
+// state matters, like refreshes or reloads.
+//
+// The handler only sees the errors writing replies to the call that runs.
+// Failures to write to superseded calls are reported to the ErrorHook of
+// the server.
+func Coalesced() HandleOption {
+	return funcHandleOpt(func(opts *HandleOptions) error {
+		opts.Concurrency = ConcurrencyCoalesced
+		return nil
+	})
+}
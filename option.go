@@ -0,0 +1,94 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Option is a value of a nullable type, which is either absent, or set to a
+// value of type T. Unlike a pointer, it distinguishes an absent value from a
+// zero one without allocating.
+//
+// Absent values are encoded as JSON null, and JSON null is decoded as an
+// absent value. Struct fields of type Option are omitted when absent if they
+// are tagged with omitzero.
+//
+// The zero Option is absent.
+type Option[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Some returns an Option set to v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{Value: v, Valid: true}
+}
+
+// None returns an absent Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionFrom returns an Option set to the value p points to, or an absent
+// Option if p is nil.
+func OptionFrom[T any](p *T) Option[T] {
+	if p == nil {
+		return Option[T]{}
+	}
+	return Some(*p)
+}
+
+// Get returns the value of the option, and whether it is set.
+func (o Option[T]) Get() (T, bool) {
+	return o.Value, o.Valid
+}
+
+// Or returns the value of the option if it is set, and def otherwise.
+func (o Option[T]) Or(def T) T {
+	if !o.Valid {
+		return def
+	}
+	return o.Value
+}
+
+// Ptr returns a pointer to a copy of the value of the option, or nil if it
+// is absent.
+func (o Option[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	v := o.Value
+	return &v
+}
+
+// IsZero reports whether the option is absent.
+func (o Option[T]) IsZero() bool {
+	return !o.Valid
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(&o.Value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Option[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
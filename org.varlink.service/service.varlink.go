@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinkrt"
 
 	"snai.pe/go-varlink/syntax"
 )
@@ -18,6 +19,7 @@ import (
 var _ = fmt.Errorf
 var _ = json.RawMessage(nil)
 var _ = context.Background
+var _ = varlinkrt.Validate
 
 type Error = varlink.Error
 
@@ -229,56 +231,19 @@ type Client struct {
 func ErrorFromCode(code string, params json.RawMessage) Error {
 	switch code {
 	case `org.varlink.service.InterfaceNotFound`:
-		var err_ InterfaceNotFoundError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.InterfaceNotFound params is invalid json: ` + err2_.Error())
-		}
-		return err_
-
+		return varlinkrt.UnmarshalError[InterfaceNotFoundError](code, params)
 	case `org.varlink.service.MethodNotFound`:
-		var err_ MethodNotFoundError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.MethodNotFound params is invalid json: ` + err2_.Error())
-		}
-		return err_
-
+		return varlinkrt.UnmarshalError[MethodNotFoundError](code, params)
 	case `org.varlink.service.MethodNotImplemented`:
-		var err_ MethodNotImplementedError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.MethodNotImplemented params is invalid json: ` + err2_.Error())
-		}
-		return err_
-
+		return varlinkrt.UnmarshalError[MethodNotImplementedError](code, params)
 	case `org.varlink.service.InvalidParameter`:
-		var err_ InvalidParameterError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.InvalidParameter params is invalid json: ` + err2_.Error())
-		}
-		return err_
-
+		return varlinkrt.UnmarshalError[InvalidParameterError](code, params)
 	case `org.varlink.service.PermissionDenied`:
-		var err_ PermissionDeniedError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.PermissionDenied params is invalid json: ` + err2_.Error())
-		}
-		return err_
-
+		return varlinkrt.UnmarshalError[PermissionDeniedError](code, params)
 	case `org.varlink.service.ExpectedMore`:
-		var err_ ExpectedMoreError
-		if err2_ := json.Unmarshal([]byte(params), &err_); err2_ != nil {
-			panic(`programming error: org.varlink.service.ExpectedMore params is invalid json: ` + err2_.Error())
-		}
-		return err_
+		return varlinkrt.UnmarshalError[ExpectedMoreError](code, params)
 	default:
-		var kvargs []any
-		var pmap map[string]any
-		if err2_ := json.Unmarshal([]byte(params), &pmap); err2_ != nil {
-			panic(`programming error: ` + code + ` params is invalid json: ` + err2_.Error())
-		}
-		for k, v := range pmap {
-			kvargs = append(kvargs, k, v)
-		}
-		return varlink.NewError(code, kvargs...)
+		return varlinkrt.GenericError(code, params)
 	}
 }
 
@@ -290,30 +255,8 @@ func (client_ *Client) GetInfo(ctx context.Context) (vendor string, product stri
 		output_ GetInfoOutput
 	)
 
-	rs, err := client_.Call(ctx, `org.varlink.service.GetInfo`, &input_)
-	if err != nil {
-		err_ = err
-		return
-	}
-
-	for rs.Next() {
-		r := rs.Reply()
-		if r.Error != "" {
-			err_ = ErrorFromCode(r.Error, r.Parameters)
-			return
-		}
-		if r.Continues {
-			err_ = fmt.Errorf("more than one reply on single-reply call")
-			return
-		}
-
-		if err := rs.Unmarshal(&output_); err != nil {
-			err_ = err
-			return
-		}
-	}
-	if err := rs.Error(); err != nil {
-		err_ = err
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `org.varlink.service.GetInfo`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
 		return
 	}
 
@@ -330,30 +273,8 @@ func (client_ *Client) GetInterfaceDescription(ctx context.Context, interface_ s
 
 	input_.Pack(interface_)
 
-	rs, err := client_.Call(ctx, `org.varlink.service.GetInterfaceDescription`, &input_)
-	if err != nil {
-		err_ = err
-		return
-	}
-
-	for rs.Next() {
-		r := rs.Reply()
-		if r.Error != "" {
-			err_ = ErrorFromCode(r.Error, r.Parameters)
-			return
-		}
-		if r.Continues {
-			err_ = fmt.Errorf("more than one reply on single-reply call")
-			return
-		}
-
-		if err := rs.Unmarshal(&output_); err != nil {
-			err_ = err
-			return
-		}
-	}
-	if err := rs.Error(); err != nil {
-		err_ = err
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `org.varlink.service.GetInterfaceDescription`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
 		return
 	}
 
@@ -383,68 +304,220 @@ func NewHandler(s Service) varlink.MethodHandler {
 // RegisterHandlers registers all of the method handlers for the specified
 // service implementation into the passed ServeMux.
 func RegisterHandlers(mux *varlink.ServeMux, s Service) {
-	mux.HandleFunc("org.varlink.service.GetInfo", func(w varlink.ReplyWriter, call *varlink.Call) {
-		var (
-			input  GetInfoInput
-			output GetInfoOutput
-		)
+	mux.HandleMethod(GetInfoHandler(s.GetInfo))
+	mux.HandleMethod(GetInterfaceDescriptionHandler(s.GetInterfaceDescription))
+}
+
+// TypedService is implemented by implementations of the org.varlink.service
+// varlink interface that take and return the input and output structs of
+// its methods. Methods replying more than once, which are marked with
+// @more or @streamable, send their replies with send.
+type TypedService interface {
+
+	// Get a list of all the interfaces a service provides and information
+	// about the implementation.
+	GetInfo(ctx context.Context, input *GetInfoInput) (*GetInfoOutput, error)
+
+	// Get the description of an interface that is implemented by this service.
+	GetInterfaceDescription(ctx context.Context, input *GetInterfaceDescriptionInput) (*GetInterfaceDescriptionOutput, error)
+}
+
+// NewDispatcher returns a handler routing the calls to the methods of the
+// org.varlink.service interface to impl, after decoding and validating
+// their input. Errors returned by impl are converted with
+// varlink.ConvertError, and calls to other methods are replied with
+// org.varlink.service.MethodNotFound. To also serve the introspection
+// methods, register the dispatcher with varlink.ServeMux.Handle, with the
+// pattern `org.varlink.service.*`.
+func NewDispatcher(impl TypedService) varlink.MethodHandler {
+	return dispatcher{impl}
+}
+
+type dispatcher struct {
+	impl TypedService
+}
 
-		if err := call.Unmarshal(&input); err != nil {
+func (d_ dispatcher) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	switch call.Method {
+	case `org.varlink.service.GetInfo`:
+		var input_ GetInfoInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
 			w.WriteError(err)
 			return
 		}
-
-		validate := func() Error {
-
-			return nil
+		output_, err := d_.impl.GetInfo(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(GetInfoOutput)
 		}
-		if err := validate(); err != nil {
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `org.varlink.service.GetInterfaceDescription`:
+		var input_ GetInterfaceDescriptionInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
 			w.WriteError(err)
 			return
 		}
-
-		var err Error
-		output.Vendor, output.Product, output.Version, output.Url, output.Interfaces, err = s.GetInfo(w.Context())
+		output_, err := d_.impl.GetInterfaceDescription(w.Context(), &input_)
 		if err != nil {
-			w.WriteError(err)
+			w.WriteError(varlink.ConvertError(w.Context(), err))
 			return
 		}
+		if output_ == nil {
+			output_ = new(GetInterfaceDescriptionOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	default:
+		w.WriteError(varlinkrt.MethodNotFound(call.Method))
+	}
+}
 
-		w.WriteReply(&output)
+// GetInfoHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetInfo method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetInfoHandler func(ctx context.Context) (vendor string, product string, version string, url string, interfaces []string, err_ Error)
+
+// MethodName returns the fully-qualified name of the GetInfo method.
+func (GetInfoHandler) MethodName() string {
+	return `org.varlink.service.GetInfo`
+}
+
+func (fn GetInfoHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetInfoInput
+		output GetInfoOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Vendor, output.Product, output.Version, output.Url, output.Interfaces, err = fn(w.Context())
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetInfoService is implemented by context-first implementations of
+// the GetInfo method, which send their replies with send.
+type GetInfoService interface {
+	GetInfo(ctx context.Context, send func(vendor string, product string, version string, url string, interfaces []string) error) error
+}
+
+// NewGetInfoHandler returns a handler of the GetInfo method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetInfoHandler(impl GetInfoService) varlink.Method {
+	return getInfoAdapter{impl}
+}
+
+type getInfoAdapter struct {
+	impl GetInfoService
+}
+
+func (getInfoAdapter) MethodName() string {
+	return `org.varlink.service.GetInfo`
+}
+
+func (a_ getInfoAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetInfoInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetInfoOutput) error) error {
+		return a_.impl.GetInfo(ctx_, func(vendor string, product string, version string, url string, interfaces []string) error {
+			var output_ GetInfoOutput
+			output_.Pack(vendor, product, version, url, interfaces)
+			return send_(&output_)
+		})
 	})
-	mux.HandleFunc("org.varlink.service.GetInterfaceDescription", func(w varlink.ReplyWriter, call *varlink.Call) {
-		var (
-			input  GetInterfaceDescriptionInput
-			output GetInterfaceDescriptionOutput
-		)
+}
 
-		if err := call.Unmarshal(&input); err != nil {
-			w.WriteError(err)
-			return
-		}
+// GetInterfaceDescriptionHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetInterfaceDescription method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetInterfaceDescriptionHandler func(ctx context.Context, interface_ string) (description string, err_ Error)
 
-		validate := func() Error {
+// MethodName returns the fully-qualified name of the GetInterfaceDescription method.
+func (GetInterfaceDescriptionHandler) MethodName() string {
+	return `org.varlink.service.GetInterfaceDescription`
+}
 
-			return nil
-		}
-		if err := validate(); err != nil {
-			w.WriteError(err)
-			return
-		}
+func (fn GetInterfaceDescriptionHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetInterfaceDescriptionInput
+		output GetInterfaceDescriptionOutput
+	)
 
-		var err Error
-		output.Description, err = s.GetInterfaceDescription(w.Context(), input.Interface)
-		if err != nil {
-			w.WriteError(err)
-			return
-		}
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Description, err = fn(w.Context(), input.Interface)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetInterfaceDescriptionService is implemented by context-first implementations of
+// the GetInterfaceDescription method, which send their replies with send.
+type GetInterfaceDescriptionService interface {
+	GetInterfaceDescription(ctx context.Context, interface_ string, send func(description string) error) error
+}
 
-		w.WriteReply(&output)
+// NewGetInterfaceDescriptionHandler returns a handler of the GetInterfaceDescription method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetInterfaceDescriptionHandler(impl GetInterfaceDescriptionService) varlink.Method {
+	return getInterfaceDescriptionAdapter{impl}
+}
+
+type getInterfaceDescriptionAdapter struct {
+	impl GetInterfaceDescriptionService
+}
+
+func (getInterfaceDescriptionAdapter) MethodName() string {
+	return `org.varlink.service.GetInterfaceDescription`
+}
+
+func (a_ getInterfaceDescriptionAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetInterfaceDescriptionInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetInterfaceDescriptionOutput) error) error {
+		return a_.impl.GetInterfaceDescription(ctx_, input_.Interface, func(description string) error {
+			var output_ GetInterfaceDescriptionOutput
+			output_.Pack(description)
+			return send_(&output_)
+		})
 	})
 }
 
 // Definition contains the definition of the varlink interface which was parsed from its description.
-var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 3, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The Varlink Service Interface is provided by every varlink service. It\n", Value: "The Varlink Service Interface is provided by every varlink service. It", Start: syntax.Cursor{Line: 1, Column: 1}, End: syntax.Cursor{Line: 1, Column: 73}}, syntax.Token{Type: "<comment>", Raw: "# describes the service and the interfaces it implements.\n", Value: "describes the service and the interfaces it implements.", Start: syntax.Cursor{Line: 2, Column: 1}, End: syntax.Cursor{Line: 2, Column: 58}}}}, Name: "org.varlink.service", Types: []syntax.TypeDef(nil), Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Get a list of all the interfaces a service provides and information\n", Value: "Get a list of all the interfaces a service provides and information", Start: syntax.Cursor{Line: 5, Column: 1}, End: syntax.Cursor{Line: 5, Column: 70}}, syntax.Token{Type: "<comment>", Raw: "# about the implementation.\n", Value: "about the implementation.", Start: syntax.Cursor{Line: 6, Column: 1}, End: syntax.Cursor{Line: 6, Column: 28}}}}, Name: "GetInfo", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 15}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 21}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 3}, Comments: []syntax.Token(nil)}, Name: "vendor", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 11}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 3}, Comments: []syntax.Token(nil)}, Name: "product", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 12}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 3}, Comments: []syntax.Token(nil)}, Name: "version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 12}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 3}, Comments: []syntax.Token(nil)}, Name: "url", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 8}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 3}, Comments: []syntax.Token(nil)}, Name: "interfaces", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 15}, Comments: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 17}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Get the description of an interface that is implemented by this service.\n", Value: "Get the description of an interface that is implemented by this service.", Start: syntax.Cursor{Line: 15, Column: 1}, End: syntax.Cursor{Line: 15, Column: 75}}}}, Name: "GetInterfaceDescription", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 31}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 32}, Comments: []syntax.Token(nil)}, Name: "interface", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 43}, Comments: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 54}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 55}, Comments: []syntax.Token(nil)}, Name: "description", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 68}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}}, Errors: []syntax.ErrorDef{syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The requested interface was not found.\n", Value: "The requested interface was not found.", Start: syntax.Cursor{Line: 18, Column: 1}, End: syntax.Cursor{Line: 18, Column: 41}}}}, Name: "InterfaceNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 25}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 26}, Comments: []syntax.Token(nil)}, Name: "interface", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 37}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The requested method was not found\n", Value: "The requested method was not found", Start: syntax.Cursor{Line: 21, Column: 1}, End: syntax.Cursor{Line: 21, Column: 37}}}}, Name: "MethodNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 22}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 23}, Comments: []syntax.Token(nil)}, Name: "method", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 31}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The interface defines the requested method, but the service does not\n", Value: "The interface defines the requested method, but the service does not", Start: syntax.Cursor{Line: 24, Column: 1}, End: syntax.Cursor{Line: 24, Column: 71}}, syntax.Token{Type: "<comment>", Raw: "# implement it.\n", Value: "implement it.", Start: syntax.Cursor{Line: 25, Column: 1}, End: syntax.Cursor{Line: 25, Column: 16}}}}, Name: "MethodNotImplemented", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 28}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 29}, Comments: []syntax.Token(nil)}, Name: "method", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 37}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# One of the passed parameters is invalid.\n", Value: "One of the passed parameters is invalid.", Start: syntax.Cursor{Line: 28, Column: 1}, End: syntax.Cursor{Line: 28, Column: 43}}}}, Name: "InvalidParameter", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 24}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 25}, Comments: []syntax.Token(nil)}, Name: "parameter", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 36}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Client is denied access\n", Value: "Client is denied access", Start: syntax.Cursor{Line: 31, Column: 1}, End: syntax.Cursor{Line: 31, Column: 26}}}}, Name: "PermissionDenied", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 24}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 35, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Method is expected to be called with 'more' set to true, but wasn't\n", Value: "Method is expected to be called with 'more' set to true, but wasn't", Start: syntax.Cursor{Line: 34, Column: 1}, End: syntax.Cursor{Line: 34, Column: 70}}}}, Name: "ExpectedMore", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 35, Column: 20}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}}}
+var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 3, Column: 1, Offset: 131}, End: syntax.Cursor{Line: 35, Column: 22, Offset: 1055}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The Varlink Service Interface is provided by every varlink service. It\n", Value: "The Varlink Service Interface is provided by every varlink service. It", Start: syntax.Cursor{Line: 1, Column: 1, Offset: 0}, End: syntax.Cursor{Line: 1, Column: 73, Offset: 72}}, syntax.Token{Type: "<comment>", Raw: "# describes the service and the interfaces it implements.\n", Value: "describes the service and the interfaces it implements.", Start: syntax.Cursor{Line: 2, Column: 1, Offset: 73}, End: syntax.Cursor{Line: 2, Column: 58, Offset: 130}}}, Tokens: []syntax.Token(nil)}, Name: "org.varlink.service", Types: []syntax.TypeDef(nil), Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 1, Offset: 260}, End: syntax.Cursor{Line: 13, Column: 2, Offset: 377}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Get a list of all the interfaces a service provides and information\n", Value: "Get a list of all the interfaces a service provides and information", Start: syntax.Cursor{Line: 5, Column: 1, Offset: 162}, End: syntax.Cursor{Line: 5, Column: 70, Offset: 231}}, syntax.Token{Type: "<comment>", Raw: "# about the implementation.\n", Value: "about the implementation.", Start: syntax.Cursor{Line: 6, Column: 1, Offset: 232}, End: syntax.Cursor{Line: 6, Column: 28, Offset: 259}}}, Tokens: []syntax.Token(nil)}, Name: "GetInfo", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 15, Offset: 274}, End: syntax.Cursor{Line: 7, Column: 17, Offset: 276}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 21, Offset: 280}, End: syntax.Cursor{Line: 13, Column: 2, Offset: 377}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 3, Offset: 284}, End: syntax.Cursor{Line: 8, Column: 17, Offset: 298}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "vendor", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 8, Column: 11, Offset: 292}, End: syntax.Cursor{Line: 8, Column: 17, Offset: 298}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 3, Offset: 302}, End: syntax.Cursor{Line: 9, Column: 18, Offset: 317}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "product", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 9, Column: 12, Offset: 311}, End: syntax.Cursor{Line: 9, Column: 18, Offset: 317}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 3, Offset: 321}, End: syntax.Cursor{Line: 10, Column: 18, Offset: 336}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "version", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 12, Offset: 330}, End: syntax.Cursor{Line: 10, Column: 18, Offset: 336}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 3, Offset: 340}, End: syntax.Cursor{Line: 11, Column: 14, Offset: 351}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "url", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 8, Offset: 345}, End: syntax.Cursor{Line: 11, Column: 14, Offset: 351}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 3, Offset: 355}, End: syntax.Cursor{Line: 12, Column: 23, Offset: 375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "interfaces", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 15, Offset: 367}, End: syntax.Cursor{Line: 12, Column: 23, Offset: 375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 17, Offset: 369}, End: syntax.Cursor{Line: 12, Column: 23, Offset: 375}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 1, Offset: 454}, End: syntax.Cursor{Line: 16, Column: 75, Offset: 528}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Get the description of an interface that is implemented by this service.\n", Value: "Get the description of an interface that is implemented by this service.", Start: syntax.Cursor{Line: 15, Column: 1, Offset: 379}, End: syntax.Cursor{Line: 15, Column: 75, Offset: 453}}}, Tokens: []syntax.Token(nil)}, Name: "GetInterfaceDescription", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 31, Offset: 484}, End: syntax.Cursor{Line: 16, Column: 50, Offset: 503}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 32, Offset: 485}, End: syntax.Cursor{Line: 16, Column: 49, Offset: 502}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "interface", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 43, Offset: 496}, End: syntax.Cursor{Line: 16, Column: 49, Offset: 502}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 54, Offset: 507}, End: syntax.Cursor{Line: 16, Column: 75, Offset: 528}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 55, Offset: 508}, End: syntax.Cursor{Line: 16, Column: 74, Offset: 527}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "description", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 16, Column: 68, Offset: 521}, End: syntax.Cursor{Line: 16, Column: 74, Offset: 527}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, Errors: []syntax.ErrorDef{syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 1, Offset: 571}, End: syntax.Cursor{Line: 19, Column: 44, Offset: 614}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The requested interface was not found.\n", Value: "The requested interface was not found.", Start: syntax.Cursor{Line: 18, Column: 1, Offset: 530}, End: syntax.Cursor{Line: 18, Column: 41, Offset: 570}}}, Tokens: []syntax.Token(nil)}, Name: "InterfaceNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 25, Offset: 595}, End: syntax.Cursor{Line: 19, Column: 44, Offset: 614}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 26, Offset: 596}, End: syntax.Cursor{Line: 19, Column: 43, Offset: 613}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "interface", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 37, Offset: 607}, End: syntax.Cursor{Line: 19, Column: 43, Offset: 613}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 1, Offset: 653}, End: syntax.Cursor{Line: 22, Column: 38, Offset: 690}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The requested method was not found\n", Value: "The requested method was not found", Start: syntax.Cursor{Line: 21, Column: 1, Offset: 616}, End: syntax.Cursor{Line: 21, Column: 37, Offset: 652}}}, Tokens: []syntax.Token(nil)}, Name: "MethodNotFound", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 22, Offset: 674}, End: syntax.Cursor{Line: 22, Column: 38, Offset: 690}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 23, Offset: 675}, End: syntax.Cursor{Line: 22, Column: 37, Offset: 689}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "method", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 22, Column: 31, Offset: 683}, End: syntax.Cursor{Line: 22, Column: 37, Offset: 689}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 1, Offset: 779}, End: syntax.Cursor{Line: 26, Column: 44, Offset: 822}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# The interface defines the requested method, but the service does not\n", Value: "The interface defines the requested method, but the service does not", Start: syntax.Cursor{Line: 24, Column: 1, Offset: 692}, End: syntax.Cursor{Line: 24, Column: 71, Offset: 762}}, syntax.Token{Type: "<comment>", Raw: "# implement it.\n", Value: "implement it.", Start: syntax.Cursor{Line: 25, Column: 1, Offset: 763}, End: syntax.Cursor{Line: 25, Column: 16, Offset: 778}}}, Tokens: []syntax.Token(nil)}, Name: "MethodNotImplemented", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 28, Offset: 806}, End: syntax.Cursor{Line: 26, Column: 44, Offset: 822}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 29, Offset: 807}, End: syntax.Cursor{Line: 26, Column: 43, Offset: 821}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "method", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 26, Column: 37, Offset: 815}, End: syntax.Cursor{Line: 26, Column: 43, Offset: 821}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 1, Offset: 867}, End: syntax.Cursor{Line: 29, Column: 43, Offset: 909}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# One of the passed parameters is invalid.\n", Value: "One of the passed parameters is invalid.", Start: syntax.Cursor{Line: 28, Column: 1, Offset: 824}, End: syntax.Cursor{Line: 28, Column: 43, Offset: 866}}}, Tokens: []syntax.Token(nil)}, Name: "InvalidParameter", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 24, Offset: 890}, End: syntax.Cursor{Line: 29, Column: 43, Offset: 909}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 25, Offset: 891}, End: syntax.Cursor{Line: 29, Column: 42, Offset: 908}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "parameter", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 29, Column: 36, Offset: 902}, End: syntax.Cursor{Line: 29, Column: 42, Offset: 908}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 1, Offset: 937}, End: syntax.Cursor{Line: 32, Column: 26, Offset: 962}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Client is denied access\n", Value: "Client is denied access", Start: syntax.Cursor{Line: 31, Column: 1, Offset: 911}, End: syntax.Cursor{Line: 31, Column: 26, Offset: 936}}}, Tokens: []syntax.Token(nil)}, Name: "PermissionDenied", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 32, Column: 24, Offset: 960}, End: syntax.Cursor{Line: 32, Column: 26, Offset: 962}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}, syntax.ErrorDef{Node: syntax.Node{Position: syntax.Cursor{Line: 35, Column: 1, Offset: 1034}, End: syntax.Cursor{Line: 35, Column: 22, Offset: 1055}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Method is expected to be called with 'more' set to true, but wasn't\n", Value: "Method is expected to be called with 'more' set to true, but wasn't", Start: syntax.Cursor{Line: 34, Column: 1, Offset: 964}, End: syntax.Cursor{Line: 34, Column: 70, Offset: 1033}}}, Tokens: []syntax.Token(nil)}, Name: "ExpectedMore", Params: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 35, Column: 20, Offset: 1053}, End: syntax.Cursor{Line: 35, Column: 22, Offset: 1055}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField(nil)}}}}
 
 // Description contains the description of the varlink interface, expressed in the IDL.
 var Description = `# The Varlink Service Interface is provided by every varlink service. It
@@ -483,3 +556,20 @@ error PermissionDenied ()
 # Method is expected to be called with 'more' set to true, but wasn't
 error ExpectedMore ()
 `
+
+// Fully-qualified names of the methods of the interface.
+const (
+	MethodGetInfo                 = `org.varlink.service.GetInfo`
+	MethodGetInterfaceDescription = `org.varlink.service.GetInterfaceDescription`
+)
+
+// Fingerprint is the fingerprint of the varlink interface definition, as
+// computed by syntax.Fingerprint.
+const Fingerprint = `1e81444ec9d18bfe43d8854adc140f2272c38f62a2a992ba842a3a7f4b1ad0b6`
+
+// VerifyAgainst checks that the specified description, typically obtained
+// from a service via org.varlink.service.GetInterfaceDescription, defines
+// the same interface that this code was generated from.
+func VerifyAgainst(description string) error {
+	return syntax.VerifyFingerprint(description, Fingerprint)
+}
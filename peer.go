@@ -0,0 +1,72 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+)
+
+// ErrNoPeerCredentials is returned by Session.PeerCredentials when the
+// credentials of the peer cannot be determined, typically because the
+// session does not run over a unix socket.
+var ErrNoPeerCredentials = errors.New("peer credentials are not available on this session")
+
+// PeerCredentials are the credentials of the process at the other end of a
+// unix socket, as recorded by the kernel when the connection was made.
+type PeerCredentials struct {
+	PID int
+	UID int
+	GID int
+}
+
+// PeerCredentials returns the credentials of the peer of the session.
+//
+// This is only supported for unix sockets on Linux; otherwise,
+// ErrNoPeerCredentials is returned.
+func (session *Session) PeerCredentials() (*PeerCredentials, error) {
+	uc, ok := session.conn.(*UnixConn)
+	if !ok {
+		return nil, ErrNoPeerCredentials
+	}
+	return peerCredentials(uc.conn)
+}
+
+// TLSConnectionState returns the state of the TLS connection of the
+// session, or false if the session does not run over TLS.
+func (session *Session) TLSConnectionState() (tls.ConnectionState, bool) {
+	tc, ok := session.conn.(*tls.Conn)
+	if !ok {
+		return tls.ConnectionState{}, false
+	}
+	return tc.ConnectionState(), true
+}
+
+// RemoteAddr returns the remote network address of the session.
+func (session *Session) RemoteAddr() net.Addr {
+	return session.conn.RemoteAddr()
+}
+
+type sessionKey struct{}
+
+// SessionFromContext returns the session on which the call being served was
+// received, or nil if ctx is not the context of a ReplyWriter.
+func SessionFromContext(ctx context.Context) *Session {
+	session, _ := ctx.Value(sessionKey{}).(*Session)
+	return session
+}
+
+type listenerKey struct{}
+
+// ListenerFromContext returns the listener that accepted the connection on
+// which the call being served was received, or nil if the connection was
+// not accepted by Server.Serve.
+func ListenerFromContext(ctx context.Context) net.Listener {
+	l, _ := ctx.Value(listenerKey{}).(net.Listener)
+	return l
+}
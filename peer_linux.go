@@ -0,0 +1,38 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+func peerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	sysconn, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ucred   *syscall.Ucred
+		sockerr error
+	)
+	err = sysconn.Control(func(fd uintptr) {
+		ucred, sockerr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sockerr != nil {
+		return nil, os.NewSyscallError("getsockopt", sockerr)
+	}
+	return &PeerCredentials{
+		PID: int(ucred.Pid),
+		UID: int(ucred.Uid),
+		GID: int(ucred.Gid),
+	}, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+	"snai.pe/go-varlink/syntax"
+)
+
+// Config configures how a plugin is started.
+type Config struct {
+	// Handler, if set, serves the calls that the plugin makes back to the
+	// host. Otherwise, these calls fail with MethodNotFound.
+	Handler varlink.MethodHandler
+
+	// Interfaces maps the names of the interfaces that the plugin must
+	// implement to their fingerprint, as computed by syntax.Fingerprint
+	// and exported by generated code. An empty fingerprint accepts any
+	// definition of the interface.
+	Interfaces map[string]string
+
+	// CloseTimeout is how long Close waits for the plugin to exit before
+	// killing it. The default is 5 seconds.
+	CloseTimeout time.Duration
+}
+
+// Plugin is a running plugin subprocess.
+type Plugin struct {
+	cmd     *exec.Cmd
+	session *varlink.Session
+	client  varlink.Client
+	timeout time.Duration
+
+	vendor, product, version, url string
+	interfaces                    []string
+
+	cancel    context.CancelFunc
+	exited    chan struct{}
+	err       error
+	closeOnce sync.Once
+}
+
+// Start launches the plugin command, and introspects the interfaces that
+// it implements.
+//
+// The plugin inherits one end of a unix socket pair, whose file descriptor
+// number is passed in the EnvFd environment variable. Its standard input
+// and output are left as set in cmd.
+//
+// If the plugin does not implement all of the interfaces of the
+// configuration, or implements different definitions of them, Start kills
+// the plugin and returns an error.
+func Start(ctx context.Context, cmd *exec.Cmd, config *Config) (*Plugin, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	local, remote, err := socketpair()
+	if err != nil {
+		return nil, err
+	}
+	defer remote.Close()
+
+	conn, err := net.FileConn(local)
+	local.Close()
+	if err != nil {
+		return nil, err
+	}
+	session := varlink.NewSession(conn)
+
+	cmd.ExtraFiles = append(cmd.ExtraFiles, remote)
+	fd := 3 + len(cmd.ExtraFiles) - 1
+	cmd.Env = append(cmd.Environ(), EnvFd+"="+strconv.Itoa(fd))
+
+	if err := cmd.Start(); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	servectx, cancel := context.WithCancel(context.Background())
+	p := &Plugin{
+		cmd:     cmd,
+		session: session,
+		client:  varlink.Client{Transport: sessionTransport{session}},
+		timeout: config.CloseTimeout,
+		cancel:  cancel,
+		exited:  make(chan struct{}),
+	}
+	if p.timeout <= 0 {
+		p.timeout = 5 * time.Second
+	}
+
+	go func() {
+		p.err = cmd.Wait()
+		close(p.exited)
+	}()
+
+	server := varlink.Server{Handler: config.Handler}
+	go server.ServeSession(servectx, session)
+
+	if err := p.introspect(ctx, config.Interfaces); err != nil {
+		p.Kill()
+		return nil, fmt.Errorf("plugin %s: %w", cmd.Path, err)
+	}
+	return p, nil
+}
+
+func socketpair() (local, remote *os.File, err error) {
+	syscall.ForkLock.RLock()
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err == nil {
+		syscall.CloseOnExec(fds[0])
+		syscall.CloseOnExec(fds[1])
+	}
+	syscall.ForkLock.RUnlock()
+	if err != nil {
+		return nil, nil, os.NewSyscallError("socketpair", err)
+	}
+	return os.NewFile(uintptr(fds[0]), "plugin"), os.NewFile(uintptr(fds[1]), "host"), nil
+}
+
+func (p *Plugin) introspect(ctx context.Context, required map[string]string) error {
+	client := service.Client{Client: p.client}
+
+	var err error
+	p.vendor, p.product, p.version, p.url, p.interfaces, err = client.GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	for intf, fingerprint := range required {
+		if !slices.Contains(p.interfaces, intf) {
+			return fmt.Errorf("interface %s is not implemented", intf)
+		}
+		if fingerprint == "" {
+			continue
+		}
+		desc, err := client.Description(ctx, intf)
+		if err != nil {
+			return fmt.Errorf("interface %s: %w", intf, err)
+		}
+		if err := syntax.VerifyFingerprint(desc, fingerprint); err != nil {
+			return fmt.Errorf("interface %s: %w", intf, err)
+		}
+	}
+	return nil
+}
+
+// Client returns a client that makes calls to the plugin.
+func (p *Plugin) Client() *varlink.Client {
+	return &p.client
+}
+
+// Session returns the session with the plugin.
+func (p *Plugin) Session() *varlink.Session {
+	return p.session
+}
+
+// Interfaces returns the names of the interfaces that the plugin implements.
+func (p *Plugin) Interfaces() []string {
+	return slices.Clone(p.interfaces)
+}
+
+// Info returns the information that the plugin reported about itself.
+func (p *Plugin) Info() (vendor, product, version, url string) {
+	return p.vendor, p.product, p.version, p.url
+}
+
+// Describe returns the description of the specified interface implemented by
+// the plugin.
+func (p *Plugin) Describe(ctx context.Context, intf string) (syntax.InterfaceDef, error) {
+	client := service.Client{Client: p.client}
+
+	desc, err := client.Description(ctx, intf)
+	if err != nil {
+		return syntax.InterfaceDef{}, err
+	}
+	parser := syntax.NewParser(strings.NewReader(desc))
+	parser.Limits = syntax.UntrustedLimits
+	return parser.Parse()
+}
+
+// Exited returns a channel that is closed once the plugin process exits.
+func (p *Plugin) Exited() <-chan struct{} {
+	return p.exited
+}
+
+// Close closes the session with the plugin, which makes Serve return on
+// the plugin side, and waits for the plugin to exit. The plugin is killed if
+// it does not exit within the close timeout of the configuration.
+//
+// Close returns the error of the plugin process, if any, as reported by
+// exec.Cmd.Wait.
+func (p *Plugin) Close() error {
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.session.Close()
+	})
+
+	select {
+	case <-p.exited:
+	case <-time.After(p.timeout):
+		p.cmd.Process.Kill()
+		<-p.exited
+	}
+	return p.err
+}
+
+// Kill kills the plugin process, and closes the session with it.
+func (p *Plugin) Kill() error {
+	err := p.cmd.Process.Kill()
+	if errors.Is(err, os.ErrProcessDone) {
+		err = nil
+	}
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.session.Close()
+	})
+	<-p.exited
+	return err
+}
+
+// sessionTransport is a RoundTripper that makes all calls on a single
+// session.
+type sessionTransport struct {
+	session *varlink.Session
+}
+
+func (t sessionTransport) RoundTrip(ctx context.Context, session *varlink.Session, call *varlink.Call) (*varlink.ReplyStream, error) {
+	if session == nil {
+		session = t.session
+	}
+	if err := session.WriteCall(ctx, call); err != nil {
+		return nil, err
+	}
+	return varlink.NewReplyStream(ctx, call, session), nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package plugin implements plugins as subprocesses speaking varlink.
+//
+// The host launches a plugin with Start, which hands the plugin one end of
+// a unix socket pair, and introspects the interfaces the plugin implements.
+// The host then calls the plugin through the client returned by
+// Plugin.Client, typically wrapped in a generated client:
+//
+//	p, err := plugin.Start(ctx, exec.Command("./greeter"), &plugin.Config{
+//		Interfaces: map[string]string{
+//			greeter.InterfaceName: greeter.Fingerprint,
+//		},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer p.Close()
+//
+//	client := greeter.Client{Client: *p.Client()}
+//	greeting, err := client.Greet(ctx, "world")
+//
+// On the other side, the plugin serves its method handler with Serve:
+//
+//	func main() {
+//		var mux varlink.ServeMux
+//		greeter.RegisterHandlers(&mux, &impl{})
+//		mux.SetDescription(greeter.InterfaceName, greeter.Description)
+//
+//		if err := plugin.Serve(context.Background(), &mux); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Since the session runs over a unix socket, file descriptors can be passed
+// in both directions, and plugins can call back into the host from their
+// method handlers with ReplyWriter.Call.
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"snai.pe/go-varlink"
+)
+
+// EnvFd is the environment variable through which the host tells the plugin
+// the file descriptor number of its end of the socket pair.
+const EnvFd = "VARLINK_PLUGIN_FD"
+
+// ErrNotPlugin is returned by Serve when the process was not launched by a
+// plugin host.
+var ErrNotPlugin = errors.New("this program is a plugin, and must be launched by its host")
+
+// IsPlugin returns whether the process was launched by a plugin host.
+func IsPlugin() bool {
+	_, ok := os.LookupEnv(EnvFd)
+	return ok
+}
+
+// Serve serves the specified method handler to the host of the plugin.
+//
+// Serve returns once the host closes the plugin, or ctx becomes done. It
+// returns ErrNotPlugin if the process was not launched by a plugin host.
+func Serve(ctx context.Context, handler varlink.MethodHandler) error {
+	fd, ok := os.LookupEnv(EnvFd)
+	if !ok {
+		return ErrNotPlugin
+	}
+	os.Unsetenv(EnvFd)
+
+	session, err := varlink.Dial(ctx, "fd:"+fd)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	server := varlink.Server{Handler: handler}
+	server.ServeSession(ctx, session)
+	return nil
+}
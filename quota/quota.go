@@ -0,0 +1,245 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package quota implements per-peer quotas for varlink servers.
+//
+// An Accountant tracks the calls made by each peer, and the size of their
+// parameters, within a sliding window, and rejects the calls exceeding the
+// quota of the peer with a snai.pe.varlink.Busy error. It is installed as
+// an interceptor of the server:
+//
+//	accountant := quota.Accountant{
+//		Window:  time.Minute,
+//		Default: quota.Limits{Calls: 600, Bytes: 16 << 20},
+//	}
+//
+//	server := varlink.Server{
+//		Handler:      &mux,
+//		Interceptors: []varlink.Interceptor{accountant.Intercept},
+//		StatsHook:    func(stats varlink.CallStats) { ... },
+//	}
+//
+// If the server has a StatsHook, the identity of the peer and its usage are
+// reported in the annotations of the statistics of each call, under the
+// keys of the Annotation constants.
+package quota
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// Keys of the annotations of the call statistics.
+const (
+	AnnotationIdentity = "quota.identity" // string
+	AnnotationCalls    = "quota.calls"    // int64, calls in the window
+	AnnotationBytes    = "quota.bytes"    // int64, bytes in the window
+	AnnotationRejected = "quota.rejected" // bool
+)
+
+// DefaultWindow is the default length of the sliding window.
+const DefaultWindow = time.Minute
+
+// Limits are the quotas of a peer within the window. Zero values mean no
+// limit.
+type Limits struct {
+	// Calls is the maximum number of calls.
+	Calls int64
+
+	// Bytes is the maximum total size of the parameters of the calls.
+	Bytes int64
+}
+
+// Usage is the usage of a peer within the window.
+type Usage struct {
+	Calls int64
+	Bytes int64
+}
+
+// Accountant tracks the usage of peers, and enforces their quotas.
+//
+// Usage is tracked with a sliding window counter: the usage of the previous
+// window is weighted by how much of it still overlaps the sliding window,
+// which approximates an exact sliding window without recording every call.
+type Accountant struct {
+	// Window is the length of the sliding window. If zero, DefaultWindow
+	// is used.
+	Window time.Duration
+
+	// Default are the limits of peers that have no entry in Limits.
+	Default Limits
+
+	// Limits are the limits of specific peers, by identity.
+	Limits map[string]Limits
+
+	// Identify, if set, returns the identity of the peer making the call
+	// served with ctx. It defaults to PeerIdentity.
+	Identify func(ctx context.Context, call *varlink.Call) string
+
+	// Now, if set, returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	mu    sync.Mutex
+	peers map[string]*counter
+}
+
+// counter counts the usage of a peer in the current and previous windows.
+type counter struct {
+	start     time.Time
+	cur, prev Usage
+}
+
+// PeerIdentity returns the identity of the peer of the session on which the
+// call served with ctx was received:
+//
+//   - "tls:<subject>" for TLS sessions with a client certificate,
+//   - "uid:<uid>" for unix sockets, on systems reporting peer credentials,
+//   - "addr:<host>" for other network connections,
+//
+// or an empty string if the peer cannot be identified.
+func PeerIdentity(ctx context.Context, call *varlink.Call) string {
+	session := varlink.SessionFromContext(ctx)
+	if session == nil {
+		return ""
+	}
+	if state, ok := session.TLSConnectionState(); ok && len(state.PeerCertificates) > 0 {
+		return "tls:" + state.PeerCertificates[0].Subject.String()
+	}
+	if creds, err := session.PeerCredentials(); err == nil {
+		return "uid:" + strconv.Itoa(creds.UID)
+	}
+	if addr := session.RemoteAddr(); addr != nil && addr.String() != "" {
+		host := addr.String()
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		return "addr:" + host
+	}
+	return ""
+}
+
+func (a *Accountant) window() time.Duration {
+	if a.Window <= 0 {
+		return DefaultWindow
+	}
+	return a.Window
+}
+
+func (a *Accountant) now() time.Time {
+	if a.Now != nil {
+		return a.Now()
+	}
+	return time.Now()
+}
+
+func (a *Accountant) limits(identity string) Limits {
+	if l, ok := a.Limits[identity]; ok {
+		return l
+	}
+	return a.Default
+}
+
+// rotate advances the windows of the counter to the one containing now, and
+// returns the weighted usage of the sliding window ending at now.
+func (c *counter) rotate(now time.Time, window time.Duration) Usage {
+	switch elapsed := now.Sub(c.start); {
+	case elapsed >= 2*window:
+		c.start, c.prev, c.cur = now, Usage{}, Usage{}
+	case elapsed >= window:
+		c.start, c.prev, c.cur = c.start.Add(window), c.cur, Usage{}
+	}
+
+	weight := 1 - float64(now.Sub(c.start))/float64(window)
+	return Usage{
+		Calls: c.cur.Calls + int64(float64(c.prev.Calls)*weight),
+		Bytes: c.cur.Bytes + int64(float64(c.prev.Bytes)*weight),
+	}
+}
+
+// Usage returns the usage of the peer with the specified identity within
+// the sliding window ending now.
+func (a *Accountant) Usage(identity string) Usage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	c, ok := a.peers[identity]
+	if !ok {
+		return Usage{}
+	}
+	return c.rotate(a.now(), a.window())
+}
+
+// Account records a call of the specified size made by the peer with the
+// specified identity, if it does not exceed its quota. It returns the
+// usage of the peer including the call, and whether the call was allowed.
+// If not, retryAfter estimates when the call would be allowed.
+func (a *Accountant) Account(identity string, size int64) (usage Usage, allowed bool, retryAfter time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now, window := a.now(), a.window()
+	if a.peers == nil {
+		a.peers = make(map[string]*counter)
+	}
+	c, ok := a.peers[identity]
+	if !ok {
+		c = &counter{start: now}
+		a.peers[identity] = c
+	}
+	a.prune(now, window)
+
+	usage = c.rotate(now, window)
+	limits := a.limits(identity)
+	if (limits.Calls > 0 && usage.Calls+1 > limits.Calls) || (limits.Bytes > 0 && usage.Bytes+size > limits.Bytes) {
+		return usage, false, c.start.Add(window).Sub(now)
+	}
+
+	c.cur.Calls++
+	c.cur.Bytes += size
+	usage.Calls++
+	usage.Bytes += size
+	return usage, true, 0
+}
+
+// prune forgets the peers that made no call in the last two windows.
+func (a *Accountant) prune(now time.Time, window time.Duration) {
+	for identity, c := range a.peers {
+		if now.Sub(c.start) >= 2*window {
+			delete(a.peers, identity)
+		}
+	}
+}
+
+// Intercept is a varlink.Interceptor that enforces the quotas. Calls
+// exceeding the quota of their peer fail with snai.pe.varlink.Busy.
+func (a *Accountant) Intercept(next varlink.MethodHandler) varlink.MethodHandler {
+	return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		ctx := w.Context()
+
+		identify := a.Identify
+		if identify == nil {
+			identify = PeerIdentity
+		}
+		identity := identify(ctx, call)
+
+		usage, allowed, retryAfter := a.Account(identity, int64(len(call.Parameters)))
+
+		varlink.AnnotateCall(ctx, AnnotationIdentity, identity)
+		varlink.AnnotateCall(ctx, AnnotationCalls, usage.Calls)
+		varlink.AnnotateCall(ctx, AnnotationBytes, usage.Bytes)
+		varlink.AnnotateCall(ctx, AnnotationRejected, !allowed)
+
+		if !allowed {
+			w.WriteError(varlink.BusyError(retryAfter))
+			return
+		}
+		next.ServeMethod(w, call)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package quota_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/quota"
+)
+
+func TestAccount(t *testing.T) {
+	now := time.Unix(0, 0)
+	accountant := quota.Accountant{
+		Window:  time.Minute,
+		Default: quota.Limits{Calls: 10},
+		Limits:  map[string]quota.Limits{"big": {Calls: 100, Bytes: 1000}},
+		Now:     func() time.Time { return now },
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, ok, _ := accountant.Account("small", 0); !ok {
+			t.Fatalf("call %d rejected", i)
+		}
+	}
+	if _, ok, retry := accountant.Account("small", 0); ok || retry != time.Minute {
+		t.Fatalf("call over quota: allowed %v, retry after %v", ok, retry)
+	}
+	if _, ok, _ := accountant.Account("big", 600); !ok {
+		t.Fatal("call of other peer rejected")
+	}
+	if _, ok, _ := accountant.Account("big", 600); ok {
+		t.Fatal("call over byte quota allowed")
+	}
+
+	// Halfway through the next window, half of the previous window still
+	// counts.
+	now = now.Add(90 * time.Second)
+	if usage := accountant.Usage("small"); usage.Calls != 5 {
+		t.Fatalf("usage is %d calls, expected 5", usage.Calls)
+	}
+	for i := 0; i < 5; i++ {
+		if _, ok, _ := accountant.Account("small", 0); !ok {
+			t.Fatalf("call %d rejected after sliding", i)
+		}
+	}
+	if _, ok, _ := accountant.Account("small", 0); ok {
+		t.Fatal("call over quota allowed after sliding")
+	}
+
+	now = now.Add(time.Hour)
+	if usage := accountant.Usage("small"); usage != (quota.Usage{}) {
+		t.Fatalf("usage is %+v after the window expired", usage)
+	}
+}
+
+func TestIntercept(t *testing.T) {
+	accountant := quota.Accountant{
+		Default: quota.Limits{Calls: 1},
+		Identify: func(ctx context.Context, call *varlink.Call) string {
+			return "peer"
+		},
+	}
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	stats := make(chan varlink.CallStats, 2)
+	server := varlink.Server{
+		Handler:      &mux,
+		Interceptors: []varlink.Interceptor{accountant.Intercept},
+		StatsHook:    func(s varlink.CallStats) { stats <- s },
+	}
+	go server.Serve(l)
+
+	uri := varlink.CallURI("unix:" + l.Addr().String())
+	for _, expected := range []string{"", varlink.ErrorBusy} {
+		rs, err := varlink.DoCallContext(context.Background(), "org.example.Ping", nil, uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs.Next()
+
+		var code string
+		var verr varlink.Error
+		if errors.As(rs.Error(), &verr) {
+			code = verr.ErrorCode()
+		} else if err := rs.Error(); err != nil {
+			t.Fatal(err)
+		}
+		if code != expected {
+			t.Errorf("got error %q, expected %q", code, expected)
+		}
+
+		s := <-stats
+		if s.Annotations[quota.AnnotationIdentity] != "peer" || s.Annotations[quota.AnnotationRejected] != (expected != "") {
+			t.Errorf("unexpected annotations %v", s.Annotations)
+		}
+	}
+}
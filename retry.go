@@ -0,0 +1,89 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"time"
+)
+
+// RetryPolicy controls how a Transport retries idempotent calls, i.e. calls
+// made with the Idempotent option, which generated clients set on the
+// methods annotated with `@idempotent`.
+//
+// A call is retried when it could not be made: its session could not be
+// opened, the call could not be written, or the session failed before the
+// first reply was read, which typically happens when a kept session was
+// closed by the service. Error replies are never retried, and neither are
+// calls made on a specific session, or with the upgrade flag.
+//
+// Idempotent calls that expect a reply are returned once their first reply
+// was read.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a call is made, including
+	// the first one. The default is 3.
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, which doubles after
+	// each retry. The default is 100ms.
+	Backoff time.Duration
+
+	// MaxBackoff caps the delay between retries. The default is 5s.
+	MaxBackoff time.Duration
+}
+
+// roundTripRetry makes an idempotent call to the URI, retrying it as set
+// by the retry policy of the transport.
+func (ts *Transport) roundTripRetry(ctx context.Context, uri URI, call *Call) (*ReplyStream, error) {
+	var (
+		attempts   = cmp.Or(ts.Retry.MaxAttempts, 3)
+		backoff    = cmp.Or(ts.Retry.Backoff, 100*time.Millisecond)
+		maxBackoff = cmp.Or(ts.Retry.MaxBackoff, 5*time.Second)
+		clock      = cmp.Or(ts.Clock, SystemClock)
+	)
+	for attempt := 1; ; attempt++ {
+		rs, err := ts.attempt(ctx, uri, call)
+		if err == nil || attempt >= attempts || ctx.Err() != nil || errors.Is(err, ErrTransportClosed) {
+			return rs, err
+		}
+
+		done := make(chan struct{})
+		timer := clock.AfterFunc(backoff, func() { close(done) })
+		select {
+		case <-done:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// attempt makes a call once, and reads its first reply. Sessions that fail
+// are closed rather than kept.
+func (ts *Transport) attempt(ctx context.Context, uri URI, call *Call) (*ReplyStream, error) {
+	session, err := ts.takeSession(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.WriteCall(ctx, call); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	rs := NewReplyStream(ctx, call, session)
+	if !call.OneWay {
+		if !rs.Next() {
+			session.Close()
+			return nil, rs.Error()
+		}
+		rs.primed = true
+	}
+	ts.giveSession(uri, session)
+	return rs, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// dialDevice opens a session over a character device.
+//
+// With the char scheme, the device is used as-is; this is typically used
+// with virtio consoles (e.g. /dev/virtio-ports/<name>), which are not
+// terminals. With the serial scheme, the device must be a terminal, and is
+// switched to raw mode; its speed can be set with the "baud" property of
+// the URI, as in serial:/dev/ttyS0;baud=115200, and is otherwise left
+// untouched.
+//
+// The device is never made the controlling terminal of the process.
+//
+// Character devices are point-to-point links, so there is no listening
+// counterpart: the side acting as the server dials the device as well,
+// and serves the session with Server.ServeSession.
+func (d *Dialer) dialDevice(ctx context.Context, u URI) (net.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if u.Address == "" {
+		return nil, fmt.Errorf("dial %v: missing device path", u)
+	}
+
+	file, err := openDevice(u.Address, u.Scheme == "serial")
+	if err != nil {
+		return nil, fmt.Errorf("dial %v: %w", u, err)
+	}
+	if baud, ok := u.Property("baud"); ok {
+		if u.Scheme != "serial" {
+			file.Close()
+			return nil, fmt.Errorf("dial %v: the baud property is only supported with the serial scheme", u)
+		}
+		if err := setBaudRate(file, baud); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("dial %v: %w", u, err)
+		}
+	}
+
+	return &fileConn{
+		File: file,
+		addr: connAddr{network: u.Scheme, address: u.Address},
+	}, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+	"unsafe"
+)
+
+var baudRates = map[int]uint32{
+	50:      syscall.B50,
+	75:      syscall.B75,
+	110:     syscall.B110,
+	134:     syscall.B134,
+	150:     syscall.B150,
+	200:     syscall.B200,
+	300:     syscall.B300,
+	600:     syscall.B600,
+	1200:    syscall.B1200,
+	1800:    syscall.B1800,
+	2400:    syscall.B2400,
+	4800:    syscall.B4800,
+	9600:    syscall.B9600,
+	19200:   syscall.B19200,
+	38400:   syscall.B38400,
+	57600:   syscall.B57600,
+	115200:  syscall.B115200,
+	230400:  syscall.B230400,
+	460800:  syscall.B460800,
+	500000:  syscall.B500000,
+	576000:  syscall.B576000,
+	921600:  syscall.B921600,
+	1000000: syscall.B1000000,
+	1152000: syscall.B1152000,
+	1500000: syscall.B1500000,
+	2000000: syscall.B2000000,
+	2500000: syscall.B2500000,
+	3000000: syscall.B3000000,
+	3500000: syscall.B3500000,
+	4000000: syscall.B4000000,
+}
+
+// openDevice opens a character device for reading and writing, and switches
+// it to raw mode if it is a terminal.
+func openDevice(path string, terminal bool) (*os.File, error) {
+	// The device is opened in non-blocking mode so that the runtime poller
+	// is used, which makes read deadlines work.
+	file, err := os.OpenFile(path, os.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !terminal {
+		return file, nil
+	}
+
+	err = updateTermios(file, func(t *syscall.Termios) {
+		// Equivalent to cfmakeraw(3).
+		t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+			syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+		t.Oflag &^= syscall.OPOST
+		t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+		t.Cflag &^= syscall.CSIZE | syscall.PARENB
+		t.Cflag |= syscall.CS8 | syscall.CREAD | syscall.CLOCAL
+		t.Cc[syscall.VMIN] = 1
+		t.Cc[syscall.VTIME] = 0
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// setBaudRate sets the input and output speed of a terminal.
+func setBaudRate(file *os.File, baud string) error {
+	n, err := strconv.Atoi(baud)
+	if err != nil {
+		return fmt.Errorf("invalid baud rate %q", baud)
+	}
+	speed, ok := baudRates[n]
+	if !ok {
+		return fmt.Errorf("unsupported baud rate %d", n)
+	}
+
+	// The syscall package does not define CBAUD, whose value depends on the
+	// architecture, but all speeds fit within it.
+	var cbaud uint32
+	for _, speed := range baudRates {
+		cbaud |= speed
+	}
+
+	return updateTermios(file, func(t *syscall.Termios) {
+		t.Cflag &^= cbaud
+		t.Cflag |= speed
+		t.Ispeed = speed
+		t.Ospeed = speed
+	})
+}
+
+func updateTermios(file *os.File, update func(*syscall.Termios)) error {
+	conn, err := file.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	var errno syscall.Errno
+	err = conn.Control(func(fd uintptr) {
+		var t syscall.Termios
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TCGETS, uintptr(unsafe.Pointer(&t)))
+		if errno != 0 {
+			return
+		}
+		update(&t)
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TCSETS, uintptr(unsafe.Pointer(&t)))
+	})
+	if err != nil {
+		return err
+	}
+	if errno != 0 {
+		return os.NewSyscallError("ioctl", errno)
+	}
+	return nil
+}
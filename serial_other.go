@@ -0,0 +1,21 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build !linux
+
+package varlink
+
+import (
+	"errors"
+	"os"
+)
+
+func openDevice(path string, terminal bool) (*os.File, error) {
+	return nil, errors.New("character devices are not supported on this platform")
+}
+
+func setBaudRate(file *os.File, baud string) error {
+	return errors.New("setting the baud rate is not supported on this platform")
+}
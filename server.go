@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"snai.pe/go-varlink/internal/service"
 )
@@ -39,6 +40,21 @@ type ReplyWriter interface {
 	// back to the client.
 	WriteReply(parameters any, opts ...ReplyOption) error
 
+	// WriteMore writes an intermediate reply back to the client, if the
+	// call requested multiple replies with the `more` flag. Otherwise, the
+	// reply is written as the final reply.
+	//
+	// WriteMore returns ErrReplied if a final reply was already written,
+	// which lets streaming handlers stop as soon as the client doesn't
+	// expect any more replies.
+	WriteMore(parameters any, opts ...ReplyOption) error
+
+	// WriteFinal writes the final reply back to the client. Any Continues
+	// option is ignored.
+	//
+	// WriteFinal returns ErrReplied if a final reply was already written.
+	WriteFinal(parameters any, opts ...ReplyOption) error
+
 	// Call makes a method call back to the client, and returns the stream of
 	// replies.
 	Call(method string, params any, opts ...CallOption) (*ReplyStream, error)
@@ -46,11 +62,41 @@ type ReplyWriter interface {
 
 type replyWriter struct {
 	session   *Session
+	call      *Call
 	ctx       context.Context
 	cancel    context.CancelCauseFunc
 	transport RoundTripper
 	mu        sync.Mutex
 	replied   bool
+	replies   int
+	errorCode string
+
+	annotations map[string]any
+
+	// errorHook is the ErrorHook of the server.
+	errorHook func(*Session, *Call, error)
+}
+
+// writeInternal writes a reply generated by the server rather than by the
+// handler, and reports the failures to write it to the error hook, since
+// nobody else can see them.
+func (w *replyWriter) writeInternal(verr Error) {
+	if err := w.WriteError(verr); err != nil && w.errorHook != nil {
+		w.errorHook(w.session, w.call, fmt.Errorf("writing %s reply: %w", verr.ErrorCode(), err))
+	}
+}
+
+type errorHookKey struct{}
+
+// reportError reports err to the ErrorHook of the server serving the call
+// that ctx belongs to, for the failures of wrappers like the concurrency
+// policies, which have nobody else to return them to.
+func reportError(ctx context.Context, err error) {
+	w, _ := ctx.Value(errorHookKey{}).(*replyWriter)
+	if w == nil {
+		return
+	}
+	w.errorHook(w.session, w.call, err)
 }
 
 func (w *replyWriter) WriteError(err Error) error {
@@ -69,6 +115,39 @@ func (w *replyWriter) WriteReply(parameters any, opts ...ReplyOption) error {
 	return w.writeReply(&reply)
 }
 
+func (w *replyWriter) WriteMore(parameters any, opts ...ReplyOption) error {
+	return w.writeChecked(parameters, w.call.More, opts)
+}
+
+func (w *replyWriter) WriteFinal(parameters any, opts ...ReplyOption) error {
+	return w.writeChecked(parameters, false, opts)
+}
+
+func (w *replyWriter) writeChecked(parameters any, continues bool, opts []ReplyOption) error {
+	if w.hasReplied() {
+		return ErrReplied
+	}
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+
+	reply, err := MakeReply(parameters, opts...)
+	if err != nil {
+		return err
+	}
+	reply.Continues = continues
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Check again, since a concurrent call may have written the final
+	// reply while this one was being made.
+	if w.replied {
+		return ErrReplied
+	}
+	return w.writeReplyLocked(&reply)
+}
+
 // Call performs a method call back to the client that initiated this session.
 func (w *replyWriter) Call(method string, params any, opts ...CallOption) (*ReplyStream, error) {
 	if err := w.ctx.Err(); err != nil {
@@ -79,6 +158,7 @@ func (w *replyWriter) Call(method string, params any, opts ...CallOption) (*Repl
 	if err != nil {
 		return nil, err
 	}
+	call.decodeOpts = w.call.decodeOpts
 
 	return w.transport.RoundTrip(w.ctx, w.session, &call)
 }
@@ -101,13 +181,30 @@ func (w *replyWriter) writeReply(reply *Reply) error {
 	if w.replied {
 		panic("method call has already been replied to.")
 	}
+	return w.writeReplyLocked(reply)
+}
 
+// writeReplyLocked writes a reply to a call that was not replied to yet.
+// w.mu must be held.
+func (w *replyWriter) writeReplyLocked(reply *Reply) error {
 	if !reply.Continues {
 		w.replied = true
+		w.errorCode = reply.Error
+	}
+	w.replies++
+	if w.call != nil && w.call.OneWay {
+		// The client asked for the reply to be suppressed.
+		return nil
 	}
 	err := w.session.WriteReply(w.ctx, reply)
-	if errors.Is(err, ErrPeerDisconnected) {
+	switch {
+	case errors.Is(err, ErrPeerDisconnected):
 		w.cancel(ErrPeerDisconnected)
+	case errors.Is(err, ErrWriteTimeout):
+		// The reply may have been partially written, and the client is
+		// not keeping up anyway: evict it.
+		w.cancel(err)
+		w.session.Close()
 	}
 	return err
 }
@@ -118,6 +215,16 @@ type Server struct {
 	// Handler is the MethodHandler used to serve method calls.
 	Handler MethodHandler
 
+	// HandlerFor, if set, returns the MethodHandler serving the calls
+	// received on a session, which allows a single server to expose
+	// distinct services depending on the listener that accepted the
+	// connection, or on the peer, as told by methods of the session like
+	// PeerCredentials. The listener is nil for sessions whose connection
+	// was not accepted by Serve. If HandlerFor returns nil, Handler is used.
+	//
+	// HandlerFor is called once per session, before reading any call.
+	HandlerFor func(l net.Listener, session *Session) MethodHandler
+
 	// Transport is the RoundTripper that should be used when driving
 	// server-to-client calls.
 	//
@@ -143,6 +250,88 @@ type Server struct {
 	// any extra client call going over the pipeline limit as defined by
 	// MaxPipelineSize.
 	PipelineOverflowErrorFunc func(call *Call) Error
+
+	// ErrorMapper, if set, is used to convert Go errors into varlink errors
+	// by handlers that use ConvertError or HandlerFuncErr.
+	ErrorMapper *ErrorMapper
+
+	// DevMode, if true, makes the server include debug information in
+	// internal errors: the error message or panic value, a truncated
+	// stack trace, and a snapshot of the call. It also makes the server
+	// recover from panics of handlers, and reply with such an error.
+	//
+	// This leaks implementation details to clients, and must only be
+	// enabled during development.
+	DevMode bool
+
+	// DecodeOptions, if set, control how the parameters of the calls
+	// received by the server, and of the replies to the calls that
+	// handlers make back to clients, are decoded.
+	DecodeOptions *DecodeOptions
+
+	// StatsHook, if set, is called with the statistics of each call once
+	// its handler returns. It is called from the goroutine serving the
+	// session, and should not block.
+	StatsHook func(CallStats)
+
+	// Interceptors wrap the Handler, the first interceptor being the
+	// outermost. See [Chain].
+	Interceptors []Interceptor
+
+	// Compression, if set, makes the server accept compression requests
+	// from clients negotiating framing, and configures the compression of
+	// the replies. See [Session.NegotiateCompression].
+	Compression *CompressionOptions
+
+	// MessageHooks are added to the sessions served by the server. See
+	// [MessageHooks].
+	MessageHooks []MessageHooks
+
+	// CanonicalJSON, if true, makes the server write canonical JSON. See
+	// [Session.CanonicalJSON].
+	CanonicalJSON bool
+
+	// Clock, if set, is the clock of the sessions served by the server,
+	// which is also used to time calls. See [Session.SetClock].
+	Clock Clock
+
+	// ErrorHook, if set, is called with the errors that the server cannot
+	// return to anyone: failures to write the replies that it generates
+	// itself, like the replies to calls without a handler, to calls left
+	// unreplied by their handler, or to calls overflowing the pipeline, and
+	// failures to reply to framing negotiations, and failures to write the
+	// replies of calls superseded under the Coalesced policy. It tells
+	// operators why clients observe missing replies.
+	//
+	// The call is the one being replied to, or nil if the reply is not to a
+	// call. ErrorHook is called from the goroutines serving the session, and
+	// should not block.
+	ErrorHook func(session *Session, call *Call, err error)
+
+	// DecodeErrorBudget is the number of calls failing to decode that the
+	// sessions served by the server tolerate. Once it is exceeded, the
+	// server replies with an ErrorProtocol error and stops serving the
+	// session.
+	// See [DecodeErrorBudget].
+	DecodeErrorBudget DecodeErrorBudget
+
+	// WriteTimeout, if set, is the time that writing a reply may take
+	// before the client is disconnected. See [Session.SetWriteTimeout].
+	//
+	// Regardless of WriteTimeout, replies blocked on clients that do not
+	// read them are interrupted when Serve returns.
+	WriteTimeout time.Duration
+
+	// DrainOnEOF, if true, makes the server serve the calls that it already
+	// read from a session when the peer stops sending, as clients do with
+	// Session.CloseWrite once they made all their calls, rather than
+	// canceling them. The session ends once they are all replied to.
+	//
+	// Half-closed and closed connections look the same when reading, so
+	// the handlers of peers that closed their connection are only canceled
+	// once writing a reply fails; handlers waiting without writing
+	// anything keep running until then.
+	DrainOnEOF bool
 }
 
 // Serve accepts incoming varlink connections on the listener l, creating a new
@@ -155,6 +344,7 @@ func (s *Server) Serve(l net.Listener) error {
 	var wg sync.WaitGroup
 
 	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, listenerKey{}, l)
 
 	defer func() {
 		cancel()
@@ -181,7 +371,8 @@ func (s *Server) Serve(l net.Listener) error {
 // ServeConn creates a session from the specified connection, reads method
 // calls, and replies to them by calling the server Handler.
 //
-// ServeConn closes the underlying connection.
+// ServeConn closes the underlying connection. Like ServeSession, it returns
+// once the handlers serving the calls of the connection have returned.
 func (s *Server) ServeConn(ctx context.Context, conn net.Conn) {
 	session := NewSession(conn)
 	defer session.Close()
@@ -191,6 +382,11 @@ func (s *Server) ServeConn(ctx context.Context, conn net.Conn) {
 
 // ServeSession reads method calls from the session and calls the server
 // Handler to reply to them.
+//
+// ServeSession returns once the session stops delivering calls, and the
+// handlers serving its calls have returned. The context passed to handlers
+// is canceled when ServeSession stops reading calls, unless the session is
+// being detached, in which case the calls already read are served first.
 func (s *Server) ServeSession(ctx context.Context, session *Session) {
 	transport := s.Transport
 	if transport == nil {
@@ -203,48 +399,133 @@ func (s *Server) ServeSession(ctx context.Context, session *Session) {
 	}
 	pipeline := make(chan Call, maxPipelineSize)
 
+	if s.ErrorMapper != nil {
+		ctx = context.WithValue(ctx, errorMapperKey{}, s.ErrorMapper)
+	}
+	ctx = context.WithValue(ctx, sessionKey{}, session)
+	session.AddHooks(s.MessageHooks...)
+
+	if s.StatsHook != nil {
+		session.RecordTimestamps(true)
+	}
+	if s.CanonicalJSON {
+		session.CanonicalJSON(true)
+	}
+	if s.Clock != nil {
+		session.SetClock(s.Clock)
+	}
+	if s.WriteTimeout > 0 {
+		session.SetWriteTimeout(s.WriteTimeout)
+	}
+	if s.DecodeErrorBudget.Max > 0 {
+		session.SetDecodeErrorBudget(s.DecodeErrorBudget)
+	}
+
+	handler := s.Handler
+	if s.HandlerFor != nil {
+		if h := s.HandlerFor(ListenerFromContext(ctx), session); h != nil {
+			handler = h
+		}
+	}
+	if handler != nil {
+		handler = Chain(handler, s.Interceptors...)
+	}
 	ctx, cancel := context.WithCancelCause(ctx)
 
+	done := make(chan struct{})
 	go func() {
+		defer close(done)
+
 		var call Call
 		for call = range pipeline {
+			call.decodeOpts = s.DecodeOptions
 			w := &replyWriter{
-				ctx:       ctx,
+				call:      &call,
+				ctx:       callContext(ctx, &call),
 				cancel:    cancel,
 				session:   session,
 				transport: transport,
+				errorHook: s.ErrorHook,
 			}
 
-			if s.Handler == nil {
-				w.WriteError(service.MethodNotFound(call.Method))
+			if handler == nil {
+				w.writeInternal(service.MethodNotFound(call.Method))
 				continue
 			}
 
-			s.Handler.ServeMethod(w, &call)
+			if s.DevMode {
+				w.ctx = context.WithValue(w.ctx, devModeKey{}, &call)
+			}
+			if s.StatsHook != nil {
+				w.ctx = context.WithValue(w.ctx, callStatsKey{}, w)
+			}
+			if s.ErrorHook != nil {
+				w.ctx = context.WithValue(w.ctx, errorHookKey{}, w)
+			}
+
+			started := session.clock.Now()
+			s.serveMethod(handler, w, &call)
+			duration := session.clock.Now().Sub(started)
 
 			if err := ctx.Err(); err != nil {
 				return
 			}
 			if !w.hasReplied() {
-				w.WriteError(service.MethodNotImplemented(call.Method))
-				continue
+				w.writeInternal(service.MethodNotImplemented(call.Method))
+			}
+			if s.StatsHook != nil {
+				s.StatsHook(w.stats(started, duration))
 			}
 		}
 	}()
 
+	// Stop the pipeline goroutine once we stop reading calls, and wait for
+	// it to exit, so that no handler outlives ServeSession. Unless the
+	// session is being detached, the context is canceled before the pipeline
+	// is closed so that handlers in progress return promptly. When the
+	// session is being detached, the calls that were already read must be
+	// served, since the session waits for them to be replied to.
+	defer func() {
+		close(pipeline)
+		<-done
+		cancel(nil)
+	}()
+
 	pipelineErrorFunc := s.PipelineOverflowErrorFunc
 
 	var call Call
 	for {
 		err := session.ReadCall(ctx, &call)
 		switch {
-		case errors.Is(err, ErrPeerDisconnected):
-			cancel(ErrPeerDisconnected)
+		case errors.Is(err, ErrSessionDetached):
+			return
+		case errors.Is(err, ErrPeerDisconnected) && s.DrainOnEOF:
+			// The peer may have only closed its writing side, and still
+			// be reading replies.
+			return
+		case errors.Is(err, ErrProtocol):
+			// Tell the peer why the session is being closed.
+			if werr := session.WriteReply(ctx, &Reply{Error: ErrorProtocol}); werr != nil {
+				s.reportError(session, nil, fmt.Errorf("writing %s reply: %w", ErrorProtocol, werr))
+			}
+			cancel(err)
 			return
 		case err != nil:
+			cancel(err)
 			return
 		}
 
+		// Framing negotiation must be replied to before reading any other
+		// call, since the peer only switches framing once it gets the reply.
+		if call.Method == framingMethod && call.Upgrade {
+			if err := session.replyFraming(ctx, &call, s.Compression); err != nil {
+				s.reportError(session, &call, fmt.Errorf("replying to framing negotiation: %w", err))
+				cancel(err)
+				return
+			}
+			continue
+		}
+
 		if pipelineErrorFunc == nil {
 			select {
 			case <-ctx.Done():
@@ -258,17 +539,40 @@ func (s *Server) ServeSession(ctx context.Context, session *Session) {
 			case pipeline <- call:
 			default:
 				w := &replyWriter{
-					ctx:     ctx,
-					cancel:  cancel,
-					session: session,
+					call:      &call,
+					ctx:       ctx,
+					cancel:    cancel,
+					session:   session,
+					errorHook: s.ErrorHook,
 				}
-				w.WriteError(pipelineErrorFunc(&call))
+				w.writeInternal(pipelineErrorFunc(&call))
 			}
 		}
 	}
 }
 
+// reportError passes an error to the ErrorHook of the server, if any.
+func (s *Server) reportError(session *Session, call *Call, err error) {
+	if s.ErrorHook != nil {
+		s.ErrorHook(session, call, err)
+	}
+}
+
+func (s *Server) serveMethod(handler MethodHandler, w *replyWriter, call *Call) {
+	if s.DevMode {
+		defer recoverHandler(w)
+	}
+	handler.ServeMethod(w, call)
+}
+
 // Listen binds the specified varlink uri and listens for incoming connections.
+//
+// Listening on "unix:@" binds an abstract unix socket with a name picked by
+// the kernel; use URIFromAddr on the address of the listener to retrieve
+// the URI that clients can connect to.
+//
+// Listening on "fd:<n>" uses the inherited listening socket with the
+// file descriptor number n, as passed by socket activation.
 func Listen(uri string) (net.Listener, error) {
 	u, err := ParseURI(uri)
 	if err != nil {
@@ -277,7 +581,14 @@ func Listen(uri string) (net.Listener, error) {
 
 	switch u.Scheme {
 	case "tcp", "unix":
-		return net.Listen(u.Scheme, u.Address)
+		addr := u.Address
+		if name, ok := u.AbstractName(); ok && name == "" {
+			// Let the kernel pick a unique abstract name.
+			addr = ""
+		}
+		return net.Listen(u.Scheme, addr)
+	case "fd":
+		return listenFd(u)
 	default:
 		return nil, fmt.Errorf("listen %v: %w", u, ErrUnsupportedScheme)
 	}
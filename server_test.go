@@ -0,0 +1,271 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+func TestHandlerFor(t *testing.T) {
+	dir := t.TempDir()
+
+	public, err := net.Listen("unix", filepath.Join(dir, "public"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer public.Close()
+	admin, err := net.Listen("unix", filepath.Join(dir, "admin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer admin.Close()
+
+	var publicMux, adminMux varlink.ServeMux
+	publicMux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+	adminMux.HandleFunc("org.example.*", func(w varlink.ReplyWriter, call *varlink.Call) {
+		if varlink.ListenerFromContext(w.Context()) != admin {
+			t.Error("admin call served with the wrong listener in context")
+		}
+		w.WriteReply(nil)
+	})
+
+	server := varlink.Server{
+		HandlerFor: func(l net.Listener, session *varlink.Session) varlink.MethodHandler {
+			creds, err := session.PeerCredentials()
+			switch {
+			case errors.Is(err, varlink.ErrNoPeerCredentials):
+			case err != nil:
+				t.Errorf("getting peer credentials: %v", err)
+			case creds.UID != os.Getuid():
+				t.Errorf("got peer uid %d, expected %d", creds.UID, os.Getuid())
+			}
+			if l == admin {
+				return &adminMux
+			}
+			return nil
+		},
+		Handler: &publicMux,
+	}
+	go server.Serve(public)
+	go server.Serve(admin)
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+	client := varlink.Client{Transport: transport}
+
+	tests := []struct {
+		l        net.Listener
+		method   string
+		expected string
+	}{
+		{public, "org.example.Ping", ""},
+		{public, "org.example.Shutdown", "org.varlink.service.MethodNotFound"},
+		{admin, "org.example.Shutdown", ""},
+	}
+	for _, tt := range tests {
+		uri := varlink.CallURI("unix:" + tt.l.Addr().String())
+		rs, err := client.Call(context.Background(), tt.method, nil, uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rs.Next()
+
+		var code string
+		var verr varlink.Error
+		if errors.As(rs.Error(), &verr) {
+			code = verr.ErrorCode()
+		} else if err := rs.Error(); err != nil {
+			t.Fatal(err)
+		}
+		if code != tt.expected {
+			t.Errorf("%s on %s: got error %q, expected %q", tt.method, tt.l.Addr(), code, tt.expected)
+		}
+	}
+}
+
+func TestServerProtocolError(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+
+	server := varlink.Server{Handler: &varlink.ServeMux{}}
+	go server.ServeConn(context.Background(), a)
+
+	go b.Write([]byte("not json\x00"))
+	frame, err := bufio.NewReader(b).ReadBytes(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reply struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(frame[:len(frame)-1], &reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Error != varlink.ErrorProtocol {
+		t.Fatalf("got reply %s, expected an %s error", frame, varlink.ErrorProtocol)
+	}
+}
+
+func TestServerErrorHook(t *testing.T) {
+	a, b := net.Pipe()
+
+	// Without a handler, the server replies MethodNotFound itself.
+	errs := make(chan error, 1)
+	server := varlink.Server{
+		ErrorHook: func(session *varlink.Session, call *varlink.Call, err error) {
+			if call == nil || call.Method != "org.example.Missing" {
+				t.Errorf("got error for call %v, expected org.example.Missing", call)
+			}
+			errs <- err
+		},
+	}
+	go server.ServeConn(context.Background(), a)
+
+	// The peer goes away before the MethodNotFound reply is written.
+	if _, err := b.Write([]byte(`{"method":"org.example.Missing"}` + "\x00")); err != nil {
+		t.Fatal(err)
+	}
+	b.Close()
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("got nil error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the error hook was not called")
+	}
+}
+
+func TestServerDrainOnEOF(t *testing.T) {
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	halfClosed := make(chan struct{})
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Count", func(w varlink.ReplyWriter, call *varlink.Call) {
+		<-halfClosed
+		for i := range 3 {
+			if err := w.WriteMore(map[string]int{"n": i}); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+		w.WriteFinal(map[string]int{"n": 3})
+	})
+	go (&varlink.Server{Handler: &mux, DrainOnEOF: true}).Serve(l)
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := varlink.NewSession(conn)
+	defer session.Close()
+
+	ctx := context.Background()
+	call, _ := varlink.MakeCall("org.example.Count", nil, varlink.More())
+	if err := session.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	if err := session.CloseWrite(); err != nil {
+		t.Fatal(err)
+	}
+	close(halfClosed)
+
+	replies := 0
+	rs := varlink.NewReplyStream(ctx, &call, session)
+	for rs.Next() {
+		replies++
+	}
+	if err := rs.Error(); err != nil || replies != 4 {
+		t.Fatalf("got %d replies and error %v, expected 4 replies", replies, err)
+	}
+
+	a, _ := net.Pipe()
+	if err := varlink.NewSession(a).CloseWrite(); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("closing the writing side of a pipe returned %v, expected ErrUnsupported", err)
+	}
+}
+
+func TestWriteFinalConcurrent(t *testing.T) {
+	l, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	const writers = 64
+	results := make(chan error, writers)
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Race", func(w varlink.ReplyWriter, call *varlink.Call) {
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for i := range writers {
+			wg.Go(func() {
+				<-start
+				if i%2 == 0 {
+					results <- w.WriteFinal(map[string]int{"n": i})
+				} else {
+					results <- w.WriteMore(map[string]int{"n": i})
+				}
+			})
+		}
+		close(start)
+		wg.Wait()
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := varlink.NewSession(conn)
+	defer session.Close()
+
+	ctx := context.Background()
+	call, _ := varlink.MakeCall("org.example.Race", nil)
+	if err := session.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	rs := varlink.NewReplyStream(ctx, &call, session)
+	for rs.Next() {
+	}
+	if err := rs.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the more flag, WriteMore writes the final reply too, so
+	// exactly one of the writers must succeed.
+	var written int
+	for range writers {
+		switch err := <-results; {
+		case err == nil:
+			written++
+		case !errors.Is(err, varlink.ErrReplied):
+			t.Fatalf("expected ErrReplied, got %v", err)
+		}
+	}
+	if written != 1 {
+		t.Fatalf("%d replies were written, expected 1", written)
+	}
+}
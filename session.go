@@ -7,6 +7,8 @@ package varlink
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -14,10 +16,14 @@ import (
 	"io"
 	"net"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var (
 	ErrFdPassingNotSupported = errors.New("file descriptor passing is not supported on this net.Conn")
+	ErrSessionDetached       = errors.New("session is being detached")
+	ErrMessageTooLarge       = errors.New("message exceeds the maximum message size")
 )
 
 // Session represents a varlink connection.
@@ -31,37 +37,196 @@ type Session struct {
 	rq       []Reply
 	inflight []*Call
 	reading  bool
+
+	// Framing state. rframing is owned by the current reader, wframing is
+	// protected by wmu, framingCall and framingCompression by cond.L, and
+	// framingPending and framingAccepted by rcond.L.
+	rframing           framing
+	wframing           framing
+	framingCall        *Call
+	framingCompression *CompressionOptions
+	framingPending     bool
+	framingAccepted    bool
+
+	// Compression state. The write side is protected by wmu, and the read
+	// side is owned by the current reader.
+	wcompress        *CompressionOptions
+	zw               *gzip.Writer
+	wzbuf            bytes.Buffer
+	zr               *gzip.Reader
+	rzbuf            bytes.Buffer
+	rframeCompressed bool
+
+	// Scratch buffer for frames that do not fit in the read buffer, and
+	// partially read length-prefixed frame. Owned by the current reader.
+	rbuf      []byte
+	rframe    []byte
+	rframeOff int
+
+	// Detach state. unreplied is the number of calls returned by ReadCall
+	// that have not been replied to yet, and is protected by cond.L;
+	// rinterrupt is protected by rcond.L.
+	detaching  atomic.Bool
+	rinterrupt bool
+	unreplied  int
+
+	timestamps   atomic.Bool
+	canonical    atomic.Bool
+	writeTimeout atomic.Int64
+
+	// Decode error budget, set before the session is used, and times of
+	// the recent decode errors, owned by the current reader.
+	decodeBudget DecodeErrorBudget
+	decodeErrors []time.Time
+
+	// Settings from SessionOptions.
+	codec      Codec
+	stats      *SessionStats
+	maxMessage int
+
+	// Message hooks, clock and fault injector, set before the session is
+	// used.
+	hooks  []MessageHooks
+	clock  Clock
+	faults FaultInjector
+
+	// One-way call batching. The settings are set before the session is
+	// used, and the current batch is protected by wmu.
+	batching   *OneWayBatching
+	batchCalls int
+	batchBytes int
+	batchTimer Timer
 }
 
 // NewSession creates a session from a net.Conn. The session takes ownership
 // of that connection, and closing the session closes the underlying connection.
 func NewSession(conn net.Conn) *Session {
+	return NewSessionWithOptions(conn, SessionOptions{})
+}
+
+// SessionOptions tune a session created with NewSessionWithOptions. The zero
+// value gives the defaults of NewSession.
+type SessionOptions struct {
+	// ReadBufferSize and WriteBufferSize are the sizes of the read and write
+	// buffers of the session. If zero, the defaults of the bufio package
+	// are used.
+	//
+	// Messages that fit in the read buffer are read without being copied,
+	// so sessions carrying large messages benefit from a larger buffer.
+	ReadBufferSize  int
+	WriteBufferSize int
+
+	// Reader, if set, is read from instead of the connection, which allows
+	// resuming a session over a connection whose first bytes were already
+	// read into a buffer, like connections hijacked from an HTTP server.
+	// It must read from the connection once its buffered data is consumed.
+	// ReadBufferSize is ignored, and file descriptors cannot be received.
+	Reader *bufio.Reader
+
+	// MaxMessageSize is the maximum size, in bytes, of the messages read
+	// from the peer. Reading a larger message fails with ErrMessageTooLarge,
+	// after which the session must be closed. If zero, the size of messages
+	// is only limited for length-prefixed frames, to 256 MiB.
+	MaxMessageSize int
+
+	// Codec encodes and decodes the messages of the session. If nil,
+	// JSONCodec is used.
+	Codec Codec
+
+	// Stats, if set, accumulates the traffic of the session. The same
+	// SessionStats may be shared by many sessions.
+	Stats *SessionStats
+
+	// WriteTimeout is the time that writing a message may take. See
+	// [Session.SetWriteTimeout].
+	WriteTimeout time.Duration
+
+	// DecodeErrorBudget is the number of messages failing to decode that
+	// the session tolerates. See [DecodeErrorBudget].
+	DecodeErrorBudget DecodeErrorBudget
+}
+
+// NewSessionWithOptions is like NewSession, but creates a session tuned with
+// the specified options, for embedders like proxies that need sessions
+// matching their environment rather than the defaults.
+func NewSessionWithOptions(conn net.Conn, opts SessionOptions) *Session {
 	switch c := conn.(type) {
 	case *net.UnixConn:
 		conn = &UnixConn{conn: c}
 	}
 
 	sess := &Session{
-		conn:  conn,
-		cond:  makeCond(&sync.Mutex{}),
-		rcond: makeCond(&sync.Mutex{}),
+		conn:       conn,
+		cond:       makeCond(&sync.Mutex{}),
+		rcond:      makeCond(&sync.Mutex{}),
+		clock:      SystemClock,
+		codec:      opts.Codec,
+		stats:      opts.Stats,
+		maxMessage: opts.MaxMessageSize,
 
 		rw: bufio.ReadWriter{
-			Reader: bufio.NewReader(conn),
-			Writer: bufio.NewWriter(conn),
+			Reader: opts.Reader,
+			Writer: bufio.NewWriterSize(conn, opts.WriteBufferSize),
 		},
 	}
+	if sess.codec == nil {
+		sess.codec = JSONCodec
+	}
+	if sess.rw.Reader == nil {
+		sess.rw.Reader = bufio.NewReaderSize(conn, opts.ReadBufferSize)
+	}
+	sess.SetWriteTimeout(opts.WriteTimeout)
+	sess.SetDecodeErrorBudget(opts.DecodeErrorBudget)
 	return sess
 }
 
+// NewStreamSession creates a session over an arbitrary stream, such as the
+// pipes of a subprocess or a channel multiplexed over another connection,
+// for RoundTrippers and servers working with transports that are not
+// net.Conn. The session takes ownership of the stream, and closing the
+// session closes it.
+//
+// Deadlines, which sessions use to detach, are only supported if the
+// stream has SetDeadline methods. File descriptors cannot be passed.
+func NewStreamSession(stream io.ReadWriteCloser) *Session {
+	if conn, ok := stream.(net.Conn); ok {
+		return NewSession(conn)
+	}
+	return NewSession(&streamConn{
+		ReadWriteCloser: stream,
+		addr:            connAddr{network: "stream", address: fmt.Sprintf("%T", stream)},
+	})
+}
+
+// RecordTimestamps enables or disables the recording of the times at which
+// calls and replies are written to and read from the session, in the SentAt
+// and ReceivedAt fields of Call and Reply. Timestamps are disabled by default.
+func (session *Session) RecordTimestamps(enabled bool) {
+	session.timestamps.Store(enabled)
+}
+
 // WriteCall writes a call to the connection.
+//
+// Writing the call is interrupted with ErrWriteTimeout if ctx becomes done,
+// or if it takes longer than the write timeout of the session.
+//
+// Unless it fails, WriteCall registers the call as in flight on the
+// session: its replies must then be read with ReadReply, typically through
+// a ReplyStream created with NewReplyStream, since replies are matched to
+// calls in the order the calls were written.
 func (session *Session) WriteCall(ctx context.Context, call *Call) error {
 
 	if err := ctx.Err(); err != nil {
 		return err
 	}
+	if session.detaching.Load() {
+		return ErrSessionDetached
+	}
+	if err := session.outboundCall(ctx, call); err != nil {
+		return err
+	}
 
-	payload, err := json.Marshal(call)
+	payload, err := session.encodeMessage(call)
 	if err != nil {
 		return err
 	}
@@ -70,33 +235,39 @@ func (session *Session) WriteCall(ctx context.Context, call *Call) error {
 		return err
 	}
 
-	if err := session.writeMsg(payload, call.FileDescriptors); err != nil {
+	if err := session.writeMsg(ctx, payload, call.FileDescriptors, call.OneWay, nil); err != nil {
 		return err
 	}
+	if session.timestamps.Load() {
+		call.SentAt = session.clock.Now()
+	}
 
-	session.cond.L.Lock()
-	session.inflight = append(session.inflight, call)
-	session.cond.L.Unlock()
+	// One-way calls have no replies to wait for.
+	if !call.OneWay {
+		session.cond.L.Lock()
+		session.inflight = append(session.inflight, call)
+		session.cond.L.Unlock()
+	}
 
 	return nil
 }
 
 func (session *Session) readCallOrReply(ctx context.Context, reply *Reply, call *Call) (isCall bool, err error) {
 
+	// Nothing may be read while a framing negotiation call is pending, since
+	// whatever the peer sends next depends on how it is replied to.
+	for session.framingPending {
+		if err := session.rcond.Wait(ctx); err != nil {
+			return false, err
+		}
+	}
+
 	// These look like a bug, but they are not. readCallOrReply is done while
 	// the rcond _is unlocked_ and must relock itself afterwards.
 	session.rcond.L.Unlock()
 	defer session.rcond.L.Lock()
 
-	var msg struct {
-		Method     *string         `json:"method"`
-		OneWay     bool            `json:"oneway"`
-		More       bool            `json:"more"`
-		Upgrade    bool            `json:"upgrade"`
-		Continues  bool            `json:"continues"`
-		Error      string          `json:"error"`
-		Parameters json.RawMessage `json:"parameters"`
-	}
+	var msg Message
 
 	if err := ctx.Err(); err != nil {
 		return false, err
@@ -107,28 +278,44 @@ func (session *Session) readCallOrReply(ctx context.Context, reply *Reply, call
 		return false, err
 	}
 
-	if err := json.Unmarshal(payload, &msg); err != nil {
-		return false, err
+	var received time.Time
+	if session.timestamps.Load() {
+		received = session.clock.Now()
+	}
+
+	if err := session.codec.Unmarshal(payload, &msg); err != nil {
+		return false, session.decodeError(err, fds)
 	}
 
-	isCall = msg.Method != nil
+	isCall = msg.IsCall()
+	msg.FileDescriptors = fds
 
-	if !isCall {
-		*reply = Reply{
-			Parameters:      msg.Parameters,
-			Error:           msg.Error,
-			Continues:       msg.Continues,
-			FileDescriptors: fds,
+	// Framing changes must be applied before reading anything else, since
+	// the peer is free to use the new framing for anything that comes after
+	// the negotiation. Calls negotiating framing hold the reads until they
+	// are replied to, since they may be rejected.
+	switch {
+	case isCall && *msg.Method == framingMethod && msg.Upgrade:
+		session.holdFraming()
+	case !isCall && msg.Error == "":
+		session.cond.L.Lock()
+		if session.framingCall != nil && len(session.inflight) > 0 && session.inflight[0] == session.framingCall {
+			var out framingReply
+			compression := session.framingCompression
+			if compression != nil && (json.Unmarshal(msg.Parameters, &out) != nil || out.Compression != compression.Algorithm) {
+				compression = nil
+			}
+			session.switchFraming(framingLengthPrefixed, compression)
 		}
+		session.cond.L.Unlock()
+	}
+
+	if !isCall {
+		*reply = msg.Reply()
+		reply.ReceivedAt = received
 	} else {
-		*call = Call{
-			Method:          *msg.Method,
-			OneWay:          msg.OneWay,
-			More:            msg.More,
-			Upgrade:         msg.Upgrade,
-			Parameters:      msg.Parameters,
-			FileDescriptors: fds,
-		}
+		*call = msg.Call()
+		call.ReceivedAt = received
 	}
 	return isCall, nil
 }
@@ -149,6 +336,27 @@ func (session *Session) waitCall(ctx context.Context, initiator *Call) error {
 	return nil
 }
 
+// idle returns whether the session has no calls in flight.
+func (session *Session) idle() bool {
+	session.cond.L.Lock()
+	defer session.cond.L.Unlock()
+
+	return len(session.inflight) == 0
+}
+
+// waitIdle waits until the session has no calls in flight.
+func (session *Session) waitIdle(ctx context.Context) error {
+	session.cond.L.Lock()
+	defer session.cond.L.Unlock()
+
+	for len(session.inflight) > 0 {
+		if err := session.cond.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ReadReply reads a reply from the connection.
 //
 // The initiating call must be specified to protect from out-of-order reception
@@ -167,6 +375,7 @@ func (session *Session) ReadReply(ctx context.Context, initiator *Call, reply *R
 	if err := session.readReply(ctx, reply); err != nil {
 		return err
 	}
+	session.inboundReply(ctx, reply)
 
 	if !reply.Continues {
 		session.cond.L.Lock()
@@ -202,6 +411,17 @@ func (session *Session) readReply(ctx context.Context, reply *Reply) error {
 		isCall, err := session.readCallOrReply(ctx, reply, &call)
 		session.rcond.Broadcast()
 
+		if err == errSkipMessage {
+			continue
+		}
+		if err != nil && session.interrupted(err) {
+			// Detach interrupted the read to take over the session, but
+			// our reply must still be read to drain the session.
+			if err := session.waitInterrupt(ctx); err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -218,17 +438,37 @@ func (session *Session) readReply(ctx context.Context, reply *Reply) error {
 //
 // ReadCall blocks until a call is received, or the context becomes done.
 func (session *Session) ReadCall(ctx context.Context, call *Call) error {
+	for {
+		if err := session.readCall(ctx, call); err != nil {
+			return err
+		}
+		err := session.inboundCall(ctx, call)
+		if err == nil {
+			return nil
+		}
+		if err := session.rejectCall(ctx, call, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (session *Session) readCall(ctx context.Context, call *Call) error {
 	session.rcond.L.Lock()
 	defer session.rcond.L.Unlock()
 
-	for session.reading && len(session.cq) == 0 {
+	for session.reading && len(session.cq) == 0 && !session.detaching.Load() {
 		if err := session.rcond.Wait(ctx); err != nil {
 			return err
 		}
 	}
 
+	if session.detaching.Load() {
+		return ErrSessionDetached
+	}
+
 	if len(session.cq) > 0 {
 		*call, session.cq = session.cq[0], session.cq[1:]
+		session.handOut(call)
 		return nil
 	}
 
@@ -242,10 +482,17 @@ func (session *Session) ReadCall(ctx context.Context, call *Call) error {
 		isCall, err := session.readCallOrReply(ctx, &reply, call)
 		session.rcond.Broadcast()
 
+		if err == errSkipMessage {
+			continue
+		}
+		if err != nil && session.interrupted(err) {
+			return ErrSessionDetached
+		}
 		if err != nil {
 			return err
 		}
 		if isCall {
+			session.handOut(call)
 			return nil
 		}
 
@@ -253,22 +500,57 @@ func (session *Session) ReadCall(ctx context.Context, call *Call) error {
 	}
 }
 
-// WriteReply writes a reply to the connection.
+// WriteReply writes a reply to the connection. Like for WriteCall, writing
+// the reply is interrupted with ErrWriteTimeout if ctx becomes done, or if
+// it takes longer than the write timeout of the session.
 func (session *Session) WriteReply(ctx context.Context, reply *Reply) error {
+	return session.writeReply(ctx, reply, nil)
+}
+
+// writeReply is WriteReply, with written, if set, called with the write
+// lock held once the reply is written. See writeMsg.
+func (session *Session) writeReply(ctx context.Context, reply *Reply, written func()) error {
 
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	payload, err := json.Marshal(reply)
-	if err != nil {
-		return err
+	err := session.outboundReply(ctx, reply)
+	if err == nil {
+		var payload []byte
+		payload, err = session.encodeMessage(reply)
+		if err == nil {
+			err = session.writeMsg(ctx, payload, reply.FileDescriptors, false, written)
+		}
 	}
 
-	return session.writeMsg(payload, reply.FileDescriptors)
+	session.settleFraming(reply)
+
+	if !reply.Continues {
+		session.cond.L.Lock()
+		if session.unreplied > 0 {
+			session.unreplied--
+		}
+		session.cond.Broadcast()
+		session.cond.L.Unlock()
+	}
+	return err
+}
+
+// handOut records that a call was returned by ReadCall and expects a reply.
+func (session *Session) handOut(call *Call) {
+	if call.OneWay {
+		return
+	}
+	session.cond.L.Lock()
+	session.unreplied++
+	session.cond.L.Unlock()
 }
 
-func (session *Session) writeMsg(msg []byte, fds []uintptr) error {
+// writeMsg writes a message to the connection. If set, written is called
+// once the message is written, before the write lock is released, which
+// lets the write side of the session change in step with the message.
+func (session *Session) writeMsg(ctx context.Context, msg []byte, fds []uintptr, oneway bool, written func()) error {
 	session.wmu.Lock()
 	defer session.wmu.Unlock()
 
@@ -277,23 +559,58 @@ func (session *Session) writeMsg(msg []byte, fds []uintptr) error {
 		return ErrFdPassingNotSupported
 	}
 
-	if _, err := session.rw.Write(msg); err != nil {
-		return err
+	if session.faults != nil {
+		if msg = session.faults.InjectWrite(msg); len(msg) == 0 {
+			return nil
+		}
 	}
 
-	if len(fds) > 0 {
-		fdpass.PassFds(fds...)
+	if session.stats != nil {
+		session.stats.MessagesWritten.Add(1)
+		session.stats.BytesWritten.Add(int64(len(msg)))
 	}
 
-	if _, err := session.rw.Write([]byte("\x00")); err != nil {
+	finish := session.writeDeadline(ctx)
+	if err := finish(session.writeMsgUnlocked(msg, fds, fdpass, oneway)); err != nil {
 		return err
 	}
+	if written != nil {
+		written()
+	}
+	return nil
+}
+
+func (session *Session) writeMsgUnlocked(msg []byte, fds []uintptr, fdpass FdPasser, oneway bool) error {
+	if session.wframing == framingLengthPrefixed {
+		if err := session.writeFrameUnlocked(msg, fds, fdpass); err != nil {
+			return err
+		}
+	} else {
+		if _, err := session.rw.Write(msg); err != nil {
+			return err
+		}
+
+		if len(fds) > 0 {
+			fdpass.PassFds(fds...)
+		}
 
-	return session.rw.Flush()
+		if _, err := session.rw.Write([]byte("\x00")); err != nil {
+			return err
+		}
+	}
+
+	if oneway && session.batching != nil && len(fds) == 0 {
+		return session.batchUnlocked(len(msg) + 1)
+	}
+	return session.flushUnlocked()
 }
 
 func (session *Session) readMsgUnlocked() (msg []byte, fds []uintptr, err error) {
-	msg, err = session.rw.ReadBytes('\x00')
+	if session.rframing == framingLengthPrefixed {
+		msg, err = session.readFrameUnlocked()
+	} else {
+		msg, session.rbuf, err = scanFrame(session.rw.Reader, session.rbuf, session.maxMessage)
+	}
 	switch {
 	case err == io.EOF:
 		return nil, nil, ErrPeerDisconnected
@@ -301,11 +618,19 @@ func (session *Session) readMsgUnlocked() (msg []byte, fds []uintptr, err error)
 		return nil, nil, err
 	}
 
+	if session.faults != nil {
+		msg = session.faults.InjectRead(msg)
+	}
+	if session.stats != nil {
+		session.stats.MessagesRead.Add(1)
+		session.stats.BytesRead.Add(int64(len(msg)))
+	}
+
 	if fdpass, ok := session.conn.(FdPasser); ok {
 		fds = fdpass.CollectFds()
 	}
 
-	return msg[:len(msg)-1], fds, nil
+	return msg, fds, nil
 }
 
 func (session *Session) Hijack() (conn net.Conn, rbuf []byte, err error) {
@@ -314,12 +639,38 @@ func (session *Session) Hijack() (conn net.Conn, rbuf []byte, err error) {
 	defer session.rcond.L.Unlock()
 	defer session.wmu.Unlock()
 
+	if err := session.flushUnlocked(); err != nil {
+		return nil, nil, err
+	}
 	conn = session.conn
 	rbuf, err = session.rw.Peek(session.rw.Reader.Buffered())
 	session.conn = nil
 	return conn, rbuf, err
 }
 
+// CloseWrite sends the batched one-way calls, if any, and shuts down the
+// writing side of the connection, which tells the peer that no more calls
+// are coming while the replies to the calls in progress, including
+// streamed ones, can still be read. Servers with DrainOnEOF set serve the
+// calls they already read before ending the session.
+//
+// CloseWrite is supported by connections with a CloseWrite method, like
+// unix and tcp connections; on others, it returns an error wrapping
+// errors.ErrUnsupported.
+func (session *Session) CloseWrite() error {
+	session.wmu.Lock()
+	defer session.wmu.Unlock()
+
+	conn, ok := session.conn.(interface{ CloseWrite() error })
+	if !ok {
+		return fmt.Errorf("closing the writing side of %T: %w", session.conn, errors.ErrUnsupported)
+	}
+	if err := session.flushUnlocked(); err != nil {
+		return err
+	}
+	return conn.CloseWrite()
+}
+
 // Close terminates the session and closes the underlying connection.
 func (session *Session) Close() error {
 	session.wmu.Lock()
@@ -327,27 +678,11 @@ func (session *Session) Close() error {
 	defer session.rcond.L.Unlock()
 	defer session.wmu.Unlock()
 
+	// Send the batched one-way calls, if any, on a best-effort basis.
+	session.flushUnlocked()
+
+	session.cond.L.Lock()
 	session.cond.Broadcast()
+	session.cond.L.Unlock()
 	return session.conn.Close()
 }
-
-// Dial opens a session for the specified uri.
-func Dial(ctx context.Context, uri string) (*Session, error) {
-	u, err := ParseURI(uri)
-	if err != nil {
-		return nil, err
-	}
-
-	var conn net.Conn
-	switch u.Scheme {
-	case "tcp", "unix":
-		conn, err = net.Dial(u.Scheme, u.Address)
-	default:
-		err = fmt.Errorf("dial %v: %w", u, ErrUnsupportedScheme)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return NewSession(conn), nil
-}
@@ -0,0 +1,411 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScanFrame(t *testing.T) {
+	small := `{"method":"org.example.Ping"}`
+	large := `{"parameters":"` + strings.Repeat("x", 64) + `"}`
+
+	in := small + "\x00" + large + "\x00" + small + "\x00"
+
+	// Use the smallest buffer size bufio allows to force the copying path
+	// on the large frame.
+	r := bufio.NewReaderSize(strings.NewReader(in), 16)
+
+	var buf []byte
+	for _, expected := range []string{small, large, small} {
+		var (
+			frame []byte
+			err   error
+		)
+		frame, buf, err = scanFrame(r, buf, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(frame) != expected {
+			t.Fatalf("read frame %q, expected %q", frame, expected)
+		}
+	}
+
+	if _, _, err := scanFrame(r, buf, 0); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+type countingConn struct {
+	net.Conn
+	read atomic.Int64
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.read.Add(int64(n))
+	return n, err
+}
+
+func TestCompression(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sconn, cconn := net.Pipe()
+	counter := &countingConn{Conn: cconn}
+	client := NewSession(counter)
+	defer client.Close()
+
+	var mux ServeMux
+	mux.HandleFunc("org.example.Echo", func(w ReplyWriter, call *Call) {
+		w.WriteReply(call.Parameters)
+	})
+	server := Server{
+		Handler:     &mux,
+		Compression: &CompressionOptions{Algorithm: CompressionGzip},
+	}
+	go server.ServeSession(ctx, NewSession(sconn))
+
+	ok, err := client.NegotiateCompression(ctx, CompressionOptions{Algorithm: CompressionGzip})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("compression was not negotiated")
+	}
+
+	params := map[string]string{"data": strings.Repeat("varlink", 4096)}
+	call, err := MakeCall("org.example.Echo", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+
+	before := counter.read.Load()
+
+	var reply Reply
+	if err := client.ReadReply(ctx, &call, &reply); err != nil {
+		t.Fatal(err)
+	}
+	var out map[string]string
+	if err := reply.Unmarshal(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out["data"] != params["data"] {
+		t.Fatal("reply does not match the call parameters")
+	}
+	if n := counter.read.Load() - before; n >= int64(len(params["data"])) {
+		t.Fatalf("read %d bytes for a %d bytes reply; reply was not compressed", n, len(params["data"]))
+	}
+}
+
+func benchmarkFrames(n int) []byte {
+	var in bytes.Buffer
+	for range n {
+		in.WriteString(`{"method":"org.example.encoding.Ping","parameters":{"ping":"Hello, world!"}}`)
+		in.WriteByte(0)
+	}
+	return in.Bytes()
+}
+
+func BenchmarkScanFrame(b *testing.B) {
+	in := benchmarkFrames(1024)
+	rd := bytes.NewReader(in)
+	r := bufio.NewReader(rd)
+
+	var buf []byte
+	b.ReportAllocs()
+	b.SetBytes(int64(len(in)) / 1024)
+	for i := 0; i < b.N; i++ {
+		var err error
+		_, buf, err = scanFrame(r, buf, 0)
+		if err == io.EOF {
+			rd.Reset(in)
+			r.Reset(rd)
+			continue
+		}
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkReadBytes measures the previous approach of reading frames, for
+// comparison with BenchmarkScanFrame.
+func BenchmarkReadBytes(b *testing.B) {
+	in := benchmarkFrames(1024)
+	rd := bytes.NewReader(in)
+	r := bufio.NewReader(rd)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(in)) / 1024)
+	for i := 0; i < b.N; i++ {
+		_, err := r.ReadBytes(0)
+		if err == io.EOF {
+			rd.Reset(in)
+			r.Reset(rd)
+			continue
+		}
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// echo makes a call on the session, and checks that its parameters are
+// echoed back.
+func echo(t *testing.T, ctx context.Context, session *Session, params any) {
+	t.Helper()
+
+	call, err := MakeCall("org.example.Echo", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := session.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	var reply Reply
+	if err := session.ReadReply(ctx, &call, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Error != "" {
+		t.Fatalf("got error %s", reply.Error)
+	}
+	expected, _ := json.Marshal(params)
+	if !bytes.Equal(reply.Parameters, expected) {
+		t.Fatalf("got reply %.40s, expected %.40s", reply.Parameters, expected)
+	}
+}
+
+func TestFraming(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sconn, cconn := net.Pipe()
+	client := NewSession(cconn)
+	defer client.Close()
+
+	var mux ServeMux
+	mux.HandleFunc("org.example.Echo", func(w ReplyWriter, call *Call) {
+		w.WriteReply(call.Parameters)
+	})
+	go (&Server{Handler: &mux}).ServeSession(ctx, NewSession(sconn))
+
+	echo(t, ctx, client, map[string]string{"data": "before"})
+
+	ok, err := client.NegotiateFraming(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("framing was not negotiated")
+	}
+	if client.rframing != framingLengthPrefixed || client.wframing != framingLengthPrefixed {
+		t.Fatal("client did not switch to length-prefixed framing")
+	}
+
+	// Send a message larger than the read buffer, which is read in parts.
+	echo(t, ctx, client, map[string]string{"data": "after"})
+	echo(t, ctx, client, map[string]string{"data": strings.Repeat("varlink", 4096)})
+}
+
+func TestFramingRejected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sconn, cconn := net.Pipe()
+	client, server := NewSession(cconn), NewSession(sconn)
+	defer client.Close()
+	defer server.Close()
+
+	// Serve the session without a Server, which does not know about framing
+	// negotiation.
+	go func() {
+		for {
+			var call Call
+			if err := server.ReadCall(ctx, &call); err != nil {
+				return
+			}
+			reply, _ := MakeReply(call.Parameters)
+			if call.Method != "org.example.Echo" {
+				reply, _ = MakeReply(nil, ErrorCode("org.varlink.service.MethodNotFound"))
+			}
+			if err := server.WriteReply(ctx, &reply); err != nil {
+				return
+			}
+		}
+	}()
+
+	ok, err := client.NegotiateFraming(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("framing was negotiated with a peer rejecting it")
+	}
+
+	// Both sides must keep using the standard framing.
+	echo(t, ctx, client, map[string]string{"data": "after"})
+	if server.rframing != framingNUL || client.wframing != framingNUL {
+		t.Fatal("a side of the session switched framing")
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+
+	session := NewSession(a)
+	session.CanonicalJSON(true)
+	defer session.Close()
+
+	call, err := MakeCall("org.example.Sign", map[string]any{
+		"z":    []any{json.RawMessage(`{ "y": 1.50, "x": "<&>" }`)},
+		"a":    true,
+		"html": "<b>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go session.WriteCall(context.Background(), &call)
+
+	frame, err := bufio.NewReader(b).ReadBytes(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"method":"org.example.Sign","parameters":{"a":true,"html":"<b>","z":[{"x":"<&>","y":1.50}]}}` + "\x00"
+	if string(frame) != expected {
+		t.Fatalf("wrote %s, expected %s", frame, expected)
+	}
+}
+
+func TestSessionOptions(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+
+	// The first message was already buffered by whoever read from the
+	// connection before the session was created.
+	first := `{"method":"org.example.First"}` + "\x00"
+	r := bufio.NewReader(io.MultiReader(strings.NewReader(first), a))
+
+	var stats SessionStats
+	session := NewSessionWithOptions(a, SessionOptions{
+		Reader:         r,
+		MaxMessageSize: 64,
+		Stats:          &stats,
+	})
+	defer session.Close()
+
+	ctx := context.Background()
+	go b.Write([]byte(`{"method":"org.example.Second"}` + "\x00" + `{"method":"org.example.` + strings.Repeat("x", 64) + `"}` + "\x00"))
+
+	for _, method := range []string{"org.example.First", "org.example.Second"} {
+		var call Call
+		if err := session.ReadCall(ctx, &call); err != nil {
+			t.Fatal(err)
+		}
+		if call.Method != method {
+			t.Fatalf("read call to %s, expected %s", call.Method, method)
+		}
+	}
+	var call Call
+	if err := session.ReadCall(ctx, &call); !errors.Is(err, ErrMessageTooLarge) {
+		t.Fatalf("reading a large message failed with %v, expected ErrMessageTooLarge", err)
+	}
+
+	if n := stats.MessagesRead.Load(); n != 2 {
+		t.Fatalf("read %d messages, expected 2", n)
+	}
+	if n := stats.BytesRead.Load(); n != int64(len(first)-1+len(`{"method":"org.example.Second"}`)) {
+		t.Fatalf("read %d bytes, expected the size of both messages", n)
+	}
+}
+
+func TestWriteTimeout(t *testing.T) {
+	call := Call{Method: "org.example.Ping", OneWay: true}
+
+	// Nobody reads from the peers, so writes block until they are
+	// interrupted. A session cannot be used after a write timed out.
+	stuck := func(opts SessionOptions) *Session {
+		a, b := net.Pipe()
+		t.Cleanup(func() { b.Close() })
+		session := NewSessionWithOptions(a, opts)
+		t.Cleanup(func() { session.Close() })
+		return session
+	}
+
+	session := stuck(SessionOptions{WriteTimeout: 10 * time.Millisecond})
+	err := session.WriteCall(context.Background(), &call)
+	if !errors.Is(err, ErrWriteTimeout) {
+		t.Fatalf("writing to a stuck peer failed with %v, expected ErrWriteTimeout", err)
+	}
+
+	session = stuck(SessionOptions{})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	err = session.WriteCall(ctx, &call)
+	if !errors.Is(err, ErrWriteTimeout) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceling a blocked write failed with %v, expected ErrWriteTimeout and context.Canceled", err)
+	}
+
+	// The deadline of a write must not outlive it.
+	a, b := net.Pipe()
+	defer b.Close()
+	go io.Copy(io.Discard, b)
+	session = NewSession(a)
+	defer session.Close()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := session.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := session.WriteCall(context.Background(), &call); err != nil {
+		t.Fatalf("writing after a deadline expired failed with %v", err)
+	}
+}
+
+func TestDecodeErrorBudget(t *testing.T) {
+	a, b := net.Pipe()
+	defer b.Close()
+
+	var stats SessionStats
+	session := NewSessionWithOptions(a, SessionOptions{
+		Stats:             &stats,
+		DecodeErrorBudget: DecodeErrorBudget{Max: 2},
+	})
+	defer session.Close()
+
+	ctx := context.Background()
+	go b.Write([]byte("garbage\x00{\x00" + `{"method":"org.example.Valid"}` + "\x00[\x00"))
+
+	var call Call
+	if err := session.ReadCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	if call.Method != "org.example.Valid" {
+		t.Fatalf("read call to %s, expected org.example.Valid", call.Method)
+	}
+	if err := session.ReadCall(ctx, &call); !errors.Is(err, ErrProtocol) {
+		t.Fatalf("reading past the budget failed with %v, expected ErrProtocol", err)
+	}
+	if n := stats.DecodeErrors.Load(); n != 3 {
+		t.Fatalf("counted %d decode errors, expected 3", n)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package sign implements signed varlink calls.
+//
+// Clients sign the parameters of their calls with a shared secret (HMAC
+// with SHA-256) or a private key (Ed25519), and servers verify the
+// signatures before serving the calls. This authenticates calls crossing
+// trust boundaries, like tcp connections, when deploying TLS is not
+// practical. Signatures do not encrypt calls, and replies are not signed.
+//
+// Signatures are carried by calls in the Extension extension field.
+// Extensions are not part of the varlink specification, and services
+// implemented with other libraries may reject calls carrying them: only sign
+// calls made to services known to accept them.
+//
+//	key := sign.HMACKey("deploy", secret)
+//
+//	// Client side
+//	rs, err := client.Call(ctx, "org.example.Deploy", &in, sign.Sign(key))
+//
+//	// Server side
+//	verifier := sign.Verifier{Keys: []*sign.Key{key}, Required: true}
+//	server := varlink.Server{
+//		Handler:      &mux,
+//		Interceptors: []varlink.Interceptor{verifier.Intercept},
+//	}
+package sign
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+)
+
+// Extension is the name of the call extension carrying signatures.
+const Extension = `snai.pe.varlink.Signature`
+
+// Signature algorithms.
+const (
+	HMACSHA256 = "hmac-sha256"
+	Ed25519    = "ed25519"
+)
+
+// DefaultMaxSkew is the default maximum difference between the time at
+// which a call was signed and the time at which it is verified.
+const DefaultMaxSkew = 5 * time.Minute
+
+// Errors returned by Verify.
+var (
+	ErrUnsigned         = errors.New("call is not signed")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrExpired          = errors.New("signature timestamp out of range")
+	ErrReplayed         = errors.New("signature replayed")
+)
+
+// Key is a key signing or verifying calls. Keys are identified by an ID,
+// which is sent with signatures so that verifiers know which key to use.
+type Key struct {
+	id         string
+	alg        string
+	secret     []byte
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+// HMACKey returns a key signing and verifying calls with HMAC-SHA256 and
+// the specified shared secret.
+func HMACKey(id string, secret []byte) *Key {
+	return &Key{id: id, alg: HMACSHA256, secret: secret}
+}
+
+// Ed25519Key returns a key signing calls with the specified Ed25519 private
+// key, and verifying them with its public key.
+func Ed25519Key(id string, key ed25519.PrivateKey) *Key {
+	return &Key{id: id, alg: Ed25519, privateKey: key, publicKey: key.Public().(ed25519.PublicKey)}
+}
+
+// Ed25519PublicKey returns a key verifying calls with the specified Ed25519
+// public key. It cannot sign calls.
+func Ed25519PublicKey(id string, key ed25519.PublicKey) *Key {
+	return &Key{id: id, alg: Ed25519, publicKey: key}
+}
+
+// ID returns the ID of the key.
+func (k *Key) ID() string {
+	return k.id
+}
+
+// Algorithm returns the signature algorithm of the key.
+func (k *Key) Algorithm() string {
+	return k.alg
+}
+
+func (k *Key) sign(data []byte) ([]byte, error) {
+	switch {
+	case k.alg == HMACSHA256:
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write(data)
+		return mac.Sum(nil), nil
+	case k.alg == Ed25519 && k.privateKey != nil:
+		return ed25519.Sign(k.privateKey, data), nil
+	default:
+		return nil, fmt.Errorf("key %q cannot sign calls", k.id)
+	}
+}
+
+func (k *Key) verify(data, sig []byte) bool {
+	switch k.alg {
+	case HMACSHA256:
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write(data)
+		return hmac.Equal(mac.Sum(nil), sig)
+	case Ed25519:
+		return ed25519.Verify(k.publicKey, data, sig)
+	default:
+		return false
+	}
+}
+
+// envelope is the value of the signature extension.
+type envelope struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Timestamp int64  `json:"ts"`
+	Nonce     string `json:"nonce"`
+	Signature string `json:"sig"`
+}
+
+// signedData returns the data covered by the signature of a call: its
+// method and flags, the timestamp and nonce of the signature, and the
+// canonical form of its parameters, so that signatures survive the
+// re-encoding of the parameters by intermediaries.
+func signedData(call *varlink.Call, env *envelope) ([]byte, error) {
+	params := []byte("{}")
+	if len(bytes.TrimSpace(call.Parameters)) > 0 {
+		var err error
+		params, err = varlink.Canonicalize(call.Parameters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("varlink-signature-v1\n")
+	buf.WriteString(call.Method)
+	buf.WriteByte('\n')
+	buf.WriteString(signedFlags(call))
+	buf.WriteByte('\n')
+	buf.WriteString(strconv.FormatInt(env.Timestamp, 10))
+	buf.WriteByte('\n')
+	buf.WriteString(env.Nonce)
+	buf.WriteByte('\n')
+	buf.Write(params)
+	return buf.Bytes(), nil
+}
+
+// signedFlags returns the flags set on the call, separated by spaces.
+func signedFlags(call *varlink.Call) string {
+	var flags []string
+	if call.OneWay {
+		flags = append(flags, "oneway")
+	}
+	if call.More {
+		flags = append(flags, "more")
+	}
+	if call.Upgrade {
+		flags = append(flags, "upgrade")
+	}
+	return strings.Join(flags, " ")
+}
+
+// SignCall signs the call with the specified key, replacing any previous
+// signature.
+func SignCall(call *varlink.Call, key *Key) error {
+	var nonce [16]byte
+	rand.Read(nonce[:])
+
+	env := envelope{
+		Algorithm: key.alg,
+		KeyID:     key.id,
+		Timestamp: time.Now().Unix(),
+		Nonce:     base64.RawURLEncoding.EncodeToString(nonce[:]),
+	}
+
+	data, err := signedData(call, &env)
+	if err != nil {
+		return err
+	}
+	sig, err := key.sign(data)
+	if err != nil {
+		return err
+	}
+	env.Signature = base64.RawURLEncoding.EncodeToString(sig)
+
+	raw, err := json.Marshal(&env)
+	if err != nil {
+		return err
+	}
+	if call.Extensions == nil {
+		call.Extensions = make(map[string]json.RawMessage)
+	}
+	call.Extensions[Extension] = raw
+	return nil
+}
+
+// Sign returns a call option signing the call with the specified key.
+//
+// The flags of the call are signed, so Sign must come after the options
+// setting them, like varlink.More.
+func Sign(key *Key) varlink.CallOption {
+	return signOption{key}
+}
+
+type signOption struct {
+	key *Key
+}
+
+func (opt signOption) SetCallOption(call *varlink.Call) error {
+	return SignCall(call, opt.key)
+}
+
+// Verifier verifies the signatures of calls.
+type Verifier struct {
+	// Keys are the keys that calls may be signed with.
+	Keys []*Key
+
+	// Required, if true, makes unsigned calls fail verification. Otherwise,
+	// unsigned calls are accepted, but the signature of signed calls must
+	// be valid.
+	Required bool
+
+	// MaxSkew is the maximum difference between the time at which a call
+	// was signed and the time at which it is verified. If zero,
+	// DefaultMaxSkew is used.
+	MaxSkew time.Duration
+
+	// Now, if set, returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	mu     sync.Mutex
+	nonces map[string]time.Time
+}
+
+// Verify verifies the signature of the call.
+//
+// Signatures are rejected if they were made too long ago or too far in the
+// future, or if they were already verified, which prevents calls from
+// being replayed. The second check only holds within a single Verifier.
+func (v *Verifier) Verify(call *varlink.Call) error {
+	raw, ok := call.Extensions[Extension]
+	if !ok {
+		if v.Required {
+			return ErrUnsigned
+		}
+		return nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	var key *Key
+	for _, k := range v.Keys {
+		if k.id == env.KeyID && k.alg == env.Algorithm {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		return ErrUnknownKey
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(env.Signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	data, err := signedData(call, &env)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if !key.verify(data, sig) {
+		return ErrInvalidSignature
+	}
+
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	skew := v.MaxSkew
+	if skew == 0 {
+		skew = DefaultMaxSkew
+	}
+	t := now()
+	signed := time.Unix(env.Timestamp, 0)
+	if signed.Before(t.Add(-skew)) || signed.After(t.Add(skew)) {
+		return ErrExpired
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.nonces == nil {
+		v.nonces = make(map[string]time.Time)
+	}
+	for nonce, expiry := range v.nonces {
+		if t.After(expiry) {
+			delete(v.nonces, nonce)
+		}
+	}
+	nonce := env.KeyID + "/" + env.Nonce
+	if _, seen := v.nonces[nonce]; seen {
+		return ErrReplayed
+	}
+	v.nonces[nonce] = signed.Add(skew)
+	return nil
+}
+
+// Intercept is a varlink.Interceptor that verifies the signature of calls.
+// Calls failing verification fail with org.varlink.service.PermissionDenied.
+func (v *Verifier) Intercept(next varlink.MethodHandler) varlink.MethodHandler {
+	return varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		if err := v.Verify(call); err != nil {
+			w.WriteError(service.PermissionDenied())
+			return
+		}
+		next.ServeMethod(w, call)
+	})
+}
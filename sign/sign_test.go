@@ -0,0 +1,93 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package sign_test
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/sign"
+)
+
+func TestVerify(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []struct {
+		signer, verifier *sign.Key
+	}{
+		{sign.HMACKey("shared", []byte("secret")), sign.HMACKey("shared", []byte("secret"))},
+		{sign.Ed25519Key("ed", priv), sign.Ed25519PublicKey("ed", pub)},
+	}
+
+	for _, key := range keys {
+		verifier := sign.Verifier{Keys: []*sign.Key{key.verifier}, Required: true}
+
+		call, err := varlink.MakeCall("org.example.Deploy", map[string]any{"b": 1, "a": "x"}, sign.Sign(key.signer))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Re-encoding the parameters does not invalidate the signature.
+		reencoded := call
+		reencoded.Parameters = json.RawMessage(`{ "a": "x", "b": 1 }`)
+		if err := verifier.Verify(&reencoded); err != nil {
+			t.Fatalf("%s: valid signature rejected: %v", key.signer.Algorithm(), err)
+		}
+
+		if err := verifier.Verify(&call); !errors.Is(err, sign.ErrReplayed) {
+			t.Fatalf("%s: replayed call verified with %v", key.signer.Algorithm(), err)
+		}
+
+		tampered, _ := varlink.MakeCall("org.example.Deploy", map[string]any{"b": 2, "a": "x"})
+		tampered.Extensions = call.Extensions
+		if err := verifier.Verify(&tampered); !errors.Is(err, sign.ErrInvalidSignature) {
+			t.Fatalf("%s: tampered call verified with %v", key.signer.Algorithm(), err)
+		}
+
+		// The flags of the call are signed too.
+		for _, flag := range []func(*varlink.Call){
+			func(c *varlink.Call) { c.OneWay = true },
+			func(c *varlink.Call) { c.More = true },
+			func(c *varlink.Call) { c.Upgrade = true },
+		} {
+			flagged, _ := varlink.MakeCall("org.example.Deploy", map[string]any{"b": 1, "a": "x"}, sign.Sign(key.signer))
+			flag(&flagged)
+			if err := verifier.Verify(&flagged); !errors.Is(err, sign.ErrInvalidSignature) {
+				t.Fatalf("%s: call with tampered flags verified with %v", key.signer.Algorithm(), err)
+			}
+		}
+		more, _ := varlink.MakeCall("org.example.Deploy", nil, varlink.More(), sign.Sign(key.signer))
+		if err := verifier.Verify(&more); err != nil {
+			t.Fatalf("%s: valid signature of a call with flags rejected: %v", key.signer.Algorithm(), err)
+		}
+	}
+
+	key := sign.HMACKey("shared", []byte("secret"))
+	verifier := sign.Verifier{Keys: []*sign.Key{key}, Required: true}
+
+	unsigned, _ := varlink.MakeCall("org.example.Deploy", nil)
+	if err := verifier.Verify(&unsigned); !errors.Is(err, sign.ErrUnsigned) {
+		t.Fatalf("unsigned call verified with %v", err)
+	}
+
+	wrongKey, _ := varlink.MakeCall("org.example.Deploy", nil, sign.Sign(sign.HMACKey("shared", []byte("other"))))
+	if err := verifier.Verify(&wrongKey); !errors.Is(err, sign.ErrInvalidSignature) {
+		t.Fatalf("call signed with the wrong secret verified with %v", err)
+	}
+
+	verifier.Now = func() time.Time { return time.Now().Add(time.Hour) }
+	old, _ := varlink.MakeCall("org.example.Deploy", nil, sign.Sign(key))
+	if err := verifier.Verify(&old); !errors.Is(err, sign.ErrExpired) {
+		t.Fatalf("expired call verified with %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build dragonfly || freebsd || netbsd || openbsd
+
+package varlink
+
+import "syscall"
+
+// The BSDs support MSG_CMSG_CLOEXEC like Linux does.
+
+const (
+	cmsgCloexec = true
+	recvFlags   = syscall.MSG_CMSG_CLOEXEC | syscall.MSG_DONTWAIT | syscall.MSG_WAITALL
+	sendFlags   = syscall.MSG_DONTWAIT
+)
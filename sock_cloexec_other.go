@@ -0,0 +1,20 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix && !linux && !dragonfly && !freebsd && !netbsd && !openbsd
+
+package varlink
+
+import "syscall"
+
+// macOS, Solaris and AIX do not support MSG_CMSG_CLOEXEC, so received file
+// descriptors are marked close-on-exec after the fact. MSG_DONTWAIT is not
+// needed either, since the sockets of package net are non-blocking.
+
+const (
+	cmsgCloexec = false
+	recvFlags   = syscall.MSG_WAITALL
+	sendFlags   = 0
+)
@@ -0,0 +1,97 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	recvFlags = syscall.MSG_CMSG_CLOEXEC | syscall.MSG_DONTWAIT | syscall.MSG_WAITALL
+	sendFlags = syscall.MSG_DONTWAIT
+)
+
+func recvmsgEintr(fd uintptr, p, oob []byte, flags int) (n, oobn int, recvflags int, err error) {
+	var iov syscall.Iovec
+	iov.Base = unsafe.SliceData(p)
+	iov.SetLen(len(p))
+
+	var (
+		rsa syscall.RawSockaddrAny
+		msg syscall.Msghdr
+	)
+	msg.Name = (*byte)(unsafe.Pointer(&rsa))
+	msg.Namelen = uint32(syscall.SizeofSockaddrAny)
+	msg.Iov = &iov
+	msg.Iovlen = 1
+	msg.Control = unsafe.SliceData(oob)
+	msg.SetControllen(len(oob))
+
+	// The pointer to msg must be converted in the argument list of
+	// RawSyscall6 itself, so that msg stays in place during the call;
+	// passing it through another function as an uintptr would let the
+	// runtime move the stack, and the kernel write to the old copy of msg.
+	//
+	// Also retry on ENOBUFS -- this is a transient error that also needs to
+	// be retried. Unfortunately we can't bubble this up to the RawConn.Read/Write
+	// APIs because the poller is edge-triggered.
+	var (
+		n1    uintptr
+		errno = syscall.EINTR
+	)
+	for errno == syscall.EINTR || errno == syscall.ENOBUFS {
+		n1, _, errno = syscall.RawSyscall6(syscall.SYS_RECVMSG, fd, uintptr(unsafe.Pointer(&msg)), uintptr(flags), 0, 0, 0)
+	}
+	switch errno {
+	case 0:
+	case syscall.EAGAIN:
+		return 0, 0, 0, errno
+	default:
+		return 0, 0, 0, &os.SyscallError{Syscall: "recvmsg", Err: errno}
+	}
+
+	return int(n1), int(msg.Controllen), int(msg.Flags), nil
+}
+
+func sendmsgEintr(fd uintptr, p, oob []byte, flags int) (n int, err error) {
+	var iov syscall.Iovec
+	iov.Base = unsafe.SliceData(p)
+	iov.SetLen(len(p))
+
+	var msg syscall.Msghdr
+	msg.Iov = &iov
+	msg.Iovlen = 1
+	msg.Control = unsafe.SliceData(oob)
+	msg.SetControllen(len(oob))
+
+	// See recvmsgEintr.
+	var (
+		n1    uintptr
+		errno = syscall.EINTR
+	)
+	for errno == syscall.EINTR || errno == syscall.ENOBUFS {
+		n1, _, errno = syscall.RawSyscall6(syscall.SYS_SENDMSG, fd, uintptr(unsafe.Pointer(&msg)), uintptr(flags), 0, 0, 0)
+	}
+	switch errno {
+	case 0:
+	case syscall.EAGAIN, syscall.EMSGSIZE:
+		return 0, errno
+	default:
+		return 0, &os.SyscallError{Syscall: "sendmsg", Err: errno}
+	}
+
+	return int(n1), nil
+}
+
+func dup(fd uintptr) (uintptr, error) {
+	newfd, _, errno := syscall.RawSyscall(syscall.SYS_FCNTL, fd, syscall.F_DUPFD_CLOEXEC, 0)
+	if errno != 0 {
+		return ^uintptr(0), &os.SyscallError{Syscall: "fcntl F_DUPFD_CLOEXEC", Err: errno}
+	}
+	return newfd, nil
+}
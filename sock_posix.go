@@ -0,0 +1,78 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix && !linux
+
+package varlink
+
+import (
+	"os"
+	"syscall"
+)
+
+// On unix systems other than Linux, the raw system call numbers are either
+// not stable or not usable at all (macOS and OpenBSD only support system
+// calls made through libc), so the portable wrappers of package syscall are
+// used instead.
+
+func recvmsgEintr(fd uintptr, p, oob []byte, flags int) (n, oobn int, recvflags int, err error) {
+	if !cmsgCloexec {
+		// The received file descriptors must be marked close-on-exec
+		// before any fork can happen.
+		syscall.ForkLock.RLock()
+		defer syscall.ForkLock.RUnlock()
+	}
+
+	// See the Linux implementation for why ENOBUFS is retried.
+	err = syscall.EINTR
+	for err == syscall.EINTR || err == syscall.ENOBUFS {
+		n, oobn, recvflags, _, err = syscall.Recvmsg(int(fd), p, oob, flags)
+	}
+	switch err {
+	case nil:
+	case syscall.EAGAIN:
+		return 0, 0, 0, err
+	default:
+		return 0, 0, 0, &os.SyscallError{Syscall: "recvmsg", Err: err}
+	}
+
+	if !cmsgCloexec {
+		cmsgs, _ := syscall.ParseSocketControlMessage(oob[:oobn])
+		for _, cmsg := range cmsgs {
+			fds, _ := syscall.ParseUnixRights(&cmsg)
+			for _, fd := range fds {
+				syscall.CloseOnExec(fd)
+			}
+		}
+	}
+	return n, oobn, recvflags, nil
+}
+
+func sendmsgEintr(fd uintptr, p, oob []byte, flags int) (n int, err error) {
+	err = syscall.EINTR
+	for err == syscall.EINTR || err == syscall.ENOBUFS {
+		n, err = syscall.SendmsgN(int(fd), p, oob, nil, flags)
+	}
+	switch err {
+	case nil:
+	case syscall.EAGAIN, syscall.EMSGSIZE:
+		return 0, err
+	default:
+		return 0, &os.SyscallError{Syscall: "sendmsg", Err: err}
+	}
+	return n, nil
+}
+
+func dup(fd uintptr) (uintptr, error) {
+	syscall.ForkLock.RLock()
+	defer syscall.ForkLock.RUnlock()
+
+	newfd, err := syscall.Dup(int(fd))
+	if err != nil {
+		return ^uintptr(0), &os.SyscallError{Syscall: "dup", Err: err}
+	}
+	syscall.CloseOnExec(newfd)
+	return uintptr(newfd), nil
+}
@@ -13,10 +13,13 @@ import (
 	"os"
 	"sync"
 	"syscall"
-	"unsafe"
 )
 
-const _SCM_MAX_FD = 253 // man unix(7) documents this limit on Linux
+// _SCM_MAX_FD is the maximum number of file descriptors passed per message.
+// man unix(7) documents this limit on Linux; other systems allow more, but
+// the same limit applies everywhere so that peers behave the same on all
+// platforms.
+const _SCM_MAX_FD = 253
 
 func align2up(v, d int) int {
 	return ((v - 1) & ^(d - 1)) + d
@@ -30,88 +33,10 @@ var oobPool = sync.Pool{
 	New: makeOOBForFds,
 }
 
-func rawSyscall6Eintr(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, errno syscall.Errno) {
-	errno = syscall.EINTR
-	// Also retry on ENOBUFS -- this is a transient error that also needs to
-	// be retried. Unfortunately we can't bubble this up to the RawConn.Read/Write
-	// APIs because the poller is edge-triggered.
-	for errno == syscall.EINTR || errno == syscall.ENOBUFS {
-		r1, r2, errno = syscall.RawSyscall6(trap, a1, a2, a3, a4, a5, a6)
-	}
-	return
-}
-
-func recvmsgEintr(fd uintptr, p, oob []byte, flags uintptr) (n, oobn int, recvflags int, err error) {
-	var iov syscall.Iovec
-	iov.Base = unsafe.SliceData(p)
-	iov.SetLen(len(p))
-
-	var (
-		rsa syscall.RawSockaddrAny
-		msg syscall.Msghdr
-	)
-	msg.Name = (*byte)(unsafe.Pointer(&rsa))
-	msg.Namelen = uint32(syscall.SizeofSockaddrAny)
-	msg.Iov = &iov
-	msg.Iovlen = 1
-	msg.Control = unsafe.SliceData(oob)
-	msg.SetControllen(len(oob))
-
-	n1, _, errno := rawSyscall6Eintr(syscall.SYS_RECVMSG,
-		fd,
-		uintptr(unsafe.Pointer(&msg)),
-		uintptr(flags),
-		0,
-		0,
-		0,
-	)
-	switch errno {
-	case 0:
-	case syscall.EAGAIN:
-		return 0, 0, 0, errno
-	default:
-		return 0, 0, 0, &os.SyscallError{Syscall: "recvmsg", Err: errno}
-	}
-
-	return int(n1), int(msg.Controllen), int(msg.Flags), nil
-}
-
-func sendmsgEintr(fd uintptr, p, oob []byte, flags uintptr) (n int, err error) {
-	var iov syscall.Iovec
-	iov.Base = unsafe.SliceData(p)
-	iov.SetLen(len(p))
-
-	var msg syscall.Msghdr
-	msg.Iov = &iov
-	msg.Iovlen = 1
-	msg.Control = unsafe.SliceData(oob)
-	msg.SetControllen(len(oob))
-
-	n1, _, errno := rawSyscall6Eintr(syscall.SYS_SENDMSG,
-		fd,
-		uintptr(unsafe.Pointer(&msg)),
-		uintptr(flags),
-		0,
-		0,
-		0,
-	)
-	switch errno {
-	case 0:
-	case syscall.EAGAIN, syscall.EMSGSIZE:
-		return 0, errno
-	default:
-		return 0, &os.SyscallError{Syscall: "sendmsg", Err: errno}
-	}
-
-	return int(n1), nil
-}
-
-func recvmsg(socket syscall.RawConn, buf, oob []byte) (obuf, ooob []byte, err error) {
-	const flags = syscall.MSG_CMSG_CLOEXEC | syscall.MSG_DONTWAIT | syscall.MSG_WAITALL
-
+func recvmsg(socket syscall.RawConn, buf, oob []byte) (obuf, ooob []byte, truncated bool, err error) {
 	var cerr error
 	err = socket.Read(func(fd uintptr) bool {
-		n, oobn, _, err := recvmsgEintr(fd, buf, oob, flags)
+		n, oobn, recvflags, err := recvmsgEintr(fd, buf, oob, recvFlags)
 		switch err {
 		case nil:
 		case syscall.EAGAIN:
@@ -124,21 +49,22 @@ func recvmsg(socket syscall.RawConn, buf, oob []byte) (obuf, ooob []byte, err er
 		cerr = err
 		oob = oob[:oobn]
 		buf = buf[:n]
+		truncated = recvflags&syscall.MSG_CTRUNC != 0
 		return true
 	})
 	if err != nil || cerr != nil {
-		return nil, nil, errors.Join(err, cerr)
+		return nil, nil, false, errors.Join(err, cerr)
 	}
 	if len(buf) == 0 {
-		return nil, nil, io.EOF
+		return nil, nil, false, io.EOF
 	}
-	return buf, oob, nil
+	return buf, oob, truncated, nil
 }
 
 func recv(socket syscall.RawConn, buf []byte, fds []uintptr) (int, []uintptr, error) {
 	oob := make([]byte, syscall.CmsgSpace(_SCM_MAX_FD*4))
 
-	buf, oob, err := recvmsg(socket, buf, oob)
+	buf, oob, truncated, err := recvmsg(socket, buf, oob)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -146,19 +72,32 @@ func recv(socket syscall.RawConn, buf []byte, fds []uintptr) (int, []uintptr, er
 	cmsgs, err := syscall.ParseSocketControlMessage(oob)
 	numfds := 0
 	for _, cmsg := range cmsgs {
+		if cmsg.Header.Level != syscall.SOL_SOCKET || cmsg.Header.Type != syscall.SCM_RIGHTS {
+			continue
+		}
 		var parsed []int
 		parsed, err = syscall.ParseUnixRights(&cmsg)
 		if err != nil {
 			err = &os.SyscallError{Syscall: "parse unix rights", Err: err}
 			break
 		}
-		for i, fd := range parsed {
-			fds[i] = uintptr(fd)
+		for _, fd := range parsed {
+			if numfds == len(fds) {
+				// More descriptors than we can hold: the peer is not
+				// following the protocol, close the extra ones.
+				_ = syscall.Close(fd)
+				truncated = true
+				continue
+			}
+			fds[numfds] = uintptr(fd)
 			numfds++
 		}
 	}
+	if err == nil && truncated {
+		err = &os.SyscallError{Syscall: "recvmsg", Err: errFdsTruncated}
+	}
 	if err != nil {
-		for _, fd := range fds {
+		for _, fd := range fds[:numfds] {
 			_ = syscall.Close(int(fd))
 		}
 		return 0, nil, err
@@ -166,13 +105,17 @@ func recv(socket syscall.RawConn, buf []byte, fds []uintptr) (int, []uintptr, er
 	return len(buf), fds[:numfds], nil
 }
 
+// errFdsTruncated is returned when some of the file descriptors sent by the
+// peer were dropped, because they did not fit in the control buffer.
+var errFdsTruncated = errors.New("too many file descriptors in message")
+
 func sendmsg(socket syscall.RawConn, buf, oob []byte) (int, error) {
 	var (
 		n    int
 		cerr error
 	)
 	err := socket.Write(func(fd uintptr) bool {
-		n1, err := sendmsgEintr(fd, buf, oob, syscall.MSG_DONTWAIT)
+		n1, err := sendmsgEintr(fd, buf, oob, sendFlags)
 		switch err {
 		case nil:
 			n = n1
@@ -235,14 +178,6 @@ func getsndbufsz(conn syscall.RawConn) (sndbufsz int, err error) {
 	return
 }
 
-func dup(fd uintptr) (uintptr, error) {
-	newfd, _, errno := syscall.RawSyscall(syscall.SYS_FCNTL, fd, syscall.F_DUPFD_CLOEXEC, 0)
-	if errno != 0 {
-		return ^uintptr(0), &os.SyscallError{Syscall: "fcntl F_DUPFD_CLOEXEC", Err: errno}
-	}
-	return newfd, nil
-}
-
 func sysClose(fd uintptr) error {
 	return syscall.Close(int(fd))
 }
@@ -0,0 +1,98 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix
+
+package varlink
+
+import (
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestUnixConnFdPassing(t *testing.T) {
+	a, b := socketpair(t)
+	sender := &UnixConn{conn: a.(*net.UnixConn)}
+	receiver := &UnixConn{conn: b.(*net.UnixConn)}
+	defer sender.Close()
+	defer receiver.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	// Pass the maximum number of file descriptors in a single message,
+	// which must be supported on every platform.
+	fds := make([]uintptr, _SCM_MAX_FD)
+	for i := range fds {
+		fds[i] = r.Fd()
+	}
+	sender.PassFds(fds...)
+	if _, err := sender.Write([]byte("fds")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 16)
+	n, err := receiver.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "fds" {
+		t.Fatalf("read %q, expected the data sent along with the descriptors", buf[:n])
+	}
+	received := receiver.CollectFds()
+	if len(received) != len(fds) {
+		t.Fatalf("received %d file descriptors, expected %d", len(received), len(fds))
+	}
+	defer func() {
+		for _, fd := range received {
+			sysClose(fd)
+		}
+	}()
+
+	for _, fd := range received {
+		flags, err := fcntlGetfd(fd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if flags&syscall.FD_CLOEXEC == 0 {
+			t.Fatalf("received file descriptor %d is not close-on-exec", fd)
+		}
+	}
+
+	// The received descriptors refer to the read end of the pipe.
+	if _, err := w.Write([]byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	f := os.NewFile(received[0], "pipe")
+	received = received[1:]
+	defer f.Close()
+	if _, err := io.ReadFull(f, buf[:1]); err != nil || buf[0] != 'x' {
+		t.Fatalf("reading from a received descriptor returned %q, %v", buf[:1], err)
+	}
+
+	dupfd, err := dup(r.Fd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sysClose(dupfd)
+	if flags, err := fcntlGetfd(dupfd); err != nil || flags&syscall.FD_CLOEXEC == 0 {
+		t.Fatalf("duplicated file descriptor is not close-on-exec (flags %#x, %v)", flags, err)
+	}
+}
+
+func fcntlGetfd(fd uintptr) (int, error) {
+	flags, _, errno := syscall.Syscall(syscall.SYS_FCNTL, fd, syscall.F_GETFD, 0)
+	if errno != 0 {
+		return 0, errno
+	}
+	return int(flags), nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"context"
+	"maps"
+	"sync/atomic"
+	"time"
+)
+
+// CallStats are the statistics of a call served by a Server, as reported to
+// its StatsHook.
+type CallStats struct {
+	// Method is the method that was called.
+	Method string
+
+	// Received is the time at which the call was read from the session.
+	Received time.Time
+
+	// Queued is how long the call waited in the pipeline of the session
+	// before being passed to the handler.
+	Queued time.Duration
+
+	// Duration is how long the handler took to serve the call.
+	Duration time.Duration
+
+	// Replies is the number of replies written by the handler.
+	Replies int
+
+	// Error is the error code of the final reply, if it was an error.
+	Error string
+
+	// Annotations are the values attached to the call with AnnotateCall.
+	Annotations map[string]any
+}
+
+// SessionStats accumulate the traffic of the sessions created with them in
+// their SessionOptions. Sizes are those of the messages, excluding framing
+// and before compression.
+type SessionStats struct {
+	MessagesRead    atomic.Int64
+	MessagesWritten atomic.Int64
+	BytesRead       atomic.Int64
+	BytesWritten    atomic.Int64
+
+	// DecodeErrors is the number of messages read that failed to decode.
+	// See [DecodeErrorBudget].
+	DecodeErrors atomic.Int64
+}
+
+type callStatsKey struct{}
+
+// AnnotateCall attaches a named value to the statistics of the call being
+// served with ctx, which are reported to the StatsHook of the server in
+// CallStats.Annotations. Interceptors use it to expose their own counters.
+//
+// AnnotateCall does nothing if ctx is not the context of a call served by
+// a server with a StatsHook.
+func AnnotateCall(ctx context.Context, key string, value any) {
+	w, _ := ctx.Value(callStatsKey{}).(*replyWriter)
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.annotations == nil {
+		w.annotations = make(map[string]any)
+	}
+	w.annotations[key] = value
+}
+
+func (w *replyWriter) stats(started time.Time, duration time.Duration) CallStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	stats := CallStats{
+		Method:      w.call.Method,
+		Received:    w.call.ReceivedAt,
+		Duration:    duration,
+		Replies:     w.replies,
+		Error:       w.errorCode,
+		Annotations: maps.Clone(w.annotations),
+	}
+	if !stats.Received.IsZero() {
+		stats.Queued = started.Sub(stats.Received)
+	}
+	return stats
+}
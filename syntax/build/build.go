@@ -0,0 +1,242 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package build assembles varlink interface definitions programmatically.
+//
+// It is meant for services that build their schema at runtime, and need a
+// correct description to register with varlink.ServeMux.SetDescription:
+//
+//	desc, err := build.Interface("org.example.dynamic", "A dynamic interface.").
+//		Type("Color", build.Enum("red", "green", "blue")).
+//		Method("Paint",
+//			build.Struct(build.Field("color", build.Named("Color"))),
+//			build.Struct(build.Field("ok", build.Bool)),
+//			"Paints with a color.").
+//		Error("Unavailable", build.Struct(build.Field("color", build.Named("Color")))).
+//		Description()
+//
+// Doc arguments are written as comments before what they document, one per
+// line; an empty string separates paragraphs.
+package build
+
+import (
+	"errors"
+	"fmt"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+// Builtin types.
+var (
+	Bool   = syntax.BuiltinType{Name: "bool"}
+	Int    = syntax.BuiltinType{Name: "int"}
+	Float  = syntax.BuiltinType{Name: "float64"}
+	String = syntax.BuiltinType{Name: "string"}
+	Object = syntax.BuiltinType{Name: "json.RawMessage"}
+)
+
+// Struct returns a struct type made of the specified fields.
+func Struct(fields ...syntax.StructField) syntax.StructType {
+	return syntax.StructType{Fields: append([]syntax.StructField{}, fields...)}
+}
+
+// Field returns a struct field.
+func Field(name string, t syntax.Type, doc ...string) syntax.StructField {
+	return syntax.StructField{Node: node(doc), Name: name, Type: t}
+}
+
+// Enum returns an enum type made of the specified values.
+func Enum(values ...string) syntax.EnumType {
+	enum := syntax.EnumType{Values: make([]syntax.EnumValue, len(values))}
+	for i, v := range values {
+		enum.Values[i].Name = v
+	}
+	return enum
+}
+
+// Array returns the type of arrays of elements of type t.
+func Array(t syntax.Type) syntax.ArrayType {
+	return syntax.ArrayType{ElemType: t}
+}
+
+// Dict returns the type of maps from strings to values of type t.
+func Dict(t syntax.Type) syntax.DictType {
+	return syntax.DictType{ElemType: t}
+}
+
+// Nullable returns the nullable variant of type t.
+func Nullable(t syntax.Type) syntax.NullableType {
+	return syntax.NullableType{Type: t}
+}
+
+// Named returns a reference to the type with the specified name, which must
+// be defined by the interface.
+func Named(name string) syntax.NamedType {
+	return syntax.NamedType{Name: name}
+}
+
+// Builder builds an interface definition. The zero value is not usable; use
+// Interface to create one.
+type Builder struct {
+	intf syntax.InterfaceDef
+}
+
+// Interface starts the definition of the interface with the specified
+// fully-qualified name.
+func Interface(name string, doc ...string) *Builder {
+	return &Builder{intf: syntax.InterfaceDef{Node: node(doc), Name: name}}
+}
+
+// Type adds the definition of a named type.
+func (b *Builder) Type(name string, t syntax.Type, doc ...string) *Builder {
+	b.intf.Types = append(b.intf.Types, syntax.TypeDef{Node: node(doc), Name: name, Type: t})
+	return b
+}
+
+// Method adds the definition of a method.
+func (b *Builder) Method(name string, input, output syntax.StructType, doc ...string) *Builder {
+	b.intf.Methods = append(b.intf.Methods, syntax.MethodDef{Node: node(doc), Name: name, Input: input, Output: output})
+	return b
+}
+
+// Error adds the definition of an error.
+func (b *Builder) Error(name string, params syntax.StructType, doc ...string) *Builder {
+	b.intf.Errors = append(b.intf.Errors, syntax.ErrorDef{Node: node(doc), Name: name, Params: params})
+	return b
+}
+
+// Build validates the interface and returns its definition.
+//
+// Names must follow the grammar of the interface description language, and
+// types, methods and errors, which share a namespace, must not be defined
+// twice. Named types must be defined by the interface. All problems are reported, joined in the returned error.
+func (b *Builder) Build() (syntax.InterfaceDef, error) {
+	v := validator{intf: &b.intf, names: make(map[string]bool)}
+	v.check(syntax.IsValidInterfaceName, "interface", b.intf.Name, "interface name")
+
+	for _, t := range b.intf.Types {
+		v.define("type", t.Name)
+		v.typ("type "+t.Name, t.Type)
+	}
+	for _, m := range b.intf.Methods {
+		v.define("method", m.Name)
+		v.typ("method "+m.Name+" input", m.Input)
+		v.typ("method "+m.Name+" output", m.Output)
+	}
+	for _, e := range b.intf.Errors {
+		v.define("error", e.Name)
+		v.typ("error "+e.Name, e.Params)
+	}
+
+	if len(v.errs) > 0 {
+		return syntax.InterfaceDef{}, errors.Join(v.errs...)
+	}
+	return b.intf, nil
+}
+
+// Description validates the interface, and returns its description in the
+// interface description language.
+func (b *Builder) Description() (string, error) {
+	intf, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+	desc, err := syntax.Format(intf)
+	if err != nil {
+		return "", err
+	}
+	return string(desc), nil
+}
+
+type validator struct {
+	intf  *syntax.InterfaceDef
+	names map[string]bool
+	errs  []error
+}
+
+func (v *validator) fail(path, format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) check(valid func(string) bool, path, name, what string) {
+	if !valid(name) {
+		v.fail(path, "invalid %s %q", what, name)
+	}
+}
+
+// define checks the name of a type, method or error definition.
+func (v *validator) define(kind, name string) {
+	path := kind + " " + name
+	v.check(syntax.IsValidTypeName, path, name, kind+" name")
+	if v.names[name] {
+		v.fail(path, "%s is already defined", name)
+	}
+	v.names[name] = true
+}
+
+func (v *validator) typ(path string, t syntax.Type) {
+	switch t := t.(type) {
+	case syntax.StructType:
+		fields := make(map[string]bool)
+		for _, f := range t.Fields {
+			fpath := path + "." + f.Name
+			v.check(syntax.IsValidFieldName, fpath, f.Name, "field name")
+			if fields[f.Name] {
+				v.fail(fpath, "field is already defined")
+			}
+			fields[f.Name] = true
+			v.typ(fpath, f.Type)
+		}
+	case syntax.EnumType:
+		values := make(map[string]bool)
+		for _, e := range t.Values {
+			v.check(syntax.IsValidFieldName, path, e.Name, "enum value")
+			if values[e.Name] {
+				v.fail(path, "enum value %q is already defined", e.Name)
+			}
+			values[e.Name] = true
+		}
+	case syntax.ArrayType:
+		v.typ(path+"[]", t.ElemType)
+	case syntax.DictType:
+		v.typ(path+"[string]", t.ElemType)
+	case syntax.NullableType:
+		if _, ok := t.Type.(syntax.NullableType); ok {
+			v.fail(path, "nullable types cannot be made nullable")
+		}
+		v.typ(path, t.Type)
+	case syntax.BuiltinType:
+		switch t.Name {
+		case Bool.Name, Int.Name, Float.Name, String.Name, Object.Name:
+		default:
+			v.fail(path, "unknown builtin type %q", t.Name)
+		}
+	case syntax.NamedType:
+		if !v.isType(t.Name) {
+			v.fail(path, "undefined type %q", t.Name)
+		}
+	case nil:
+		v.fail(path, "missing type")
+	default:
+		v.fail(path, "unknown type %T", t)
+	}
+}
+
+func (v *validator) isType(name string) bool {
+	for _, t := range v.intf.Types {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func node(doc []string) syntax.Node {
+	var n syntax.Node
+	for _, line := range doc {
+		n.Comments = append(n.Comments, syntax.Token{Type: syntax.TokenComment, Value: line})
+	}
+	return n
+}
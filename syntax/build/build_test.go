@@ -0,0 +1,90 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package build_test
+
+import (
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+	"snai.pe/go-varlink/syntax/build"
+)
+
+func TestBuilder(t *testing.T) {
+	b := build.Interface("org.example.dynamic", "A dynamic interface.").
+		Type("Color", build.Enum("red", "green", "blue")).
+		Type("Canvas", build.Struct(
+			build.Field("size", build.Array(build.Int)),
+			build.Field("layers", build.Dict(build.Nullable(build.Named("Color"))), "Layers by name."),
+			build.Field("meta", build.Object),
+		)).
+		Method("Paint",
+			build.Struct(build.Field("color", build.Named("Color"))),
+			build.Struct(build.Field("ok", build.Bool)),
+			"Paints with a color.", "", "Returns whether it worked.").
+		Error("Unavailable", build.Struct(build.Field("ratio", build.Float), build.Field("why", build.String)))
+
+	desc, err := b.Description()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `# A dynamic interface.
+interface org.example.dynamic
+
+type Color (red, green, blue)
+
+type Canvas (
+  size: []int,
+  # Layers by name.
+  layers: [string]?Color,
+  meta: object
+)
+
+# Paints with a color.
+#
+# Returns whether it worked.
+method Paint(color: Color) -> (ok: bool)
+
+error Unavailable (ratio: float, why: string)
+`
+	if desc != expected {
+		t.Fatalf("got description:\n%s\nexpected:\n%s", desc, expected)
+	}
+
+	intf, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := syntax.VerifyFingerprint(desc, syntax.Fingerprint(intf)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuilderValidation(t *testing.T) {
+	_, err := build.Interface("example").
+		Type("color", build.Enum("red", "red")).
+		Type("Point", build.Struct(build.Field("x", build.Int), build.Field("x", build.Nullable(build.Nullable(build.Int))))).
+		Method("Point", build.Struct(build.Field("bad-name", build.Named("Missing"))), build.Struct()).
+		Build()
+	if err == nil {
+		t.Fatal("invalid interface was built")
+	}
+
+	for _, msg := range []string{
+		`interface: invalid interface name "example"`,
+		`type color: invalid type name "color"`,
+		`type color: enum value "red" is already defined`,
+		`type Point.x: field is already defined`,
+		`type Point.x: nullable types cannot be made nullable`,
+		`method Point: Point is already defined`,
+		`method Point input.bad-name: invalid field name "bad-name"`,
+		`method Point input.bad-name: undefined type "Missing"`,
+	} {
+		if !strings.Contains(err.Error(), msg) {
+			t.Errorf("error %q does not report %q", err, msg)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Canonicalize returns the normal form of an interface definition, which
+// only depends on what the interface defines and documents: two
+// definitions that only differ in layout have equal normal forms, as
+// compared with reflect.DeepEqual, and format to the same text.
+//
+// In the normal form, types come first, then methods, then errors, each
+// sorted by name; struct fields and enum values keep their order, which is
+// significant. Positions and lossless tokens are cleared, and comments are
+// all placed before the node they document, with their text trimmed and
+// any blank line separating them replaced by an empty comment, so that the
+// paragraphs of their documentation are preserved.
+func Canonicalize(intf InterfaceDef) InterfaceDef {
+	out := InterfaceDef{
+		Node:    canonicalNode(intf.Node),
+		Name:    intf.Name,
+		Types:   make([]TypeDef, len(intf.Types)),
+		Methods: make([]MethodDef, len(intf.Methods)),
+		Errors:  make([]ErrorDef, len(intf.Errors)),
+	}
+	for i, t := range intf.Types {
+		out.Types[i] = TypeDef{Node: canonicalNode(t.Node), Name: t.Name, Type: canonicalizeType(t.Type)}
+	}
+	for i, m := range intf.Methods {
+		out.Methods[i] = MethodDef{
+			Node:   canonicalNode(m.Node),
+			Name:   m.Name,
+			Input:  canonicalizeType(m.Input).(StructType),
+			Output: canonicalizeType(m.Output).(StructType),
+		}
+	}
+	for i, e := range intf.Errors {
+		out.Errors[i] = ErrorDef{Node: canonicalNode(e.Node), Name: e.Name, Params: canonicalizeType(e.Params).(StructType)}
+	}
+	slices.SortFunc(out.Types, func(a, b TypeDef) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(out.Methods, func(a, b MethodDef) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(out.Errors, func(a, b ErrorDef) int { return cmp.Compare(a.Name, b.Name) })
+	return out
+}
+
+func canonicalizeType(t Type) Type {
+	switch t := t.(type) {
+	case StructType:
+		fields := make([]StructField, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = StructField{Node: canonicalNode(f.Node), Name: f.Name, Type: canonicalizeType(f.Type)}
+		}
+		return StructType{Node: canonicalNode(t.Node), Fields: fields}
+	case EnumType:
+		values := make([]EnumValue, len(t.Values))
+		for i, v := range t.Values {
+			values[i] = EnumValue{Node: canonicalNode(v.Node), Name: v.Name}
+		}
+		return EnumType{Node: canonicalNode(t.Node), Values: values}
+	case ArrayType:
+		return ArrayType{Node: canonicalNode(t.Node), ElemType: canonicalizeType(t.ElemType)}
+	case DictType:
+		return DictType{Node: canonicalNode(t.Node), ElemType: canonicalizeType(t.ElemType)}
+	case NullableType:
+		return NullableType{Node: canonicalNode(t.Node), Type: canonicalizeType(t.Type)}
+	case BuiltinType:
+		return BuiltinType{Node: canonicalNode(t.Node), Name: t.Name}
+	case NamedType:
+		return NamedType{Node: canonicalNode(t.Node), Name: t.Name}
+	default:
+		panic(fmt.Sprintf("unknown type %T", t))
+	}
+}
+
+// canonicalNode returns the node without positions nor tokens, and with
+// normalized comments.
+func canonicalNode(n Node) Node {
+	var comments []Token
+	line := 0
+	for _, c := range n.Comments {
+		if line != 0 && c.Start.Line > line+1 {
+			comments = append(comments, Token{Type: TokenComment, Value: ""})
+		}
+		line = c.Start.Line
+
+		text, _ := c.Value.(string)
+		comments = append(comments, Token{Type: TokenComment, Value: strings.TrimSpace(text)})
+	}
+	return Node{Comments: comments}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestCanonicalize(t *testing.T) {
+	const a = `# An example
+interface org.example.canonical
+
+error NotFound (name: string)
+
+# Resolves names.
+#
+# Returns all addresses.
+method Resolve(name: string) -> (addresses: []string, ttl: int # seconds
+)
+
+type Color (red, green, blue)
+method Alias(name: string) -> ()
+`
+	const b = `#   An example
+interface org.example.canonical
+method Alias(name:string)->()
+#Resolves names.
+#
+#  Returns all addresses.
+method Resolve(name: string) -> (
+  addresses: []string,
+  # seconds
+  ttl: int
+)
+type Color(red,green,blue)
+error NotFound(name: string)
+`
+	const expected = `# An example
+interface org.example.canonical
+
+type Color (red, green, blue)
+
+method Alias(name: string) -> ()
+
+# Resolves names.
+#
+# Returns all addresses.
+method Resolve(name: string) -> (
+  addresses: []string,
+  # seconds
+  ttl: int
+)
+
+error NotFound (name: string)
+`
+
+	var canonical []syntax.InterfaceDef
+	for _, source := range []string{a, b} {
+		p := syntax.NewParser(strings.NewReader(source))
+		p.Lossless = true
+		intf, err := p.Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		canonical = append(canonical, syntax.Canonicalize(intf))
+	}
+	if !reflect.DeepEqual(canonical[0], canonical[1]) {
+		t.Fatalf("normal forms differ:\n%+v\n%+v", canonical[0], canonical[1])
+	}
+
+	out, err := syntax.Format(canonical[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Fatalf("formatted normal form:\n%s\nexpected:\n%s", out, expected)
+	}
+	if doc := canonical[0].Methods[1].Doc(); len(doc) != 2 {
+		t.Fatalf("got documentation %q, expected two paragraphs", doc)
+	}
+}
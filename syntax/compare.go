@@ -0,0 +1,296 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import "fmt"
+
+// Change is a difference between two versions of an interface definition,
+// as reported by Compare.
+type Change struct {
+	// Breaking is whether the change breaks clients or services written
+	// against the old version of the interface.
+	Breaking bool
+
+	// Path locates the change in the interface, like
+	// "method Resolve input.flags" or "type Record.labels[string]".
+	Path string
+
+	// Message describes the change.
+	Message string
+}
+
+func (c Change) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%s: %s: %s", kind, c.Path, c.Message)
+}
+
+// Compare reports the changes made to an interface definition between its
+// old and new versions, in the order of the definitions they affect.
+//
+// Whether a change is breaking depends on the direction in which the
+// affected type flows. Removing a definition or a field and changing a type
+// are always breaking. New fields are compatible, unless they are required
+// fields of method inputs, which old clients do not send. Making a field
+// nullable is compatible for inputs, and breaking for outputs and errors,
+// and the reverse holds for making it required. Likewise, removing enum
+// values is breaking for inputs, and adding enum values is breaking for
+// outputs and errors, whose old clients do not expect them. Named types
+// follow the directions of all the methods and errors using them in either
+// version, and unused named types are treated as flowing both ways. Changes
+// to named types are reported once, after those of methods and errors.
+func Compare(old, new InterfaceDef) []Change {
+	c := comparer{
+		oldTypes: make(map[string]Type),
+		newTypes: make(map[string]Type),
+		uses:     make(map[string]direction),
+	}
+	for _, t := range old.Types {
+		c.oldTypes[t.Name] = t.Type
+	}
+	for _, t := range new.Types {
+		c.newTypes[t.Name] = t.Type
+	}
+
+	for _, intf := range []InterfaceDef{old, new} {
+		for _, m := range intf.Methods {
+			c.use(m.Input, dirIn)
+			c.use(m.Output, dirOut)
+		}
+		for _, e := range intf.Errors {
+			c.use(e.Params, dirOut)
+		}
+	}
+
+	if old.Name != new.Name {
+		c.report(true, "interface", "renamed from %s to %s", old.Name, new.Name)
+	}
+
+	newMethods := make(map[string]MethodDef)
+	for _, m := range new.Methods {
+		newMethods[m.Name] = m
+	}
+	for _, m := range old.Methods {
+		path := "method " + m.Name
+		n, ok := newMethods[m.Name]
+		if !ok {
+			c.report(true, path, "method removed")
+			continue
+		}
+		c.typ(path+" input", dirIn, m.Input, n.Input)
+		c.typ(path+" output", dirOut, m.Output, n.Output)
+	}
+	for _, m := range new.Methods {
+		if !hasMethod(old.Methods, m.Name) {
+			c.report(false, "method "+m.Name, "method added")
+		}
+	}
+
+	newErrors := make(map[string]ErrorDef)
+	for _, e := range new.Errors {
+		newErrors[e.Name] = e
+	}
+	for _, e := range old.Errors {
+		path := "error " + e.Name
+		n, ok := newErrors[e.Name]
+		if !ok {
+			c.report(true, path, "error removed")
+			continue
+		}
+		c.typ(path, dirOut, e.Params, n.Params)
+	}
+	for _, e := range new.Errors {
+		if !hasError(old.Errors, e.Name) {
+			c.report(false, "error "+e.Name, "error added")
+		}
+	}
+
+	for _, t := range old.Types {
+		if _, ok := c.newTypes[t.Name]; !ok {
+			c.report(true, "type "+t.Name, "type removed")
+			continue
+		}
+		dir := c.uses[t.Name]
+		if dir == 0 {
+			dir = dirIn | dirOut
+		}
+		c.typ("type "+t.Name, dir, t.Type, c.newTypes[t.Name])
+	}
+	for _, t := range new.Types {
+		if _, ok := c.oldTypes[t.Name]; !ok {
+			c.report(false, "type "+t.Name, "type added")
+		}
+	}
+
+	return c.changes
+}
+
+// direction is the direction in which values of a type flow: inputs from
+// clients to services, outputs from services to clients.
+type direction int
+
+const (
+	dirIn direction = 1 << iota
+	dirOut
+)
+
+type comparer struct {
+	oldTypes, newTypes map[string]Type
+
+	// uses holds the directions in which named types flow.
+	uses map[string]direction
+
+	changes []Change
+}
+
+func (c *comparer) report(breaking bool, path, format string, args ...any) {
+	c.changes = append(c.changes, Change{
+		Breaking: breaking,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// use records that the named types referenced by t flow in the specified
+// direction.
+func (c *comparer) use(t Type, dir direction) {
+	Inspect(t, func(node any) bool {
+		named, ok := node.(NamedType)
+		if !ok || c.uses[named.Name]&dir == dir {
+			return true
+		}
+		c.uses[named.Name] |= dir
+		for _, types := range []map[string]Type{c.oldTypes, c.newTypes} {
+			if t, ok := types[named.Name]; ok {
+				c.use(t, dir)
+			}
+		}
+		return true
+	})
+}
+
+func (c *comparer) typ(path string, dir direction, old, new Type) {
+	oldNullable, newNullable := false, false
+	if t, ok := old.(NullableType); ok {
+		old, oldNullable = t.Type, true
+	}
+	if t, ok := new.(NullableType); ok {
+		new, newNullable = t.Type, true
+	}
+	switch {
+	case oldNullable && !newNullable:
+		c.report(dir&dirIn != 0, path, "no longer nullable")
+	case !oldNullable && newNullable:
+		c.report(dir&dirOut != 0, path, "now nullable")
+	}
+
+	switch old := old.(type) {
+	case NamedType:
+		// Named types are compared with the other definitions.
+		if new, ok := new.(NamedType); ok && new.Name == old.Name {
+			return
+		}
+	case BuiltinType:
+		if new, ok := new.(BuiltinType); ok && new.Name == old.Name {
+			return
+		}
+	case ArrayType:
+		if new, ok := new.(ArrayType); ok {
+			c.typ(path+"[]", dir, old.ElemType, new.ElemType)
+			return
+		}
+	case DictType:
+		if new, ok := new.(DictType); ok {
+			c.typ(path+"[string]", dir, old.ElemType, new.ElemType)
+			return
+		}
+	case StructType:
+		if new, ok := new.(StructType); ok {
+			c.structType(path, dir, old, new)
+			return
+		}
+	case EnumType:
+		if new, ok := new.(EnumType); ok {
+			c.enumType(path, dir, old, new)
+			return
+		}
+	}
+
+	var p printer
+	c.report(true, path, "type changed from %s to %s", p.inline(old), p.inline(new))
+}
+
+func (c *comparer) structType(path string, dir direction, old, new StructType) {
+	for _, f := range old.Fields {
+		i := fieldIndex(new.Fields, f.Name)
+		if i < 0 {
+			c.report(true, path+"."+f.Name, "field removed")
+			continue
+		}
+		c.typ(path+"."+f.Name, dir, f.Type, new.Fields[i].Type)
+	}
+	for _, f := range new.Fields {
+		if fieldIndex(old.Fields, f.Name) >= 0 {
+			continue
+		}
+		if _, nullable := f.Type.(NullableType); !nullable && dir&dirIn != 0 {
+			c.report(true, path+"."+f.Name, "required field added")
+		} else {
+			c.report(false, path+"."+f.Name, "field added")
+		}
+	}
+}
+
+func (c *comparer) enumType(path string, dir direction, old, new EnumType) {
+	for _, v := range old.Values {
+		if !hasValue(new.Values, v.Name) {
+			c.report(dir&dirIn != 0, path, "value %s removed", v.Name)
+		}
+	}
+	for _, v := range new.Values {
+		if !hasValue(old.Values, v.Name) {
+			c.report(dir&dirOut != 0, path, "value %s added", v.Name)
+		}
+	}
+}
+
+func hasMethod(methods []MethodDef, name string) bool {
+	for _, m := range methods {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasError(errors []ErrorDef, name string) bool {
+	for _, e := range errors {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldIndex(fields []StructField, name string) int {
+	for i, f := range fields {
+		if f.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasValue(values []EnumValue, name string) bool {
+	for _, v := range values {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestCompare(t *testing.T) {
+	const old = `interface org.example.compare
+type Mode (fast, safe)
+type Entry (name: string, size: int, tags: []string)
+method Get(name: string, mode: ?Mode) -> (entry: Entry, state: Mode)
+method Put(entry: Entry) -> ()
+method Delete(name: string) -> ()
+error NotFound (name: string)
+error Busy ()
+`
+	const new = `interface org.example.compare
+type Mode (fast, safe, lazy)
+type Entry (name: string, size: float, tags: []string, owner: ?string, checksum: string)
+method Get(name: string, mode: Mode, depth: ?int) -> (entry: Entry, state: ?Mode)
+method Put(entry: Entry) -> ()
+method List() -> (names: []string)
+error NotFound (name: string, hint: ?string)
+`
+	expected := []string{
+		"breaking: method Get input.mode: no longer nullable",
+		"compatible: method Get input.depth: field added",
+		"breaking: method Get output.state: now nullable",
+		"breaking: method Delete: method removed",
+		"compatible: method List: method added",
+		"compatible: error NotFound.hint: field added",
+		"breaking: error Busy: error removed",
+		"breaking: type Mode: value lazy added",
+		"breaking: type Entry.size: type changed from int to float",
+		"compatible: type Entry.owner: field added",
+		"breaking: type Entry.checksum: required field added",
+	}
+
+	parse := func(src string) syntax.InterfaceDef {
+		intf, err := syntax.NewParser(strings.NewReader(src)).Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return intf
+	}
+
+	var changes []string
+	for _, change := range syntax.Compare(parse(old), parse(new)) {
+		changes = append(changes, change.String())
+	}
+	if strings.Join(changes, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("got changes:\n%s\nexpected:\n%s", strings.Join(changes, "\n"), strings.Join(expected, "\n"))
+	}
+
+	if changes := syntax.Compare(parse(old), parse(old)); len(changes) != 0 {
+		t.Fatalf("comparing an interface to itself reported %v", changes)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Diagnostic is a syntax error along with its context in the document it
+// was found in, for reporting to users.
+type Diagnostic struct {
+	*Error
+
+	// Line is the text of the line of the error, without its line ending.
+	Line string
+
+	// Caret points at the text in error when printed under Line, with a
+	// "^" under its first character, and "~" under the rest of it.
+	Caret string
+
+	// Expected is the set of tokens that were expected instead of the
+	// text in error, if the error is about an unexpected token.
+	Expected []TokenType
+}
+
+// NewDiagnostic returns the diagnostic of a syntax error found in source, the
+// document that was parsed. It returns nil if err is not a syntax error.
+func NewDiagnostic(err error, source string) *Diagnostic {
+	var serr *Error
+	if !errors.As(err, &serr) {
+		return nil
+	}
+	d := Diagnostic{Error: serr}
+
+	var expected UnexpectedTokenError
+	if errors.As(serr.Err, &expected) {
+		d.Expected = expected
+	}
+
+	offset := min(max(serr.Offset, 0), len(source))
+	start := strings.LastIndexByte(source[:offset], '\n') + 1
+	end := strings.IndexByte(source[start:], '\n')
+	if end == -1 {
+		end = len(source)
+	} else {
+		end += start
+	}
+	d.Line = strings.TrimSuffix(source[start:end], "\r")
+
+	// Tabs are kept so that the caret lines up with the text, whatever the
+	// tab width of the terminal.
+	var caret strings.Builder
+	for _, r := range source[start:offset] {
+		if r == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+	if stop := min(serr.End.Offset, start+len(d.Line)); stop > offset {
+		n := utf8.RuneCountInString(source[offset:stop])
+		caret.WriteString(strings.Repeat("~", n-1))
+	}
+	d.Caret = caret.String()
+	return &d
+}
+
+// String formats the diagnostic in the style of compilers: the error, then
+// the line of the error, and the caret pointing at the text in error.
+func (d *Diagnostic) String() string {
+	return fmt.Sprintf("%v\n\t%s\n\t%s", d.Error, d.Line, d.Caret)
+}
+
+// FormatError formats an error returned while parsing source. Syntax errors
+// are formatted with their context, as described by Diagnostic, and other
+// errors as-is.
+func FormatError(err error, source string) string {
+	if d := NewDiagnostic(err, source); d != nil {
+		return d.String()
+	}
+	return err.Error()
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import "strings"
+
+// Doc is the documentation of a definition, extracted from its comments.
+// Each element is a paragraph, made of consecutive comment lines joined by
+// spaces.
+type Doc []string
+
+// ParseDoc extracts the documentation from the specified comments.
+//
+// Leading '#' markers and surrounding whitespace are stripped from each
+// comment. Comments that are empty once stripped, like a lone '#', and blank
+// lines between comments separate paragraphs.
+func ParseDoc(comments []Token) Doc {
+	var (
+		doc  Doc
+		para []string
+		line int
+	)
+	flush := func() {
+		if len(para) > 0 {
+			doc = append(doc, strings.Join(para, " "))
+			para = nil
+		}
+	}
+	for _, c := range comments {
+		if line != 0 && c.Start.Line > line+1 {
+			flush()
+		}
+		line = c.Start.Line
+
+		text, _ := c.Value.(string)
+		text = strings.TrimSpace(strings.TrimLeft(text, "#"))
+		if text == "" {
+			flush()
+			continue
+		}
+		para = append(para, text)
+	}
+	flush()
+	return doc
+}
+
+// Synopsis returns the first paragraph of the documentation.
+func (d Doc) Synopsis() string {
+	if len(d) == 0 {
+		return ""
+	}
+	return d[0]
+}
+
+// String returns the paragraphs of the documentation separated by blank
+// lines.
+func (d Doc) String() string {
+	return strings.Join(d, "\n\n")
+}
+
+// Doc returns the documentation of the node, extracted from its comments
+// with ParseDoc.
+func (n Node) Doc() Doc {
+	return ParseDoc(n.Comments)
+}
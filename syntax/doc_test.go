@@ -0,0 +1,54 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestDoc(t *testing.T) {
+	const source = `## The documentation of an interface.
+interface org.example.doc
+
+# Resolves names,
+# over the network.
+#
+# Results are cached.
+method Resolve(
+  # The name to resolve.
+  name: string
+) -> (
+  address: string # The first address.
+)
+`
+	intf, err := syntax.NewParser(strings.NewReader(source)).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	method := intf.Methods[0]
+	for _, tc := range []struct {
+		doc      syntax.Doc
+		expected syntax.Doc
+	}{
+		{intf.Doc(), syntax.Doc{"The documentation of an interface."}},
+		{method.Doc(), syntax.Doc{"Resolves names, over the network.", "Results are cached."}},
+		{method.Input.Fields[0].Doc(), syntax.Doc{"The name to resolve."}},
+		{method.Output.Fields[0].Doc(), syntax.Doc{"The first address."}},
+	} {
+		if !slices.Equal(tc.doc, tc.expected) {
+			t.Errorf("got doc %q, expected %q", tc.doc, tc.expected)
+		}
+	}
+
+	if s := method.Doc().Synopsis(); s != "Resolves names, over the network." {
+		t.Errorf("got synopsis %q", s)
+	}
+}
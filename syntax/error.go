@@ -23,6 +23,10 @@ type Error struct {
 	// The cursor position of the error
 	Cursor
 
+	// The position right after the text in error, which spans the bytes
+	// from Offset to End.Offset, for instance to underline it.
+	End Cursor
+
 	// The concrete error
 	Err error
 }
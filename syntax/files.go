@@ -0,0 +1,80 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ParseFiles parses the interface definitions of the specified files, each
+// of which may hold several definitions, as with Parser.ParseAll. Paths
+// naming directories stand for the .varlink files they contain, in lexical
+// order.
+//
+// ParseFiles parses every file, even after errors, and returns the
+// definitions that were parsed successfully along with the errors of all
+// files joined with errors.Join. Syntax errors are *Error values carrying
+// the name of their file. Interfaces defined more than once are reported
+// as errors as well.
+func ParseFiles(paths ...string) ([]InterfaceDef, error) {
+	var (
+		intfs []InterfaceDef
+		errs  []error
+		seen  = make(map[string]string)
+	)
+
+	parse := func(path string) {
+		f, err := os.Open(path)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		defer f.Close()
+
+		defs, err := NewParser(f).ParseAll()
+		if serr, ok := err.(*Error); ok {
+			serr.Filename = path
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, intf := range defs {
+			if prev, ok := seen[intf.Name]; ok {
+				errs = append(errs, fmt.Errorf("%s:%d:%d: interface %s is already defined in %s",
+					path, intf.Position.Line, intf.Position.Column, intf.Name, prev))
+				continue
+			}
+			seen[intf.Name] = path
+			intfs = append(intfs, intf)
+		}
+	}
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !info.IsDir() {
+			parse(path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".varlink" {
+				parse(filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	return intfs, errors.Join(errs...)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrFingerprintMismatch = errors.New("interface definition does not match fingerprint")
+)
+
+// Fingerprint returns a fingerprint of the interface definition, suitable
+// to detect whether two definitions describe the same interface.
+//
+// The fingerprint only depends on what the interface defines: comments,
+// whitespace, and the order in which types, methods and errors are defined
+// do not affect it.
+func Fingerprint(intf InterfaceDef) string {
+	defs := make([]string, 0, len(intf.Types)+len(intf.Methods)+len(intf.Errors))
+	for _, t := range intf.Types {
+		defs = append(defs, "type "+t.Name+" "+canonicalType(t.Type))
+	}
+	for _, m := range intf.Methods {
+		defs = append(defs, "method "+m.Name+canonicalType(m.Input)+" -> "+canonicalType(m.Output))
+	}
+	for _, e := range intf.Errors {
+		defs = append(defs, "error "+e.Name+" "+canonicalType(e.Params))
+	}
+	slices.Sort(defs)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "interface %s\n", intf.Name)
+	for _, def := range defs {
+		fmt.Fprintln(h, def)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func canonicalType(t Type) string {
+	switch t := t.(type) {
+	case StructType:
+		fields := make([]string, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = f.Name + ": " + canonicalType(f.Type)
+		}
+		return "(" + strings.Join(fields, ", ") + ")"
+	case EnumType:
+		values := make([]string, len(t.Values))
+		for i, v := range t.Values {
+			values[i] = v.Name
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+	case ArrayType:
+		return "[]" + canonicalType(t.ElemType)
+	case DictType:
+		return "[string]" + canonicalType(t.ElemType)
+	case NullableType:
+		return "?" + canonicalType(t.Type)
+	case BuiltinType:
+		return t.Name
+	case NamedType:
+		return t.Name
+	default:
+		panic(fmt.Sprintf("unknown type %T", t))
+	}
+}
+
+// VerifyFingerprint parses the specified interface description, and
+// checks that its fingerprint matches the expected one.
+//
+// It is typically used to check that code generated from an interface
+// definition matches the description that a service reports at runtime.
+func VerifyFingerprint(description, fingerprint string) error {
+	intf, err := NewParser(strings.NewReader(description)).Parse()
+	if err != nil {
+		return err
+	}
+	if actual := Fingerprint(intf); actual != fingerprint {
+		return fmt.Errorf("%s: %w (got %s, expected %s)", intf.Name, ErrFingerprintMismatch, actual, fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"errors"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+	stdtest1 "snai.pe/go-varlink/syntax/testdata/standard/org.example.encoding"
+)
+
+func TestFingerprint(t *testing.T) {
+	if err := stdtest1.VerifyAgainst(stdtest1.Description); err != nil {
+		t.Fatal(err)
+	}
+
+	reordered := `interface org.example.encoding
+method GetOrder(num: int) -> (order: Order)
+method Ping(ping: string) -> (pong: string)
+type Order (shipments: []Shipment, order_num: int, customer: string)
+type Shipment (name: string, description: string, size: int, weight: ?int)
+type State (start: ?bool, progress: ?int, end: ?bool)
+`
+	if err := stdtest1.VerifyAgainst(reordered); err != nil {
+		t.Fatalf("reordering definitions changed the fingerprint: %v", err)
+	}
+
+	changed := reordered + "error NotFound ()\n"
+	if err := stdtest1.VerifyAgainst(changed); !errors.Is(err, syntax.ErrFingerprintMismatch) {
+		t.Fatalf("expected fingerprint mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxLineWidth is the width past which Format breaks structs and enums
+// over multiple lines.
+const maxLineWidth = 80
+
+// Format returns the interface definition in the varlink interface
+// description language, in a canonical layout.
+//
+// Definitions are written in the order in which they appear in the parsed
+// document, separated by blank lines, along with their comments. Structs and
+// enums are written on a single line if they fit, and otherwise with one
+// field or value per line, indented by two spaces; comments on fields and
+// values always cause the latter. Since they parse to the same type, `any`
+// is written as `object`.
+//
+// Comments that are not attached to any node, like those separated from
+// the next definition by a blank line, are dropped, unless the interface
+// was parsed in lossless mode: they are then written as standalone blocks
+// where they appeared, or before the definition they appeared in.
+//
+// Formatting a parsed document, parsing the result and formatting it again
+// yields the same output.
+func Format(intf InterfaceDef) ([]byte, error) {
+	var p printer
+
+	detached := detachedComments(intf)
+	for len(detached) > 0 && detached[0][0].Start.Offset < intf.Position.Offset {
+		p.comments("", detached[0], -1)
+		p.buf.WriteByte('\n')
+		detached = detached[1:]
+	}
+	p.comments("", intf.Comments, -1)
+	fmt.Fprintf(&p.buf, "interface %s\n", intf.Name)
+
+	type def struct {
+		offset, end int
+		print       func()
+	}
+	var defs []def
+	for _, block := range detached {
+		defs = append(defs, def{block[0].Start.Offset, -1, func() {
+			p.comments("", block, -1)
+		}})
+	}
+	for _, t := range intf.Types {
+		defs = append(defs, def{t.Position.Offset, t.End.Offset, func() {
+			p.comments("", t.Comments, -1)
+			p.line("type "+t.Name+" ", t.Type)
+		}})
+	}
+	for _, m := range intf.Methods {
+		defs = append(defs, def{m.Position.Offset, m.End.Offset, func() {
+			p.comments("", m.Comments, -1)
+			input := p.typ(m.Input, "", len("method ")+utf8.RuneCountInString(m.Name))
+			p.line("method "+m.Name+input+" -> ", m.Output)
+		}})
+	}
+	for _, e := range intf.Errors {
+		defs = append(defs, def{e.Position.Offset, e.End.Offset, func() {
+			p.comments("", e.Comments, -1)
+			p.line("error "+e.Name+" ", e.Params)
+		}})
+	}
+	// Comments detached inside a definition are written before it; since
+	// they come first, sorting keeps them there.
+	for i := range detached {
+		for _, d := range defs[len(detached):] {
+			if d.offset <= defs[i].offset && defs[i].offset < d.end {
+				defs[i].offset = d.offset
+			}
+		}
+	}
+	slices.SortStableFunc(defs, func(a, b def) int {
+		return cmp.Compare(a.offset, b.offset)
+	})
+
+	for _, def := range defs {
+		p.buf.WriteByte('\n')
+		def.print()
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.buf.Bytes(), nil
+}
+
+// detachedComments returns the comments of a document parsed in lossless
+// mode that are not attached to any node, in blocks of comments on
+// consecutive lines.
+func detachedComments(intf InterfaceDef) [][]Token {
+	attached := make(map[int]bool)
+	Inspect(intf, func(node any) bool {
+		if n, ok := node.(interface{ comments() []Token }); ok {
+			for _, c := range n.comments() {
+				attached[c.Start.Offset] = true
+			}
+		}
+		return true
+	})
+
+	var (
+		blocks [][]Token
+		block  []Token
+	)
+	flush := func() {
+		if len(block) > 0 {
+			blocks = append(blocks, block)
+			block = nil
+		}
+	}
+	for _, tok := range intf.Tokens {
+		switch {
+		case tok.Type == TokenComment && !attached[tok.Start.Offset]:
+			if len(block) > 0 && tok.Start.Line > block[len(block)-1].Start.Line+1 {
+				flush()
+			}
+			block = append(block, tok)
+		case tok.Type == TokenWhitespace || tok.Type == TokenNewline:
+		default:
+			flush()
+		}
+	}
+	flush()
+	return blocks
+}
+
+type printer struct {
+	buf bytes.Buffer
+	err error
+}
+
+// line writes a line made of prefix followed by the specified type.
+func (p *printer) line(prefix string, t Type) {
+	p.buf.WriteString(prefix)
+	p.buf.WriteString(p.typ(t, "", lastLineWidth(prefix)))
+	p.buf.WriteByte('\n')
+}
+
+// comments writes the comments of a node that precede it, one per line.
+// Comments starting past offset follow the node on its last line, and are
+// not written; an offset of -1 means that all comments precede the node.
+func (p *printer) comments(indent string, comments []Token, offset int) {
+	for _, c := range comments {
+		if offset >= 0 && c.Start.Offset > offset {
+			continue
+		}
+		p.buf.WriteString(indent)
+		p.buf.WriteString(commentText(c))
+		p.buf.WriteByte('\n')
+	}
+}
+
+// trailing returns the comment following a node at the specified offset on
+// its last line, if any.
+func trailing(comments []Token, offset int) string {
+	for _, c := range comments {
+		if c.Start.Offset > offset {
+			return " " + commentText(c)
+		}
+	}
+	return ""
+}
+
+func commentText(c Token) string {
+	if c.Raw == "" {
+		value, _ := c.Value.(string)
+		return strings.TrimRightFunc("# "+value, unicode.IsSpace)
+	}
+	return strings.TrimRightFunc(c.Raw, unicode.IsSpace)
+}
+
+// typ returns the text of a type. Structs and enums are broken over
+// multiple lines at the specified indentation if they do not fit after
+// width columns.
+func (p *printer) typ(t Type, indent string, width int) string {
+	switch t := t.(type) {
+	case StructType:
+		if hasComments(t) || width+utf8.RuneCountInString(p.inline(t)) > maxLineWidth {
+			return p.structType(t, indent)
+		}
+		return p.inline(t)
+	case EnumType:
+		if hasComments(t) || width+utf8.RuneCountInString(p.inline(t)) > maxLineWidth {
+			return p.enumType(t, indent)
+		}
+		return p.inline(t)
+	case ArrayType:
+		return "[]" + p.typ(t.ElemType, indent, width+2)
+	case DictType:
+		return "[string]" + p.typ(t.ElemType, indent, width+8)
+	case NullableType:
+		return "?" + p.typ(t.Type, indent, width+1)
+	default:
+		return p.inline(t)
+	}
+}
+
+// inline returns the text of a type written on a single line.
+func (p *printer) inline(t Type) string {
+	switch t := t.(type) {
+	case StructType:
+		fields := make([]string, len(t.Fields))
+		for i, f := range t.Fields {
+			fields[i] = f.Name + ": " + p.inline(f.Type)
+		}
+		return "(" + strings.Join(fields, ", ") + ")"
+	case EnumType:
+		values := make([]string, len(t.Values))
+		for i, v := range t.Values {
+			values[i] = v.Name
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+	case ArrayType:
+		return "[]" + p.inline(t.ElemType)
+	case DictType:
+		return "[string]" + p.inline(t.ElemType)
+	case NullableType:
+		return "?" + p.inline(t.Type)
+	case BuiltinType:
+		if name, ok := builtinNames[t.Name]; ok {
+			return name
+		}
+		p.fail(fmt.Errorf("unknown builtin type %q", t.Name))
+		return t.Name
+	case NamedType:
+		return t.Name
+	default:
+		p.fail(fmt.Errorf("unknown type %T", t))
+		return ""
+	}
+}
+
+func (p *printer) structType(t StructType, indent string) string {
+	var b strings.Builder
+	inner := indent + "  "
+
+	b.WriteString("(\n")
+	for i, f := range t.Fields {
+		var comments printer
+		comments.comments(inner, f.Comments, f.Position.Offset)
+		b.Write(comments.buf.Bytes())
+
+		prefix := inner + f.Name + ": "
+		b.WriteString(prefix)
+		b.WriteString(p.typ(f.Type, inner, utf8.RuneCountInString(prefix)))
+		if i < len(t.Fields)-1 {
+			b.WriteByte(',')
+		}
+		b.WriteString(trailing(f.Comments, f.Position.Offset))
+		b.WriteByte('\n')
+	}
+	b.WriteString(indent + ")")
+	return b.String()
+}
+
+func (p *printer) enumType(t EnumType, indent string) string {
+	var b strings.Builder
+	inner := indent + "  "
+
+	b.WriteString("(\n")
+	for i, v := range t.Values {
+		var comments printer
+		comments.comments(inner, v.Comments, v.Position.Offset)
+		b.Write(comments.buf.Bytes())
+
+		b.WriteString(inner + v.Name)
+		if i < len(t.Values)-1 {
+			b.WriteByte(',')
+		}
+		b.WriteString(trailing(v.Comments, v.Position.Offset))
+		b.WriteByte('\n')
+	}
+	b.WriteString(indent + ")")
+	return b.String()
+}
+
+func (p *printer) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// hasComments returns whether any field or value of the type has comments.
+func hasComments(t Type) bool {
+	switch t := t.(type) {
+	case StructType:
+		for _, f := range t.Fields {
+			if len(f.Comments) > 0 || hasComments(f.Type) {
+				return true
+			}
+		}
+	case EnumType:
+		for _, v := range t.Values {
+			if len(v.Comments) > 0 {
+				return true
+			}
+		}
+	case ArrayType:
+		return hasComments(t.ElemType)
+	case DictType:
+		return hasComments(t.ElemType)
+	case NullableType:
+		return hasComments(t.Type)
+	}
+	return false
+}
+
+// lastLineWidth returns the width of the last line of s.
+func lastLineWidth(s string) int {
+	return utf8.RuneCountInString(s[strings.LastIndexByte(s, '\n')+1:])
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestFormat(t *testing.T) {
+	const source = `# An example
+interface   org.example.format
+# Resolves names.
+method Resolve(name:string, flags: ?[]( recursive , cached )) -> (
+  # The addresses of the name.
+  addresses: [string]( family: int, address: string ), ttl: int # seconds
+)
+
+type Color (red,green,blue)
+error NotFound(name: string)
+
+type Record (name: string, description: string, labels: [string]string, created: float, data: any)
+`
+	const expected = `# An example
+interface org.example.format
+
+# Resolves names.
+method Resolve(name: string, flags: ?[](recursive, cached)) -> (
+  # The addresses of the name.
+  addresses: [string](family: int, address: string),
+  ttl: int # seconds
+)
+
+type Color (red, green, blue)
+
+error NotFound (name: string)
+
+type Record (
+  name: string,
+  description: string,
+  labels: [string]string,
+  created: float,
+  data: object
+)
+`
+
+	intf, err := syntax.NewParser(bytes.NewReader([]byte(source))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := syntax.Format(intf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Fatalf("got:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	for _, path := range []string{
+		"testdata/standard/org.example.encoding.varlink",
+		"../org.varlink.service/service.varlink",
+		"../contrib/podman/io.podman.varlink",
+	} {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		intf, err := syntax.NewParser(bytes.NewReader(src)).Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := syntax.Format(intf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reparsed, err := syntax.NewParser(bytes.NewReader(out)).Parse()
+		if err != nil {
+			t.Fatalf("%s: formatted definition does not parse: %v", path, err)
+		}
+		if syntax.Fingerprint(reparsed) != syntax.Fingerprint(intf) {
+			t.Errorf("%s: formatted definition differs from the original", path)
+		}
+		again, err := syntax.Format(reparsed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, out) {
+			t.Errorf("%s: formatting is not stable:\n%s", path, again)
+		}
+	}
+}
+
+func TestFormatLossless(t *testing.T) {
+	const source = `# Copyright notice.
+
+# An example
+interface org.example.lossless
+
+# TODO: more types.
+
+type A (a: int)
+method M() -> (
+  b: bool
+  # more to come
+)
+
+# The end.
+`
+	const expected = `# Copyright notice.
+
+# An example
+interface org.example.lossless
+
+# TODO: more types.
+
+type A (a: int)
+
+# more to come
+
+method M() -> (b: bool)
+
+# The end.
+`
+
+	src := source
+	for range 2 {
+		p := syntax.NewParser(strings.NewReader(src))
+		p.Lossless = true
+		intf, err := p.Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := syntax.Format(intf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != expected {
+			t.Fatalf("got:\n%s\nexpected:\n%s", out, expected)
+		}
+		src = string(out)
+	}
+}
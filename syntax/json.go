@@ -0,0 +1,202 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The AST marshals to JSON for tools that are not written in Go, and for
+// snapshots of parse results. Nodes are objects with the fields of their Go
+// types, in lower case. Types additionally have a "kind" field, which is
+// one of "struct", "enum", "builtin", "named", "array", "dict" and
+// "nullable", and builtin types are named as in the interface description
+// language, "any" being written "object".
+
+// builtinNames maps the names of builtin types in the AST to their names in
+// the interface description language.
+var builtinNames = map[string]string{
+	"bool":            "bool",
+	"int":             "int",
+	"string":          "string",
+	"float64":         "float",
+	"json.RawMessage": "object",
+}
+
+func (t StructType) MarshalJSON() ([]byte, error) {
+	type plain StructType
+	return marshalKind("struct", plain(t))
+}
+
+func (t EnumType) MarshalJSON() ([]byte, error) {
+	type plain EnumType
+	return marshalKind("enum", plain(t))
+}
+
+func (t BuiltinType) MarshalJSON() ([]byte, error) {
+	name, ok := builtinNames[t.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown builtin type %q", t.Name)
+	}
+	t.Name = name
+	type plain BuiltinType
+	return marshalKind("builtin", plain(t))
+}
+
+func (t *BuiltinType) UnmarshalJSON(data []byte) error {
+	type plain BuiltinType
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	for name, idl := range builtinNames {
+		if t.Name == idl {
+			t.Name = name
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown builtin type %q", t.Name)
+}
+
+func (t NamedType) MarshalJSON() ([]byte, error) {
+	type plain NamedType
+	return marshalKind("named", plain(t))
+}
+
+func (t ArrayType) MarshalJSON() ([]byte, error) {
+	type plain ArrayType
+	return marshalKind("array", plain(t))
+}
+
+func (t *ArrayType) UnmarshalJSON(data []byte) error {
+	type plain ArrayType
+	var aux struct {
+		plain
+		ElemType json.RawMessage `json:"elem"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*t = ArrayType(aux.plain)
+	return unmarshalType(aux.ElemType, &t.ElemType)
+}
+
+func (t DictType) MarshalJSON() ([]byte, error) {
+	type plain DictType
+	return marshalKind("dict", plain(t))
+}
+
+func (t *DictType) UnmarshalJSON(data []byte) error {
+	type plain DictType
+	var aux struct {
+		plain
+		ElemType json.RawMessage `json:"elem"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*t = DictType(aux.plain)
+	return unmarshalType(aux.ElemType, &t.ElemType)
+}
+
+func (t NullableType) MarshalJSON() ([]byte, error) {
+	type plain NullableType
+	return marshalKind("nullable", plain(t))
+}
+
+func (t *NullableType) UnmarshalJSON(data []byte) error {
+	type plain NullableType
+	var aux struct {
+		plain
+		Type json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*t = NullableType(aux.plain)
+	return unmarshalType(aux.Type, &t.Type)
+}
+
+func (f *StructField) UnmarshalJSON(data []byte) error {
+	type plain StructField
+	var aux struct {
+		plain
+		Type json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*f = StructField(aux.plain)
+	return unmarshalType(aux.Type, &f.Type)
+}
+
+func (def *TypeDef) UnmarshalJSON(data []byte) error {
+	type plain TypeDef
+	var aux struct {
+		plain
+		Type json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*def = TypeDef(aux.plain)
+	return unmarshalType(aux.Type, &def.Type)
+}
+
+// marshalKind marshals a type along with its kind.
+func marshalKind[T any](kind string, t T) ([]byte, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	// t always marshals to a non-empty object.
+	prefix := `{"kind":"` + kind + `",`
+	return append([]byte(prefix), data[1:]...), nil
+}
+
+// unmarshalType unmarshals a type into t, using its kind to pick the Type
+// implementation.
+func unmarshalType(data json.RawMessage, t *Type) error {
+	if len(data) == 0 {
+		return fmt.Errorf("missing type")
+	}
+	var kind struct {
+		Kind *string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &kind); err != nil {
+		return err
+	}
+	if kind.Kind == nil {
+		return fmt.Errorf("type has no kind")
+	}
+
+	var err error
+	switch *kind.Kind {
+	case "struct":
+		*t, err = decodeType[StructType](data)
+	case "enum":
+		*t, err = decodeType[EnumType](data)
+	case "builtin":
+		*t, err = decodeType[BuiltinType](data)
+	case "named":
+		*t, err = decodeType[NamedType](data)
+	case "array":
+		*t, err = decodeType[ArrayType](data)
+	case "dict":
+		*t, err = decodeType[DictType](data)
+	case "nullable":
+		*t, err = decodeType[NullableType](data)
+	default:
+		return fmt.Errorf("unknown type kind %q", *kind.Kind)
+	}
+	return err
+}
+
+func decodeType[T Type](data []byte) (Type, error) {
+	var typ T
+	err := json.Unmarshal(data, &typ)
+	return typ, err
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	const source = "interface org.example.json\n" +
+		"type T (a: ?[]float, b: [string](x, y))\n"
+	const expected = `{
+  "position": {"line": 1, "column": 1, "offset": 0},
+  "end": {"line": 2, "column": 40, "offset": 66},
+  "name": "org.example.json",
+  "types": [{
+    "position": {"line": 2, "column": 1, "offset": 27},
+    "end": {"line": 2, "column": 40, "offset": 66},
+    "name": "T",
+    "type": {"kind": "struct",
+      "position": {"line": 2, "column": 8, "offset": 34},
+      "end": {"line": 2, "column": 40, "offset": 66},
+      "fields": [
+      {"position": {"line": 2, "column": 9, "offset": 35}, "end": {"line": 2, "column": 20, "offset": 46}, "name": "a", "type":
+        {"kind": "nullable", "position": {"line": 2, "column": 12, "offset": 38}, "end": {"line": 2, "column": 20, "offset": 46}, "type":
+          {"kind": "array", "position": {"line": 2, "column": 13, "offset": 39}, "end": {"line": 2, "column": 20, "offset": 46}, "elem":
+            {"kind": "builtin", "position": {"line": 2, "column": 15, "offset": 41}, "end": {"line": 2, "column": 20, "offset": 46}, "name": "float"}}}},
+      {"position": {"line": 2, "column": 22, "offset": 48}, "end": {"line": 2, "column": 39, "offset": 65}, "name": "b", "type":
+        {"kind": "dict", "position": {"line": 2, "column": 25, "offset": 51}, "end": {"line": 2, "column": 39, "offset": 65}, "elem":
+          {"kind": "enum", "position": {"line": 2, "column": 33, "offset": 59}, "end": {"line": 2, "column": 39, "offset": 65}, "values": [
+            {"position": {"line": 2, "column": 34, "offset": 60}, "end": {"line": 2, "column": 35, "offset": 61}, "name": "x"},
+            {"position": {"line": 2, "column": 37, "offset": 63}, "end": {"line": 2, "column": 38, "offset": 64}, "name": "y"}]}}}]}
+  }]
+}`
+
+	intf, err := syntax.NewParser(strings.NewReader(source)).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(intf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(expected)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, compact.Bytes()) {
+		t.Fatalf("got:\n%s\nexpected:\n%s", out, compact.Bytes())
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, path := range []string{
+		"testdata/standard/org.example.encoding.varlink",
+		"../org.varlink.service/service.varlink",
+		"../contrib/podman/io.podman.varlink",
+	} {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		intf, err := syntax.NewParser(bytes.NewReader(src)).Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(intf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded syntax.InterfaceDef
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if !reflect.DeepEqual(decoded, intf) {
+			t.Errorf("%s: decoded AST differs from the original", path)
+		}
+	}
+}
@@ -16,27 +16,31 @@ import (
 )
 
 // Cursor represents a cursor position within a document, i.e. a line and
-// a column number, both starting at 1.
+// a column number, both starting at 1, and the byte offset of the position
+// from the start of the document. Columns count characters, while offsets
+// count bytes, and can be used to slice the document directly.
 type Cursor struct {
-	Line, Column int
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Offset int `json:"offset"`
 }
 
 // Token represents a token in the lexer stream.
 type Token struct {
 	// The type of this token.
-	Type TokenType
+	Type TokenType `json:"type"`
 
 	// The original string representation of this token.
-	Raw string
+	Raw string `json:"raw"`
 
 	// The value interpreted from Raw (may be nil).
-	Value interface{}
+	Value interface{} `json:"value"`
 
 	// The starting position of this token.
-	Start Cursor
+	Start Cursor `json:"start"`
 
 	// The end position of this token.
-	End Cursor
+	End Cursor `json:"end"`
 }
 
 // IsAny returns true if the token is one of the specified token types.
@@ -184,7 +188,7 @@ func NewLexer(input io.Reader) *Lexer {
 
 func (l *Lexer) reset() {
 	l.state = l.lex
-	l.NextPosition = Cursor{1, 1}
+	l.NextPosition = Cursor{Line: 1, Column: 1}
 	l.Position = l.NextPosition
 	l.TokenPosition = l.NextPosition
 	l.tokens = make(chan Token, 2)
@@ -209,7 +213,7 @@ func (l *Lexer) error(err error) stateFunc {
 		typ = TokenEOF
 	}
 	if _, ok := err.(*Error); !ok {
-		err = &Error{Cursor: l.TokenPosition, Err: err}
+		err = &Error{Cursor: l.TokenPosition, End: l.NextPosition, Err: err}
 	}
 	token := Token{
 		Type:  typ,
@@ -262,6 +266,7 @@ func (l *Lexer) readRune() (r rune, w int, err error) {
 	}
 	l.token.WriteRune(r)
 	l.Position = l.NextPosition
+	l.NextPosition.Offset += w
 	switch r {
 	case '\n':
 		l.NextPosition.Line++
@@ -0,0 +1,89 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrLimitExceeded is wrapped by the errors returned when the input of a
+// parser exceeds its Limits.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// Limits bounds the resources used by a parser, so that descriptions
+// obtained from untrusted peers, for instance with GetInterfaceDescription,
+// can be parsed without unbounded stack and heap growth. Zero fields are
+// unlimited.
+//
+// Exceeding a limit ends parsing, even with ParseRecover.
+type Limits struct {
+	// MaxSize is the maximum size of the input, in bytes.
+	MaxSize int
+
+	// MaxTokens is the maximum number of tokens of the input, including
+	// whitespace, newlines and comments.
+	MaxTokens int
+
+	// MaxDepth is the maximum nesting depth of types, counted from the
+	// fields of methods and errors and from type definitions: in
+	// "type T ?[]string", string is 3 levels deep.
+	MaxDepth int
+}
+
+// UntrustedLimits are limits suited to parsing untrusted descriptions. They
+// are far above the needs of real interfaces.
+var UntrustedLimits = Limits{
+	MaxSize:   1 << 20,
+	MaxTokens: 1 << 18,
+	MaxDepth:  64,
+}
+
+// limitReader fails reads past its remaining number of bytes.
+type limitReader struct {
+	r   io.RuneReader
+	n   int
+	max int
+}
+
+func (l *limitReader) ReadRune() (r rune, size int, err error) {
+	if l.n <= 0 {
+		// Only fail if there is more input.
+		if _, _, err := l.r.ReadRune(); err != nil {
+			return 0, 0, err
+		}
+		return 0, 0, fmt.Errorf("%w: input is larger than %d bytes", ErrLimitExceeded, l.max)
+	}
+	r, size, err = l.r.ReadRune()
+	l.n -= size
+	if l.n < 0 {
+		return 0, 0, fmt.Errorf("%w: input is larger than %d bytes", ErrLimitExceeded, l.max)
+	}
+	return r, size, err
+}
+
+// countToken accounts for a token read from the lexer.
+func (p *parser) countToken(token Token) {
+	if token.Type == TokenEOF {
+		return
+	}
+	p.ntokens++
+	if max := p.limits.MaxTokens; max > 0 && p.ntokens > max {
+		p.error(token, fmt.Errorf("%w: input has more than %d tokens", ErrLimitExceeded, max))
+	}
+}
+
+// nest enters a nested type, starting at token, and returns the function
+// leaving it.
+func (p *parser) nest(token Token) (leave func()) {
+	p.depth++
+	if max := p.limits.MaxDepth; max > 0 && p.depth > max {
+		p.depth--
+		p.error(token, fmt.Errorf("%w: types are nested more than %d levels deep", ErrLimitExceeded, max))
+	}
+	return func() { p.depth-- }
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"sort"
+	"strings"
+)
+
+// Raw returns the text of the node, reconstructed from its tokens. It is
+// empty unless the node was parsed in lossless mode.
+func (n Node) Raw() string {
+	var b strings.Builder
+	for _, tok := range n.Tokens {
+		b.WriteString(tok.Raw)
+	}
+	return b.String()
+}
+
+// attachTokens sets the tokens of intf to all of the specified tokens, and
+// the tokens of every other node to the tokens between its start and end
+// positions.
+func attachTokens(intf *InterfaceDef, tokens []Token) {
+	intf.Tokens = tokens
+
+	span := func(n *Node) {
+		lo := sort.Search(len(tokens), func(i int) bool {
+			return tokens[i].Start.Offset >= n.Position.Offset
+		})
+		hi := sort.Search(len(tokens), func(i int) bool {
+			return tokens[i].Start.Offset >= n.End.Offset
+		})
+		n.Tokens = tokens[lo:hi:hi]
+	}
+
+	var typ func(t Type) Type
+	typ = func(t Type) Type {
+		switch t := t.(type) {
+		case StructType:
+			span(&t.Node)
+			for i := range t.Fields {
+				span(&t.Fields[i].Node)
+				t.Fields[i].Type = typ(t.Fields[i].Type)
+			}
+			return t
+		case EnumType:
+			span(&t.Node)
+			for i := range t.Values {
+				span(&t.Values[i].Node)
+			}
+			return t
+		case BuiltinType:
+			span(&t.Node)
+			return t
+		case NamedType:
+			span(&t.Node)
+			return t
+		case ArrayType:
+			span(&t.Node)
+			t.ElemType = typ(t.ElemType)
+			return t
+		case DictType:
+			span(&t.Node)
+			t.ElemType = typ(t.ElemType)
+			return t
+		case NullableType:
+			span(&t.Node)
+			t.Type = typ(t.Type)
+			return t
+		}
+		return t
+	}
+
+	for i := range intf.Types {
+		def := &intf.Types[i]
+		span(&def.Node)
+		def.Type = typ(def.Type)
+	}
+	for i := range intf.Methods {
+		def := &intf.Methods[i]
+		span(&def.Node)
+		def.Input = typ(def.Input).(StructType)
+		def.Output = typ(def.Output).(StructType)
+	}
+	for i := range intf.Errors {
+		def := &intf.Errors[i]
+		span(&def.Node)
+		def.Params = typ(def.Params).(StructType)
+	}
+}
+
+// splitTokens attaches the tokens of a stream of interface definitions to
+// each of them. The tokens of an interface start with its comments, and end
+// where the next interface starts.
+func splitTokens(intfs []InterfaceDef, tokens []Token) {
+	start := func(intf *InterfaceDef) int {
+		if len(intf.Comments) > 0 {
+			return intf.Comments[0].Start.Offset
+		}
+		return intf.Position.Offset
+	}
+	for i := range intfs {
+		lo, hi := 0, len(tokens)
+		if i > 0 {
+			lo = sort.Search(len(tokens), func(j int) bool {
+				return tokens[j].Start.Offset >= start(&intfs[i])
+			})
+		}
+		if i+1 < len(intfs) {
+			hi = sort.Search(len(tokens), func(j int) bool {
+				return tokens[j].Start.Offset >= start(&intfs[i+1])
+			})
+		}
+		attachTokens(&intfs[i], tokens[lo:hi:hi])
+	}
+}
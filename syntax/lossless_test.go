@@ -0,0 +1,64 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestLossless(t *testing.T) {
+	for _, path := range []string{
+		"testdata/standard/org.example.encoding.varlink",
+		"../org.varlink.service/service.varlink",
+		"../contrib/podman/io.podman.varlink",
+	} {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := syntax.NewParser(strings.NewReader(string(src)))
+		p.Lossless = true
+		intf, err := p.Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if intf.Raw() != string(src) {
+			t.Errorf("%s: tokens do not reconstruct the original text", path)
+		}
+		for _, def := range intf.Types {
+			if def.Raw() != def.Text(string(src)) {
+				t.Errorf("%s: type %s: got %q, expected %q", path, def.Name, def.Raw(), def.Text(string(src)))
+			}
+		}
+	}
+
+	const source = "# A\ninterface org.example.a\nmethod M(\n  x:  int, # x\n  y: ?[]string\n) -> ()\n\n\n# B\ninterface org.example.b\ntype T ( a:bool )\n"
+	p := syntax.NewParser(strings.NewReader(source))
+	p.Lossless = true
+	intfs, err := p.ParseAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, b := intfs[0].Raw(), intfs[1].Raw(); a+b != source || !strings.HasPrefix(b, "# B\n") {
+		t.Fatalf("got interfaces %q and %q, expected them to split the source", a, b)
+	}
+	input := intfs[0].Methods[0].Input
+	for i, expected := range []string{"x:  int", "y: ?[]string"} {
+		if raw := input.Fields[i].Raw(); raw != expected {
+			t.Errorf("got field %q, expected %q", raw, expected)
+		}
+	}
+	if raw := input.Fields[1].Type.(syntax.NullableType).Type.(syntax.ArrayType).Raw(); raw != "[]string" {
+		t.Errorf("got type %q, expected []string", raw)
+	}
+	if raw := intfs[1].Types[0].Type.(syntax.StructType).Raw(); raw != "( a:bool )" {
+		t.Errorf("got struct %q, expected ( a:bool )", raw)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import goregexp "regexp"
+
+// The names recognized by the lexer, anchored to match whole strings.
+var (
+	reValidName      = goregexp.MustCompile(`^` + rname + `$`)
+	reValidInterface = goregexp.MustCompile(`^` + rintf + `$`)
+	reValidField     = goregexp.MustCompile(`^` + rfield + `$`)
+)
+
+// IsValidInterfaceName returns whether name is a valid interface name, like
+// org.example.service. Like the parser in Lenient mode, it accepts
+// uppercase letters, which the grammar does not.
+func IsValidInterfaceName(name string) bool {
+	return reValidInterface.MatchString(name)
+}
+
+// IsValidMethodName returns whether name is a valid method name, like
+// GetInfo. Method names are not qualified by their interface: the method
+// name of org.example.service.GetInfo is GetInfo.
+func IsValidMethodName(name string) bool {
+	return reValidName.MatchString(name)
+}
+
+// IsValidTypeName returns whether name is a valid name for a type or an
+// error, like Item.
+func IsValidTypeName(name string) bool {
+	return reValidName.MatchString(name)
+}
+
+// IsValidFieldName returns whether name is a valid name for a struct field or
+// an enum value, like item_count. Keywords are valid field names.
+func IsValidFieldName(name string) bool {
+	return reValidField.MatchString(name)
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestValidNames(t *testing.T) {
+	tests := []struct {
+		valid func(string) bool
+		name  string
+		ok    bool
+	}{
+		{syntax.IsValidInterfaceName, "org.example.my-service", true},
+		{syntax.IsValidInterfaceName, "org.example.", false},
+		{syntax.IsValidInterfaceName, "example", false},
+		{syntax.IsValidInterfaceName, "org.example.Ping x", false},
+		{syntax.IsValidMethodName, "GetInfo", true},
+		{syntax.IsValidMethodName, "getInfo", false},
+		{syntax.IsValidMethodName, "org.example.GetInfo", false},
+		{syntax.IsValidTypeName, "Item2", true},
+		{syntax.IsValidTypeName, "Item_2", false},
+		{syntax.IsValidFieldName, "item_count", true},
+		{syntax.IsValidFieldName, "type", true},
+		{syntax.IsValidFieldName, "item__count", false},
+		{syntax.IsValidFieldName, "", false},
+	}
+	for _, tt := range tests {
+		if ok := tt.valid(tt.name); ok != tt.ok {
+			t.Errorf("%q: got valid %v, expected %v", tt.name, ok, tt.ok)
+		}
+	}
+}
@@ -6,11 +6,52 @@
 package syntax
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"strings"
+	"unicode/utf8"
 )
 
+// Mode selects how closely the parser follows the published grammar.
+type Mode int
+
+const (
+	// Lenient, the default, accepts the common deviations from the grammar
+	// found in the wild: CR and CRLF line endings, Unicode line separators
+	// and whitespace, trailing commas in structs and enums, definitions
+	// sharing a line, interfaces without any definition, and interface
+	// names of any length and case.
+	Lenient Mode = iota
+
+	// Strict enforces the published grammar exactly, and rejects the
+	// deviations accepted by Lenient. Use it to check that a description
+	// will be accepted by other implementations.
+	Strict
+)
+
+// maxInterfaceNameLen is the maximum length of interface names, which are
+// reverse domain names.
+const maxInterfaceNameLen = 255
+
 // Parser is the parser for the Varlink Interface Definition Language.
 type Parser struct {
+	// Lossless, if set, makes the parser retain all the tokens of the
+	// input, including whitespace, newlines and comments, in the Tokens of
+	// the nodes they belong to. The raw text of the tokens of an interface
+	// is the exact text it was parsed from, which lets tools rewrite parts
+	// of a document without losing its formatting. Format also keeps the
+	// comments that are not attached to any node, which are otherwise
+	// dropped.
+	Lossless bool
+
+	// Mode is the parsing mode; see Lenient and Strict.
+	Mode Mode
+
+	// Limits bounds the size and complexity of the input. Set it, for
+	// instance to UntrustedLimits, when parsing untrusted descriptions.
+	Limits Limits
+
 	p parser
 }
 
@@ -24,12 +65,78 @@ func NewParser(in io.Reader) *Parser {
 
 // Parse parses the input and returns the parsed interface definition.
 func (p *Parser) Parse() (intf InterfaceDef, err error) {
-	return p.p.Parse()
+	p.setup()
+	intf, err = p.p.Parse()
+	if p.Lossless {
+		attachTokens(&intf, p.p.tokens)
+	}
+	return intf, err
+}
+
+// ParseRecover is like Parse, but does not stop at the first syntax error.
+// Instead, it skips to the next definition starting at the beginning of a
+// line, and carries on parsing from there, which lets tools like editors
+// and linters report all the errors of a document at once.
+//
+// ParseRecover returns the definitions that were parsed successfully, and
+// the errors that were encountered, in order. Errors in the interface
+// header and lexical errors, like invalid characters, still end parsing.
+func (p *Parser) ParseRecover() (intf InterfaceDef, errs []error) {
+	p.setup()
+	intf, errs = p.p.parse(true, false)
+	if p.Lossless {
+		attachTokens(&intf, p.p.tokens)
+	}
+	return intf, errs
+}
+
+// ParseAll parses the input as a sequence of interface definitions, as
+// found in concatenated description files, and returns them in order. It
+// stops at the first syntax error, and returns the definitions that were
+// parsed until then along with it.
+func (p *Parser) ParseAll() (intfs []InterfaceDef, err error) {
+	p.setup()
+	if p.Lossless {
+		defer func() { splitTokens(intfs, p.p.tokens) }()
+	}
+	for {
+		intf, errs := p.p.parse(false, true)
+		if len(errs) > 0 {
+			return intfs, errs[0]
+		}
+		intfs = append(intfs, intf)
+
+		// parse gave back the token that ended the definition, so
+		// peeking does not lex anything.
+		if p.p.Peek().Type == TokenEOF {
+			return intfs, nil
+		}
+	}
+}
+
+// setup applies the settings of the parser.
+func (p *Parser) setup() {
+	p.p.lossless = p.Lossless
+	p.p.strict = p.Mode == Strict
+	p.p.limits = p.Limits
+	if _, ok := p.p.lexer.Input.(*limitReader); !ok && p.Limits.MaxSize > 0 {
+		p.p.lexer.Input = &limitReader{r: p.p.lexer.Input, n: p.Limits.MaxSize, max: p.Limits.MaxSize}
+	}
 }
 
 type parser struct {
 	lexer *Lexer
 	prev  []Token
+	last  Token // last token returned by Next
+
+	lossless bool
+	tokens   []Token // all tokens read from the lexer, if lossless
+
+	strict bool
+
+	limits  Limits
+	ntokens int // number of tokens read from the lexer
+	depth   int // nesting depth of the type being parsed
 }
 
 func (p *parser) Next() (token Token) {
@@ -39,6 +146,13 @@ func (p *parser) Next() (token Token) {
 			token, p.prev = p.prev[last], p.prev[:last]
 		} else {
 			token = p.lexer.Next()
+			p.countToken(token)
+			if p.lossless && token.Type != TokenEOF {
+				p.tokens = append(p.tokens, token)
+			}
+			if p.strict {
+				p.checkStrict(token)
+			}
 		}
 		switch token.Type {
 		case TokenWhitespace:
@@ -46,6 +160,7 @@ func (p *parser) Next() (token Token) {
 		case TokenError:
 			p.error(token, nil)
 		}
+		p.last = token
 		return token
 	}
 }
@@ -73,19 +188,49 @@ func (p *parser) Accept(expect ...TokenType) (token Token) {
 	panic("unreachable")
 }
 
+// checkStrict rejects the whitespace and line endings that are not part of
+// the grammar, which only allows spaces, tabs and line feeds.
+func (p *parser) checkStrict(token Token) {
+	var valid bool
+	switch token.Type {
+	case TokenWhitespace:
+		valid = strings.Trim(token.Raw, " \t") == ""
+	case TokenNewline:
+		valid = token.Raw == "\n"
+	case TokenComment:
+		valid = !strings.ContainsRune(token.Raw, '\r')
+	default:
+		return
+	}
+	if !valid {
+		p.error(token, errors.New("only spaces, tabs and line feeds are allowed in strict mode"))
+	}
+}
+
 func (p *parser) error(token Token, err error) {
 	if token.Type == TokenError {
 		panic(token.Value.(*Error))
 	}
 	err = TokenTypeError{Token: token, Err: err}
-	panic(&Error{Cursor: token.Start, Err: err})
+	panic(&Error{Cursor: token.Start, End: after(token), Err: err})
 }
 
 func (p *parser) Parse() (intf InterfaceDef, err error) {
+	intf, errs := p.parse(false, false)
+	if len(errs) > 0 {
+		err = errs[0]
+	}
+	return intf, err
+}
+
+// parse parses an interface definition. If recovering, it skips over the
+// definitions with syntax errors instead of stopping at the first error. If
+// multiple, it stops at the start of the next interface definition.
+func (p *parser) parse(recovering, multiple bool) (intf InterfaceDef, errs []error) {
 	defer func() {
 		if e := recover(); e != nil {
 			if ee, ok := e.(*Error); ok {
-				err = ee
+				errs = append(errs, ee)
 			} else {
 				panic(e)
 			}
@@ -102,36 +247,160 @@ func (p *parser) Parse() (intf InterfaceDef, err error) {
 	// <interface-name>
 	name := p.Accept(TokenInterfaceName)
 	intf.Name = name.Value.(string)
+	intf.End = p.end()
+	if p.strict {
+		p.checkInterfaceName(name)
+	}
 
 	p.Accept(TokenNewline, TokenComment)
 
 	for {
 		comments := p.Comments()
-		token := p.Peek()
+		switch token := p.Peek(); token.Type {
+		case TokenEOF:
+			p.checkMembers(&intf, token)
+			return intf, errs
+		case TokenInterfaceDef:
+			if multiple {
+				p.checkMembers(&intf, token)
+				p.Back(comments...)
+				return intf, errs
+			}
+		}
+		if err := p.definition(&intf, comments); err != nil {
+			errs = append(errs, err)
+			if !recovering || errors.Is(err, ErrLimitExceeded) {
+				return intf, errs
+			}
+			p.synchronize()
+			continue
+		}
+		intf.End = p.end()
+	}
+}
 
-		switch token.Type {
-		case TokenTypeDef:
-			typedef := p.TypeDef()
-			typedef.Comments = comments
-			intf.Types = append(intf.Types, typedef)
+// checkInterfaceName checks that an interface name follows the grammar in
+// strict mode: it must be lowercase, and at most maxInterfaceNameLen long.
+func (p *parser) checkInterfaceName(name Token) {
+	value := name.Value.(string)
+	if len(value) > maxInterfaceNameLen {
+		p.error(name, fmt.Errorf("interface name is longer than %d characters", maxInterfaceNameLen))
+	}
+	if strings.ToLower(value) != value {
+		p.error(name, errors.New("interface name must be lowercase"))
+	}
+}
+
+// checkMembers checks that intf has at least one definition in strict mode,
+// before the token ending it.
+func (p *parser) checkMembers(intf *InterfaceDef, end Token) {
+	if !p.strict || len(intf.Types)+len(intf.Methods)+len(intf.Errors) > 0 {
+		return
+	}
+	p.error(end, UnexpectedTokenError{TokenTypeDef, TokenMethodDef, TokenErrorDef})
+}
 
-		case TokenMethodDef:
-			method := p.MethodDef()
-			method.Comments = comments
-			intf.Methods = append(intf.Methods, method)
+// definition parses a type, method or error definition into intf.
+func (p *parser) definition(intf *InterfaceDef, comments []Token) (err *Error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if ee, ok := e.(*Error); ok {
+				err = ee
+			} else {
+				panic(e)
+			}
+		}
+	}()
 
-		case TokenErrorDef:
-			errdef := p.ErrorDef()
-			errdef.Comments = comments
-			intf.Errors = append(intf.Errors, errdef)
+	switch token := p.Peek(); token.Type {
+	case TokenTypeDef:
+		typedef := p.TypeDef()
+		typedef.Comments = comments
+		intf.Types = append(intf.Types, typedef)
 
-		case TokenEOF:
-			return intf, nil
+	case TokenMethodDef:
+		method := p.MethodDef()
+		method.Comments = comments
+		intf.Methods = append(intf.Methods, method)
+
+	case TokenErrorDef:
+		errdef := p.ErrorDef()
+		errdef.Comments = comments
+		intf.Errors = append(intf.Errors, errdef)
+
+	default:
+		p.Next()
+		p.error(token, UnexpectedTokenError{TokenTypeDef, TokenMethodDef, TokenErrorDef})
+	}
 
+	// The grammar ends each definition with a newline.
+	if p.strict {
+		switch token := p.Peek(); token.Type {
+		case TokenNewline, TokenComment, TokenEOF:
 		default:
-			p.error(token, UnexpectedTokenError{TokenTypeDef, TokenMethodDef, TokenErrorDef})
+			p.Next()
+			p.error(token, UnexpectedTokenError{TokenNewline})
 		}
 	}
+	return nil
+}
+
+// synchronize skips the tokens following a syntax error, up to the next
+// type, method or error keyword at the beginning of a line.
+//
+// Since the error may have happened while the lexer was coercing
+// identifiers into names, keywords are recognized from the raw text of the
+// tokens rather than from their type.
+func (p *parser) synchronize() {
+	p.lexer.CoerceIdentifierType = TokenEOF
+
+	isKeyword := func(token Token) bool {
+		switch keywordTokenMap[token.Raw] {
+		case TokenTypeDef, TokenMethodDef, TokenErrorDef:
+			return true
+		}
+		return false
+	}
+
+	// The offending token may be the start of the next definition. The
+	// comments preceding the next definition are given back along with it.
+	var comments []Token
+	token := p.last
+	linestart := token.Start.Column == 1
+	for {
+		switch {
+		case token.Type == TokenEOF:
+			p.Back(token)
+			return
+		case linestart && isKeyword(token):
+			token.Type = keywordTokenMap[token.Raw]
+			p.Back(append(comments, token)...)
+			return
+		case token.Type == TokenComment && linestart:
+			comments = append(comments, token)
+		default:
+			comments = comments[:0]
+		}
+		// Comments include the newline that ends them.
+		linestart = token.Type == TokenNewline || token.Type == TokenComment
+		token = p.Next()
+	}
+}
+
+// end returns the position right after the last token that was read.
+func (p *parser) end() Cursor {
+	return after(p.last)
+}
+
+// after returns the position right after a token. The End of tokens is the
+// position of their last character, which may span several bytes.
+func after(token Token) Cursor {
+	end := token.End
+	if _, w := utf8.DecodeLastRuneInString(token.Raw); w > 0 {
+		end.Column++
+		end.Offset += w
+	}
+	return end
 }
 
 func (p *parser) Comments() (comments []Token) {
@@ -158,14 +427,19 @@ func (p *parser) TypeDef() (typedef TypeDef) {
 	typedef.Name = name.Value.(string)
 
 	typedef.Type = p.Type()
+	typedef.End = p.end()
 	return
 }
 
 func (p *parser) Type() Type {
-	switch token := p.Next(); token.Type {
+	token := p.Next()
+	defer p.nest(token)()
+
+	switch token.Type {
 	case TokenOption:
 		typ := NullableType{Type: p.Type()}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 	default:
 		p.Back(token)
@@ -178,10 +452,12 @@ func (p *parser) NonNullableType() Type {
 	case TokenArray:
 		typ := ArrayType{ElemType: p.Type()}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 	case TokenDict:
 		typ := DictType{ElemType: p.Type()}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 	default:
 		p.Back(token)
@@ -195,21 +471,35 @@ func (p *parser) ElementType() Type {
 	case TokenTypeBool, TokenTypeInt, TokenTypeString:
 		typ := BuiltinType{Name: token.Type.String()}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 
 	case TokenTypeFloat:
 		typ := BuiltinType{Name: "float64"}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 
 	case TokenTypeObject, TokenTypeAny:
 		typ := BuiltinType{Name: "json.RawMessage"}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 
 	case TokenLParen:
+		// Look past the comments and newlines to tell structs and enums
+		// apart, keeping them all so that the first field or value gets
+		// its comments.
 		p.lexer.CoerceIdentifierType = TokenFieldName
-		comments := p.Comments()
+		var comments []Token
+		for {
+			next := p.Next()
+			if next.Type != TokenComment && next.Type != TokenNewline {
+				p.Back(next)
+				break
+			}
+			comments = append(comments, next)
+		}
 
 		firstnameOrRparen := p.Accept(TokenFieldName, TokenRParen)
 		p.lexer.CoerceIdentifierType = TokenEOF
@@ -238,6 +528,7 @@ func (p *parser) ElementType() Type {
 	case TokenName:
 		typ := NamedType{Name: token.Value.(string)}
 		typ.Position = token.Start
+		typ.End = p.end()
 		return typ
 
 	default:
@@ -258,6 +549,7 @@ func (p *parser) ElementType() Type {
 func (p *parser) EnumType() (e EnumType) {
 	p.lexer.CoerceIdentifierType = TokenFieldName
 	defer func() { p.lexer.CoerceIdentifierType = TokenEOF }()
+	defer func() { e.End = p.end() }()
 
 	start := p.Accept(TokenLParen)
 	e.Position = start.Start
@@ -270,15 +562,20 @@ func (p *parser) EnumType() (e EnumType) {
 		// ignored
 	}
 
-	var last bool
+	var last, comma bool
 	for {
+		// Field names may collide with keywords (e.g. "type"), so the
+		// coercion must be in effect before looking past the comments.
+		p.lexer.CoerceIdentifierType = TokenFieldName
 		comments := p.Comments()
 
-		p.lexer.CoerceIdentifierType = TokenFieldName
 		name := p.Accept(TokenFieldName, TokenRParen)
 		p.lexer.CoerceIdentifierType = TokenEOF
 
 		if name.Type == TokenRParen {
+			if p.strict && comma {
+				p.error(name, UnexpectedTokenError{TokenFieldName})
+			}
 			return e
 		}
 		if last {
@@ -291,13 +588,15 @@ func (p *parser) EnumType() (e EnumType) {
 		val.Comments = comments
 		val.Name = name.Value.(string)
 		val.Position = name.Start
+		val.End = p.end()
 
-		comma := p.Next()
-		if comma.Type != TokenComma {
+		sep := p.Next()
+		comma = sep.Type == TokenComma
+		if !comma {
 			// Last value may skip the comma, but requires no more
 			// values after that
 			last = true
-			p.Back(comma)
+			p.Back(sep)
 		}
 
 		p.lexer.CoerceIdentifierType = TokenFieldName
@@ -311,12 +610,17 @@ func (p *parser) EnumType() (e EnumType) {
 		case TokenNewline:
 			// ignored
 		case TokenRParen:
+			if p.strict && comma {
+				p.error(next, UnexpectedTokenError{TokenFieldName})
+			}
 			return e
 		}
 	}
 }
 
 func (p *parser) StructType() (s StructType) {
+	defer func() { s.End = p.end() }()
+
 	start := p.Accept(TokenLParen)
 	s.Position = start.Start
 
@@ -332,15 +636,20 @@ func (p *parser) StructType() (s StructType) {
 		// ignored
 	}
 
-	var last bool
+	var last, comma bool
 	for {
+		// Field names may collide with keywords (e.g. "type"), so the
+		// coercion must be in effect before looking past the comments.
+		p.lexer.CoerceIdentifierType = TokenFieldName
 		comments := p.Comments()
 
-		p.lexer.CoerceIdentifierType = TokenFieldName
 		name := p.Accept(TokenFieldName, TokenRParen)
 		p.lexer.CoerceIdentifierType = TokenEOF
 
 		if name.Type == TokenRParen {
+			if p.strict && comma {
+				p.error(name, UnexpectedTokenError{TokenFieldName})
+			}
 			return s
 		}
 		if last {
@@ -356,13 +665,15 @@ func (p *parser) StructType() (s StructType) {
 
 		p.Accept(TokenColon)
 		field.Type = p.Type()
+		field.End = p.end()
 
-		comma := p.Next()
-		if comma.Type != TokenComma {
+		sep := p.Next()
+		comma = sep.Type == TokenComma
+		if !comma {
 			// Last field may skip the comma, but requires no more
 			// fields after that
 			last = true
-			p.Back(comma)
+			p.Back(sep)
 		}
 
 		p.lexer.CoerceIdentifierType = TokenFieldName
@@ -376,6 +687,9 @@ func (p *parser) StructType() (s StructType) {
 		case TokenNewline:
 			// ignored
 		case TokenRParen:
+			if p.strict && comma {
+				p.error(next, UnexpectedTokenError{TokenFieldName})
+			}
 			return s
 		}
 	}
@@ -395,6 +709,7 @@ func (p *parser) MethodDef() (method MethodDef) {
 	p.Accept(TokenArrow)
 
 	method.Output = p.StructType()
+	method.End = p.end()
 	return
 }
 
@@ -406,5 +721,6 @@ func (p *parser) ErrorDef() (err ErrorDef) {
 	err.Name = name.Value.(string)
 
 	err.Params = p.StructType()
+	err.End = p.end()
 	return
 }
@@ -7,9 +7,12 @@ package syntax_test
 
 import (
 	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 
 	"snai.pe/go-varlink/syntax"
@@ -71,6 +74,68 @@ func TestVarlinkStandardSuite(t *testing.T) {
 	})
 }
 
+func TestDefinitionText(t *testing.T) {
+	const source = `# Interface with déjà vu comments
+interface org.example.text
+
+# Typé
+type Pair (a: int, b: []?string)
+
+method Swap(pair: Pair) -> (
+  pair: Pair # swapped
+)
+
+error Failed ()
+`
+	intf, err := syntax.NewParser(bytes.NewReader([]byte(source))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text, expected string
+	}{
+		{intf.Types[0].Text(source), "type Pair (a: int, b: []?string)"},
+		{intf.Methods[0].Text(source), "method Swap(pair: Pair) -> (\n  pair: Pair # swapped\n)"},
+		{intf.Errors[0].Text(source), "error Failed ()"},
+	}
+	for _, tt := range tests {
+		if tt.text != tt.expected {
+			t.Errorf("got definition text %q, expected %q", tt.text, tt.expected)
+		}
+	}
+}
+
+func TestFirstFieldComments(t *testing.T) {
+	const source = `interface org.example.comments
+
+type Point (
+  # abscissa
+  x: int,
+  y: int
+)
+
+type Color (
+  # primary
+  red,
+  green
+)
+`
+	intf, err := syntax.NewParser(bytes.NewReader([]byte(source))).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	field := intf.Types[0].Type.(syntax.StructType).Fields[0]
+	if len(field.Comments) != 1 || field.Comments[0].Value != "abscissa" {
+		t.Errorf("got comments %v on the first field, expected the abscissa comment", field.Comments)
+	}
+	value := intf.Types[1].Type.(syntax.EnumType).Values[0]
+	if len(value.Comments) != 1 || value.Comments[0].Value != "primary" {
+		t.Errorf("got comments %v on the first value, expected the primary comment", value.Comments)
+	}
+}
+
 func BenchmarkStandardSuite(b *testing.B) {
 	filepath.Walk("testdata/standard", func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -138,3 +203,207 @@ func FuzzParser(f *testing.F) {
 		syntax.NewParser(bytes.NewReader(txt)).Parse()
 	})
 }
+
+func TestParseRecover(t *testing.T) {
+	const source = `interface org.example.recover
+type Good (a: int)
+type Bad (a: int,
+method Broken(x: ) -> ()
+# A method
+method Fine(x: string) -> (y: bool)
+error Oops (: int)
+type Last (b: string)
+`
+	intf, errs := syntax.NewParser(bytes.NewReader([]byte(source))).ParseRecover()
+
+	var lines []int
+	for _, err := range errs {
+		var serr *syntax.Error
+		if !errors.As(err, &serr) {
+			t.Fatalf("got error %v of type %T, expected *syntax.Error", err, err)
+		}
+		lines = append(lines, serr.Cursor.Line)
+	}
+	// Bad is unterminated, so Broken is read as part of it.
+	if !slices.Equal(lines, []int{4, 7}) {
+		t.Fatalf("got errors %v, expected errors on lines 4 and 7", errs)
+	}
+
+	var names []string
+	for _, typ := range intf.Types {
+		names = append(names, typ.Name)
+	}
+	for _, m := range intf.Methods {
+		names = append(names, m.Name)
+	}
+	if !slices.Equal(names, []string{"Good", "Last", "Fine"}) {
+		t.Fatalf("parsed definitions %v, expected Good, Last and Fine", names)
+	}
+	if len(intf.Methods) != 1 || len(intf.Methods[0].Comments) != 1 {
+		t.Fatalf("expected the comments of Fine to be kept")
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	const source = `# First interface.
+interface org.example.a
+method A() -> ()
+
+# Second interface.
+interface org.example.b
+type B (b: bool)
+`
+	intfs, err := syntax.NewParser(strings.NewReader(source)).ParseAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(intfs) != 2 || intfs[0].Name != "org.example.a" || intfs[1].Name != "org.example.b" {
+		t.Fatalf("got interfaces %v, expected org.example.a and org.example.b", intfs)
+	}
+	if len(intfs[0].Methods) != 1 || len(intfs[1].Types) != 1 {
+		t.Fatal("definitions were not attached to their interface")
+	}
+	if doc := intfs[1].Doc().String(); doc != "Second interface." {
+		t.Fatalf("got doc %q for the second interface", doc)
+	}
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"ab.varlink":  source,
+		"bad.varlink": "interface org.example.c\nmethod C(\n",
+		"dup.varlink": "interface org.example.a\ntype T (a: int)\n",
+		"other.txt":   "not an interface",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	intfs, err = syntax.ParseFiles(dir)
+	if len(intfs) != 2 {
+		t.Fatalf("got %d interfaces from the directory, expected 2", len(intfs))
+	}
+	var serr *syntax.Error
+	if !errors.As(err, &serr) || serr.Filename != filepath.Join(dir, "bad.varlink") {
+		t.Fatalf("parsing the directory failed with %v, expected a syntax error in bad.varlink", err)
+	}
+	if !strings.Contains(err.Error(), "interface org.example.a is already defined") {
+		t.Fatalf("parsing the directory failed with %v, expected a duplicate definition error", err)
+	}
+}
+
+func TestErrorRange(t *testing.T) {
+	tests := []struct {
+		source, text string
+	}{
+		{"interface org.example.range\nmethod Café(x: int) -> ()\n", "é"},
+		{"interface org.example.range\ntype Pair (a: int bool: int)\n", "bool"},
+		{"interface org.example.range\ntype Bad (a: int) }\n", "}"},
+	}
+	for _, tt := range tests {
+		_, err := syntax.NewParser(strings.NewReader(tt.source)).Parse()
+		var serr *syntax.Error
+		if !errors.As(err, &serr) {
+			t.Fatalf("parsing %q returned %v, expected a syntax error", tt.source, err)
+		}
+		if text := tt.source[serr.Offset:serr.End.Offset]; text != tt.text {
+			t.Errorf("error %v spans %q, expected %q", err, text, tt.text)
+		}
+	}
+}
+
+func TestParserModes(t *testing.T) {
+	tests := []struct {
+		source string
+		strict bool // whether the source is valid in strict mode
+	}{
+		{"interface org.example.mode\n\tmethod A(x: int) -> ()\n", true},
+		{"# doc\ninterface org.example.mode\ntype T (a: int, b: (c, d))\n", true},
+		{"interface org.example.mode\r\nmethod A() -> ()\r\n", false},
+		{"interface org.example.mode\nmethod A() -> () # doc\r\n", false},
+		{"interface org.example.mode\ntype T (a: int,)\n", false},
+		{"interface org.example.mode\ntype T (\n  a: int,\n)\n", false},
+		{"interface org.example.mode\ntype T (a, b,)\n", false},
+		{"interface org.example.mode\nmethod A() -> () method B() -> ()\n", false},
+		{"interface org.example.mode\n", false},
+		{"interface org.Example.mode\nmethod A() -> ()\n", false},
+		{"interface org.example." + strings.Repeat("a", 256) + "\nmethod A() -> ()\n", false},
+	}
+	for _, tt := range tests {
+		if _, err := syntax.NewParser(strings.NewReader(tt.source)).Parse(); err != nil {
+			t.Errorf("parsing %q in lenient mode failed: %v", tt.source, err)
+		}
+
+		p := syntax.NewParser(strings.NewReader(tt.source))
+		p.Mode = syntax.Strict
+		_, err := p.Parse()
+		if tt.strict && err != nil {
+			t.Errorf("parsing %q in strict mode failed: %v", tt.source, err)
+		}
+		var serr *syntax.Error
+		if !tt.strict && !errors.As(err, &serr) {
+			t.Errorf("parsing %q in strict mode returned %v, expected a syntax error", tt.source, err)
+		}
+	}
+}
+
+func TestDiagnostic(t *testing.T) {
+	const source = "interface org.example.diag\n\ttype Pair (a: int bool: int)\n"
+	_, err := syntax.NewParser(strings.NewReader(source)).Parse()
+
+	d := syntax.NewDiagnostic(err, source)
+	if d == nil {
+		t.Fatalf("got no diagnostic for %v", err)
+	}
+	if d.Line != "\ttype Pair (a: int bool: int)" {
+		t.Errorf("got line %q", d.Line)
+	}
+	if d.Caret != "\t                  ^~~~" {
+		t.Errorf("got caret %q", d.Caret)
+	}
+	if !slices.Equal(d.Expected, []syntax.TokenType{syntax.TokenRParen, syntax.TokenComment, syntax.TokenNewline, syntax.TokenFieldName}) {
+		t.Errorf("got expected tokens %v", d.Expected)
+	}
+
+	expected := "at 2:20: on token `bool`: expected token ), <comment>, <newline>, or <field-name>\n" +
+		"\t\ttype Pair (a: int bool: int)\n" +
+		"\t\t                  ^~~~"
+	if s := syntax.FormatError(err, source); s != expected {
+		t.Errorf("formatted error as:\n%s\nexpected:\n%s", s, expected)
+	}
+}
+
+func TestParserLimits(t *testing.T) {
+	const source = "interface org.example.limits\ntype T ?[]string\nmethod A(x: T) -> ()\n"
+
+	tests := []struct {
+		limits syntax.Limits
+		ok     bool
+	}{
+		{syntax.Limits{}, true},
+		{syntax.UntrustedLimits, true},
+		{syntax.Limits{MaxDepth: 3}, true},
+		{syntax.Limits{MaxDepth: 2}, false},
+		{syntax.Limits{MaxSize: len(source)}, true},
+		{syntax.Limits{MaxSize: len(source) - 1}, false},
+		{syntax.Limits{MaxTokens: 10}, false},
+	}
+	for _, tt := range tests {
+		p := syntax.NewParser(strings.NewReader(source))
+		p.Limits = tt.limits
+		_, err := p.Parse()
+		if tt.ok && err != nil {
+			t.Errorf("parsing with limits %+v failed: %v", tt.limits, err)
+		}
+		if !tt.ok && !errors.Is(err, syntax.ErrLimitExceeded) {
+			t.Errorf("parsing with limits %+v returned %v, expected ErrLimitExceeded", tt.limits, err)
+		}
+	}
+
+	// Deeply nested input must fail cleanly, even when recovering.
+	deep := "interface org.example.limits\ntype T " + strings.Repeat("?[]", 10000) + "int\nmethod A() -> ()\n"
+	p := syntax.NewParser(strings.NewReader(deep))
+	p.Limits = syntax.UntrustedLimits
+	if _, errs := p.ParseRecover(); len(errs) != 1 || !errors.Is(errs[0], syntax.ErrLimitExceeded) {
+		t.Errorf("got errors %v, expected a single ErrLimitExceeded", errs)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Edit is a change to the text of a document, replacing the Length bytes at
+// Offset with Text, as sent by editors.
+type Edit struct {
+	Offset int
+	Length int
+	Text   string
+}
+
+// Apply returns source with the edit applied.
+func (e Edit) Apply(source string) string {
+	return source[:e.Offset] + e.Text + source[e.Offset+e.Length:]
+}
+
+// Reparse returns the interface definition of the document resulting from
+// applying edit to source, the document prev was parsed from. Only the
+// definitions touched by the edit, and the one following them, whose
+// comments the edit may have changed, are parsed again; the other
+// definitions are taken from prev, with their positions moved past the
+// edit. This keeps reparsing cheap for editors and language servers,
+// which reparse documents on every keystroke.
+//
+// Edits to the interface header, and edits that make the touched
+// definitions invalid, fall back to parsing the whole document, and the
+// returned error is that of Parse. The reparsed definitions have no lossless
+// tokens.
+func Reparse(prev InterfaceDef, source string, edit Edit) (InterfaceDef, error) {
+	if edit.Offset < 0 || edit.Length < 0 || edit.Offset+edit.Length > len(source) {
+		return InterfaceDef{}, fmt.Errorf("edit of %d bytes at offset %d is out of the bounds of the document", edit.Length, edit.Offset)
+	}
+	updated := edit.Apply(source)
+
+	intf, ok := reparse(prev, source, updated, edit)
+	if !ok {
+		return NewParser(strings.NewReader(updated)).Parse()
+	}
+	return intf, nil
+}
+
+// segment is the text of a definition, from the beginning of the line of
+// its first comment to the beginning of the next segment.
+type segment struct {
+	start Cursor // always at the beginning of a line
+	def   Node
+}
+
+// reparse reparses the segments touched by the edit, and reports whether
+// it could.
+func reparse(prev InterfaceDef, source, updated string, edit Edit) (InterfaceDef, bool) {
+	var segments []segment
+	add := func(def Node) {
+		start := def.Position
+		if len(def.Comments) > 0 {
+			start = def.Comments[0].Start
+		}
+		bol := strings.LastIndexByte(source[:start.Offset], '\n') + 1
+		segments = append(segments, segment{
+			start: Cursor{Line: start.Line, Column: 1, Offset: bol},
+			def:   def,
+		})
+	}
+	for _, t := range prev.Types {
+		add(t.Node)
+	}
+	for _, m := range prev.Methods {
+		add(m.Node)
+	}
+	for _, e := range prev.Errors {
+		add(e.Node)
+	}
+	if len(segments) == 0 {
+		return InterfaceDef{}, false
+	}
+	slices.SortFunc(segments, func(a, b segment) int {
+		return cmp.Compare(a.start.Offset, b.start.Offset)
+	})
+
+	// Definitions sharing a line cannot be told apart by segment.
+	for i := 1; i < len(segments); i++ {
+		if segments[i].start.Offset < segments[i-1].def.End.Offset {
+			return InterfaceDef{}, false
+		}
+	}
+	if edit.Offset < segments[0].start.Offset {
+		return InterfaceDef{}, false
+	}
+
+	// Find the segments touched by the edit, including the ones it starts
+	// or ends at the boundary of.
+	end := func(i int) int {
+		if i+1 < len(segments) {
+			return segments[i+1].start.Offset
+		}
+		return len(source)
+	}
+	first := len(segments) - 1
+	for i := range segments {
+		if edit.Offset <= end(i) {
+			first = i
+			break
+		}
+	}
+	last := first
+	for last+1 < len(segments) && edit.Offset+edit.Length >= segments[last+1].start.Offset {
+		last++
+	}
+	last = min(last+1, len(segments)-1)
+
+	start, stop := segments[first].start, end(last)
+	delta := shifter{
+		offset: len(edit.Text) - edit.Length,
+		line:   strings.Count(edit.Text, "\n") - strings.Count(source[edit.Offset:edit.Offset+edit.Length], "\n"),
+	}
+
+	header := "interface " + prev.Name + "\n"
+	fragment, err := NewParser(strings.NewReader(header + updated[start.Offset:stop+delta.offset])).Parse()
+	if err != nil {
+		return InterfaceDef{}, false
+	}
+	moved := shifter{offset: start.Offset - len(header), line: start.Line - 2}
+
+	intf := InterfaceDef{Node: prev.Node, Name: prev.Name}
+	before := func(n Node) bool { return n.Position.Offset < start.Offset }
+	after := func(n Node) bool { return n.Position.Offset >= stop }
+
+	for _, t := range prev.Types {
+		if before(t.Node) {
+			intf.Types = append(intf.Types, t)
+		}
+	}
+	for _, t := range fragment.Types {
+		intf.Types = append(intf.Types, moved.typeDef(t))
+	}
+	for _, t := range prev.Types {
+		if after(t.Node) {
+			intf.Types = append(intf.Types, delta.typeDef(t))
+		}
+	}
+
+	for _, m := range prev.Methods {
+		if before(m.Node) {
+			intf.Methods = append(intf.Methods, m)
+		}
+	}
+	for _, m := range fragment.Methods {
+		intf.Methods = append(intf.Methods, moved.methodDef(m))
+	}
+	for _, m := range prev.Methods {
+		if after(m.Node) {
+			intf.Methods = append(intf.Methods, delta.methodDef(m))
+		}
+	}
+
+	for _, e := range prev.Errors {
+		if before(e.Node) {
+			intf.Errors = append(intf.Errors, e)
+		}
+	}
+	for _, e := range fragment.Errors {
+		intf.Errors = append(intf.Errors, moved.errorDef(e))
+	}
+	for _, e := range prev.Errors {
+		if after(e.Node) {
+			intf.Errors = append(intf.Errors, delta.errorDef(e))
+		}
+	}
+
+	// The interface ends with its last definition.
+	nodes := definitionNodes(intf)
+	if len(nodes) == 0 {
+		return InterfaceDef{}, false
+	}
+	intf.End = Cursor{}
+	for _, n := range nodes {
+		if n.End.Offset > intf.End.Offset {
+			intf.End = n.End
+		}
+	}
+	return intf, true
+}
+
+func definitionNodes(intf InterfaceDef) []Node {
+	var nodes []Node
+	for _, t := range intf.Types {
+		nodes = append(nodes, t.Node)
+	}
+	for _, m := range intf.Methods {
+		nodes = append(nodes, m.Node)
+	}
+	for _, e := range intf.Errors {
+		nodes = append(nodes, e.Node)
+	}
+	return nodes
+}
+
+// shifter moves the positions of nodes by a number of bytes and lines.
+// Nodes are moved by whole lines, so their columns do not change.
+type shifter struct {
+	offset, line int
+}
+
+func (s shifter) cursor(c Cursor) Cursor {
+	c.Offset += s.offset
+	c.Line += s.line
+	return c
+}
+
+func (s shifter) tokens(tokens []Token) []Token {
+	if tokens == nil {
+		return nil
+	}
+	out := make([]Token, len(tokens))
+	for i, t := range tokens {
+		t.Start, t.End = s.cursor(t.Start), s.cursor(t.End)
+		out[i] = t
+	}
+	return out
+}
+
+func (s shifter) node(n Node) Node {
+	return Node{
+		Position: s.cursor(n.Position),
+		End:      s.cursor(n.End),
+		Comments: s.tokens(n.Comments),
+		Tokens:   s.tokens(n.Tokens),
+	}
+}
+
+func (s shifter) typeDef(t TypeDef) TypeDef {
+	return TypeDef{Node: s.node(t.Node), Name: t.Name, Type: s.typ(t.Type)}
+}
+
+func (s shifter) methodDef(m MethodDef) MethodDef {
+	return MethodDef{
+		Node:   s.node(m.Node),
+		Name:   m.Name,
+		Input:  s.typ(m.Input).(StructType),
+		Output: s.typ(m.Output).(StructType),
+	}
+}
+
+func (s shifter) errorDef(e ErrorDef) ErrorDef {
+	return ErrorDef{Node: s.node(e.Node), Name: e.Name, Params: s.typ(e.Params).(StructType)}
+}
+
+func (s shifter) typ(t Type) Type {
+	switch t := t.(type) {
+	case StructType:
+		var fields []StructField
+		for _, f := range t.Fields {
+			fields = append(fields, StructField{Node: s.node(f.Node), Name: f.Name, Type: s.typ(f.Type)})
+		}
+		return StructType{Node: s.node(t.Node), Fields: fields}
+	case EnumType:
+		var values []EnumValue
+		for _, v := range t.Values {
+			values = append(values, EnumValue{Node: s.node(v.Node), Name: v.Name})
+		}
+		return EnumType{Node: s.node(t.Node), Values: values}
+	case ArrayType:
+		return ArrayType{Node: s.node(t.Node), ElemType: s.typ(t.ElemType)}
+	case DictType:
+		return DictType{Node: s.node(t.Node), ElemType: s.typ(t.ElemType)}
+	case NullableType:
+		return NullableType{Node: s.node(t.Node), Type: s.typ(t.Type)}
+	case BuiltinType:
+		return BuiltinType{Node: s.node(t.Node), Name: t.Name}
+	case NamedType:
+		return NamedType{Node: s.node(t.Node), Name: t.Name}
+	default:
+		panic(fmt.Sprintf("unknown type %T", t))
+	}
+}
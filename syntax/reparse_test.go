@@ -0,0 +1,77 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestReparse(t *testing.T) {
+	const source = `# An interface
+interface org.example.reparse
+
+# A pair
+type Pair (a: int, b: []?string)
+
+type Color (red, green)
+
+# Swaps a pair
+method Swap(pair: Pair) -> (
+  pair: Pair # swapped
+)
+
+method Paint(color: Color) -> ()
+
+error Failed (reason: string)
+`
+	edit := func(old, new string) syntax.Edit {
+		i := strings.Index(source, old)
+		if i == -1 {
+			t.Fatalf("%q is not in the source", old)
+		}
+		return syntax.Edit{Offset: i, Length: len(old), Text: new}
+	}
+
+	tests := []struct {
+		name string
+		edit syntax.Edit
+	}{
+		{"rename field", edit("b: []?string", "second: []?string")},
+		{"add lines", edit("  pair: Pair # swapped\n", "  pair: Pair # swapped\n  count: int\n")},
+		{"remove lines", edit("type Color (red, green)\n\n", "")},
+		{"add definition", edit("error Failed", "method New() -> ()\n\nerror Failed")},
+		{"add doc comment", edit("\nmethod Paint", "# Paints\nmethod Paint")},
+		{"edit last definition", edit("reason: string", "reason: string, code: int")},
+		{"edit header", edit("An interface", "The interface")},
+		{"break definition", edit("(red, green)", "(red, green")},
+		{"insert at end", syntax.Edit{Offset: len(source), Text: "error Other ()\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, err := syntax.NewParser(strings.NewReader(source)).Parse()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			updated := tt.edit.Apply(source)
+			expected, experr := syntax.NewParser(strings.NewReader(updated)).Parse()
+
+			intf, err := syntax.Reparse(prev, source, tt.edit)
+			if (err == nil) != (experr == nil) {
+				t.Fatalf("reparsing returned error %v, expected %v", err, experr)
+			}
+			if err == nil && !reflect.DeepEqual(intf, expected) {
+				t.Logf("reparsed: %#v", intf)
+				t.Logf("parsed: %#v", expected)
+				t.Fatal("reparsed interface is different from the parsed one")
+			}
+		})
+	}
+}
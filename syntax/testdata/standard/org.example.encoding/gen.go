@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinkrt"
 
 	"snai.pe/go-varlink/syntax"
 )
@@ -17,6 +18,9 @@ import (
 var _ = fmt.Errorf
 var _ = json.RawMessage(nil)
 var _ = context.Background
+var _ = varlinkrt.Validate
+
+type Error = varlink.Error
 
 // InterfaceName is the fully-qualified name of this varlink interface.
 const InterfaceName = `org.example.encoding`
@@ -40,6 +44,19 @@ type Order struct {
 	Customer  string     `json:"customer"`
 }
 
+// Validate checks the values of the fields of Order, as a
+// parameter of the specified name.
+func (t Order) Validate(param string) Error {
+	for _, e := range t.Shipments {
+		if v, ok := any(e).(interface{ Validate(string) Error }); ok {
+			if err := v.Validate(varlinkrt.JoinParam(param, "shipments[*]")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Input parameters for Ping method.
 //
 // You shouldn't have to use this type directly; it is only useful if you
@@ -115,9 +132,9 @@ type GetOrderOutput struct {
 	Order Order `json:"order"`
 }
 
-func (output *GetOrderOutput) Validate(param string) varlink.Error {
-	if v, ok := any(output.Order).(interface{ Validate() varlink.Error }); ok {
-		if err := v.Validate(); err != nil {
+func (output *GetOrderOutput) Validate(param string) Error {
+	if v, ok := any(output.Order).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "order")); err != nil {
 			return err
 		}
 	}
@@ -145,18 +162,10 @@ type Client struct {
 
 // ErrorFromCode returns a new varlink error constructed from the specified
 // code and parameters.
-func ErrorFromCode(code string, params json.RawMessage) varlink.Error {
+func ErrorFromCode(code string, params json.RawMessage) Error {
 	switch code {
 	default:
-		var kvargs []any
-		var pmap map[string]any
-		if err2_ := json.Unmarshal([]byte(params), &pmap); err2_ != nil {
-			panic(`programming error: ` + code + ` params is invalid json: ` + err2_.Error())
-		}
-		for k, v := range pmap {
-			kvargs = append(kvargs, k, v)
-		}
-		return varlink.NewError(code, kvargs...)
+		return varlinkrt.GenericError(code, params)
 	}
 }
 
@@ -169,30 +178,8 @@ func (client_ *Client) Ping(ctx context.Context, ping string) (pong string, err_
 
 	input_.Pack(ping)
 
-	rs, err := client_.Call(ctx, `org.example.encoding.Ping`, &input_)
-	if err != nil {
-		err_ = err
-		return
-	}
-
-	for rs.Next() {
-		r := rs.Reply()
-		if r.Error != "" {
-			err_ = ErrorFromCode(r.Error, r.Parameters)
-			return
-		}
-		if r.Continues {
-			err_ = fmt.Errorf("more than one reply on single-reply call")
-			return
-		}
-
-		if err := rs.Unmarshal(&output_); err != nil {
-			err_ = err
-			return
-		}
-	}
-	if err := rs.Error(); err != nil {
-		err_ = err
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `org.example.encoding.Ping`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
 		return
 	}
 
@@ -209,30 +196,8 @@ func (client_ *Client) GetOrder(ctx context.Context, num int) (order Order, err_
 
 	input_.Pack(num)
 
-	rs, err := client_.Call(ctx, `org.example.encoding.GetOrder`, &input_)
-	if err != nil {
-		err_ = err
-		return
-	}
-
-	for rs.Next() {
-		r := rs.Reply()
-		if r.Error != "" {
-			err_ = ErrorFromCode(r.Error, r.Parameters)
-			return
-		}
-		if r.Continues {
-			err_ = fmt.Errorf("more than one reply on single-reply call")
-			return
-		}
-
-		if err := rs.Unmarshal(&output_); err != nil {
-			err_ = err
-			return
-		}
-	}
-	if err := rs.Error(); err != nil {
-		err_ = err
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `org.example.encoding.GetOrder`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
 		return
 	}
 
@@ -245,10 +210,10 @@ func (client_ *Client) GetOrder(ctx context.Context, num int) (order Order, err_
 type Service interface {
 
 	// Returns the same string
-	Ping(ctx context.Context, ping string) (pong string, err_ varlink.Error)
+	Ping(ctx context.Context, ping string) (pong string, err_ Error)
 
 	// Returns a fake order given an order number
-	GetOrder(ctx context.Context, num int) (order Order, err_ varlink.Error)
+	GetOrder(ctx context.Context, num int) (order Order, err_ Error)
 }
 
 // NewHandler creates a new method handler for the specified service implementation.
@@ -261,68 +226,219 @@ func NewHandler(s Service) varlink.MethodHandler {
 // RegisterHandlers registers all of the method handlers for the specified
 // service implementation into the passed ServeMux.
 func RegisterHandlers(mux *varlink.ServeMux, s Service) {
-	mux.HandleFunc("org.example.encoding.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
-		var (
-			input  PingInput
-			output PingOutput
-		)
+	mux.HandleMethod(PingHandler(s.Ping))
+	mux.HandleMethod(GetOrderHandler(s.GetOrder))
+}
+
+// TypedService is implemented by implementations of the org.example.encoding
+// varlink interface that take and return the input and output structs of
+// its methods. Methods replying more than once, which are marked with
+// @more or @streamable, send their replies with send.
+type TypedService interface {
+
+	// Returns the same string
+	Ping(ctx context.Context, input *PingInput) (*PingOutput, error)
+
+	// Returns a fake order given an order number
+	GetOrder(ctx context.Context, input *GetOrderInput) (*GetOrderOutput, error)
+}
 
-		if err := call.Unmarshal(&input); err != nil {
+// NewDispatcher returns a handler routing the calls to the methods of the
+// org.example.encoding interface to impl, after decoding and validating
+// their input. Errors returned by impl are converted with
+// varlink.ConvertError, and calls to other methods are replied with
+// org.varlink.service.MethodNotFound. To also serve the introspection
+// methods, register the dispatcher with varlink.ServeMux.Handle, with the
+// pattern `org.example.encoding.*`.
+func NewDispatcher(impl TypedService) varlink.MethodHandler {
+	return dispatcher{impl}
+}
+
+type dispatcher struct {
+	impl TypedService
+}
+
+func (d_ dispatcher) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	switch call.Method {
+	case `org.example.encoding.Ping`:
+		var input_ PingInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
 			w.WriteError(err)
 			return
 		}
-
-		validate := func() varlink.Error {
-
-			return nil
+		output_, err := d_.impl.Ping(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(PingOutput)
 		}
-		if err := validate(); err != nil {
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	case `org.example.encoding.GetOrder`:
+		var input_ GetOrderInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
 			w.WriteError(err)
 			return
 		}
-
-		var err varlink.Error
-		output.Pong, err = s.Ping(w.Context(), input.Ping)
+		output_, err := d_.impl.GetOrder(w.Context(), &input_)
 		if err != nil {
-			w.WriteError(err)
+			w.WriteError(varlink.ConvertError(w.Context(), err))
 			return
 		}
+		if output_ == nil {
+			output_ = new(GetOrderOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	default:
+		w.WriteError(varlinkrt.MethodNotFound(call.Method))
+	}
+}
 
-		w.WriteReply(&output)
+// PingHandler is an adapter to allow the use of ordinary
+// functions as handlers of the Ping method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type PingHandler func(ctx context.Context, ping string) (pong string, err_ Error)
+
+// MethodName returns the fully-qualified name of the Ping method.
+func (PingHandler) MethodName() string {
+	return `org.example.encoding.Ping`
+}
+
+func (fn PingHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  PingInput
+		output PingOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Pong, err = fn(w.Context(), input.Ping)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// PingService is implemented by context-first implementations of
+// the Ping method, which send their replies with send.
+type PingService interface {
+	Ping(ctx context.Context, ping string, send func(pong string) error) error
+}
+
+// NewPingHandler returns a handler of the Ping method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewPingHandler(impl PingService) varlink.Method {
+	return pingAdapter{impl}
+}
+
+type pingAdapter struct {
+	impl PingService
+}
+
+func (pingAdapter) MethodName() string {
+	return `org.example.encoding.Ping`
+}
+
+func (a_ pingAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ PingInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*PingOutput) error) error {
+		return a_.impl.Ping(ctx_, input_.Ping, func(pong string) error {
+			var output_ PingOutput
+			output_.Pack(pong)
+			return send_(&output_)
+		})
 	})
-	mux.HandleFunc("org.example.encoding.GetOrder", func(w varlink.ReplyWriter, call *varlink.Call) {
-		var (
-			input  GetOrderInput
-			output GetOrderOutput
-		)
+}
 
-		if err := call.Unmarshal(&input); err != nil {
-			w.WriteError(err)
-			return
-		}
+// GetOrderHandler is an adapter to allow the use of ordinary
+// functions as handlers of the GetOrder method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type GetOrderHandler func(ctx context.Context, num int) (order Order, err_ Error)
 
-		validate := func() varlink.Error {
+// MethodName returns the fully-qualified name of the GetOrder method.
+func (GetOrderHandler) MethodName() string {
+	return `org.example.encoding.GetOrder`
+}
 
-			return nil
-		}
-		if err := validate(); err != nil {
-			w.WriteError(err)
-			return
-		}
+func (fn GetOrderHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  GetOrderInput
+		output GetOrderOutput
+	)
 
-		var err varlink.Error
-		output.Order, err = s.GetOrder(w.Context(), input.Num)
-		if err != nil {
-			w.WriteError(err)
-			return
-		}
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Order, err = fn(w.Context(), input.Num)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// GetOrderService is implemented by context-first implementations of
+// the GetOrder method, which send their replies with send.
+type GetOrderService interface {
+	GetOrder(ctx context.Context, num int, send func(order Order) error) error
+}
 
-		w.WriteReply(&output)
+// NewGetOrderHandler returns a handler of the GetOrder method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewGetOrderHandler(impl GetOrderService) varlink.Method {
+	return getOrderAdapter{impl}
+}
+
+type getOrderAdapter struct {
+	impl GetOrderService
+}
+
+func (getOrderAdapter) MethodName() string {
+	return `org.example.encoding.GetOrder`
+}
+
+func (a_ getOrderAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ GetOrderInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*GetOrderOutput) error) error {
+		return a_.impl.GetOrder(ctx_, input_.Num, func(order Order) error {
+			var output_ GetOrderOutput
+			output_.Pack(order)
+			return send_(&output_)
+		})
 	})
 }
 
 // Definition contains the definition of the varlink interface which was parsed from its description.
-var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 2, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Example Varlink service\n", Value: "Example Varlink service", Start: syntax.Cursor{Line: 1, Column: 1}, End: syntax.Cursor{Line: 1, Column: 26}}}}, Name: "org.example.encoding", Types: []syntax.TypeDef{syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 1}, Comments: []syntax.Token(nil)}, Name: "State", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 12}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 3}, Comments: []syntax.Token(nil)}, Name: "start", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 10}, Comments: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 11}, Comments: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 3}, Comments: []syntax.Token(nil)}, Name: "progress", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 13}, Comments: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 14}, Comments: []syntax.Token(nil)}, Name: "int"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 3}, Comments: []syntax.Token(nil)}, Name: "end", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 8}, Comments: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 9}, Comments: []syntax.Token(nil)}, Name: "bool"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 1}, Comments: []syntax.Token(nil)}, Name: "Shipment", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 15}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 3}, Comments: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 9}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 3}, Comments: []syntax.Token(nil)}, Name: "description", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 16}, Comments: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 3}, Comments: []syntax.Token(nil)}, Name: "size", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 9}, Comments: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 3}, Comments: []syntax.Token(nil)}, Name: "weight", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 11}, Comments: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 12}, Comments: []syntax.Token(nil)}, Name: "int"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 1}, Comments: []syntax.Token(nil)}, Name: "Order", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 12}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 3}, Comments: []syntax.Token(nil)}, Name: "shipments", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 14}, Comments: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 16}, Comments: []syntax.Token(nil)}, Name: "Shipment"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 3}, Comments: []syntax.Token(nil)}, Name: "order_num", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 14}, Comments: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 3}, Comments: []syntax.Token(nil)}, Name: "customer", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 13}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}}, Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Returns the same string\n", Value: "Returns the same string", Start: syntax.Cursor{Line: 23, Column: 1}, End: syntax.Cursor{Line: 23, Column: 26}}}}, Name: "Ping", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 12}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 13}, Comments: []syntax.Token(nil)}, Name: "ping", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 19}, Comments: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 30}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 31}, Comments: []syntax.Token(nil)}, Name: "pong", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 37}, Comments: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 1}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Returns a fake order given an order number\n", Value: "Returns a fake order given an order number", Start: syntax.Cursor{Line: 26, Column: 1}, End: syntax.Cursor{Line: 26, Column: 45}}}}, Name: "GetOrder", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 16}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 17}, Comments: []syntax.Token(nil)}, Name: "num", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 22}, Comments: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 30}, Comments: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 31}, Comments: []syntax.Token(nil)}, Name: "order", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 38}, Comments: []syntax.Token(nil)}, Name: "Order"}}}}}}, Errors: []syntax.ErrorDef(nil)}
+var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 2, Column: 1, Offset: 26}, End: syntax.Cursor{Line: 27, Column: 44, Offset: 444}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Example Varlink service\n", Value: "Example Varlink service", Start: syntax.Cursor{Line: 1, Column: 1, Offset: 0}, End: syntax.Cursor{Line: 1, Column: 26, Offset: 25}}}, Tokens: []syntax.Token(nil)}, Name: "org.example.encoding", Types: []syntax.TypeDef{syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 1, Offset: 58}, End: syntax.Cursor{Line: 8, Column: 2, Offset: 119}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "State", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 12, Offset: 69}, End: syntax.Cursor{Line: 8, Column: 2, Offset: 119}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 3, Offset: 73}, End: syntax.Cursor{Line: 5, Column: 15, Offset: 85}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "start", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 10, Offset: 80}, End: syntax.Cursor{Line: 5, Column: 15, Offset: 85}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 5, Column: 11, Offset: 81}, End: syntax.Cursor{Line: 5, Column: 15, Offset: 85}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 3, Offset: 89}, End: syntax.Cursor{Line: 6, Column: 17, Offset: 103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "progress", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 13, Offset: 99}, End: syntax.Cursor{Line: 6, Column: 17, Offset: 103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 6, Column: 14, Offset: 100}, End: syntax.Cursor{Line: 6, Column: 17, Offset: 103}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 3, Offset: 107}, End: syntax.Cursor{Line: 7, Column: 13, Offset: 117}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "end", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 8, Offset: 112}, End: syntax.Cursor{Line: 7, Column: 13, Offset: 117}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 9, Offset: 113}, End: syntax.Cursor{Line: 7, Column: 13, Offset: 117}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "bool"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 1, Offset: 121}, End: syntax.Cursor{Line: 15, Column: 2, Offset: 205}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Shipment", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 10, Column: 15, Offset: 135}, End: syntax.Cursor{Line: 15, Column: 2, Offset: 205}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 3, Offset: 139}, End: syntax.Cursor{Line: 11, Column: 15, Offset: 151}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "name", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 11, Column: 9, Offset: 145}, End: syntax.Cursor{Line: 11, Column: 15, Offset: 151}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 3, Offset: 155}, End: syntax.Cursor{Line: 12, Column: 22, Offset: 174}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "description", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 12, Column: 16, Offset: 168}, End: syntax.Cursor{Line: 12, Column: 22, Offset: 174}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 3, Offset: 178}, End: syntax.Cursor{Line: 13, Column: 12, Offset: 187}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "size", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 13, Column: 9, Offset: 184}, End: syntax.Cursor{Line: 13, Column: 12, Offset: 187}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 3, Offset: 191}, End: syntax.Cursor{Line: 14, Column: 15, Offset: 203}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "weight", Type: syntax.NullableType{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 11, Offset: 199}, End: syntax.Cursor{Line: 14, Column: 15, Offset: 203}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 14, Column: 12, Offset: 200}, End: syntax.Cursor{Line: 14, Column: 15, Offset: 203}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}}}, syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 1, Offset: 207}, End: syntax.Cursor{Line: 21, Column: 2, Offset: 283}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Order", Type: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 17, Column: 12, Offset: 218}, End: syntax.Cursor{Line: 21, Column: 2, Offset: 283}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 3, Offset: 222}, End: syntax.Cursor{Line: 18, Column: 24, Offset: 243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "shipments", Type: syntax.ArrayType{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 14, Offset: 233}, End: syntax.Cursor{Line: 18, Column: 24, Offset: 243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, ElemType: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 18, Column: 16, Offset: 235}, End: syntax.Cursor{Line: 18, Column: 24, Offset: 243}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Shipment"}}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 3, Offset: 247}, End: syntax.Cursor{Line: 19, Column: 17, Offset: 261}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "order_num", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 19, Column: 14, Offset: 258}, End: syntax.Cursor{Line: 19, Column: 17, Offset: 261}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}, syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 3, Offset: 265}, End: syntax.Cursor{Line: 20, Column: 19, Offset: 281}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "customer", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 20, Column: 13, Offset: 275}, End: syntax.Cursor{Line: 20, Column: 19, Offset: 281}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}}, Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 1, Offset: 311}, End: syntax.Cursor{Line: 24, Column: 44, Offset: 354}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Returns the same string\n", Value: "Returns the same string", Start: syntax.Cursor{Line: 23, Column: 1, Offset: 285}, End: syntax.Cursor{Line: 23, Column: 26, Offset: 310}}}, Tokens: []syntax.Token(nil)}, Name: "Ping", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 12, Offset: 322}, End: syntax.Cursor{Line: 24, Column: 26, Offset: 336}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 13, Offset: 323}, End: syntax.Cursor{Line: 24, Column: 25, Offset: 335}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "ping", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 19, Offset: 329}, End: syntax.Cursor{Line: 24, Column: 25, Offset: 335}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 30, Offset: 340}, End: syntax.Cursor{Line: 24, Column: 44, Offset: 354}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 31, Offset: 341}, End: syntax.Cursor{Line: 24, Column: 43, Offset: 353}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "pong", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 24, Column: 37, Offset: 347}, End: syntax.Cursor{Line: 24, Column: 43, Offset: 353}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "string"}}}}}, syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 1, Offset: 401}, End: syntax.Cursor{Line: 27, Column: 44, Offset: 444}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Returns a fake order given an order number\n", Value: "Returns a fake order given an order number", Start: syntax.Cursor{Line: 26, Column: 1, Offset: 356}, End: syntax.Cursor{Line: 26, Column: 45, Offset: 400}}}, Tokens: []syntax.Token(nil)}, Name: "GetOrder", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 16, Offset: 416}, End: syntax.Cursor{Line: 27, Column: 26, Offset: 426}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 17, Offset: 417}, End: syntax.Cursor{Line: 27, Column: 25, Offset: 425}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "num", Type: syntax.BuiltinType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 22, Offset: 422}, End: syntax.Cursor{Line: 27, Column: 25, Offset: 425}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "int"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 30, Offset: 430}, End: syntax.Cursor{Line: 27, Column: 44, Offset: 444}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 31, Offset: 431}, End: syntax.Cursor{Line: 27, Column: 43, Offset: 443}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "order", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 27, Column: 38, Offset: 438}, End: syntax.Cursor{Line: 27, Column: 43, Offset: 443}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Order"}}}}}}, Errors: []syntax.ErrorDef(nil)}
 
 // Description contains the description of the varlink interface, expressed in the IDL.
 var Description = `# Example Varlink service
@@ -353,3 +469,20 @@ method Ping(ping: string) -> (pong: string)
 # Returns a fake order given an order number
 method GetOrder(num: int) -> (order: Order)
 `
+
+// Fully-qualified names of the methods of the interface.
+const (
+	MethodPing     = `org.example.encoding.Ping`
+	MethodGetOrder = `org.example.encoding.GetOrder`
+)
+
+// Fingerprint is the fingerprint of the varlink interface definition, as
+// computed by syntax.Fingerprint.
+const Fingerprint = `25d7549a37acd746671f571c6e8d151ac2379b9792ffb811782b746ec4b29a62`
+
+// VerifyAgainst checks that the specified description, typically obtained
+// from a service via org.varlink.service.GetInterfaceDescription, defines
+// the same interface that this code was generated from.
+func VerifyAgainst(description string) error {
+	return syntax.VerifyFingerprint(description, Fingerprint)
+}
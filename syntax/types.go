@@ -8,10 +8,21 @@ package syntax
 // Node represents a node in the AST. All AST types embed this.
 type Node struct {
 	// The starting position of the node in the file (ignoring comments and whitespace)
-	Position Cursor
+	Position Cursor `json:"position"`
+
+	// The position right after the node in the file (ignoring comments)
+	End Cursor `json:"end"`
 
 	// Any comments attached to this node
-	Comments []Token
+	Comments []Token `json:"comments,omitempty"`
+
+	// All the tokens of the node, from its start to its end, including
+	// whitespace and comments. Only set by parsers in lossless mode.
+	Tokens []Token `json:"tokens,omitempty"`
+}
+
+func (n Node) comments() []Token {
+	return n.Comments
 }
 
 // InterfaceDef is the definition of a varlink interface.
@@ -19,16 +30,16 @@ type InterfaceDef struct {
 	Node
 
 	// The fully-qualified name of the interface.
-	Name string
+	Name string `json:"name"`
 
 	// Types defined in this interface.
-	Types []TypeDef
+	Types []TypeDef `json:"types,omitempty"`
 
 	// Methods defined in this interface.
-	Methods []MethodDef
+	Methods []MethodDef `json:"methods,omitempty"`
 
 	// Error types defined in this interface.
-	Errors []ErrorDef
+	Errors []ErrorDef `json:"errors,omitempty"`
 }
 
 // TypeDef is the definition of a named varlink type.
@@ -36,10 +47,16 @@ type TypeDef struct {
 	Node
 
 	// Name of the type.
-	Name string
+	Name string `json:"name"`
 
 	// Type definition.
-	Type Type
+	Type Type `json:"type"`
+}
+
+// Text returns the exact text of the definition in source, the document it
+// was parsed from, excluding its comments.
+func (def *TypeDef) Text(source string) string {
+	return source[def.Position.Offset:def.End.Offset]
 }
 
 // Type is the interface representing all possible AST nodes representing a varlink type.
@@ -52,7 +69,7 @@ type StructType struct {
 	Node
 
 	// The fields making up this struct.
-	Fields []StructField
+	Fields []StructField `json:"fields"`
 }
 
 func (StructType) isType() {}
@@ -62,10 +79,10 @@ type StructField struct {
 	Node
 
 	// The name of the field.
-	Name string
+	Name string `json:"name"`
 
 	// The type of the field.
-	Type Type
+	Type Type `json:"type"`
 }
 
 // EnumType is a Type defining an enumeration.
@@ -73,7 +90,7 @@ type EnumType struct {
 	Node
 
 	// The values that make up the enumeration.
-	Values []EnumValue
+	Values []EnumValue `json:"values"`
 }
 
 // EnumValue is an enumeration value.
@@ -81,7 +98,7 @@ type EnumValue struct {
 	Node
 
 	// The name of the enumeration value.
-	Name string
+	Name string `json:"name"`
 }
 
 func (EnumType) isType() {}
@@ -90,7 +107,7 @@ func (EnumType) isType() {}
 type BuiltinType struct {
 	Node
 
-	Name string
+	Name string `json:"name"`
 }
 
 func (BuiltinType) isType() {}
@@ -100,7 +117,7 @@ type NamedType struct {
 	Node
 
 	// The name of the type.
-	Name string
+	Name string `json:"name"`
 }
 
 func (NamedType) isType() {}
@@ -110,7 +127,7 @@ type ArrayType struct {
 	Node
 
 	// The type of the array elements.
-	ElemType Type
+	ElemType Type `json:"elem"`
 }
 
 func (ArrayType) isType() {}
@@ -120,7 +137,7 @@ type DictType struct {
 	Node
 
 	// The type of the map values.
-	ElemType Type
+	ElemType Type `json:"elem"`
 }
 
 func (DictType) isType() {}
@@ -130,7 +147,7 @@ type NullableType struct {
 	Node
 
 	// The type that is made nullable.
-	Type Type
+	Type Type `json:"type"`
 }
 
 func (NullableType) isType() {}
@@ -140,13 +157,19 @@ type MethodDef struct {
 	Node
 
 	// The name of the method.
-	Name string
+	Name string `json:"name"`
 
 	// The input parameters.
-	Input StructType
+	Input StructType `json:"input"`
 
 	// The output parameters.
-	Output StructType
+	Output StructType `json:"output"`
+}
+
+// Text returns the exact text of the definition in source, the document it
+// was parsed from, excluding its comments.
+func (def *MethodDef) Text(source string) string {
+	return source[def.Position.Offset:def.End.Offset]
 }
 
 // ErrorDef represents an error type definition.
@@ -154,8 +177,14 @@ type ErrorDef struct {
 	Node
 
 	// The name of the error type.
-	Name string
+	Name string `json:"name"`
 
 	// The parameters of the error.
-	Params StructType
+	Params StructType `json:"params"`
+}
+
+// Text returns the exact text of the definition in source, the document it
+// was parsed from, excluding its comments.
+func (def *ErrorDef) Text(source string) string {
+	return source[def.Position.Offset:def.End.Offset]
 }
@@ -0,0 +1,111 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children of
+// node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node any) (w Visitor)
+}
+
+// Walk traverses an AST in depth-first order: it starts by calling
+// v.Visit(node); node must not be nil. If the visitor w returned by
+// v.Visit(node) is not nil, Walk is invoked recursively with visitor w for
+// each of the non-nil children of node, followed by a call of w.Visit(nil).
+//
+// Nodes are the AST values themselves, rather than pointers to them:
+// InterfaceDef, TypeDef, MethodDef, ErrorDef, StructField, EnumValue, and
+// the implementations of Type. The definitions of an InterfaceDef are
+// visited in the order in which they appear in the parsed document, the
+// input of a method before its output, and fields and values in order.
+func Walk(v Visitor, node any) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case InterfaceDef:
+		type def struct {
+			offset int
+			node   any
+		}
+		var defs []def
+		for _, t := range n.Types {
+			defs = append(defs, def{t.Position.Offset, t})
+		}
+		for _, m := range n.Methods {
+			defs = append(defs, def{m.Position.Offset, m})
+		}
+		for _, e := range n.Errors {
+			defs = append(defs, def{e.Position.Offset, e})
+		}
+		slices.SortStableFunc(defs, func(a, b def) int {
+			return cmp.Compare(a.offset, b.offset)
+		})
+		for _, def := range defs {
+			Walk(v, def.node)
+		}
+	case TypeDef:
+		walkType(v, n.Type)
+	case MethodDef:
+		Walk(v, n.Input)
+		Walk(v, n.Output)
+	case ErrorDef:
+		Walk(v, n.Params)
+	case StructType:
+		for _, f := range n.Fields {
+			Walk(v, f)
+		}
+	case StructField:
+		walkType(v, n.Type)
+	case EnumType:
+		for _, e := range n.Values {
+			Walk(v, e)
+		}
+	case ArrayType:
+		walkType(v, n.ElemType)
+	case DictType:
+		walkType(v, n.ElemType)
+	case NullableType:
+		walkType(v, n.Type)
+	case EnumValue, BuiltinType, NamedType:
+		// No children.
+	default:
+		panic(fmt.Sprintf("syntax.Walk: unexpected node type %T", n))
+	}
+
+	v.Visit(nil)
+}
+
+func walkType(v Visitor, t Type) {
+	if t != nil {
+		Walk(v, t)
+	}
+}
+
+type inspector func(any) bool
+
+func (f inspector) Visit(node any) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses an AST in depth-first order: it starts by calling
+// f(node); node must not be nil. If f returns true, Inspect invokes f
+// recursively for each of the non-nil children of node, followed by a
+// call of f(nil). See Walk for the nodes and their order.
+func Inspect(node any, f func(any) bool) {
+	Walk(inspector(f), node)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package syntax_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+func TestInspect(t *testing.T) {
+	const source = `interface org.example.walk
+error NotFound (name: string)
+type Color (red, green)
+method Get(names: []string) -> (colors: [string]?Color)
+`
+	intf, err := syntax.NewParser(strings.NewReader(source)).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var visited []string
+	syntax.Inspect(intf, func(node any) bool {
+		switch n := node.(type) {
+		case nil:
+			return false
+		case syntax.InterfaceDef:
+			visited = append(visited, "interface "+n.Name)
+		case syntax.TypeDef:
+			visited = append(visited, "type "+n.Name)
+		case syntax.MethodDef:
+			visited = append(visited, "method "+n.Name)
+		case syntax.ErrorDef:
+			visited = append(visited, "error "+n.Name)
+		case syntax.StructField:
+			visited = append(visited, "field "+n.Name)
+		case syntax.EnumValue:
+			visited = append(visited, "value "+n.Name)
+		case syntax.BuiltinType:
+			visited = append(visited, n.Name)
+		case syntax.NamedType:
+			visited = append(visited, n.Name)
+		default:
+			visited = append(visited, strings.TrimPrefix(fmt.Sprintf("%T", n), "syntax."))
+		}
+		// Do not descend into errors.
+		_, isError := node.(syntax.ErrorDef)
+		return !isError
+	})
+
+	expected := []string{
+		"interface org.example.walk",
+		"error NotFound",
+		"type Color", "EnumType", "value red", "value green",
+		"method Get",
+		"StructType", "field names", "ArrayType", "string",
+		"StructType", "field colors", "DictType", "NullableType", "Color",
+	}
+	if !slices.Equal(visited, expected) {
+		t.Fatalf("visited:\n%s\nexpected:\n%s", strings.Join(visited, "\n"), strings.Join(expected, "\n"))
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build linux && !amd64 && !386
+
+package varlink
+
+import "syscall"
+
+const sysSetns = syscall.SYS_SETNS
@@ -0,0 +1,9 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+// The syscall package does not define SYS_SETNS on 386.
+const sysSetns = 346
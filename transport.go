@@ -6,17 +6,39 @@
 package varlink
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
+	"time"
 )
 
-var DefaultTransport RoundTripper = &Transport{}
+// DefaultTransport is the RoundTripper used by clients without a Transport.
+// It sends calls to the services configured by the VARLINK_ADDRESS
+// environment variable; see AddressFromEnvironment.
+var DefaultTransport RoundTripper = &Transport{Address: AddressFromEnvironment}
+
+// ErrTransportClosed is returned by Transport.RoundTrip once the transport
+// has been closed.
+var ErrTransportClosed = errors.New("transport is closed")
 
 // RoundTripper is an interface representing the ability to make a single
 // method call, and returning a reply stream.
 //
+// If session is nil, the RoundTripper picks or opens the session the call
+// is written to, typically from the URI of the call; otherwise, the call
+// must be written to that session, which is how handlers make calls back to
+// their client. A RoundTripper writes the call with Session.WriteCall, and
+// returns a stream created with NewReplyStream, which reads the replies.
+// Sessions can be opened with a Dialer, or created with NewSession and
+// NewStreamSession over connections established by other means.
+//
+// RoundTrippers may also wrap another RoundTripper, for instance to log or
+// alter calls; see the roundtripper example.
+//
 // A RoundTripper must be safe for concurrent use by multiple goroutines.
 type RoundTripper interface {
 	RoundTrip(ctx context.Context, session *Session, call *Call) (*ReplyStream, error)
@@ -27,7 +49,8 @@ type RoundTripper interface {
 // By default, Transport caches connections for future re-use. This may leave
 // open connections when accessing many URIs. This behavior can be managed
 // using the [Transport.CloseIdleConnections] method and the
-// [Transport.MaxKeepAliveSessions] field.
+// [Transport.MaxKeepAliveSessions] field. A transport that is no longer
+// needed should be closed with [Transport.Close].
 type Transport struct {
 	// Server is varlink server used for any new session opened by the
 	// transport to serve any received session calls.
@@ -44,14 +67,66 @@ type Transport struct {
 	// with call handling on that session.
 	SessionServeContext func(URI, *Session) context.Context
 
+	// Dialer, if set, is used to open new sessions.
+	Dialer *Dialer
+
+	// Address, if set, returns the URI to send calls made without one to.
+	// If Address is nil or returns the zero URI, the call is sent to the
+	// unix socket named after its interface in the abstract namespace.
+	Address func(*Call) (URI, error)
+
+	// NegotiateFraming, if true, makes the transport attempt to switch new
+	// sessions to length-prefixed framing. See [Session.NegotiateFraming].
+	NegotiateFraming bool
+
+	// RecordTimestamps, if true, enables the recording of timestamps on new
+	// sessions. See [Session.RecordTimestamps].
+	RecordTimestamps bool
+
+	// CanonicalJSON, if true, makes new sessions write canonical JSON. See
+	// [Session.CanonicalJSON].
+	CanonicalJSON bool
+
+	// Clock, if set, is the clock of new sessions. See [Session.SetClock].
+	Clock Clock
+
+	// Faults, if set, injects faults into the messages of new sessions.
+	// See [Session.InjectFaults].
+	Faults FaultInjector
+
+	// OneWayBatching, if set, makes new sessions batch the one-way calls
+	// written to them. See [Session.BatchOneWay].
+	OneWayBatching *OneWayBatching
+
+	// Compression, if set, makes the transport attempt to switch new
+	// sessions to length-prefixed framing with compression. See
+	// [Session.NegotiateCompression].
+	Compression *CompressionOptions
+
+	// MessageHooks are added to new sessions, once framing has been
+	// negotiated. See [MessageHooks].
+	MessageHooks []MessageHooks
+
+	// Retry, if set, makes the transport retry idempotent calls that fail
+	// before getting a reply. See [RetryPolicy].
+	Retry *RetryPolicy
+
 	mu       sync.Mutex
 	sessions map[URI]chan *Session
+	serving  map[*Session]context.CancelFunc
+	closed   bool
 }
 
 func (ts *Transport) RoundTrip(ctx context.Context, session *Session, call *Call) (*ReplyStream, error) {
 	ts.init()
 
 	uri := call.URI
+	if uri == (URI{}) && ts.Address != nil {
+		var err error
+		if uri, err = ts.Address(call); err != nil {
+			return nil, err
+		}
+	}
 	if uri == (URI{}) {
 		i := strings.LastIndexByte(call.Method, '.')
 		if i == -1 {
@@ -61,15 +136,19 @@ func (ts *Transport) RoundTrip(ctx context.Context, session *Session, call *Call
 		uri = URI{Scheme: "unix", Address: "@" + intf}
 	}
 
+	if session == nil && call.Idempotent && !call.Upgrade && ts.Retry != nil {
+		return ts.roundTripRetry(ctx, uri, call)
+	}
+
 	if session == nil {
 		var err error
-		session, err = ts.takeSession(ctx, call.URI)
+		session, err = ts.takeSession(ctx, uri)
 		if err != nil {
 			return nil, err
 		}
 
 		if !call.Upgrade {
-			defer ts.giveSession(call.URI, session)
+			defer ts.giveSession(uri, session)
 		}
 	}
 
@@ -80,16 +159,56 @@ func (ts *Transport) RoundTrip(ctx context.Context, session *Session, call *Call
 	return NewReplyStream(ctx, call, session), nil
 }
 
+// Preconnect opens a session to the specified URI, checks that the service
+// answers by calling org.varlink.service.GetInfo on it, and keeps the
+// session for the next call made to that URI. Latency-sensitive clients
+// can use it at startup, so that their first call does not pay for dialing
+// and negotiating the session.
+//
+// If a session to the URI is already kept, Preconnect checks that one
+// instead. Error replies to GetInfo, for instance from services that do not
+// implement org.varlink.service, do not make Preconnect fail, since the
+// session works; other errors close the session, and are returned.
+func (ts *Transport) Preconnect(ctx context.Context, uri URI) error {
+	ts.init()
+
+	session, err := ts.takeSession(ctx, uri)
+	if err != nil {
+		return err
+	}
+
+	call := Call{Method: "org.varlink.service.GetInfo", URI: uri}
+	rs, err := ts.RoundTrip(ctx, session, &call)
+	if err == nil {
+		for rs.Next() {
+		}
+		err = rs.Error()
+	}
+	var verr Error
+	if err != nil && !errors.As(err, &verr) {
+		session.Close()
+		return err
+	}
+
+	ts.giveSession(uri, session)
+	return nil
+}
+
 func (ts *Transport) init() {
 	ts.mu.Lock()
 	if ts.sessions == nil {
 		ts.sessions = make(map[URI]chan *Session)
+		ts.serving = make(map[*Session]context.CancelFunc)
 	}
 	ts.mu.Unlock()
 }
 
 func (ts *Transport) takeSession(ctx context.Context, uri URI) (*Session, error) {
 	ts.mu.Lock()
+	if ts.closed {
+		ts.mu.Unlock()
+		return nil, ErrTransportClosed
+	}
 	ch := ts.sessions[uri]
 	if ch == nil {
 		maxsessions := ts.MaxKeepAliveSessions
@@ -107,10 +226,36 @@ func (ts *Transport) takeSession(ctx context.Context, uri URI) (*Session, error)
 	default:
 	}
 
-	session, err := Dial(ctx, uri.String())
+	dialer := ts.Dialer
+	if dialer == nil {
+		dialer = &Dialer{}
+	}
+
+	session, err := dialer.DialURI(ctx, uri)
 	if err != nil {
 		return nil, err
 	}
+	session.RecordTimestamps(ts.RecordTimestamps)
+	session.CanonicalJSON(ts.CanonicalJSON)
+	session.SetClock(ts.Clock)
+	if ts.Faults != nil {
+		session.InjectFaults(ts.Faults)
+	}
+	session.BatchOneWay(ts.OneWayBatching)
+
+	switch {
+	case ts.Compression != nil:
+		if _, err := session.NegotiateCompression(ctx, *ts.Compression); err != nil {
+			session.Close()
+			return nil, err
+		}
+	case ts.NegotiateFraming:
+		if _, err := session.NegotiateFraming(ctx); err != nil {
+			session.Close()
+			return nil, err
+		}
+	}
+	session.AddHooks(ts.MessageHooks...)
 
 	newctx := ts.SessionServeContext
 	if newctx == nil {
@@ -118,8 +263,26 @@ func (ts *Transport) takeSession(ctx context.Context, uri URI) (*Session, error)
 			return context.Background()
 		}
 	}
+	servectx, cancel := context.WithCancel(newctx(uri, session))
 
-	go ts.Server.ServeSession(newctx(uri, session), session)
+	ts.mu.Lock()
+	if ts.closed {
+		ts.mu.Unlock()
+		cancel()
+		session.Close()
+		return nil, ErrTransportClosed
+	}
+	ts.serving[session] = cancel
+	ts.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		ts.Server.ServeSession(servectx, session)
+
+		ts.mu.Lock()
+		delete(ts.serving, session)
+		ts.mu.Unlock()
+	}()
 
 	return session, nil
 }
@@ -127,8 +290,13 @@ func (ts *Transport) takeSession(ctx context.Context, uri URI) (*Session, error)
 func (ts *Transport) giveSession(uri URI, session *Session) {
 	ts.mu.Lock()
 	ch := ts.sessions[uri]
+	closed := ts.closed
 	ts.mu.Unlock()
 
+	if closed {
+		go ts.closeWhenIdle(session)
+		return
+	}
 	if ch == nil {
 		panic("programming error: no associated session channel exists for uri")
 	}
@@ -136,25 +304,90 @@ func (ts *Transport) giveSession(uri URI, session *Session) {
 	select {
 	case ch <- session:
 	default:
-		session.Close()
+		go ts.closeWhenIdle(session)
 	}
 }
 
-// CloseIdleConnections closes any idle connections that have been opened and
-// cached by the RoundTrip method.
+// closeWhenIdle closes the session once it has no calls in flight.
+func (ts *Transport) closeWhenIdle(session *Session) {
+	session.waitIdle(context.Background())
+	session.Close()
+}
+
+// drainIdle removes all pooled sessions. Sessions without calls in flight
+// are closed and the others are returned, unless closeBusy is set, in
+// which case they are closed as soon as their calls complete.
+//
+// drainIdle must be called with ts.mu held.
+func (ts *Transport) drainIdle(closeBusy bool) (busy map[URI][]*Session) {
+	for uri, ch := range ts.sessions {
+	drain:
+		for {
+			select {
+			case session := <-ch:
+				switch {
+				case session.idle():
+					session.Close()
+				case closeBusy:
+					go ts.closeWhenIdle(session)
+				default:
+					if busy == nil {
+						busy = make(map[URI][]*Session)
+					}
+					busy[uri] = append(busy[uri], session)
+				}
+			default:
+				break drain
+			}
+		}
+	}
+	return busy
+}
+
+// CloseIdleConnections closes all sessions that have been opened and
+// cached by the RoundTrip method, and that have no calls in flight.
 func (ts *Transport) CloseIdleConnections() {
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
 
-	for _, ch := range ts.sessions {
-		select {
-		case session := <-ch:
-			session.Close()
-		default:
+	for uri, sessions := range ts.drainIdle(false) {
+		ch := ts.sessions[uri]
+		for _, session := range sessions {
+			select {
+			case ch <- session:
+			default:
+				go ts.closeWhenIdle(session)
+			}
 		}
 	}
 }
 
+// Close closes the transport. Cached sessions are closed once the calls in
+// flight on them complete, and the serving of calls made by peers on these
+// sessions is stopped.
+//
+// Once closed, the transport refuses to open new sessions, and RoundTrip
+// returns ErrTransportClosed for calls that are not made on a specific
+// session.
+func (ts *Transport) Close() error {
+	ts.init()
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.closed {
+		return nil
+	}
+	ts.closed = true
+
+	ts.drainIdle(true)
+	for _, cancel := range ts.serving {
+		cancel()
+	}
+	clear(ts.sessions)
+	return nil
+}
+
 // FdPasser represents the ability for a connection to send and receive file
 // descriptors.
 //
@@ -175,41 +408,243 @@ type FdPasser interface {
 
 // ReplyStream represents a stream of replies that result from a method call.
 type ReplyStream struct {
-	ctx  context.Context
-	call *Call
-	sess *Session
-	cur  Reply
-	err  error
-	more bool
+	ctx   context.Context
+	call  *Call
+	sess  *Session
+	clock Clock
+	cur   Reply
+	err   error
+	more  bool
+
+	// cancel, if set, is called once the stream ends.
+	cancel context.CancelFunc
+
+	// primed is set when the current reply was read before the stream was
+	// returned, and must be returned by the next call to Next.
+	primed bool
+
+	// static holds the remaining replies of streams that do not read from
+	// a session, and staticErr the error that ends them.
+	static    []Reply
+	staticErr error
+
+	mu           sync.Mutex
+	stats        ReplyStreamStats
+	stallTimeout time.Duration
+	onStall      func(ReplyStreamStats)
+}
+
+// ReplyStreamStats are flow statistics of a reply stream.
+type ReplyStreamStats struct {
+	// Replies is the number of replies received so far.
+	Replies int
+
+	// Bytes is the total size of the parameters of the replies received
+	// so far.
+	Bytes int64
+
+	// Started is the time at which the stream was created.
+	Started time.Time
+
+	// LastReply is the time at which the last reply was received, or the
+	// zero time if no reply was received yet.
+	LastReply time.Time
+
+	// Latency is the round-trip latency of the call, from the time it was
+	// written to the time its first reply was received. If the session
+	// records timestamps, these are the times at which the frames were
+	// written and read; otherwise, Latency also includes the time the
+	// caller took to call Next.
+	Latency time.Duration
+
+	// clock is the clock of the stream.
+	clock Clock
+}
+
+// SinceLastReply returns the time elapsed since the last reply was
+// received, or since the stream was created if no reply was received yet,
+// as measured by the clock of the session the stream reads from.
+func (stats ReplyStreamStats) SinceLastReply() time.Duration {
+	now := cmp.Or(stats.clock, SystemClock).Now()
+	if stats.LastReply.IsZero() {
+		return now.Sub(stats.Started)
+	}
+	return now.Sub(stats.LastReply)
 }
 
 // NewReplyStream creates a new reply stream for the specified call, reading
 // from the specified session.
 //
-// The specified call must have been previously sent via session.WriteCall.
+// The specified call must have been previously sent via session.WriteCall,
+// and the stream must be read until Next returns false, unless the session
+// is closed; otherwise, the replies of the calls written after it cannot be
+// read. Streams of one-way calls have no replies.
 func NewReplyStream(ctx context.Context, call *Call, session *Session) *ReplyStream {
-	return &ReplyStream{ctx: ctx, call: call, sess: session, more: true}
+	return &ReplyStream{
+		ctx:   ctx,
+		call:  call,
+		sess:  session,
+		clock: session.clock,
+		more:  !call.OneWay,
+		stats: ReplyStreamStats{Started: session.clock.Now(), clock: session.clock},
+	}
+}
+
+// NewStaticReplyStream creates a reply stream that does not read from any
+// session, and returns the specified replies instead, error replies and
+// continues flags included. It is meant to test code consuming reply
+// streams without a transport:
+//
+//	rs := varlink.NewStaticReplyStream(ctx, []varlink.Reply{
+//		{Parameters: json.RawMessage(`{"n":1}`), Continues: true},
+//		{Parameters: json.RawMessage(`{}`), Error: "org.example.Failed"},
+//	}, nil)
+//
+// Like for streams reading from a session, the stream ends after the first
+// reply that does not continue. If the last reply continues, Next fails
+// after it with err, or io.ErrUnexpectedEOF if err is nil, which simulates
+// a session failing in the middle of the stream. Next also fails once ctx
+// is done.
+func NewStaticReplyStream(ctx context.Context, replies []Reply, err error) *ReplyStream {
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return &ReplyStream{
+		ctx:       ctx,
+		call:      &Call{},
+		clock:     SystemClock,
+		more:      true,
+		static:    replies,
+		staticErr: err,
+		stats:     ReplyStreamStats{Started: SystemClock.Now(), clock: SystemClock},
+	}
+}
+
+// Stats returns the flow statistics of the stream. It is safe to call Stats
+// concurrently with Next, for instance to report the progress of a long
+// stream from another goroutine.
+func (r *ReplyStream) Stats() ReplyStreamStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// OnStall registers a function that is called when Next waits for a reply
+// for longer than the specified timeout, which typically means that the
+// server is stuck. The function is called from its own goroutine, at most
+// once per call to Next, and Next keeps waiting for the reply.
+//
+// OnStall must be called before Next.
+func (r *ReplyStream) OnStall(timeout time.Duration, fn func(ReplyStreamStats)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stallTimeout = timeout
+	r.onStall = fn
 }
 
 // Next advances the stream by one reply, and returns whether there are
 // more replies to come after this.
 func (r *ReplyStream) Next() bool {
+	if r.primed {
+		r.primed = false
+		if !r.more {
+			r.end()
+		}
+		return true
+	}
 	if !r.more {
 		return false
 	}
-	r.err = r.sess.ReadReply(r.ctx, r.call, &r.cur)
+
+	if r.sess == nil {
+		r.err = r.nextStatic()
+	} else {
+		r.mu.Lock()
+		if r.onStall != nil && r.stallTimeout > 0 {
+			onStall := r.onStall
+			timer := r.clock.AfterFunc(r.stallTimeout, func() {
+				onStall(r.Stats())
+			})
+			defer timer.Stop()
+		}
+		r.mu.Unlock()
+
+		r.err = r.sess.ReadReply(r.ctx, r.call, &r.cur)
+	}
 	if r.err != nil {
-		r.more = false
+		r.end()
 		return false
 	}
+	r.cur.decodeOpts = r.call.decodeOpts
+
+	r.mu.Lock()
+	if r.stats.Replies == 0 {
+		sent, received := r.call.SentAt, r.cur.ReceivedAt
+		if sent.IsZero() {
+			sent = r.stats.Started
+		}
+		if received.IsZero() {
+			received = r.clock.Now()
+		}
+		r.stats.Latency = received.Sub(sent)
+	}
+	r.stats.Replies++
+	r.stats.Bytes += int64(len(r.cur.Parameters))
+	r.stats.LastReply = r.clock.Now()
+	r.mu.Unlock()
 
 	if r.cur.Error != "" {
 		r.err = &varlinkError{Code: r.cur.Error, Parameters: r.cur.Parameters}
 	}
 	r.more = r.cur.Continues
+	if !r.more {
+		r.end()
+	}
 	return true
 }
 
+// end marks the end of the stream.
+func (r *ReplyStream) end() {
+	r.more = false
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+// discard ends the stream, and reads its remaining replies in the
+// background, so that the replies to the next calls made on its session can
+// be read. Unlike Next, reading them is not interrupted when the context of
+// the stream becomes done.
+func (r *ReplyStream) discard() {
+	more := r.more
+	r.end()
+	if !more || r.sess == nil {
+		return
+	}
+	sess, call := r.sess, r.call
+	go func() {
+		var reply Reply
+		for {
+			err := sess.ReadReply(context.Background(), call, &reply)
+			if err != nil || !reply.Continues {
+				return
+			}
+		}
+	}()
+}
+
+// nextStatic reads the next reply of a static stream.
+func (r *ReplyStream) nextStatic() error {
+	if err := r.ctx.Err(); err != nil {
+		return err
+	}
+	if len(r.static) == 0 {
+		return r.staticErr
+	}
+	r.cur, r.static = r.static[0], r.static[1:]
+	return nil
+}
+
 // Reply returns the current error in the stream. These can be session errors,
 // or error replies. Error replies are converted and returned as Go errors.
 func (r *ReplyStream) Error() error {
@@ -228,3 +663,39 @@ func (r *ReplyStream) Reply() *Reply {
 func (r *ReplyStream) Unmarshal(params any) Error {
 	return r.cur.Unmarshal(params)
 }
+
+// CollectReplies reads all of the replies of the stream, and unmarshals them
+// into a slice of T. It is meant for calls streaming listings with the
+// `more` flag:
+//
+//	rs, err := client.Call(ctx, "org.example.List", nil, varlink.More())
+//	if err != nil {
+//		return err
+//	}
+//	items, err := varlink.CollectReplies[Item](rs)
+//
+// Replies that fail to unmarshal are skipped, and their errors are joined
+// with errors.Join to the returned error, along with the error that ended
+// the stream, if any. The replies that were successfully unmarshaled are
+// returned in all cases.
+func CollectReplies[T any](stream *ReplyStream) ([]T, error) {
+	var (
+		out  []T
+		errs []error
+	)
+	for stream.Next() {
+		if err := stream.Error(); err != nil {
+			break
+		}
+		var v T
+		if err := stream.Unmarshal(&v); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		out = append(out, v)
+	}
+	if err := stream.Error(); err != nil {
+		errs = append(errs, err)
+	}
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,345 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// pipeStream is one end of a bidirectional stream made of two pipes.
+type pipeStream struct {
+	*io.PipeReader
+	*io.PipeWriter
+}
+
+func (s pipeStream) Close() error {
+	return errors.Join(s.PipeReader.Close(), s.PipeWriter.Close())
+}
+
+// sessionTransport is a RoundTripper making all calls over one session.
+type sessionTransport struct {
+	session *varlink.Session
+}
+
+func (t *sessionTransport) RoundTrip(ctx context.Context, session *varlink.Session, call *varlink.Call) (*varlink.ReplyStream, error) {
+	if session == nil {
+		session = t.session
+	}
+	if err := session.WriteCall(ctx, call); err != nil {
+		return nil, err
+	}
+	return varlink.NewReplyStream(ctx, call, session), nil
+}
+
+func TestCustomRoundTripper(t *testing.T) {
+	r1, w1 := io.Pipe()
+	r2, w2 := io.Pipe()
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Echo", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(call.Parameters)
+	})
+	server := varlink.Server{Handler: &mux}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server.ServeSession(context.Background(), varlink.NewStreamSession(pipeStream{r1, w2}))
+	}()
+
+	session := varlink.NewStreamSession(pipeStream{r2, w1})
+	client := varlink.Client{Transport: &sessionTransport{session: session}}
+
+	for _, echo := range []string{"hello", "world"} {
+		rs, err := client.Call(context.Background(), "org.example.Echo", map[string]string{"echo": echo})
+		if err != nil {
+			t.Fatal(err)
+		}
+		replies, err := varlink.CollectReplies[map[string]string](rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(replies) != 1 || replies[0]["echo"] != echo {
+			t.Fatalf("got replies %v, expected echo %q", replies, echo)
+		}
+	}
+
+	session.Close()
+	<-done
+}
+
+// countingListener counts the connections it accepts.
+type countingListener struct {
+	net.Listener
+	accepted atomic.Int32
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.accepted.Add(1)
+	}
+	return conn, err
+}
+
+func TestPreconnect(t *testing.T) {
+	inner, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &countingListener{Listener: inner}
+	defer l.Close()
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+
+	uri, err := varlink.ParseURI("unix:" + l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Preconnect(context.Background(), uri); err != nil {
+		t.Fatal(err)
+	}
+
+	client := varlink.Client{Transport: transport, URI: uri}
+	rs, err := client.Call(context.Background(), "org.example.Ping", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := varlink.CollectReplies[struct{}](rs); err != nil {
+		t.Fatal(err)
+	}
+	if n := l.accepted.Load(); n != 1 {
+		t.Fatalf("server accepted %d connections, expected the preconnected session to be reused", n)
+	}
+
+	uri.Address += ".missing"
+	if err := transport.Preconnect(context.Background(), uri); err == nil {
+		t.Fatal("preconnecting to a missing service succeeded")
+	}
+}
+
+// closingListener closes the first connection it accepts.
+type closingListener struct {
+	net.Listener
+	accepted atomic.Int32
+}
+
+func (l *closingListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil || l.accepted.Add(1) > 1 {
+			return conn, err
+		}
+		conn.Close()
+	}
+}
+
+func TestTransportRetry(t *testing.T) {
+	inner, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &closingListener{Listener: inner}
+	defer l.Close()
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	transport := &varlink.Transport{Retry: &varlink.RetryPolicy{Backoff: time.Millisecond}}
+	defer transport.Close()
+
+	uri, err := varlink.ParseURI("unix:" + l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := varlink.Client{Transport: transport, URI: uri}
+	call := func(opts ...varlink.CallOption) error {
+		rs, err := client.Call(context.Background(), "org.example.Ping", nil, opts...)
+		if err != nil {
+			return err
+		}
+		_, err = varlink.CollectReplies[struct{}](rs)
+		return err
+	}
+
+	// The first session is closed by the service, and kept by the
+	// transport after the call fails.
+	if err := call(); err == nil {
+		t.Fatal("call over a closed session succeeded")
+	}
+	if err := call(varlink.Idempotent()); err != nil {
+		t.Fatalf("idempotent call was not retried: %v", err)
+	}
+	if n := l.accepted.Load(); n != 2 {
+		t.Fatalf("server accepted %d connections, expected 2", n)
+	}
+}
+
+func TestStaticReplyStream(t *testing.T) {
+	ctx := context.Background()
+	replies := []varlink.Reply{
+		{Parameters: []byte(`{"n":1}`), Continues: true},
+		{Parameters: []byte(`{"n":2}`), Continues: true},
+		{Parameters: []byte(`{"n":3}`)},
+		{Parameters: []byte(`{"n":4}`)},
+	}
+	type item struct{ N int }
+
+	items, err := varlink.CollectReplies[item](varlink.NewStaticReplyStream(ctx, replies, nil))
+	if err != nil || len(items) != 3 || items[2].N != 3 {
+		t.Fatalf("got %v, %v, expected the replies up to the first one that does not continue", items, err)
+	}
+
+	failed := []varlink.Reply{replies[0], {Parameters: []byte(`{}`), Error: "org.example.Failed"}}
+	rs := varlink.NewStaticReplyStream(ctx, failed, nil)
+	_, err = varlink.CollectReplies[item](rs)
+	var verr varlink.Error
+	if !errors.As(err, &verr) || verr.ErrorCode() != "org.example.Failed" {
+		t.Fatalf("collecting replies failed with %v, expected org.example.Failed", err)
+	}
+	if stats := rs.Stats(); stats.Replies != 2 {
+		t.Fatalf("got %d replies in stats, expected 2", stats.Replies)
+	}
+
+	items, err = varlink.CollectReplies[item](varlink.NewStaticReplyStream(ctx, replies[:1], varlink.ErrPeerDisconnected))
+	if !errors.Is(err, varlink.ErrPeerDisconnected) || len(items) != 1 {
+		t.Fatalf("got %v, %v, expected one item and ErrPeerDisconnected", items, err)
+	}
+}
+
+// trackingListener counts the connections it accepts, and those that are
+// closed.
+type trackingListener struct {
+	net.Listener
+	accepted atomic.Int32
+	closed   atomic.Int32
+}
+
+type trackedConn struct {
+	net.Conn
+	once sync.Once
+	l    *trackingListener
+}
+
+func (c *trackedConn) Close() error {
+	c.once.Do(func() { c.l.closed.Add(1) })
+	return c.Conn.Close()
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.accepted.Add(1)
+	return &trackedConn{Conn: conn, l: l}, nil
+}
+
+func TestCloseIdleConnections(t *testing.T) {
+	inner, err := net.Listen("unix", filepath.Join(t.TempDir(), "sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &trackingListener{Listener: inner}
+	defer l.Close()
+
+	release := make(chan struct{})
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Ping", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(nil)
+	})
+	mux.HandleFunc("org.example.Stream", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteMore(map[string]int{"n": 0})
+		<-release
+		w.WriteFinal(map[string]int{"n": 1})
+	})
+	go (&varlink.Server{Handler: &mux}).Serve(l)
+
+	transport := &varlink.Transport{}
+	defer transport.Close()
+
+	uri, err := varlink.ParseURI("unix:" + l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := varlink.Client{Transport: transport, URI: uri}
+	ctx := context.Background()
+
+	ping := func() {
+		t.Helper()
+		rs, err := client.Call(ctx, "org.example.Ping", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := varlink.CollectReplies[struct{}](rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitClosed := func(n int32) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for l.closed.Load() != n {
+			if time.Now().After(deadline) {
+				t.Fatalf("%d connections were closed, expected %d", l.closed.Load(), n)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// Idle sessions are closed, and the next call opens a new one.
+	ping()
+	transport.CloseIdleConnections()
+	waitClosed(1)
+	ping()
+	if n := l.accepted.Load(); n != 2 {
+		t.Fatalf("server accepted %d connections, expected 2", n)
+	}
+	transport.CloseIdleConnections()
+	waitClosed(2)
+
+	// Sessions with calls in flight are kept, and their calls complete.
+	rs, err := client.Call(ctx, "org.example.Stream", nil, varlink.More())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rs.Next() || rs.Error() != nil {
+		t.Fatalf("reading the first reply: %v", rs.Error())
+	}
+	transport.CloseIdleConnections()
+	close(release)
+
+	type item struct{ N int }
+	items, err := varlink.CollectReplies[item](rs)
+	if err != nil || len(items) != 1 || items[0].N != 1 {
+		t.Fatalf("got %v, %v, expected the call in flight to complete", items, err)
+	}
+	ping()
+	if n := l.accepted.Load(); n != 3 {
+		t.Fatalf("server accepted %d connections, expected the busy session to be kept", n)
+	}
+	if n := l.closed.Load(); n != 2 {
+		t.Fatalf("%d connections were closed, expected the busy session to be kept", n)
+	}
+}
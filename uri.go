@@ -0,0 +1,186 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// URI is a varlink address, in the form <scheme>:<address>[;<properties>].
+//
+// For the unix scheme, addresses starting with "@" designate sockets in the
+// abstract namespace.
+//
+// The address and property values are percent-escaped in the string form
+// of the URI when they contain characters that would otherwise change its
+// meaning, like ";" or NUL bytes. Bracketed hosts, like the IPv6 address in
+// "tcp:[fe80::1%eth0]:80", are written and parsed verbatim, so that their
+// zones are preserved.
+type URI struct {
+	Scheme  string
+	Address string
+
+	// Properties holds the raw, ";"-separated list of key=value properties
+	// following the address. Use the Property method to look up a value.
+	Properties string
+}
+
+// ParseURI parses the input Varlink URI.
+func ParseURI(uri string) (URI, error) {
+	scheme, rest, ok := strings.Cut(uri, ":")
+	if !ok {
+		return URI{}, fmt.Errorf("parsing %q: not in the form <scheme>:<addr>", uri)
+	}
+
+	rawaddr, props, _ := strings.Cut(rest, ";")
+
+	u := URI{
+		Scheme:     scheme,
+		Address:    unescapeURIComponent(rawaddr),
+		Properties: props,
+	}
+	if u.Scheme == "unix" && strings.HasPrefix(u.Address, "\x00") {
+		u.Address = "@" + u.Address[1:]
+	}
+	return u, nil
+}
+
+// AbstractUnixURI returns the URI of the unix socket with the specified
+// name in the abstract namespace.
+//
+// When listening, an empty name makes the kernel pick a unique name; see
+// URIFromAddr to find out which.
+func AbstractUnixURI(name string) URI {
+	return URI{Scheme: "unix", Address: "@" + name}
+}
+
+// URIFromAddr returns the URI corresponding to a network address, typically
+// the address of a listener or connection.
+func URIFromAddr(addr net.Addr) (URI, error) {
+	switch addr.Network() {
+	case "tcp", "tcp4", "tcp6":
+		return URI{Scheme: "tcp", Address: addr.String()}, nil
+	case "unix":
+		name := addr.String()
+		if strings.HasPrefix(name, "\x00") {
+			name = "@" + name[1:]
+		}
+		return URI{Scheme: "unix", Address: name}, nil
+	default:
+		return URI{}, fmt.Errorf("address %v: %w", addr, ErrUnsupportedScheme)
+	}
+}
+
+// AbstractName returns the name of the abstract unix socket designated by
+// u, and whether u designates an abstract unix socket at all.
+func (u URI) AbstractName() (string, bool) {
+	if u.Scheme != "unix" {
+		return "", false
+	}
+	if name, ok := strings.CutPrefix(u.Address, "@"); ok {
+		return name, true
+	}
+	if name, ok := strings.CutPrefix(u.Address, "\x00"); ok {
+		return name, true
+	}
+	return "", false
+}
+
+// Property returns the unescaped value of the specified property, and
+// whether the property is set. Properties without a value, like "ro" in
+// "unix:/run/foo;ro", have an empty value.
+func (u URI) Property(key string) (string, bool) {
+	for _, prop := range strings.Split(u.Properties, ";") {
+		k, v, _ := strings.Cut(prop, "=")
+		if k != key {
+			continue
+		}
+		return unescapeURIComponent(v), true
+	}
+	return "", false
+}
+
+func (u URI) String() string {
+	var out strings.Builder
+	out.WriteString(u.Scheme)
+	out.WriteByte(':')
+	escapeURIComponent(&out, u.Address)
+	if u.Properties != "" {
+		out.WriteByte(';')
+		out.WriteString(u.Properties)
+	}
+	return out.String()
+}
+
+func shouldEscape(c byte) bool {
+	return c < 0x20 || c == 0x7f || c == '%' || c == ';'
+}
+
+// bracketedHost returns the length of the bracketed host that s starts
+// with, as in "[fe80::1%eth0]:80", or 0 if it does not start with one.
+func bracketedHost(s string) int {
+	if !strings.HasPrefix(s, "[") {
+		return 0
+	}
+	return strings.IndexByte(s, ']') + 1
+}
+
+func escapeURIComponent(out *strings.Builder, s string) {
+	const hex = "0123456789ABCDEF"
+	n := bracketedHost(s)
+	out.WriteString(s[:n])
+	for i := n; i < len(s); i++ {
+		c := s[i]
+		if shouldEscape(c) {
+			out.WriteByte('%')
+			out.WriteByte(hex[c>>4])
+			out.WriteByte(hex[c&0xf])
+		} else {
+			out.WriteByte(c)
+		}
+	}
+}
+
+func unhex(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// unescapeURIComponent undoes the escapes written by escapeURIComponent.
+// Other percent signs are left as-is, since they are legitimately found in
+// unescaped addresses.
+func unescapeURIComponent(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var out strings.Builder
+	n := bracketedHost(s)
+	out.WriteString(s[:n])
+	for i := n; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) {
+			out.WriteByte(s[i])
+			continue
+		}
+		hi, ok1 := unhex(s[i+1])
+		lo, ok2 := unhex(s[i+2])
+		if !ok1 || !ok2 || !shouldEscape(hi<<4|lo) {
+			out.WriteByte(s[i])
+			continue
+		}
+		out.WriteByte(hi<<4 | lo)
+		i += 2
+	}
+	return out.String()
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"runtime"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestURIRoundTrip(t *testing.T) {
+	tests := []struct {
+		uri  varlink.URI
+		text string
+	}{
+		{varlink.URI{Scheme: "unix", Address: "/run/org.example.foo"}, "unix:/run/org.example.foo"},
+		{varlink.URI{Scheme: "unix", Address: "@org.example.foo"}, "unix:@org.example.foo"},
+		{varlink.URI{Scheme: "unix", Address: "/run/a;b"}, "unix:/run/a%3Bb"},
+		{varlink.URI{Scheme: "unix", Address: "/run/100%"}, "unix:/run/100%25"},
+		{varlink.URI{Scheme: "unix", Address: "@with\x00nul"}, "unix:@with%00nul"},
+		{varlink.URI{Scheme: "unix", Address: "/run/foo", Properties: "mode=0600"}, "unix:/run/foo;mode=0600"},
+		{varlink.URI{Scheme: "tcp", Address: "127.0.0.1:12345"}, "tcp:127.0.0.1:12345"},
+		{varlink.URI{Scheme: "tcp", Address: "[fe80::1%eth0]:80"}, "tcp:[fe80::1%eth0]:80"},
+		{varlink.URI{Scheme: "tcp", Address: "[fe80::1%12]:80"}, "tcp:[fe80::1%12]:80"},
+		{varlink.URI{Scheme: "tcp", Address: "[fe80::1%a1]:80"}, "tcp:[fe80::1%a1]:80"},
+		{varlink.URI{Scheme: "tcp", Address: "[fe80::1%25]:80"}, "tcp:[fe80::1%25]:80"},
+	}
+
+	for _, tt := range tests {
+		if s := tt.uri.String(); s != tt.text {
+			t.Errorf("%#v formatted as %q, expected %q", tt.uri, s, tt.text)
+		}
+		u, err := varlink.ParseURI(tt.text)
+		if err != nil {
+			t.Errorf("parsing %q: %v", tt.text, err)
+			continue
+		}
+		if u != tt.uri {
+			t.Errorf("%q parsed as %#v, expected %#v", tt.text, u, tt.uri)
+		}
+	}
+}
+
+func TestURIUnescape(t *testing.T) {
+	// Only the escapes that String writes are undone.
+	for text, address := range map[string]string{
+		"unix:/run/100%":       "/run/100%",
+		"unix:/run/a%41":       "/run/a%41",
+		"unix:/run/a%3b":       "/run/a;",
+		"unix:/run/%":          "/run/%",
+		"tcp:[fe80::1%0a]:80":  "[fe80::1%0a]:80",
+		"tcp:[fe80::1%eth0]:8": "[fe80::1%eth0]:8",
+	} {
+		u, err := varlink.ParseURI(text)
+		if err != nil {
+			t.Errorf("parsing %q: %v", text, err)
+			continue
+		}
+		if u.Address != address {
+			t.Errorf("%q parsed with address %q, expected %q", text, u.Address, address)
+		}
+	}
+}
+
+func TestURIAbstract(t *testing.T) {
+	u, err := varlink.ParseURI("unix:\x00org.example.foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != varlink.AbstractUnixURI("org.example.foo") {
+		t.Fatalf("NUL-prefixed address parsed as %#v", u)
+	}
+	if name, ok := u.AbstractName(); !ok || name != "org.example.foo" {
+		t.Fatalf("AbstractName returned %q, %v", name, ok)
+	}
+
+	if runtime.GOOS != "linux" {
+		t.Skip("abstract unix sockets are only supported on linux")
+	}
+
+	// Listening on an empty abstract name autobinds a unique name.
+	l, err := varlink.Listen("unix:@")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	bound, err := varlink.URIFromAddr(l.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, ok := bound.AbstractName(); !ok || name == "" {
+		t.Fatalf("listener bound to %v, expected an autobound abstract socket", bound)
+	}
+}
+
+func TestURIProperty(t *testing.T) {
+	u, err := varlink.ParseURI("serial:/dev/ttyS0;baud=115200;ro")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := u.Property("baud"); !ok || v != "115200" {
+		t.Errorf("baud = %q, %v", v, ok)
+	}
+	if _, ok := u.Property("ro"); !ok {
+		t.Error("ro property not found")
+	}
+	if _, ok := u.Property("parity"); ok {
+		t.Error("unexpected parity property")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"snai.pe/go-varlink/internal/service"
 )
@@ -45,18 +46,73 @@ type Call struct {
 	// protocol/payload.
 	Upgrade bool `json:"upgrade,omitempty"`
 
+	// Idempotent, if true, marks the call as safe to make more than once,
+	// which lets transports with a retry policy retry it. It is not sent.
+	Idempotent bool `json:"-"`
+
 	// Input parameters.
 	Parameters json.RawMessage `json:"parameters,omitempty"`
 
+	// Extensions holds optional, non-standard data attached to the call,
+	// keyed by extension name. Servers of this package ignore the
+	// extensions they do not know about, but peers implemented with other
+	// libraries may reject calls carrying any.
+	Extensions map[string]json.RawMessage `json:"extensions,omitempty"`
+
 	// FileDescriptors is a list of open file descriptors sent or received with
 	// the method call.
 	FileDescriptors []uintptr `json:"-"`
+
+	// SentAt and ReceivedAt are the times at which the call was written to,
+	// and read from the session. They are only recorded by sessions for
+	// which timestamps are enabled. See [Session.RecordTimestamps].
+	SentAt     time.Time `json:"-"`
+	ReceivedAt time.Time `json:"-"`
+
+	decodeOpts *DecodeOptions
+}
+
+// DecodeOptions control how the parameters of calls and replies are decoded
+// by their Unmarshal methods. They can be set on a Client for the replies it
+// receives, and on a Server for the calls it receives.
+//
+// Unless Unmarshal is set, numbers decoded into interface values, like the
+// values of a map[string]any, are stored as json.Number rather than
+// float64, since varlink integers may not fit in a float64. Code asserting
+// such values to float64 must use the methods of json.Number instead.
+type DecodeOptions struct {
+	// AllowUnknownFields, if true, makes decoding ignore unknown fields,
+	// instead of failing with InvalidParameter.
+	AllowUnknownFields bool
+
+	// Unmarshal, if set, is used to decode parameters instead of
+	// encoding/json, for instance to accept time formats other than RFC 3339.
+	// The other options are then ignored. Errors that implement Error are
+	// returned as-is, and others are wrapped in an UnmarshalError.
+	Unmarshal func(data []byte, v any) error
 }
 
 func decode(data []byte, v any) Error {
-	dec := json.NewDecoder(bytes.NewReader(data))
-	dec.DisallowUnknownFields()
-	if err := dec.Decode(v); err != nil {
+	return decodeWith(nil, data, v)
+}
+
+func decodeWith(opts *DecodeOptions, data []byte, v any) Error {
+	var err error
+	switch {
+	case opts != nil && opts.Unmarshal != nil:
+		err = opts.Unmarshal(data, v)
+	default:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		if opts == nil || !opts.AllowUnknownFields {
+			dec.DisallowUnknownFields()
+		}
+		// Integers past 2^53 would lose precision as float64, so numbers
+		// decoded into interface values are kept as json.Number. Integer
+		// fields are parsed directly, and fail to decode on overflow.
+		dec.UseNumber()
+		err = dec.Decode(v)
+	}
+	if err != nil {
 		var (
 			ute  *json.UnmarshalTypeError
 			verr Error
@@ -86,7 +142,13 @@ func decode(data []byte, v any) Error {
 }
 
 func (c *Call) Unmarshal(v any) Error {
-	return decode([]byte(c.Parameters), v)
+	return decodeWith(c.decodeOpts, []byte(c.Parameters), v)
+}
+
+// SetDecodeOptions sets the options used by Unmarshal to decode the
+// parameters of the call.
+func (c *Call) SetDecodeOptions(opts *DecodeOptions) {
+	c.decodeOpts = opts
 }
 
 func MakeCall(method string, params any, opts ...CallOption) (call Call, err error) {
@@ -121,10 +183,23 @@ type Reply struct {
 	// FileDescriptors is a list of file descriptors send or received with the
 	// reply.
 	FileDescriptors []uintptr `json:"-"`
+
+	// ReceivedAt is the time at which the reply was read from the session.
+	// It is only recorded by sessions for which timestamps are enabled. See
+	// [Session.RecordTimestamps].
+	ReceivedAt time.Time `json:"-"`
+
+	decodeOpts *DecodeOptions
 }
 
 func (r *Reply) Unmarshal(v any) Error {
-	return decode([]byte(r.Parameters), v)
+	return decodeWith(r.decodeOpts, []byte(r.Parameters), v)
+}
+
+// SetDecodeOptions sets the options used by Unmarshal to decode the
+// parameters of the reply.
+func (r *Reply) SetDecodeOptions(opts *DecodeOptions) {
+	r.decodeOpts = opts
 }
 
 func MakeReply(params any, opts ...ReplyOption) (reply Reply, err error) {
@@ -143,35 +218,3 @@ func MakeReply(params any, opts ...ReplyOption) (reply Reply, err error) {
 	}
 	return reply, nil
 }
-
-type URI struct {
-	Scheme  string
-	Address string
-}
-
-// ParseURI parses the input Varlink URI.
-func ParseURI(uri string) (URI, error) {
-
-	// This isn't a real parser at the moment, because none of the URIs
-	// in the wild are using anything more complex than <scheme>:<addr>.
-
-	scheme, rest, ok := strings.Cut(uri, ":")
-	if !ok {
-		return URI{}, fmt.Errorf("parsing %q: not in the form <scheme>:<addr>", uri)
-	}
-
-	addr, props, _ := strings.Cut(rest, ";")
-
-	// Everything after ";" is called "properties" and are reserved for future
-	// extensions.
-	_ = props
-
-	return URI{
-		Scheme:  scheme,
-		Address: addr,
-	}, nil
-}
-
-func (u URI) String() string {
-	return fmt.Sprintf("%s:%s", u.Scheme, u.Address)
-}
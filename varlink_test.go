@@ -0,0 +1,125 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink_test
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+func TestLargeIntegers(t *testing.T) {
+	type params struct {
+		N int64 `json:"n"`
+		V any   `json:"v"`
+	}
+
+	for _, n := range []int64{1<<53 + 1, math.MaxInt64, math.MinInt64} {
+		call, err := varlink.MakeCall("org.example.Large", params{N: n, V: n})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var out params
+		if err := call.Unmarshal(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out.N != n {
+			t.Errorf("got n = %d, expected %d", out.N, n)
+		}
+		if v, ok := out.V.(json.Number); !ok || v.String() != strconv.FormatInt(n, 10) {
+			t.Errorf("got v = %#v, expected json.Number(%d)", out.V, n)
+		}
+	}
+
+	call := varlink.Call{Method: "org.example.Large", Parameters: json.RawMessage(`{"n":9223372036854775808}`)}
+	var out params
+	err := call.Unmarshal(&out)
+	if err == nil || err.ErrorCode() != "org.varlink.service.InvalidParameter" {
+		t.Fatalf("decoding an overflowing integer failed with %v, expected InvalidParameter", err)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	call := varlink.Call{
+		Method:     "org.example.Ping",
+		More:       true,
+		Parameters: json.RawMessage(`{"ping":"hello"}`),
+	}
+	msg := call.Message()
+	raw, err := json.Marshal(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"method":"org.example.Ping","more":true,"parameters":{"ping":"hello"}}`; string(raw) != expected {
+		t.Fatalf("got %s, expected %s", raw, expected)
+	}
+
+	var decoded varlink.Message
+	if err := json.Unmarshal([]byte(`{"error":"org.example.Failed","parameters":{}}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.IsCall() {
+		t.Fatal("reply decoded as a call")
+	}
+	if reply := decoded.Reply(); reply.Error != "org.example.Failed" {
+		t.Fatalf("got error %q, expected org.example.Failed", reply.Error)
+	}
+
+	// Replies keep their parameters when re-encoded, even empty ones.
+	for _, tc := range []struct {
+		reply    varlink.Reply
+		expected string
+	}{
+		{varlink.Reply{Parameters: json.RawMessage(`{"pong":"hello"}`), Continues: true}, `{"continues":true,"parameters":{"pong":"hello"}}`},
+		{varlink.Reply{Error: "org.example.Failed"}, `{"error":"org.example.Failed","parameters":{}}`},
+	} {
+		msg := tc.reply.Message()
+		raw, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(raw) != tc.expected {
+			t.Fatalf("got %s, expected %s", raw, tc.expected)
+		}
+	}
+}
+
+func TestOption(t *testing.T) {
+	type params struct {
+		A varlink.Option[int]    `json:"a,omitzero"`
+		B varlink.Option[int]    `json:"b,omitzero"`
+		C varlink.Option[string] `json:"c"`
+	}
+
+	raw, err := json.Marshal(params{A: varlink.Some(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"a":0,"c":null}`; string(raw) != expected {
+		t.Fatalf("got %s, expected %s", raw, expected)
+	}
+
+	out := params{B: varlink.Some(1)}
+	if err := json.Unmarshal([]byte(`{"a":0,"b":null}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := out.A.Get(); !ok || v != 0 {
+		t.Fatalf("got a=%v (set: %v), expected 0", v, ok)
+	}
+	if out.B.Valid || out.C.Valid {
+		t.Fatalf("got %+v, expected b and c to be absent", out)
+	}
+	if p := out.B.Ptr(); p != nil {
+		t.Fatalf("got pointer %v to an absent value, expected nil", p)
+	}
+	if v := out.C.Or("default"); v != "default" {
+		t.Fatalf("got %q, expected default", v)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package varlinkgen derives varlink interface definitions from Go types,
+// for services written Go-first that publish their description instead of
+// hand-writing it:
+//
+//	type PingInput struct {
+//		Ping string `json:"ping"`
+//	}
+//	type PingOutput struct {
+//		Pong string `json:"pong"`
+//	}
+//
+//	desc, err := varlinkgen.Description("org.example.ping", map[string]any{
+//		"Ping": func(ctx context.Context, in PingInput) (PingOutput, error) { ... },
+//	})
+//	mux.SetDescription("org.example.ping", desc)
+package varlinkgen
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"snai.pe/go-varlink/syntax"
+	"snai.pe/go-varlink/syntax/build"
+)
+
+// FromTypes returns the definition of an interface with the specified
+// methods, mapping the name of each method to a function implementing it.
+//
+// Functions take an optional context.Context followed by an optional input
+// struct, and return an optional output struct followed by an optional
+// error; structs may also be passed by pointer. Since the names of function
+// parameters are not known at runtime, struct fields name the parameters
+// of methods.
+//
+// Go types map to varlink types as encoding/json encodes them: fields are
+// named after their json tag, embedded structs are flattened, pointers and
+// varlink.Option values are nullable, maps with string keys are dicts, and types implementing
+// encoding.TextMarshaler, like time.Time, are strings, while other types
+// implementing json.Marshaler are objects. Named struct types become type definitions of the interface.
+func FromTypes(interfaceName string, methods map[string]any) (syntax.InterfaceDef, error) {
+	g := generator{names: make(map[reflect.Type]string)}
+
+	var defs []func(*build.Builder)
+	for _, name := range slices.Sorted(maps.Keys(methods)) {
+		in, out, err := g.method(reflect.TypeOf(methods[name]))
+		if err != nil {
+			return syntax.InterfaceDef{}, fmt.Errorf("method %s: %w", name, err)
+		}
+		defs = append(defs, func(b *build.Builder) { b.Method(name, in, out) })
+	}
+	if g.err != nil {
+		return syntax.InterfaceDef{}, g.err
+	}
+
+	b := build.Interface(interfaceName)
+	for _, t := range g.types {
+		b.Type(t.Name, t.Type)
+	}
+	for _, def := range defs {
+		def(b)
+	}
+	return b.Build()
+}
+
+// Description is like FromTypes, but returns the description of the
+// interface in the interface description language.
+func Description(interfaceName string, methods map[string]any) (string, error) {
+	intf, err := FromTypes(interfaceName, methods)
+	if err != nil {
+		return "", err
+	}
+	desc, err := syntax.Format(intf)
+	return string(desc), err
+}
+
+var (
+	contextType       = reflect.TypeFor[context.Context]()
+	errorType         = reflect.TypeFor[error]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	rawMessageType    = reflect.TypeFor[json.RawMessage]()
+)
+
+// isOption returns whether t is an instance of varlink.Option.
+func isOption(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == "snai.pe/go-varlink" && strings.HasPrefix(t.Name(), "Option[")
+}
+
+type generator struct {
+	names map[reflect.Type]string
+	types []syntax.TypeDef
+	err   error
+}
+
+func (g *generator) method(fn reflect.Type) (in, out syntax.StructType, err error) {
+	if fn == nil || fn.Kind() != reflect.Func {
+		return in, out, fmt.Errorf("got %v, expected a function", fn)
+	}
+
+	params := make([]reflect.Type, fn.NumIn())
+	for i := range params {
+		params[i] = fn.In(i)
+	}
+	if len(params) > 0 && params[0] == contextType {
+		params = params[1:]
+	}
+	results := make([]reflect.Type, fn.NumOut())
+	for i := range results {
+		results[i] = fn.Out(i)
+	}
+	if n := len(results); n > 0 && results[n-1].Implements(errorType) {
+		results = results[:n-1]
+	}
+	if len(params) > 1 || len(results) > 1 || fn.IsVariadic() {
+		return in, out, fmt.Errorf("function %v must take and return at most one struct", fn)
+	}
+
+	if len(params) == 1 {
+		if in, err = g.params(params[0]); err != nil {
+			return in, out, fmt.Errorf("input: %w", err)
+		}
+	} else {
+		in = build.Struct()
+	}
+	if len(results) == 1 {
+		if out, err = g.params(results[0]); err != nil {
+			return in, out, fmt.Errorf("output: %w", err)
+		}
+	} else {
+		out = build.Struct()
+	}
+	return in, out, nil
+}
+
+// params returns the struct of the parameters of a method.
+func (g *generator) params(t reflect.Type) (syntax.StructType, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return syntax.StructType{}, fmt.Errorf("got %v, expected a struct", t)
+	}
+	return g.structType(t), g.err
+}
+
+func (g *generator) typ(t reflect.Type) syntax.Type {
+	switch {
+	case t == rawMessageType:
+		return build.Object
+	case isOption(t):
+		return build.Nullable(g.typ(t.Field(0).Type))
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		// Types like time.Time that implement both marshalers are
+		// typically encoded as strings.
+		return build.String
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return build.Object
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return build.Bool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return build.Int
+	case reflect.Float32, reflect.Float64:
+		return build.Float
+	case reflect.String:
+		return build.String
+	case reflect.Interface:
+		return build.Object
+	case reflect.Pointer:
+		return build.Nullable(g.typ(t.Elem()))
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes byte slices as base64 strings.
+			return build.String
+		}
+		return build.Array(g.typ(t.Elem()))
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			g.fail(fmt.Errorf("map type %v must have string keys", t))
+		}
+		return build.Dict(g.typ(t.Elem()))
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structType(t)
+		}
+		return g.named(t)
+	default:
+		g.fail(fmt.Errorf("type %v has no varlink equivalent", t))
+		return build.Object
+	}
+}
+
+// named returns a reference to the type definition of a named struct type,
+// defining it first if needed.
+func (g *generator) named(t reflect.Type) syntax.Type {
+	if name, ok := g.names[t]; ok {
+		return build.Named(name)
+	}
+	name := t.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		// Instantiations of generic types are named after their type
+		// parameters, which is not a valid varlink name.
+		name = name[:i]
+	}
+	for other, n := range g.names {
+		if n == name {
+			g.fail(fmt.Errorf("types %v and %v have the same name", other, t))
+		}
+	}
+	g.names[t] = name
+
+	// Define the type before its fields, so that recursive types refer to
+	// themselves.
+	i := len(g.types)
+	g.types = append(g.types, syntax.TypeDef{Name: name})
+	g.types[i].Type = g.structType(t)
+	return build.Named(name)
+}
+
+func (g *generator) structType(t reflect.Type) syntax.StructType {
+	s := build.Struct()
+	g.fields(&s, t)
+	return s
+}
+
+func (g *generator) fields(s *syntax.StructType, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.fields(s, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Fields = append(s.Fields, build.Field(name, g.typ(f.Type)))
+	}
+}
+
+func (g *generator) fail(err error) {
+	if g.err == nil {
+		g.err = err
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkgen_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinkgen"
+)
+
+type Node struct {
+	Name     string  `json:"name"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+type Meta struct {
+	Labels  map[string]string `json:"labels"`
+	Created time.Time         `json:"created"`
+}
+
+type ListInput struct {
+	Filter *string             `json:"filter"`
+	Limit  int                 `json:"limit"`
+	Offset varlink.Option[int] `json:"offset,omitzero"`
+	secret int
+}
+
+type ListOutput struct {
+	Meta
+	Nodes   []Node          `json:"nodes"`
+	Extra   json.RawMessage `json:"extra"`
+	Score   float64         `json:"score"`
+	Ignored bool            `json:"-"`
+}
+
+func TestDescription(t *testing.T) {
+	desc, err := varlinkgen.Description("org.example.tree", map[string]any{
+		"List":  func(ctx context.Context, in *ListInput) (ListOutput, error) { return ListOutput{}, nil },
+		"Reset": func(context.Context) error { return nil },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const expected = `interface org.example.tree
+
+type Node (name: string, children: []?Node)
+
+method List(filter: ?string, limit: int, offset: ?int) -> (
+  labels: [string]string,
+  created: string,
+  nodes: []Node,
+  extra: object,
+  score: float
+)
+
+method Reset() -> ()
+`
+	if desc != expected {
+		t.Fatalf("got description:\n%s\nexpected:\n%s", desc, expected)
+	}
+
+	_, err = varlinkgen.FromTypes("org.example.tree", map[string]any{
+		"bad": func(ctx context.Context, in map[int]string) {},
+	})
+	if err == nil || !strings.Contains(err.Error(), "expected a struct") {
+		t.Fatalf("deriving an invalid method returned %v", err)
+	}
+}
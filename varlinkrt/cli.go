@@ -0,0 +1,378 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/syntax"
+)
+
+// ErrUsage is returned by CLI.Run when the command line is invalid, after
+// the usage was printed.
+var ErrUsage = errors.New("invalid usage")
+
+// CLI is a command-line interface to the methods of an interface, with a
+// subcommand per method, whose flags are the input fields of the method:
+//
+//	<name> <method> [flags]
+//
+// Subcommands and flags are named after the methods and fields in
+// kebab-case, so that the GetOrder method is called with "get-order", and
+// its order_num field is set with "-order-num". Flags of enum fields only
+// accept the values of the enum, flags of struct, array and dict fields take
+// JSON values, and flags of fields that are not nullable must be set,
+// except for booleans. The -more flag calls the method with the `more` flag,
+// unless the method has a field of the same name.
+//
+// Each reply is printed as an indented JSON object.
+//
+// Generated code returns a CLI from NewCLI.
+type CLI struct {
+	// Name is the name of the program, as printed in its usage.
+	Name string
+
+	// Client is the client calling the methods.
+	Client *varlink.Client
+
+	// Interface is the definition of the interface.
+	Interface syntax.InterfaceDef
+
+	// Inputs holds, for each method name, a function returning a new input
+	// struct of the method, whose fields are in the order of the definition.
+	Inputs map[string]func() any
+
+	// DecodeError decodes the error replies of the methods.
+	DecodeError ErrorDecoder
+
+	// Stdout and Stderr receive the replies and the usage. If nil,
+	// os.Stdout and os.Stderr are used.
+	Stdout, Stderr io.Writer
+}
+
+func (cli *CLI) stdout() io.Writer {
+	if cli.Stdout == nil {
+		return os.Stdout
+	}
+	return cli.Stdout
+}
+
+func (cli *CLI) stderr() io.Writer {
+	if cli.Stderr == nil {
+		return os.Stderr
+	}
+	return cli.Stderr
+}
+
+// Run runs the command line args, without the program name. The help
+// subcommand prints the usage of the program, or of a method.
+func (cli *CLI) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		cli.usage(cli.stderr())
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		if len(args) < 2 {
+			cli.usage(cli.stdout())
+			return nil
+		}
+		method := cli.lookup(args[1])
+		if method == nil {
+			fmt.Fprintf(cli.stderr(), "%s: unknown command %q\n", cli.Name, args[1])
+			return ErrUsage
+		}
+		fs, _ := cli.flagSet(method)
+		fs.SetOutput(cli.stdout())
+		fs.Usage()
+		return nil
+	}
+
+	method := cli.lookup(args[0])
+	if method == nil {
+		fmt.Fprintf(cli.stderr(), "%s: unknown command %q\n", cli.Name, args[0])
+		cli.usage(cli.stderr())
+		return ErrUsage
+	}
+
+	fs, cmd := cli.flagSet(method)
+	fs.SetOutput(cli.stderr())
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return ErrUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(cli.stderr(), "%s %s: unexpected arguments %q\n", cli.Name, cmd.name, fs.Args())
+		return ErrUsage
+	}
+	for _, f := range cmd.fields {
+		if f.required && !f.set {
+			fmt.Fprintf(cli.stderr(), "%s %s: missing required flag -%s\n", cli.Name, cmd.name, f.name)
+			return ErrUsage
+		}
+	}
+
+	var opts []varlink.CallOption
+	if cmd.more {
+		opts = append(opts, varlink.More())
+	}
+	rs, err := cli.Client.Call(ctx, cli.Interface.Name+"."+method.Name, cmd.input, opts...)
+	if err != nil {
+		return err
+	}
+	for rs.Next() {
+		r := rs.Reply()
+		if r.Error != "" {
+			if cli.DecodeError != nil {
+				return cli.DecodeError(r.Error, r.Parameters)
+			}
+			return GenericError(r.Error, r.Parameters)
+		}
+		var out bytes.Buffer
+		params := r.Parameters
+		if len(params) == 0 {
+			params = json.RawMessage("{}")
+		}
+		if err := json.Indent(&out, params, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		if _, err := out.WriteTo(cli.stdout()); err != nil {
+			return err
+		}
+	}
+	return rs.Error()
+}
+
+func (cli *CLI) usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: %s <command> [flags]\n\ncommands:\n", cli.Name)
+	for _, method := range cli.Interface.Methods {
+		fmt.Fprintf(w, "  %-20s %s\n", kebabCase(method.Name), method.Doc().Synopsis())
+	}
+	fmt.Fprintf(w, "\nRun \"%s help <command>\" for more information about a command.\n", cli.Name)
+}
+
+func (cli *CLI) lookup(name string) *syntax.MethodDef {
+	for i, method := range cli.Interface.Methods {
+		if kebabCase(method.Name) == name && cli.Inputs[method.Name] != nil {
+			return &cli.Interface.Methods[i]
+		}
+	}
+	return nil
+}
+
+// cliCommand is the state of a subcommand being parsed.
+type cliCommand struct {
+	name   string
+	input  any
+	fields []*fieldFlag
+	more   bool
+}
+
+// flagSet returns the flag set of a method, whose flags fill in a new
+// input struct.
+func (cli *CLI) flagSet(method *syntax.MethodDef) (*flag.FlagSet, *cliCommand) {
+	cmd := &cliCommand{
+		name:  kebabCase(method.Name),
+		input: cli.Inputs[method.Name](),
+	}
+	fs := flag.NewFlagSet(cli.Name+" "+cmd.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s %s [flags]\n", cli.Name, cmd.name)
+		if doc := method.Doc().String(); doc != "" {
+			fmt.Fprintf(fs.Output(), "\n%s\n", strings.TrimSpace(doc))
+		}
+		fmt.Fprint(fs.Output(), "\nflags:\n")
+		fs.PrintDefaults()
+	}
+
+	input := reflect.ValueOf(cmd.input).Elem()
+	for i, field := range method.Input.Fields {
+		f := cli.fieldFlag(field, input.Field(i))
+		cmd.fields = append(cmd.fields, f)
+		fs.Var(f, f.name, f.usage(field))
+	}
+	if fs.Lookup("more") == nil {
+		fs.BoolVar(&cmd.more, "more", false, "call the method with the more flag, printing each reply")
+	}
+	return fs, cmd
+}
+
+// Kinds of field flags, besides the builtin types.
+const (
+	flagEnum = "enum"
+	flagJSON = "json"
+)
+
+// fieldFlag is a flag.Value setting a field of an input struct.
+type fieldFlag struct {
+	name     string
+	value    reflect.Value
+	kind     string // builtin type name, flagEnum or flagJSON
+	typename string
+	values   []string // enum values
+	required bool
+	set      bool
+}
+
+func (cli *CLI) fieldFlag(field syntax.StructField, value reflect.Value) *fieldFlag {
+	f := &fieldFlag{
+		name:  kebabCase(field.Name),
+		value: value,
+		kind:  flagJSON,
+	}
+
+	t := field.Type
+	if nullable, ok := t.(syntax.NullableType); ok {
+		t = nullable.Type
+	} else {
+		f.required = true
+	}
+
+	f.typename = flagJSON
+	if named, ok := t.(syntax.NamedType); ok {
+		f.typename = named.Name
+		for _, def := range cli.Interface.Types {
+			if def.Name == named.Name {
+				t = def.Type
+				break
+			}
+		}
+	}
+	switch t := t.(type) {
+	case syntax.BuiltinType:
+		switch t.Name {
+		case "bool", "int", "string":
+			f.kind, f.typename = t.Name, t.Name
+		case "float64":
+			f.kind, f.typename = "float", "float"
+		default:
+			f.typename = "object"
+		}
+	case syntax.EnumType:
+		f.kind = flagEnum
+		if f.typename == flagJSON {
+			f.typename = "enum"
+		}
+		for _, v := range t.Values {
+			f.values = append(f.values, v.Name)
+		}
+	}
+	if f.kind == "bool" {
+		f.required = false
+	}
+	return f
+}
+
+func (f *fieldFlag) usage(field syntax.StructField) string {
+	usage := field.Doc().Synopsis()
+	var notes []string
+	notes = append(notes, "`"+f.typename+"`")
+	if f.kind == flagEnum {
+		notes = append(notes, "one of "+strings.Join(f.values, ", "))
+	}
+	if f.required {
+		notes = append(notes, "required")
+	}
+	if usage != "" {
+		usage += " "
+	}
+	return usage + "(" + strings.Join(notes, ", ") + ")"
+}
+
+func (f *fieldFlag) String() string {
+	if f == nil || !f.value.IsValid() || !f.set {
+		return ""
+	}
+	data, _ := json.Marshal(f.value.Interface())
+	return string(data)
+}
+
+func (f *fieldFlag) IsBoolFlag() bool {
+	return f.kind == "bool"
+}
+
+// Set sets the field to the value of the flag. Values are converted to JSON
+// and unmarshaled into the field, which also supports the fields that are
+// generated as other Go types than the default ones, like time.Time.
+func (f *fieldFlag) Set(s string) error {
+	data := []byte(s)
+	switch f.kind {
+	case "bool":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		data = strconv.AppendBool(nil, b)
+	case "int":
+		n, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		data = strconv.AppendInt(nil, n, 10)
+	case "float":
+		x, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		data = strconv.AppendFloat(nil, x, 'g', -1, 64)
+	case flagEnum:
+		if !slices.Contains(f.values, s) {
+			return fmt.Errorf("must be one of %s", strings.Join(f.values, ", "))
+		}
+		fallthrough
+	case "string":
+		data, _ = json.Marshal(s)
+	}
+
+	v := reflect.New(f.value.Type())
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		if f.kind == flagJSON {
+			return fmt.Errorf("invalid JSON value: %w", err)
+		}
+		return err
+	}
+	f.value.Set(v.Elem())
+	f.set = true
+	return nil
+}
+
+// kebabCase converts an IDL name to kebab-case, as in get-order for
+// GetOrder, and order-num for order_num.
+func kebabCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '_' {
+			b.WriteByte('-')
+			continue
+		}
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
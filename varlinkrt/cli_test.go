@@ -0,0 +1,69 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/syntax/build"
+	"snai.pe/go-varlink/varlinkrt"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+func TestCLIFloatAndObject(t *testing.T) {
+	params := build.Struct(build.Field("factor", build.Float), build.Field("data", build.Object))
+	intf, err := build.Interface("org.example.scale").Method("Scale", params, params).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.scale.Scale", func(w varlink.ReplyWriter, call *varlink.Call) {
+		w.WriteReply(call.Parameters)
+	})
+	server := varlink.Server{Handler: &mux}
+
+	conn, peer := varlinktest.SessionPipe()
+	defer conn.Close()
+	ctx := context.Background()
+	go server.ServeSession(ctx, peer)
+
+	type scaleInput struct {
+		Factor float64 `json:"factor"`
+		Data   any     `json:"data"`
+	}
+	var stdout, stderr strings.Builder
+	cli := varlinkrt.CLI{
+		Name:        "example",
+		Client:      &varlink.Client{Transport: sessionTransport{conn}},
+		Interface:   intf,
+		Inputs:      map[string]func() any{"Scale": func() any { return new(scaleInput) }},
+		DecodeError: varlinkrt.GenericError,
+		Stdout:      &stdout,
+		Stderr:      &stderr,
+	}
+
+	if err := cli.Run(ctx, []string{"scale", "-factor", "1.5", "-data", `{"a":[1]}`}); err != nil {
+		t.Fatalf("%v: %s", err, stderr.String())
+	}
+	expected := "{\n  \"factor\": 1.5,\n  \"data\": {\n    \"a\": [\n      1\n    ]\n  }\n}\n"
+	if stdout.String() != expected {
+		t.Fatalf("got output %q, expected %q", stdout.String(), expected)
+	}
+
+	for _, args := range [][]string{
+		{"scale", "-factor", "x", "-data", "{}"},
+		{"scale", "-factor", "1", "-data", "{"},
+	} {
+		if err := cli.Run(ctx, args); !errors.Is(err, varlinkrt.ErrUsage) {
+			t.Errorf("running %q returned %v, expected ErrUsage", args, err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// OrderedMap is a varlink dict whose keys keep the order in which they were
+// received or set. Generated code uses it for dict fields marked with the
+// `@ordered` directive.
+//
+// An OrderedMap is marshaled as a JSON object, like a map, so services and
+// clients are free to represent the same dict as a map or an OrderedMap.
+//
+// The zero value is an empty map ready to use.
+type OrderedMap[T any] struct {
+	keys   []string
+	values map[string]T
+}
+
+// OrderedMapOf returns an OrderedMap holding the entries of m, sorted by
+// key.
+func OrderedMapOf[T any](m map[string]T) OrderedMap[T] {
+	return OrderedMap[T]{
+		keys:   slices.Sorted(maps.Keys(m)),
+		values: maps.Clone(m),
+	}
+}
+
+// Len returns the number of entries in the map.
+func (m OrderedMap[T]) Len() int {
+	return len(m.keys)
+}
+
+// Get returns the value of the specified key, and whether it was found.
+func (m OrderedMap[T]) Get(key string) (T, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// Set sets the value of the specified key. New keys are added after the
+// existing ones; existing keys keep their position.
+func (m *OrderedMap[T]) Set(key string, value T) {
+	if m.values == nil {
+		m.values = make(map[string]T)
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete removes the specified key from the map.
+func (m *OrderedMap[T]) Delete(key string) {
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	m.keys = slices.DeleteFunc(m.keys, func(k string) bool { return k == key })
+}
+
+// Keys returns the keys of the map, in order.
+func (m OrderedMap[T]) Keys() []string {
+	return slices.Clone(m.keys)
+}
+
+// All returns an iterator over the entries of the map, in order.
+func (m OrderedMap[T]) All() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		for _, k := range m.keys {
+			if !yield(k, m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns the entries of the map as a Go map, losing their order.
+func (m OrderedMap[T]) Map() map[string]T {
+	return maps.Clone(m.values)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m OrderedMap[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Like for maps, a JSON null
+// leaves the map unchanged, and the last value of duplicate keys wins.
+func (m *OrderedMap[T]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case nil:
+		return nil
+	case json.Delim('{'):
+	default:
+		return &json.UnmarshalTypeError{Value: jsonKind(tok), Type: reflect.TypeFor[OrderedMap[T]]()}
+	}
+
+	out := OrderedMap[T]{values: make(map[string]T)}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+
+		var value T
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		out.Set(key, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	*m = out
+	return nil
+}
+
+// jsonKind describes the JSON value starting with tok, for error messages.
+func jsonKind(tok json.Token) string {
+	switch tok.(type) {
+	case json.Delim:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	default:
+		return "number"
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"snai.pe/go-varlink/varlinkrt"
+)
+
+func TestOrderedMapJSON(t *testing.T) {
+	const input = `{"zebra":1,"apple":2,"mango":3,"apple":4}`
+
+	var m varlinkrt.OrderedMap[int]
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatal(err)
+	}
+	if keys := m.Keys(); !slices.Equal(keys, []string{"zebra", "apple", "mango"}) {
+		t.Fatalf("got keys %v, expected them in input order", keys)
+	}
+	if v, _ := m.Get("apple"); v != 4 {
+		t.Fatalf("got apple = %d, expected the last duplicate value 4", v)
+	}
+
+	m.Delete("zebra")
+	m.Set("banana", 5)
+	out, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"apple":4,"mango":3,"banana":5}`; string(out) != expected {
+		t.Fatalf("got %s, expected %s", out, expected)
+	}
+
+	// Both representations of a dict decode the same object.
+	var plain map[string]int
+	if err := json.Unmarshal(out, &plain); err != nil {
+		t.Fatal(err)
+	}
+	if ordered := varlinkrt.OrderedMapOf(plain); !slices.Equal(ordered.Keys(), []string{"apple", "banana", "mango"}) {
+		t.Fatalf("got keys %v, expected them sorted", ordered.Keys())
+	}
+
+	if err := json.Unmarshal([]byte(`["apple"]`), &m); err == nil {
+		t.Fatal("unmarshaling an array succeeded, expected an error")
+	}
+}
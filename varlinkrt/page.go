@@ -0,0 +1,94 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt
+
+import (
+	"iter"
+	"strconv"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/internal/service"
+)
+
+// LimitPage enforces the maximum page size of a paginated method on the
+// limit requested by a call: missing limits and limits above max are set to
+// max, unless max is zero. Limits below 1 are rejected with
+// org.varlink.service.InvalidParameter.
+func LimitPage(limit **int, max int, param string) varlink.Error {
+	switch {
+	case *limit != nil && **limit < 1:
+		return service.InvalidParameter(param)
+	case max > 0 && (*limit == nil || **limit > max):
+		*limit = &max
+	}
+	return nil
+}
+
+// LimitPageOption is LimitPage for limits generated as varlink.Option, by
+// the -nullable=option mode of the code generator.
+func LimitPageOption(limit *varlink.Option[int], max int, param string) varlink.Error {
+	p := limit.Ptr()
+	if err := LimitPage(&p, max, param); err != nil {
+		return err
+	}
+	*limit = varlink.OptionFrom(p)
+	return nil
+}
+
+// Pages returns an iterator over the items of all pages of a paginated
+// method. fetch is called with the cursor of each page, starting with nil,
+// and returns its items and the cursor of the next page, which is null or
+// empty for the last page. Iteration stops after the first error.
+func Pages[T any](fetch func(cursor *string) (items []T, next *string, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var cursor *string
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == nil || *next == "" {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// Page returns the page of items starting at the offset encoded in cursor,
+// holding at most limit items, along with the cursor of the next page. It
+// serves paginated methods listing items held in memory; services backed by
+// a database typically use their own cursors instead.
+//
+// A nil cursor starts at the first item, and a nil or non-positive limit
+// returns all remaining items. Cursors that Page did not return are rejected with
+// org.varlink.service.InvalidParameter.
+func Page[T any](items []T, cursor *string, limit *int, param string) ([]T, *string, varlink.Error) {
+	var start int
+	if cursor != nil {
+		n, err := strconv.Atoi(*cursor)
+		if err != nil || n < 0 || n > len(items) {
+			return nil, nil, service.InvalidParameter(param)
+		}
+		start = n
+	}
+	end := len(items)
+	if limit != nil && *limit > 0 && start+*limit < end {
+		end = start + *limit
+	}
+	var next *string
+	if end < len(items) {
+		next = Ptr(strconv.Itoa(end))
+	}
+	return items[start:end], next, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/internal/service"
+)
+
+// JoinParam returns the name of the parameter holding the field of another
+// parameter, as in meta.labels. The name of a field of the input or output
+// itself, whose parameter name is empty, is the field name.
+func JoinParam(param, field string) string {
+	if param == "" {
+		return field
+	}
+	return param + "." + field
+}
+
+// RequireFields checks that the JSON object in data holds all the fields of
+// v that are not nullable, including those of nested objects, and returns
+// an org.varlink.service.InvalidParameter error naming the first missing
+// one otherwise. Fields set to null are missing.
+//
+// v is a generated struct, or a pointer to one, into which data was
+// successfully unmarshaled: fields are nullable if they are tagged with
+// omitempty or omitzero, and the elements of arrays and dicts if they are
+// pointers or options.
+func RequireFields(data json.RawMessage, v any) varlink.Error {
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+	return requireFields(data, reflect.TypeOf(v), "")
+}
+
+var unmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+func requireFields(data []byte, t reflect.Type, param string) varlink.Error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if isOption(t) {
+		t = t.Field(0).Type
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Enums, ordered maps, objects and the types set by -type-map
+		// and @go-type decode themselves.
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil
+		}
+		for i := range t.NumField() {
+			f := t.Field(i)
+			name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			key := JoinParam(param, name)
+			raw, ok := fields[name]
+			if !ok || isNull(raw) {
+				if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+					return service.InvalidParameter(key)
+				}
+				continue
+			}
+			if err := requireFields(raw, f.Type, key); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Map:
+		var elems []json.RawMessage
+		if t.Kind() == reflect.Map {
+			var m map[string]json.RawMessage
+			if err := json.Unmarshal(data, &m); err != nil {
+				return nil
+			}
+			for _, e := range m {
+				elems = append(elems, e)
+			}
+		} else if err := json.Unmarshal(data, &elems); err != nil {
+			return nil
+		}
+		key := param + "[*]"
+		for _, e := range elems {
+			if isNull(e) {
+				if t.Elem().Kind() != reflect.Pointer && !isOption(t.Elem()) {
+					return service.InvalidParameter(key)
+				}
+				continue
+			}
+			if err := requireFields(e, t.Elem(), key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// isOption returns whether t is an instance of varlink.Option.
+func isOption(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == "snai.pe/go-varlink" && strings.HasPrefix(t.Name(), "Option[")
+}
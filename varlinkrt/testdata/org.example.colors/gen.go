@@ -0,0 +1,314 @@
+// This file was automatically generated by snai.pe/go-varlink/codegen
+// DO NOT EDIT
+
+// Example service exercising enums
+package colors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinkrt"
+
+	"snai.pe/go-varlink/syntax"
+)
+
+var _ = fmt.Errorf
+var _ = json.RawMessage(nil)
+var _ = context.Background
+var _ = varlinkrt.Validate
+
+type Error = varlink.Error
+
+// InterfaceName is the fully-qualified name of this varlink interface.
+const InterfaceName = `org.example.colors`
+
+type Color string
+
+const (
+	ColorRed       Color = "red"
+	ColorGreen     Color = "green"
+	ColorLightBlue Color = "light_blue"
+)
+
+// String returns the value of e, as written in the interface definition.
+func (e Color) String() string {
+	return string(e)
+}
+
+func (e Color) Validate(param string) Error {
+	return varlinkrt.ValidateEnum(e, param, ColorRed, ColorGreen, ColorLightBlue)
+}
+
+func (e *Color) UnmarshalJSON(data []byte) error {
+	return varlinkrt.UnmarshalEnum(data, e, ColorRed, ColorGreen, ColorLightBlue)
+}
+
+func (e Color) MarshalJSON() ([]byte, error) {
+	return varlinkrt.MarshalEnum(e, ColorRed, ColorGreen, ColorLightBlue)
+}
+
+// Input parameters for Complement method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ComplementInput struct {
+	Color Color `json:"color"`
+}
+
+func (input *ComplementInput) Validate(param string) Error {
+	if v, ok := any(input.Color).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "color")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ComplementInput from a
+// parameter list.
+func (input_ *ComplementInput) Pack(color Color) {
+	input_.Color = color
+}
+
+// Unpack unpacks the fields of ComplementInput to a
+// parameter list.
+func (input_ *ComplementInput) Unpack() (color Color) {
+	color = input_.Color
+	return
+}
+
+// Output parameters for Complement method.
+//
+// You shouldn't have to use this type directly; it is only useful if you
+// need to manually send method calls. Instead, use the methods of the
+// Client type.
+type ComplementOutput struct {
+	Complement Color `json:"complement"`
+}
+
+func (output *ComplementOutput) Validate(param string) Error {
+	if v, ok := any(output.Complement).(interface{ Validate(string) Error }); ok {
+		if err := v.Validate(varlinkrt.JoinParam(param, "complement")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pack fills in the fields of ComplementOutput from a
+// parameter list.
+func (output_ *ComplementOutput) Pack(complement Color) {
+	output_.Complement = complement
+}
+
+// Unpack unpacks the fields of ComplementInput to a
+// parameter list.
+func (output_ *ComplementOutput) Unpack() (complement Color) {
+	complement = output_.Complement
+	return
+}
+
+// Client represents a varlink client that implements the org.example.colors
+// interface.
+type Client struct {
+	varlink.Client
+}
+
+// ErrorFromCode returns a new varlink error constructed from the specified
+// code and parameters.
+func ErrorFromCode(code string, params json.RawMessage) Error {
+	switch code {
+	default:
+		return varlinkrt.GenericError(code, params)
+	}
+}
+
+// Returns the complementary color
+func (client_ *Client) Complement(ctx context.Context, color Color) (complement Color, err_ error) {
+	var (
+		input_  ComplementInput
+		output_ ComplementOutput
+	)
+
+	input_.Pack(color)
+
+	err_ = varlinkrt.CallOnce(ctx, &client_.Client, `org.example.colors.Complement`, &input_, &output_, ErrorFromCode)
+	if err_ != nil {
+		return
+	}
+
+	complement = output_.Unpack()
+	return
+}
+
+// Service is the interface that servers that implement the org.example.colors
+// varlink interface must adhere to.
+type Service interface {
+
+	// Returns the complementary color
+	Complement(ctx context.Context, color Color) (complement Color, err_ Error)
+}
+
+// NewHandler creates a new method handler for the specified service implementation.
+func NewHandler(s Service) varlink.MethodHandler {
+	var mux varlink.ServeMux
+	RegisterHandlers(&mux, s)
+	return &mux
+}
+
+// RegisterHandlers registers all of the method handlers for the specified
+// service implementation into the passed ServeMux.
+func RegisterHandlers(mux *varlink.ServeMux, s Service) {
+	mux.HandleMethod(ComplementHandler(s.Complement))
+}
+
+// TypedService is implemented by implementations of the org.example.colors
+// varlink interface that take and return the input and output structs of
+// its methods. Methods replying more than once, which are marked with
+// @more or @streamable, send their replies with send.
+type TypedService interface {
+
+	// Returns the complementary color
+	Complement(ctx context.Context, input *ComplementInput) (*ComplementOutput, error)
+}
+
+// NewDispatcher returns a handler routing the calls to the methods of the
+// org.example.colors interface to impl, after decoding and validating
+// their input. Errors returned by impl are converted with
+// varlink.ConvertError, and calls to other methods are replied with
+// org.varlink.service.MethodNotFound. To also serve the introspection
+// methods, register the dispatcher with varlink.ServeMux.Handle, with the
+// pattern `org.example.colors.*`.
+func NewDispatcher(impl TypedService) varlink.MethodHandler {
+	return dispatcher{impl}
+}
+
+type dispatcher struct {
+	impl TypedService
+}
+
+func (d_ dispatcher) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	switch call.Method {
+	case `org.example.colors.Complement`:
+		var input_ ComplementInput
+		if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+			w.WriteError(err)
+			return
+		}
+		output_, err := d_.impl.Complement(w.Context(), &input_)
+		if err != nil {
+			w.WriteError(varlink.ConvertError(w.Context(), err))
+			return
+		}
+		if output_ == nil {
+			output_ = new(ComplementOutput)
+		}
+		w.WriteReply(varlinkrt.SelectOutput(call, output_))
+	default:
+		w.WriteError(varlinkrt.MethodNotFound(call.Method))
+	}
+}
+
+// ComplementHandler is an adapter to allow the use of ordinary
+// functions as handlers of the Complement method. It implements
+// varlink.Method, and is registered with varlink.ServeMux.HandleMethod.
+type ComplementHandler func(ctx context.Context, color Color) (complement Color, err_ Error)
+
+// MethodName returns the fully-qualified name of the Complement method.
+func (ComplementHandler) MethodName() string {
+	return `org.example.colors.Complement`
+}
+
+func (fn ComplementHandler) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var (
+		input  ComplementInput
+		output ComplementOutput
+	)
+
+	if err := varlinkrt.DecodeInput(call, &input); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	var err Error
+	output.Complement, err = fn(w.Context(), input.Color)
+	if err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	w.WriteReply(varlinkrt.SelectOutput(call, &output))
+}
+
+// ComplementService is implemented by context-first implementations of
+// the Complement method, which send their replies with send.
+type ComplementService interface {
+	Complement(ctx context.Context, color Color, send func(complement Color) error) error
+}
+
+// NewComplementHandler returns a handler of the Complement method calling
+// impl, to be registered with varlink.ServeMux.HandleMethod.
+//
+// Every call to send writes a reply, all but the last with the continues
+// flag, which requires the call to have the `more` flag. Errors returned by
+// impl are converted with varlink.ConvertError.
+func NewComplementHandler(impl ComplementService) varlink.Method {
+	return complementAdapter{impl}
+}
+
+type complementAdapter struct {
+	impl ComplementService
+}
+
+func (complementAdapter) MethodName() string {
+	return `org.example.colors.Complement`
+}
+
+func (a_ complementAdapter) ServeMethod(w varlink.ReplyWriter, call *varlink.Call) {
+	var input_ ComplementInput
+	if err := varlinkrt.DecodeInput(call, &input_); err != nil {
+		w.WriteError(err)
+		return
+	}
+
+	varlinkrt.ServeStream(w, call, func(ctx_ context.Context, send_ func(*ComplementOutput) error) error {
+		return a_.impl.Complement(ctx_, input_.Color, func(complement Color) error {
+			var output_ ComplementOutput
+			output_.Pack(complement)
+			return send_(&output_)
+		})
+	})
+}
+
+// Definition contains the definition of the varlink interface which was parsed from its description.
+var Definition = syntax.InterfaceDef{Node: syntax.Node{Position: syntax.Cursor{Line: 2, Column: 1, Offset: 35}, End: syntax.Cursor{Line: 7, Column: 55, Offset: 190}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Example service exercising enums\n", Value: "Example service exercising enums", Start: syntax.Cursor{Line: 1, Column: 1, Offset: 0}, End: syntax.Cursor{Line: 1, Column: 35, Offset: 34}}}, Tokens: []syntax.Token(nil)}, Name: "org.example.colors", Types: []syntax.TypeDef{syntax.TypeDef{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 1, Offset: 65}, End: syntax.Cursor{Line: 4, Column: 36, Offset: 100}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Color", Type: syntax.EnumType{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 12, Offset: 76}, End: syntax.Cursor{Line: 4, Column: 36, Offset: 100}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Values: []syntax.EnumValue{syntax.EnumValue{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 13, Offset: 77}, End: syntax.Cursor{Line: 4, Column: 16, Offset: 80}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "red"}, syntax.EnumValue{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 18, Offset: 82}, End: syntax.Cursor{Line: 4, Column: 23, Offset: 87}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "green"}, syntax.EnumValue{Node: syntax.Node{Position: syntax.Cursor{Line: 4, Column: 25, Offset: 89}, End: syntax.Cursor{Line: 4, Column: 35, Offset: 99}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "light_blue"}}}}}, Methods: []syntax.MethodDef{syntax.MethodDef{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 1, Offset: 136}, End: syntax.Cursor{Line: 7, Column: 55, Offset: 190}, Comments: []syntax.Token{syntax.Token{Type: "<comment>", Raw: "# Returns the complementary color\n", Value: "Returns the complementary color", Start: syntax.Cursor{Line: 6, Column: 1, Offset: 102}, End: syntax.Cursor{Line: 6, Column: 34, Offset: 135}}}, Tokens: []syntax.Token(nil)}, Name: "Complement", Input: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 18, Offset: 153}, End: syntax.Cursor{Line: 7, Column: 32, Offset: 167}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 19, Offset: 154}, End: syntax.Cursor{Line: 7, Column: 31, Offset: 166}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "color", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 26, Offset: 161}, End: syntax.Cursor{Line: 7, Column: 31, Offset: 166}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Color"}}}}, Output: syntax.StructType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 36, Offset: 171}, End: syntax.Cursor{Line: 7, Column: 55, Offset: 190}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Fields: []syntax.StructField{syntax.StructField{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 37, Offset: 172}, End: syntax.Cursor{Line: 7, Column: 54, Offset: 189}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "complement", Type: syntax.NamedType{Node: syntax.Node{Position: syntax.Cursor{Line: 7, Column: 49, Offset: 184}, End: syntax.Cursor{Line: 7, Column: 54, Offset: 189}, Comments: []syntax.Token(nil), Tokens: []syntax.Token(nil)}, Name: "Color"}}}}}}, Errors: []syntax.ErrorDef(nil)}
+
+// Description contains the description of the varlink interface, expressed in the IDL.
+var Description = `# Example service exercising enums
+interface org.example.colors
+
+type Color (red, green, light_blue)
+
+# Returns the complementary color
+method Complement(color: Color) -> (complement: Color)
+`
+
+// Fully-qualified names of the methods of the interface.
+const (
+	MethodComplement = `org.example.colors.Complement`
+)
+
+// Fingerprint is the fingerprint of the varlink interface definition, as
+// computed by syntax.Fingerprint.
+const Fingerprint = `d977ae51a3138be795b18d69bacdf954dbc00f8a5f34852edce2096660e4b73e`
+
+// VerifyAgainst checks that the specified description, typically obtained
+// from a service via org.varlink.service.GetInterfaceDescription, defines
+// the same interface that this code was generated from.
+func VerifyAgainst(description string) error {
+	return syntax.VerifyFingerprint(description, Fingerprint)
+}
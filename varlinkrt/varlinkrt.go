@@ -0,0 +1,313 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package varlinkrt provides runtime support for the code generated by
+// snai.pe/go-varlink/cmd/codegen.
+//
+// Generated code calls into this package rather than inlining the same
+// helpers in every generated file, which keeps generated files small and
+// lets fixes reach existing generated code without regenerating it.
+//
+// Most functions of this package are only meant to be called by generated
+// code, but the nullable helpers are also convenient to users of generated
+// types.
+package varlinkrt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"slices"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/internal/service"
+)
+
+// Ptr returns a pointer to a copy of v. It is useful to fill in nullable
+// fields of generated types.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value pointed to by p, or the zero value of T if p is
+// nil. It is useful to read nullable fields of generated types.
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// ValidateEnum returns an org.varlink.service.InvalidParameter error for
+// the specified parameter if v is not one of the allowed values.
+func ValidateEnum[T ~string](v T, param string, values ...T) varlink.Error {
+	if !slices.Contains(values, v) {
+		return varlink.NewError("org.varlink.service.InvalidParameter", "parameter", param)
+	}
+	return nil
+}
+
+// UnmarshalEnum unmarshals the JSON string in data into v, and validates
+// it against the allowed values.
+func UnmarshalEnum[T ~string](data []byte, v *T, values ...T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if err := ValidateEnum(T(s), "", values...); err != nil {
+		return err
+	}
+	*v = T(s)
+	return nil
+}
+
+// MarshalEnum validates v against the allowed values, and marshals it into
+// a JSON string.
+func MarshalEnum[T ~string](v T, values ...T) ([]byte, error) {
+	if err := ValidateEnum(v, "", values...); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(v))
+}
+
+// Validate validates v with its Validate method, if it has one. The
+// parameter name is used to report which parameter is invalid.
+func Validate(v any, param string) varlink.Error {
+	if v, ok := v.(interface{ Validate(string) varlink.Error }); ok {
+		return v.Validate(param)
+	}
+	return nil
+}
+
+// DecodeInput unmarshals the parameters of the call into input, checks
+// that the fields that are not nullable are set with RequireFields, and
+// validates them.
+func DecodeInput(call *varlink.Call, input any) varlink.Error {
+	if err := call.Unmarshal(input); err != nil {
+		return err
+	}
+	if err := RequireFields(call.Parameters, input); err != nil {
+		return err
+	}
+	return Validate(input, "")
+}
+
+// ErrorDecoder decodes the parameters of an error reply into a varlink
+// error. Generated packages provide one as their ErrorFromCode function.
+type ErrorDecoder func(code string, params json.RawMessage) varlink.Error
+
+// UnmarshalError unmarshals the parameters of an error reply into an error
+// of type T. If the parameters do not match T, a generic error is returned
+// instead.
+func UnmarshalError[T varlink.Error](code string, params json.RawMessage) varlink.Error {
+	var err T
+	if json.Unmarshal([]byte(params), &err) != nil {
+		return GenericError(code, params)
+	}
+	return err
+}
+
+// GenericError returns a varlink error with the specified code, whose
+// parameters are the fields of the params object.
+func GenericError(code string, params json.RawMessage) varlink.Error {
+	if len(params) == 0 {
+		return varlink.NewError(code)
+	}
+	verr, err := varlink.NewErrorParams(code, params)
+	if err != nil {
+		return varlink.NewError(code)
+	}
+	return verr
+}
+
+// CallOnce calls a method expecting exactly one reply, and unmarshals the
+// reply parameters into output. Error replies are decoded with decodeError.
+func CallOnce(ctx context.Context, client *varlink.Client, method string, input, output any, decodeError ErrorDecoder, opts ...varlink.CallOption) error {
+	rs, err := client.Call(ctx, method, input, opts...)
+	if err != nil {
+		return err
+	}
+
+	for rs.Next() {
+		r := rs.Reply()
+		if r.Error != "" {
+			return decodeError(r.Error, r.Parameters)
+		}
+		if r.Continues {
+			return errors.New("more than one reply on single-reply call")
+		}
+		if err := rs.Unmarshal(output); err != nil {
+			return err
+		}
+	}
+	return rs.Error()
+}
+
+// CallMore calls a method with the `more` flag, and returns an iterator over
+// its replies, whose parameters are unmarshaled into new values of O. Error
+// replies are decoded with decodeError. Iteration stops after the first
+// error.
+//
+// Stopping the iteration early discards the remaining replies in the
+// background, since the replies to the next calls made on the same session
+// come after them.
+func CallMore[O any](ctx context.Context, client *varlink.Client, method string, input any, decodeError ErrorDecoder, opts ...varlink.CallOption) iter.Seq2[*O, error] {
+	return func(yield func(*O, error) bool) {
+		rs, err := client.Call(ctx, method, input, append([]varlink.CallOption{varlink.More()}, opts...)...)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for rs.Next() {
+			r := rs.Reply()
+			if r.Error != "" {
+				yield(nil, decodeError(r.Error, r.Parameters))
+				return
+			}
+			output := new(O)
+			if err := rs.Unmarshal(output); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(output, nil) {
+				go func() {
+					for rs.Next() {
+					}
+				}()
+				return
+			}
+		}
+		if err := rs.Error(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// SelectOutput returns the output parameters of a reply to the call, with
+// the fields that the call does not select with varlink.FieldsExtension
+// left out, and the others in the order in which they are selected. If the
+// call selects all fields, output is returned as-is.
+func SelectOutput(call *varlink.Call, output any) any {
+	names, ok := call.SelectedFields()
+	if !ok {
+		return output
+	}
+	data, err := json.Marshal(output)
+	if err != nil {
+		// Let the reply fail to encode.
+		return output
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return output
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, name := range names {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+		delete(fields, name)
+	}
+	buf.WriteByte('}')
+	return json.RawMessage(buf.Bytes())
+}
+
+// MethodNotFound returns the org.varlink.service.MethodNotFound error for
+// the specified method.
+func MethodNotFound(method string) varlink.Error {
+	return service.MethodNotFound(method)
+}
+
+// ServeStream serves a call with fn, which sends the replies to the call
+// with send. Every reply but the last is written with the continues flag,
+// which requires the call to have the `more` flag: otherwise, sending more
+// than one reply fails with org.varlink.service.ExpectedMore. If fn sends
+// no reply, the zero value of O is replied. Replies only carry the fields
+// selected by the call, as per SelectOutput.
+//
+// If fn returns an error, it is converted with varlink.ConvertError and
+// written as the final reply, after the reply sent last, if any.
+func ServeStream[O any](w varlink.ReplyWriter, call *varlink.Call, fn func(ctx context.Context, send func(*O) error) error) {
+	var (
+		last    *O
+		pending bool
+	)
+	send := func(output *O) error {
+		if pending {
+			if !call.More {
+				return service.ExpectedMore()
+			}
+			if err := w.WriteMore(SelectOutput(call, last)); err != nil {
+				return err
+			}
+		}
+		last, pending = output, true
+		return nil
+	}
+
+	ctx := w.Context()
+	if err := fn(ctx, send); err != nil {
+		if pending && call.More {
+			w.WriteMore(SelectOutput(call, last))
+		}
+		w.WriteError(varlink.ConvertError(ctx, err))
+		return
+	}
+	if !pending {
+		last = new(O)
+	}
+	w.WriteReply(SelectOutput(call, last))
+}
+
+// ServeItems serves a call to a method whose output is a list of items with
+// fn, which sends the items one at a time with send. If the call has the
+// `more` flag, each item is replied on its own, in the output built by wrap
+// from a slice holding only that item; otherwise, the items are collected,
+// and replied all at once. This lets a single implementation serve both the
+// clients streaming the items and the clients that want them in one reply.
+//
+// An empty list is replied as a single output without items. Errors are
+// handled like in ServeStream.
+func ServeItems[T, O any](w varlink.ReplyWriter, call *varlink.Call, wrap func([]T) *O, fn func(ctx context.Context, send func(T) error) error) {
+	if call.More {
+		ServeStream(w, call, func(ctx context.Context, send func(*O) error) error {
+			var sent bool
+			err := fn(ctx, func(item T) error {
+				sent = true
+				return send(wrap([]T{item}))
+			})
+			if err == nil && !sent {
+				err = send(wrap([]T{}))
+			}
+			return err
+		})
+		return
+	}
+
+	ctx := w.Context()
+	items := []T{}
+	err := fn(ctx, func(item T) error {
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		w.WriteError(varlink.ConvertError(ctx, err))
+		return
+	}
+	w.WriteReply(SelectOutput(call, wrap(items)))
+}
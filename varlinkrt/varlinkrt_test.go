@@ -0,0 +1,408 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinkrt_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+	"snai.pe/go-varlink/varlinkrt"
+	"snai.pe/go-varlink/varlinkrt/testdata/org.example.colors"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+//go:generate go run snai.pe/go-varlink/cmd/codegen -output=testdata/org.example.colors/gen.go testdata/org.example.colors.varlink
+
+type describer struct{}
+
+func (describer) GetInterfaceDescription(ctx context.Context, name string, send func(description string) error) error {
+	if name == "org.example.missing" {
+		return errors.New("no such interface")
+	}
+	for _, part := range []string{"interface", name} {
+		if err := send(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestServeStream(t *testing.T) {
+	// ServeMux implements org.varlink.service itself, so the handler is
+	// served directly.
+	server := varlink.Server{Handler: service.NewGetInterfaceDescriptionHandler(describer{})}
+
+	client, conn := varlinktest.SessionPipe()
+	defer client.Close()
+	ctx := context.Background()
+	go server.ServeSession(ctx, conn)
+
+	call := func(name string, more bool) ([]string, error) {
+		t.Helper()
+		var opts []varlink.CallOption
+		if more {
+			opts = append(opts, varlink.More())
+		}
+		c, err := varlink.MakeCall(service.MethodGetInterfaceDescription, service.GetInterfaceDescriptionInput{Interface: name}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.WriteCall(ctx, &c); err != nil {
+			t.Fatal(err)
+		}
+		var parts []string
+		rs := varlink.NewReplyStream(ctx, &c, client)
+		for rs.Next() {
+			var out service.GetInterfaceDescriptionOutput
+			if err := rs.Unmarshal(&out); err != nil {
+				return parts, err
+			}
+			parts = append(parts, out.Description)
+		}
+		return parts, rs.Error()
+	}
+
+	parts, err := call("org.example.ok", true)
+	if err != nil || !slices.Equal(parts, []string{"interface", "org.example.ok"}) {
+		t.Fatalf("got replies %q and error %v, expected two replies", parts, err)
+	}
+
+	_, err = call("org.example.ok", false)
+	var verr varlink.Error
+	if !errors.As(err, &verr) || verr.ErrorCode() != "org.varlink.service.ExpectedMore" {
+		t.Fatalf("streaming without the more flag failed with %v, expected ExpectedMore", err)
+	}
+
+	_, err = call("org.example.missing", false)
+	if !errors.As(err, &verr) || verr.ErrorCode() != "snai.pe.varlink.InternalError" {
+		t.Fatalf("returning an error failed with %v, expected it to be converted", err)
+	}
+}
+
+func TestSelectOutput(t *testing.T) {
+	computed := false
+	handler := service.GetInfoHandler(func(ctx context.Context) (vendor, product, version, url string, interfaces []string, err_ service.Error) {
+		if varlink.FieldSelected(ctx, "interfaces") {
+			computed = true
+			interfaces = []string{"org.example.expensive"}
+		}
+		return "vendor", "product", "1", "https://example.org", interfaces, nil
+	})
+	server := varlink.Server{Handler: handler}
+
+	client, conn := varlinktest.SessionPipe()
+	defer client.Close()
+	ctx := context.Background()
+	go server.ServeSession(ctx, conn)
+
+	call := func(opts ...varlink.CallOption) string {
+		t.Helper()
+		c, err := varlink.MakeCall(service.MethodGetInfo, service.GetInfoInput{}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.WriteCall(ctx, &c); err != nil {
+			t.Fatal(err)
+		}
+		var reply varlink.Reply
+		if err := client.ReadReply(ctx, &c, &reply); err != nil {
+			t.Fatal(err)
+		}
+		return string(reply.Parameters)
+	}
+
+	if out := call(varlink.SelectFields("version", "vendor", "unknown")); out != `{"version":"1","vendor":"vendor"}` || computed {
+		t.Fatalf("got reply %s, expected the selected fields only", out)
+	}
+	if out := call(); !computed || out != `{"vendor":"vendor","product":"product","version":"1","url":"https://example.org","interfaces":["org.example.expensive"]}` {
+		t.Fatalf("got reply %s, expected all fields", out)
+	}
+}
+
+func TestPages(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6}
+
+	var pages int
+	list := func(cursor *string, limit *int) ([]int, *string, error) {
+		pages++
+		if err := varlinkrt.LimitPage(&limit, 3, "limit"); err != nil {
+			return nil, nil, err
+		}
+		page, next, err := varlinkrt.Page(items, cursor, limit, "cursor")
+		if err != nil {
+			return nil, nil, err
+		}
+		return page, next, nil
+	}
+
+	var got []int
+	for item, err := range varlinkrt.Pages(func(cursor *string) ([]int, *string, error) {
+		return list(cursor, varlinkrt.Ptr(5))
+	}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, item)
+	}
+	if !slices.Equal(got, items) || pages != 3 {
+		t.Fatalf("got items %v in %d pages, expected %v in 3 pages", got, pages, items)
+	}
+
+	var verr varlink.Error
+	if _, _, err := list(nil, varlinkrt.Ptr(0)); !errors.As(err, &verr) || verr.ErrorCode() != "org.varlink.service.InvalidParameter" {
+		t.Fatalf("listing with a zero limit failed with %v, expected InvalidParameter", err)
+	}
+	if _, _, err := list(varlinkrt.Ptr("x"), nil); !errors.As(err, &verr) || verr.ErrorCode() != "org.varlink.service.InvalidParameter" {
+		t.Fatalf("listing with an invalid cursor failed with %v, expected InvalidParameter", err)
+	}
+}
+
+func TestServeItems(t *testing.T) {
+	type output struct {
+		Items []int `json:"items"`
+	}
+	wrap := func(items []int) *output { return &output{Items: items} }
+	count := func(n int) func(context.Context, func(int) error) error {
+		return func(ctx context.Context, send func(int) error) error {
+			for i := range n {
+				if err := send(i); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	w := varlinktest.NewReplyWriter(t)
+	varlinkrt.ServeItems(w, &varlink.Call{}, wrap, count(3))
+	var out output
+	if w.ExpectReply(&out); !slices.Equal(out.Items, []int{0, 1, 2}) {
+		t.Fatalf("got items %v in a single reply, expected 0, 1 and 2", out.Items)
+	}
+	w.ExpectEnd()
+
+	w = varlinktest.NewReplyWriter(t)
+	w.More = true
+	varlinkrt.ServeItems(w, &varlink.Call{More: true}, wrap, count(3))
+	for i := range 3 {
+		var out output
+		reply := w.ExpectReply(&out)
+		if !slices.Equal(out.Items, []int{i}) || reply.Continues != (i < 2) {
+			t.Fatalf("got reply %s (continues: %v) for item %d", reply.Parameters, reply.Continues, i)
+		}
+	}
+	w.ExpectEnd()
+
+	w = varlinktest.NewReplyWriter(t)
+	w.More = true
+	varlinkrt.ServeItems(w, &varlink.Call{More: true}, wrap, count(0))
+	if reply := w.ExpectReply(nil); string(reply.Parameters) != `{"items":[]}` {
+		t.Fatalf("got reply %s for no items, expected an empty list", reply.Parameters)
+	}
+	w.ExpectEnd()
+}
+
+type typedService struct{}
+
+func (typedService) GetInfo(ctx context.Context, input *service.GetInfoInput) (*service.GetInfoOutput, error) {
+	return &service.GetInfoOutput{Vendor: "example"}, nil
+}
+
+func (typedService) GetInterfaceDescription(ctx context.Context, input *service.GetInterfaceDescriptionInput) (*service.GetInterfaceDescriptionOutput, error) {
+	return nil, service.InterfaceNotFound(input.Interface)
+}
+
+func TestDispatcher(t *testing.T) {
+	handler := service.NewDispatcher(typedService{})
+
+	w := varlinktest.NewReplyWriter(t)
+	handler.ServeMethod(w, &varlink.Call{Method: service.MethodGetInfo, Parameters: []byte(`{}`)})
+	var info service.GetInfoOutput
+	if w.ExpectReply(&info); info.Vendor != "example" {
+		t.Fatalf("got vendor %q, expected example", info.Vendor)
+	}
+	w.ExpectEnd()
+
+	for method, code := range map[string]string{
+		service.MethodGetInterfaceDescription: "org.varlink.service.InterfaceNotFound",
+		"org.varlink.service.Missing":         "org.varlink.service.MethodNotFound",
+	} {
+		w := varlinktest.NewReplyWriter(t)
+		handler.ServeMethod(w, &varlink.Call{Method: method, Parameters: []byte(`{"interface":"org.example"}`)})
+		w.ExpectErrorCode(code)
+		w.ExpectEnd()
+	}
+
+	w = varlinktest.NewReplyWriter(t)
+	handler.ServeMethod(w, &varlink.Call{Method: service.MethodGetInterfaceDescription, Parameters: []byte(`{}`)})
+	w.ExpectErrorCode("org.varlink.service.InvalidParameter")
+	w.ExpectEnd()
+}
+
+func TestRequireFields(t *testing.T) {
+	type item struct {
+		Name string  `json:"name"`
+		Note *string `json:"note,omitempty"`
+	}
+	type input struct {
+		Items []item                 `json:"items"`
+		ByKey map[string]*item       `json:"by_key"`
+		Best  varlink.Option[item]   `json:"best,omitzero"`
+		Limit varlink.Option[int]    `json:"limit,omitzero"`
+		Extra varlink.Option[string] `json:"extra,omitzero"`
+	}
+
+	for params, missing := range map[string]string{
+		`{"items":[{"name":"a"}],"by_key":{"a":null}}`:             "",
+		`{"items":[],"by_key":{},"best":{"name":"b","note":null}}`: "",
+		`{"by_key":{}}`:                        "items",
+		`{"items":null,"by_key":{}}`:           "items",
+		`{"items":[{"note":"n"}],"by_key":{}}`: "items[*].name",
+		`{"items":[null],"by_key":{}}`:         "items[*]",
+		`{"items":[],"by_key":{"a":{}}}`:       "by_key[*].name",
+		`{"items":[],"by_key":{},"best":{}}`:   "best.name",
+		``:                                     "items",
+	} {
+		var in input
+		err := varlinkrt.RequireFields([]byte(params), &in)
+		switch {
+		case missing == "" && err != nil:
+			t.Errorf("%s: got %v, expected no error", params, err)
+		case missing != "" && err == nil:
+			t.Errorf("%s: got no error, expected %s to be missing", params, missing)
+		case missing != "":
+			got, _ := json.Marshal(err)
+			if expected := `{"parameter":"` + missing + `"}`; err.ErrorCode() != "org.varlink.service.InvalidParameter" || string(got) != expected {
+				t.Errorf("%s: got %s %s, expected %s to be missing", params, err.ErrorCode(), got, missing)
+			}
+		}
+	}
+}
+
+// sessionTransport makes all calls on the same session.
+type sessionTransport struct {
+	session *varlink.Session
+}
+
+func (t sessionTransport) RoundTrip(ctx context.Context, _ *varlink.Session, call *varlink.Call) (*varlink.ReplyStream, error) {
+	if err := t.session.WriteCall(ctx, call); err != nil {
+		return nil, err
+	}
+	return varlink.NewReplyStream(ctx, call, t.session), nil
+}
+
+func TestCallMore(t *testing.T) {
+	server := varlink.Server{Handler: service.NewGetInterfaceDescriptionHandler(describer{})}
+
+	conn, peer := varlinktest.SessionPipe()
+	defer conn.Close()
+	ctx := context.Background()
+	go server.ServeSession(ctx, peer)
+
+	client := varlink.Client{Transport: sessionTransport{conn}}
+	describe := func(name string) iter.Seq2[*service.GetInterfaceDescriptionOutput, error] {
+		input := service.GetInterfaceDescriptionInput{Interface: name}
+		return varlinkrt.CallMore[service.GetInterfaceDescriptionOutput](ctx, &client, service.MethodGetInterfaceDescription, &input, service.ErrorFromCode)
+	}
+
+	var parts []string
+	for out, err := range describe("org.example") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, out.Description)
+	}
+	if !slices.Equal(parts, []string{"interface", "org.example"}) {
+		t.Fatalf("got replies %q", parts)
+	}
+
+	// Stopping early must not hold back the replies to the next calls.
+	for range describe("org.example") {
+		break
+	}
+	for _, err := range describe("org.example.missing") {
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+
+	// The options of the caller must be left untouched, even if their
+	// slice has room for the more flag.
+	opts := make([]varlink.CallOption, 1, 2)
+	opts[0] = varlink.Idempotent()
+	input := service.GetInterfaceDescriptionInput{Interface: "org.example"}
+	for _, err := range varlinkrt.CallMore[service.GetInterfaceDescriptionOutput](ctx, &client, service.MethodGetInterfaceDescription, &input, service.ErrorFromCode, opts...) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if opts[:2][1] != nil {
+		t.Fatal("CallMore wrote into the options slice of the caller")
+	}
+}
+
+func TestCLI(t *testing.T) {
+	server := varlink.Server{Handler: service.NewGetInterfaceDescriptionHandler(describer{})}
+
+	conn, peer := varlinktest.SessionPipe()
+	defer conn.Close()
+	ctx := context.Background()
+	go server.ServeSession(ctx, peer)
+
+	var stdout, stderr strings.Builder
+	cli := varlinkrt.CLI{
+		Name:      "example",
+		Client:    &varlink.Client{Transport: sessionTransport{conn}},
+		Interface: service.Definition,
+		Inputs: map[string]func() any{
+			"GetInterfaceDescription": func() any { return new(service.GetInterfaceDescriptionInput) },
+		},
+		DecodeError: service.ErrorFromCode,
+		Stdout:      &stdout,
+		Stderr:      &stderr,
+	}
+
+	err := cli.Run(ctx, []string{"get-interface-description", "-more", "-interface", "org.example"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "{\n  \"description\": \"interface\"\n}\n{\n  \"description\": \"org.example\"\n}\n"
+	if stdout.String() != expected {
+		t.Fatalf("got output %q, expected %q", stdout.String(), expected)
+	}
+
+	for _, args := range [][]string{
+		{},
+		{"get-info"},
+		{"get-interface-description", "-more"},
+		{"get-interface-description", "-interface", "org.example", "extra"},
+	} {
+		if err := cli.Run(ctx, args); !errors.Is(err, varlinkrt.ErrUsage) {
+			t.Errorf("running %q returned %v, expected ErrUsage", args, err)
+		}
+	}
+}
+
+func TestEnumString(t *testing.T) {
+	if s := colors.ColorLightBlue.String(); s != "light_blue" {
+		t.Fatalf("got %q, expected the value of the enum", s)
+	}
+	if s := fmt.Sprintf("%v", colors.ComplementInput{Color: colors.ColorRed}); s != "{red}" {
+		t.Fatalf("enum was formatted as %q, expected {red}", s)
+	}
+	var e colors.Color
+	if err := json.Unmarshal([]byte(`"green"`), &e); err != nil || e.String() != "green" {
+		t.Fatalf("got %q, %v after decoding \"green\"", e, err)
+	}
+}
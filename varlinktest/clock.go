@@ -0,0 +1,89 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// FakeClock is a varlink.Clock whose time only changes when told to, which
+// makes the timing of sessions, transports and servers deterministic in
+// tests.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *FakeClock
+	when  time.Time
+	f     func()
+}
+
+// NewFakeClock returns a fake clock set to the specified time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc registers f to be called once the clock has advanced by d.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) varlink.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{clock: c, when: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, and calls the functions of the
+// timers that expire, in expiration order. Unlike with time.AfterFunc, the
+// functions are called synchronously, from the goroutine calling Advance.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+
+	var expired []*fakeTimer
+	c.timers = slices.DeleteFunc(c.timers, func(t *fakeTimer) bool {
+		if t.when.After(c.now) {
+			return false
+		}
+		expired = append(expired, t)
+		return true
+	})
+	c.mu.Unlock()
+
+	slices.SortStableFunc(expired, func(a, b *fakeTimer) int {
+		return a.when.Compare(b.when)
+	})
+	for _, t := range expired {
+		t.f()
+	}
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := slices.Index(c.timers, t)
+	if i == -1 {
+		return false
+	}
+	c.timers = slices.Delete(c.timers, i, i+1)
+	return true
+}
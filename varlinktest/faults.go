@@ -0,0 +1,105 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest
+
+import (
+	"sync"
+	"time"
+
+	"snai.pe/go-varlink"
+)
+
+// Faults is a varlink.FaultInjector whose faults are armed by tests, for
+// instance to check that a client retries calls whose replies are lost, or
+// that a timeout fires when a service is slow:
+//
+//	var faults varlinktest.Faults
+//	session.InjectFaults(&faults)
+//
+//	faults.DropNextWrite()
+//	err := session.WriteCall(ctx, &call) // never reaches the peer
+//
+// The zero value injects no faults. Faults may be armed at any time, from
+// any goroutine.
+type Faults struct {
+	// Clock, if set, is the clock used to delay reads. The default is
+	// varlink.SystemClock.
+	Clock varlink.Clock
+
+	mu        sync.Mutex
+	drops     int
+	corrupts  []func([]byte) []byte
+	readDelay time.Duration
+}
+
+// DropNextWrite makes the next message written be silently dropped.
+func (f *Faults) DropNextWrite() {
+	f.DropWrites(1)
+}
+
+// DropWrites makes the next n messages written be silently dropped.
+func (f *Faults) DropWrites(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.drops += n
+}
+
+// CorruptNextWrite makes the next message written be replaced by the
+// result of fn. If fn is nil, the message is truncated to half its size,
+// which makes it invalid JSON.
+func (f *Faults) CorruptNextWrite(fn func(msg []byte) []byte) {
+	if fn == nil {
+		fn = func(msg []byte) []byte {
+			return msg[:len(msg)/2]
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.corrupts = append(f.corrupts, fn)
+}
+
+// DelayReads delays each message read by d, until DelayReads is called
+// again. A zero duration disables the delay.
+func (f *Faults) DelayReads(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.readDelay = d
+}
+
+// InjectWrite implements varlink.FaultInjector.
+func (f *Faults) InjectWrite(msg []byte) []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.drops > 0 {
+		f.drops--
+		return nil
+	}
+	if len(f.corrupts) > 0 {
+		fn := f.corrupts[0]
+		f.corrupts = f.corrupts[1:]
+		return fn(append([]byte(nil), msg...))
+	}
+	return msg
+}
+
+// InjectRead implements varlink.FaultInjector.
+func (f *Faults) InjectRead(msg []byte) []byte {
+	f.mu.Lock()
+	delay := f.readDelay
+	f.mu.Unlock()
+
+	if delay > 0 {
+		clock := f.Clock
+		if clock == nil {
+			clock = varlink.SystemClock
+		}
+		done := make(chan struct{})
+		clock.AfterFunc(delay, func() { close(done) })
+		<-done
+	}
+	return msg
+}
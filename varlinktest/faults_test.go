@@ -0,0 +1,130 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+
+	c1, c2 := net.Pipe()
+	client, server := varlink.NewSession(c1), varlink.NewSession(c2)
+	defer client.Close()
+	defer server.Close()
+
+	var faults varlinktest.Faults
+	client.InjectFaults(&faults)
+
+	calls := make(chan varlink.Call)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			var call varlink.Call
+			if err := server.ReadCall(ctx, &call); err != nil {
+				errs <- err
+				return
+			}
+			calls <- call
+		}
+	}()
+
+	faults.DropNextWrite()
+	for _, method := range []string{"org.example.Dropped", "org.example.Received"} {
+		call, _ := varlink.MakeCall(method, nil, varlink.OneWay())
+		if err := client.WriteCall(ctx, &call); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if call := <-calls; call.Method != "org.example.Received" {
+		t.Fatalf("received %s, expected the first call to be dropped", call.Method)
+	}
+
+	faults.CorruptNextWrite(nil)
+	call, _ := varlink.MakeCall("org.example.Corrupted", nil, varlink.OneWay())
+	if err := client.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("corrupted call was read without error")
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	ctx := context.Background()
+	clock := varlinktest.NewFakeClock(time.Unix(1000, 0))
+
+	c1, c2 := net.Pipe()
+	client, server := varlink.NewSession(c1), varlink.NewSession(c2)
+	defer client.Close()
+	defer server.Close()
+	client.SetClock(clock)
+
+	var faults varlinktest.Faults
+	faults.Clock = clock
+	faults.DelayReads(time.Second)
+	client.InjectFaults(&faults)
+
+	go func() {
+		var call varlink.Call
+		if err := server.ReadCall(ctx, &call); err != nil {
+			return
+		}
+		reply, _ := varlink.MakeReply(nil)
+		server.WriteReply(ctx, &reply)
+	}()
+
+	call, _ := varlink.MakeCall("org.example.Slow", nil)
+	if err := client.WriteCall(ctx, &call); err != nil {
+		t.Fatal(err)
+	}
+	rs := varlink.NewReplyStream(ctx, &call, client)
+
+	stalled := make(chan varlink.ReplyStreamStats, 1)
+	rs.OnStall(500*time.Millisecond, func(stats varlink.ReplyStreamStats) {
+		stalled <- stats
+	})
+
+	done := make(chan bool)
+	go func() { done <- rs.Next() }()
+
+	// The reply is held by the read delay until the clock advances past it,
+	// and the stall timer fires first.
+	var didStall bool
+	for {
+		select {
+		case stats := <-stalled:
+			didStall = true
+			if since := stats.SinceLastReply(); since < 500*time.Millisecond {
+				t.Errorf("stalled %v after the call, expected at least 500ms", since)
+			}
+		case <-done:
+			if err := rs.Error(); err != nil {
+				t.Fatal(err)
+			}
+			if !didStall {
+				t.Fatal("stall callback was not called before the delayed reply")
+			}
+			if stats := rs.Stats(); stats.LastReply.Before(time.Unix(1001, 0)) {
+				t.Fatalf("reply received at %v, before the read delay elapsed", stats.LastReply)
+			}
+			clock.Advance(2 * time.Second)
+			if since := rs.Stats().SinceLastReply(); since != 2*time.Second {
+				t.Fatalf("%v elapsed since the last reply, expected 2s", since)
+			}
+			return
+		case <-time.After(time.Millisecond):
+			clock.Advance(250 * time.Millisecond)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build !unix
+
+package varlinktest
+
+// On platforms without unix file descriptors, descriptors are passed as-is
+// and never closed by the pipe.
+
+func dup(fd uintptr) (uintptr, error) {
+	return fd, nil
+}
+
+func sysClose(fd uintptr) error {
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+//go:build unix
+
+package varlinktest
+
+import (
+	"os"
+	"syscall"
+)
+
+func dup(fd uintptr) (uintptr, error) {
+	syscall.ForkLock.RLock()
+	defer syscall.ForkLock.RUnlock()
+
+	newfd, err := syscall.Dup(int(fd))
+	if err != nil {
+		return ^uintptr(0), &os.SyscallError{Syscall: "dup", Err: err}
+	}
+	syscall.CloseOnExec(newfd)
+	return uintptr(newfd), nil
+}
+
+func sysClose(fd uintptr) error {
+	return syscall.Close(int(fd))
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+// Package varlinktest provides utilities for testing varlink clients and
+// services.
+package varlinktest
+
+import (
+	"net"
+	"sync"
+
+	"snai.pe/go-varlink"
+)
+
+// FdConn is one end of an in-memory connection created by FdPipe. It
+// implements varlink.FdPasser by transferring duplicated file descriptors
+// in-process, which allows exercising code paths that pass file descriptors
+// without real unix sockets.
+type FdConn struct {
+	net.Conn
+
+	peer *FdConn
+
+	wmu      sync.Mutex
+	wfds     []uintptr
+	wtotal   int64
+	rmu      sync.Mutex
+	rtotal   int64
+	rfds     []uintptr
+	incoming []fdBatch // protected by rmu
+}
+
+// fdBatch is a set of file descriptors that is made available to the reader
+// once it has read up to offset bytes.
+type fdBatch struct {
+	offset int64
+	fds    []uintptr
+}
+
+// FdPipe creates a synchronous, in-memory, full duplex connection, like
+// net.Pipe. Both ends of the connection support passing file descriptors.
+//
+// File descriptors passed with PassFds are duplicated when the next write
+// happens, and become available to the peer's CollectFds once it has read
+// the last byte of that write. Descriptors that are never collected are
+// closed when the receiving end is closed.
+func FdPipe() (*FdConn, *FdConn) {
+	c1, c2 := net.Pipe()
+	a, b := &FdConn{Conn: c1}, &FdConn{Conn: c2}
+	a.peer, b.peer = b, a
+	return a, b
+}
+
+// SessionPipe creates two varlink sessions connected to each other with
+// FdPipe.
+func SessionPipe() (*varlink.Session, *varlink.Session) {
+	a, b := FdPipe()
+	return varlink.NewSession(a), varlink.NewSession(b)
+}
+
+func (c *FdConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+
+	c.rtotal += int64(n)
+	i := 0
+	for ; i < len(c.incoming) && c.incoming[i].offset <= c.rtotal; i++ {
+		c.rfds = append(c.rfds, c.incoming[i].fds...)
+	}
+	c.incoming = c.incoming[i:]
+	return n, err
+}
+
+func (c *FdConn) Write(b []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	if len(c.wfds) > 0 && len(b) > 0 {
+		fds := make([]uintptr, 0, len(c.wfds))
+		for _, fd := range c.wfds {
+			newfd, err := dup(fd)
+			if err != nil {
+				for _, fd := range fds {
+					sysClose(fd)
+				}
+				return 0, err
+			}
+			fds = append(fds, newfd)
+		}
+		c.wfds = c.wfds[:0]
+
+		c.peer.rmu.Lock()
+		c.peer.incoming = append(c.peer.incoming, fdBatch{
+			offset: c.wtotal + int64(len(b)),
+			fds:    fds,
+		})
+		c.peer.rmu.Unlock()
+	}
+
+	n, err := c.Conn.Write(b)
+	c.wtotal += int64(n)
+	return n, err
+}
+
+// PassFds queues the specified file descriptors to be sent during the next
+// write.
+func (c *FdConn) PassFds(fds ...uintptr) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	c.wfds = append(c.wfds, fds...)
+}
+
+// CollectFds returns the file descriptors received during previous reads.
+func (c *FdConn) CollectFds() (fds []uintptr) {
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+
+	fds, c.rfds = c.rfds, nil
+	return fds
+}
+
+func (c *FdConn) Close() error {
+	err := c.Conn.Close()
+
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+
+	for _, fd := range c.rfds {
+		sysClose(fd)
+	}
+	for _, batch := range c.incoming {
+		for _, fd := range batch.fds {
+			sysClose(fd)
+		}
+	}
+	c.rfds, c.incoming = nil, nil
+	return err
+}
+
+var _ varlink.FdPasser = (*FdConn)(nil)
@@ -0,0 +1,74 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+func TestSessionPipeFdPassing(t *testing.T) {
+	client, server := varlinktest.SessionPipe()
+	defer client.Close()
+	defer server.Close()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	srv := varlink.Server{
+		Handler: varlink.HandlerFunc(func(rw varlink.ReplyWriter, call *varlink.Call) {
+			rw.WriteReply(nil, varlink.Fd(r.Fd()))
+		}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.ServeSession(ctx, server)
+
+	call, err := varlink.MakeCall("org.example.Open", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs, err := varlink.DefaultTransport.RoundTrip(ctx, client, &call)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rs.Next() {
+		t.Fatal(rs.Error())
+	}
+	if err := rs.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	fds := rs.Reply().FileDescriptors
+	if len(fds) != 1 {
+		t.Fatalf("received %d file descriptors, expected 1", len(fds))
+	}
+	received := os.NewFile(fds[0], "received")
+	defer received.Close()
+
+	if _, err := io.WriteString(w, "hello"); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	data, err := io.ReadAll(received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("read %q from received file descriptor, expected %q", data, "hello")
+	}
+}
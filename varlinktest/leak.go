@@ -0,0 +1,103 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// LeakTimeout is how long VerifyNoLeaks waits for goroutines to exit before
+// reporting them as leaked.
+var LeakTimeout = 5 * time.Second
+
+// VerifyNoLeaks takes a snapshot of the running goroutines, and registers a
+// cleanup function with t that fails the test if goroutines started since
+// then are still running at the end of the test. Goroutines are given
+// LeakTimeout to exit, since they typically do so shortly after the
+// connections they serve are closed.
+//
+// Goroutines started by the testing package are ignored. VerifyNoLeaks
+// cannot tell apart the goroutines of concurrent tests, and must not be
+// used by parallel tests.
+//
+// Clients must close the transports they used before the end of the test,
+// since transports keep idle sessions, and the goroutines serving them,
+// open:
+//
+//	func TestService(t *testing.T) {
+//		varlinktest.VerifyNoLeaks(t)
+//
+//		transport := &varlink.Transport{}
+//		defer transport.Close()
+//		...
+//	}
+func VerifyNoLeaks(t testing.TB) {
+	t.Helper()
+
+	before := make(map[string]bool)
+	for _, g := range goroutines() {
+		before[g.id] = true
+	}
+
+	t.Cleanup(func() {
+		var leaked []goroutine
+		deadline := time.Now().Add(LeakTimeout)
+		for delay := time.Millisecond; ; delay = min(2*delay, 100*time.Millisecond) {
+			leaked = leaked[:0]
+			for _, g := range goroutines() {
+				if !before[g.id] && !g.testing() {
+					leaked = append(leaked, g)
+				}
+			}
+			if len(leaked) == 0 || time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(delay)
+		}
+
+		for _, g := range leaked {
+			t.Errorf("leaked goroutine %s", g.stack)
+		}
+	})
+}
+
+// goroutine is a goroutine in a stack dump of all goroutines.
+type goroutine struct {
+	id    string
+	stack string
+}
+
+// testing returns whether the goroutine was started by the testing package.
+func (g goroutine) testing() bool {
+	return strings.Contains(g.stack, "\ncreated by testing.")
+}
+
+func goroutines() []goroutine {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	var gs []goroutine
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		// Stacks start with "goroutine <id> [<state>]:".
+		id, _, ok := strings.Cut(strings.TrimPrefix(string(stack), "goroutine "), " ")
+		if !ok {
+			continue
+		}
+		gs = append(gs, goroutine{id: id, stack: string(stack)})
+	}
+	return gs
+}
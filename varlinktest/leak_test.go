@@ -0,0 +1,51 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+func TestServeConnNoLeaks(t *testing.T) {
+	varlinktest.VerifyNoLeaks(t)
+
+	var returned atomic.Bool
+	started := make(chan struct{})
+
+	var mux varlink.ServeMux
+	mux.HandleFunc("org.example.Wait", func(w varlink.ReplyWriter, call *varlink.Call) {
+		close(started)
+		<-w.Context().Done()
+		returned.Store(true)
+	})
+	server := varlink.Server{Handler: &mux}
+
+	c1, c2 := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server.ServeConn(context.Background(), c2)
+	}()
+
+	client := varlink.NewSession(c1)
+	call, _ := varlink.MakeCall("org.example.Wait", nil)
+	if err := client.WriteCall(context.Background(), &call); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	client.Close()
+
+	<-done
+	if !returned.Load() {
+		t.Fatal("ServeConn returned before the handler")
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"snai.pe/go-varlink"
+)
+
+// ReplyWriter is a varlink.ReplyWriter that records the replies written by a
+// handler, for unit tests of handlers that do not need a session:
+//
+//	w := varlinktest.NewReplyWriter(t)
+//	call, _ := varlink.MakeCall("org.example.Ping", map[string]string{"ping": "hi"})
+//	handler.ServeMethod(w, &call)
+//
+//	var out struct{ Pong string }
+//	w.ExpectReply(&out)
+//	w.ExpectEnd()
+//
+// Like the ReplyWriter of a server, WriteMore only continues replies if More
+// is set, and writing after the final reply fails the test.
+type ReplyWriter struct {
+	// More is whether the call being served has the more flag.
+	More bool
+
+	// CallFunc, if set, serves the calls that the handler makes back to the
+	// client. Calls fail with ErrNoClient otherwise.
+	CallFunc func(method string, params any, opts ...varlink.CallOption) (*varlink.ReplyStream, error)
+
+	t       testing.TB
+	ctx     context.Context
+	mu      sync.Mutex
+	replies []varlink.Reply
+	next    int
+	done    bool
+}
+
+// ErrNoClient is returned by the Call method of a ReplyWriter without
+// CallFunc.
+var ErrNoClient = errors.New("varlinktest: no client to call back")
+
+// NewReplyWriter returns a ReplyWriter reporting to t, whose context is
+// canceled when the test ends.
+func NewReplyWriter(t testing.TB) *ReplyWriter {
+	return &ReplyWriter{t: t, ctx: t.Context()}
+}
+
+// WithContext returns a copy of w, without any replies, whose Context
+// returns ctx.
+func (w *ReplyWriter) WithContext(ctx context.Context) *ReplyWriter {
+	return &ReplyWriter{More: w.More, CallFunc: w.CallFunc, t: w.t, ctx: ctx}
+}
+
+// Context implements varlink.ReplyWriter.
+func (w *ReplyWriter) Context() context.Context {
+	return w.ctx
+}
+
+// WriteError implements varlink.ReplyWriter.
+func (w *ReplyWriter) WriteError(err varlink.Error) error {
+	return w.WriteReply(err, varlink.ErrorCode(err.ErrorCode()))
+}
+
+// WriteReply implements varlink.ReplyWriter.
+func (w *ReplyWriter) WriteReply(parameters any, opts ...varlink.ReplyOption) error {
+	reply, err := varlink.MakeReply(parameters, opts...)
+	if err != nil {
+		return err
+	}
+	return w.write(reply)
+}
+
+// WriteMore implements varlink.ReplyWriter.
+func (w *ReplyWriter) WriteMore(parameters any, opts ...varlink.ReplyOption) error {
+	return w.writeChecked(parameters, w.More, opts)
+}
+
+// WriteFinal implements varlink.ReplyWriter.
+func (w *ReplyWriter) WriteFinal(parameters any, opts ...varlink.ReplyOption) error {
+	return w.writeChecked(parameters, false, opts)
+}
+
+// Call implements varlink.ReplyWriter.
+func (w *ReplyWriter) Call(method string, params any, opts ...varlink.CallOption) (*varlink.ReplyStream, error) {
+	if w.CallFunc == nil {
+		return nil, ErrNoClient
+	}
+	return w.CallFunc(method, params, opts...)
+}
+
+func (w *ReplyWriter) writeChecked(parameters any, continues bool, opts []varlink.ReplyOption) error {
+	w.mu.Lock()
+	done := w.done
+	w.mu.Unlock()
+	if done {
+		return varlink.ErrReplied
+	}
+
+	reply, err := varlink.MakeReply(parameters, opts...)
+	if err != nil {
+		return err
+	}
+	reply.Continues = continues
+	return w.write(reply)
+}
+
+func (w *ReplyWriter) write(reply varlink.Reply) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.done {
+		w.t.Errorf("handler wrote reply %s after the final reply", reply.Parameters)
+		return varlink.ErrReplied
+	}
+	if reply.Continues && !w.More {
+		w.t.Errorf("handler wrote a continued reply to a call without the more flag")
+	}
+	w.done = !reply.Continues
+	w.replies = append(w.replies, reply)
+	return nil
+}
+
+// Replies returns all the replies written so far.
+func (w *ReplyWriter) Replies() []varlink.Reply {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]varlink.Reply(nil), w.replies...)
+}
+
+// ExpectReply checks that the next reply that was written is not an error,
+// decodes its parameters into v unless v is nil, and returns it, so that
+// its Continues flag and file descriptors can be inspected. It fails the
+// test otherwise.
+func (w *ReplyWriter) ExpectReply(v any) varlink.Reply {
+	w.t.Helper()
+
+	reply := w.pop()
+	if reply.Error != "" {
+		w.t.Fatalf("got error %s %s, expected a reply", reply.Error, reply.Parameters)
+	}
+	if v != nil {
+		if err := reply.Unmarshal(v); err != nil {
+			w.t.Fatalf("decoding reply %s: %v", reply.Parameters, err)
+		}
+	}
+	return reply
+}
+
+// ExpectErrorCode checks that the next reply that was written is an error
+// with the specified code, and returns it. It fails the test otherwise.
+func (w *ReplyWriter) ExpectErrorCode(code string) varlink.Reply {
+	w.t.Helper()
+
+	reply := w.pop()
+	switch reply.Error {
+	case code:
+	case "":
+		w.t.Fatalf("got reply %s, expected error %s", reply.Parameters, code)
+	default:
+		w.t.Fatalf("got error %s %s, expected error %s", reply.Error, reply.Parameters, code)
+	}
+	return reply
+}
+
+// ExpectEnd checks that all replies were consumed by ExpectReply and
+// ExpectErrorCode, and that the handler wrote a final reply.
+func (w *ReplyWriter) ExpectEnd() {
+	w.t.Helper()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if extra := w.replies[w.next:]; len(extra) > 0 {
+		w.t.Fatalf("got %d unexpected replies, starting with %s", len(extra), extra[0].Parameters)
+	}
+	if !w.done {
+		w.t.Fatalf("handler did not write a final reply")
+	}
+}
+
+func (w *ReplyWriter) pop() varlink.Reply {
+	w.t.Helper()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.next == len(w.replies) {
+		w.t.Fatalf("got %d replies, expected more", len(w.replies))
+	}
+	reply := w.replies[w.next]
+	w.next++
+	return reply
+}
+
+var _ varlink.ReplyWriter = (*ReplyWriter)(nil)
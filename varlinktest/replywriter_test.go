@@ -0,0 +1,62 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlinktest_test
+
+import (
+	"errors"
+	"testing"
+
+	"snai.pe/go-varlink"
+	"snai.pe/go-varlink/org.varlink.service"
+	"snai.pe/go-varlink/varlinktest"
+)
+
+func TestReplyWriter(t *testing.T) {
+	count := varlink.HandlerFunc(func(w varlink.ReplyWriter, call *varlink.Call) {
+		var in struct{ N int }
+		if err := call.Unmarshal(&in); err != nil || in.N < 0 {
+			w.WriteError(service.InvalidParameter("n"))
+			return
+		}
+		for i := range in.N {
+			w.WriteMore(map[string]int{"i": i}, varlink.Fd(uintptr(i)))
+		}
+		w.WriteFinal(nil)
+	})
+
+	call, err := varlink.MakeCall("org.example.Count", map[string]int{"n": 2}, varlink.More())
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := varlinktest.NewReplyWriter(t)
+	w.More = call.More
+	count.ServeMethod(w, &call)
+
+	for i := range 2 {
+		var out struct{ I int }
+		reply := w.ExpectReply(&out)
+		if out.I != i || !reply.Continues || len(reply.FileDescriptors) != 1 {
+			t.Fatalf("got reply %s (continues: %v, fds: %v), expected continued reply %d with a fd", reply.Parameters, reply.Continues, reply.FileDescriptors, i)
+		}
+	}
+	if reply := w.ExpectReply(nil); reply.Continues {
+		t.Fatal("last reply continues")
+	}
+	w.ExpectEnd()
+
+	call, err = varlink.MakeCall("org.example.Count", map[string]int{"n": -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w = varlinktest.NewReplyWriter(t)
+	count.ServeMethod(w, &call)
+	w.ExpectErrorCode("org.varlink.service.InvalidParameter")
+	w.ExpectEnd()
+
+	if _, err := w.Call("org.example.Back", nil); !errors.Is(err, varlinktest.ErrNoClient) {
+		t.Fatalf("calling back returned %v, expected ErrNoClient", err)
+	}
+}
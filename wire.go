@@ -0,0 +1,111 @@
+// Copyright 2026 Franklin "Snaipe" Mathieu.
+//
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package varlink
+
+import "encoding/json"
+
+// Names of the reserved fields of the messages on the wire.
+const (
+	FieldMethod     = "method"
+	FieldOneWay     = "oneway"
+	FieldMore       = "more"
+	FieldUpgrade    = "upgrade"
+	FieldContinues  = "continues"
+	FieldError      = "error"
+	FieldParameters = "parameters"
+	FieldExtensions = "extensions"
+)
+
+// Message is the envelope of the messages on the wire, which are either
+// calls, when Method is set, or replies. Sessions decode messages into a
+// Message before telling calls and replies apart; proxies, bridges and tests
+// can use it to construct and inspect raw messages.
+type Message struct {
+	// Method is the method called, or nil if the message is a reply.
+	Method *string `json:"method,omitempty"`
+
+	OneWay    bool   `json:"oneway,omitempty"`
+	More      bool   `json:"more,omitempty"`
+	Upgrade   bool   `json:"upgrade,omitempty"`
+	Continues bool   `json:"continues,omitempty"`
+	Error     string `json:"error,omitempty"`
+
+	Parameters json.RawMessage            `json:"parameters,omitempty"`
+	Extensions map[string]json.RawMessage `json:"extensions,omitempty"`
+
+	// FileDescriptors is the list of file descriptors sent or received with
+	// the message.
+	FileDescriptors []uintptr `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler. Unlike calls, replies always carry
+// their parameters, as an empty object if there are none, since most
+// implementations of varlink fail to decode replies without them.
+func (m Message) MarshalJSON() ([]byte, error) {
+	type message Message
+	if m.Method == nil && len(m.Parameters) == 0 {
+		m.Parameters = json.RawMessage("{}")
+	}
+	return json.Marshal(message(m))
+}
+
+// IsCall reports whether the message is a call.
+func (m *Message) IsCall() bool {
+	return m.Method != nil
+}
+
+// Call returns the call held by the message. The reply fields of the
+// message are ignored.
+func (m *Message) Call() Call {
+	var method string
+	if m.Method != nil {
+		method = *m.Method
+	}
+	return Call{
+		Method:          method,
+		OneWay:          m.OneWay,
+		More:            m.More,
+		Upgrade:         m.Upgrade,
+		Parameters:      m.Parameters,
+		Extensions:      m.Extensions,
+		FileDescriptors: m.FileDescriptors,
+	}
+}
+
+// Reply returns the reply held by the message. The call fields of the
+// message are ignored.
+func (m *Message) Reply() Reply {
+	return Reply{
+		Parameters:      m.Parameters,
+		Error:           m.Error,
+		Continues:       m.Continues,
+		FileDescriptors: m.FileDescriptors,
+	}
+}
+
+// Message returns the message carrying the call.
+func (call *Call) Message() Message {
+	method := call.Method
+	return Message{
+		Method:          &method,
+		OneWay:          call.OneWay,
+		More:            call.More,
+		Upgrade:         call.Upgrade,
+		Parameters:      call.Parameters,
+		Extensions:      call.Extensions,
+		FileDescriptors: call.FileDescriptors,
+	}
+}
+
+// Message returns the message carrying the reply.
+func (r *Reply) Message() Message {
+	return Message{
+		Continues:       r.Continues,
+		Error:           r.Error,
+		Parameters:      r.Parameters,
+		FileDescriptors: r.FileDescriptors,
+	}
+}